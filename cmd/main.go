@@ -1,6 +1,8 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"log"
 	"os"
@@ -12,10 +14,20 @@ import (
 	"github.com/airgap-solution/crypto-wallet-rest/internal/adapters/crypto/providers/kaspa"
 	"github.com/airgap-solution/crypto-wallet-rest/internal/adapters/crypto/providers/litecoin"
 	"github.com/airgap-solution/crypto-wallet-rest/internal/adapters/crypto/providers/solana"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/adapters/indexer/blockscout"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/adapters/indexer/etherscan"
 	"github.com/airgap-solution/crypto-wallet-rest/internal/adapters/provider"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/adapters/rates/cmc"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/adapters/rates/file"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/adapters/rates/httpjson"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/adapters/rates/median"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/adapters/rates/static"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/adapters/rates/testnet"
 	"github.com/airgap-solution/crypto-wallet-rest/internal/config"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
 	"github.com/airgap-solution/crypto-wallet-rest/internal/core/service"
 	"github.com/airgap-solution/crypto-wallet-rest/internal/ports"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/tracing"
 	"github.com/restartfu/gophig"
 )
 
@@ -25,27 +37,124 @@ func main() {
 		log.Fatalln(err)
 	}
 
-	cmcRestCfg := cmcrest.NewConfiguration()
-	cmcRestCfg.Scheme = "http"
-	cmcRestCfg.Host = conf.CMCRestAddr
-	cmcRestClient := cmcrest.NewAPIClient(cmcRestCfg)
+	shutdownTracing, err := tracing.Setup(context.Background(), conf.Tracing)
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	rateProvider, err := newRateProvider(conf)
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	historicalRateProvider, err := newHistoricalRateProvider(conf)
+	if err != nil {
+		log.Fatalln(err)
+	}
 
-	providerAdapter := provider.NewAdapter(cmcRestClient.DefaultAPI, map[string]ports.CryptoProvider{
+	ethMainnetIndexer, err := newIndexer(conf.Crypto.Ethereum.MainnetIndexer)
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	ethTestnetIndexer, err := newIndexer(conf.Crypto.Ethereum.TestnetIndexer)
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	providerAdapter := provider.NewAdapter(rateProvider, historicalRateProvider, map[string]ports.CryptoProvider{
 		"KAS":         kaspa.NewAdapter(conf.Crypto.Kaspa.MainnetRPC),
 		"BTC":         bitcoin.NewAdapter(conf.Crypto.Bitcoin.MainnetRPC, false),
 		"BTC_TESTNET": bitcoin.NewAdapter(conf.Crypto.Bitcoin.TestnetRPC, true),
 		"LTC":         litecoin.NewAdapter(conf.Crypto.Litecoin.MainnetRPC, false),
 		"LTC_TESTNET": litecoin.NewAdapter(conf.Crypto.Litecoin.TestnetRPC, true),
-		"ETH":         ethereum.NewAdapter(conf.Crypto.Ethereum.MainnetRPC),
-		"ETH_TESTNET": ethereum.NewAdapter(conf.Crypto.Ethereum.TestnetRPC),
-		"SOL":         solana.NewAdapter(conf.Crypto.Solana.MainnetRPC),
-		"SOL_TESTNET": solana.NewAdapter(conf.Crypto.Solana.TestnetRPC),
+		"ETH":         ethereum.NewAdapter(conf.Crypto.Ethereum.MainnetRPC, false, ethereum.WithIndexer(ethMainnetIndexer)),
+		"ETH_TESTNET": ethereum.NewAdapter(conf.Crypto.Ethereum.TestnetRPC, true, ethereum.WithIndexer(ethTestnetIndexer)),
+		"SOL":         solana.NewAdapter(conf.Crypto.Solana.MainnetRPC, false),
+		"SOL_TESTNET": solana.NewAdapter(conf.Crypto.Solana.TestnetRPC, true),
 	})
-	servicer := service.New(providerAdapter)
+	fiatSymbols, err := domain.NewFiatSymbols(conf.Rates.FiatSymbols)
+	if err != nil {
+		log.Fatalln(err)
+	}
+	servicer := service.New(providerAdapter, service.WithFiatSymbols(fiatSymbols))
 
 	srv := internal.Assemble(conf, servicer)
-	if err := srv.ListenAndServe(); err != nil {
-		log.Fatalln(err)
+	err = srv.ListenAndServe()
+	if shutdownErr := shutdownTracing(context.Background()); shutdownErr != nil {
+		log.Println(shutdownErr)
+	}
+	log.Fatalln(err)
+}
+
+func newRateProvider(conf config.Config) (ports.RateProvider, error) {
+	httpSources := make(map[string]config.HTTPJSONRateConfig, len(conf.Rates.HTTPJSON))
+	for _, source := range conf.Rates.HTTPJSON {
+		httpSources[source.Name] = source
+	}
+
+	sources := make([]ports.RateProvider, 0, len(conf.Rates.Sources))
+	for _, name := range conf.Rates.Sources {
+		switch name {
+		case cmc.SourceName:
+			cmcRestCfg := cmcrest.NewConfiguration()
+			cmcRestCfg.Scheme = "http"
+			cmcRestCfg.Host = conf.CMCRestAddr
+			sources = append(sources, cmc.NewAdapter(cmcrest.NewAPIClient(cmcRestCfg).DefaultAPI))
+		case static.SourceName:
+			sources = append(sources, static.NewAdapter(conf.Rates.Static.Prices))
+		default:
+			source, ok := httpSources[name]
+			if !ok {
+				return nil, fmt.Errorf("unknown rate source %q", name)
+			}
+			sources = append(sources, httpjson.NewAdapter(source.Name, source.URL, source.RatePath, source.Change24hPath))
+		}
+	}
+	if len(sources) == 0 {
+		return nil, errors.New("no rate sources configured")
+	}
+
+	rateProvider := sources[0]
+	if len(sources) > 1 {
+		rateProvider = median.NewAdapter(conf.Rates.MaxDeviation, sources...)
+	}
+
+	return testnet.NewAdapter(rateProvider, testnet.Mode(conf.Rates.Testnet.Mode), conf.Rates.Testnet.FixedRate)
+}
+
+func newHistoricalRateProvider(conf config.Config) (ports.HistoricalRateProvider, error) {
+	var source ports.HistoricalRateProvider
+	switch conf.Rates.Historical.Source {
+	case "":
+		return nil, nil
+	case cmc.SourceName:
+		source = cmc.NewHistoricalAdapter("http://" + conf.CMCRestAddr)
+	case file.SourceName:
+		adapter, err := file.NewAdapter(conf.Rates.Historical.PriceFile)
+		if err != nil {
+			return nil, err
+		}
+		source = adapter
+	default:
+		return nil, fmt.Errorf("unknown historical rate source %q", conf.Rates.Historical.Source)
+	}
+
+	return testnet.NewHistoricalAdapter(source, testnet.Mode(conf.Rates.Testnet.Mode), conf.Rates.Testnet.FixedRate)
+}
+
+// newIndexer returns the history indexer of an EVM network, or nil when none
+// is configured.
+func newIndexer(conf config.IndexerConfig) (ports.TransactionIndexer, error) {
+	switch conf.Kind {
+	case "":
+		return nil, nil
+	case etherscan.SourceName:
+		return etherscan.NewAdapter(conf.URL, conf.APIKey, conf.ChainID), nil
+	case blockscout.SourceName:
+		return blockscout.NewAdapter(conf.URL, conf.APIKey), nil
+	default:
+		return nil, fmt.Errorf("unknown indexer %q", conf.Kind)
 	}
 }
 
@@ -0,0 +1,506 @@
+package bitcoin
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"math/big"
+	"slices"
+	"strconv"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/tracing"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// incrementalRelayFee in sat/vB is what a replacement pays on top of the
+// fee of the transaction it replaces, for its own size, under BIP-125.
+const incrementalRelayFee = 1.0
+
+var (
+	ErrUnknownTransaction = &domain.Error{
+		Code:    domain.CodeBadRequest,
+		Message: "transaction is not in the history of the wallet",
+	}
+	ErrTxConfirmed = &domain.Error{
+		Code:    domain.CodeBadRequest,
+		Message: "transaction is already confirmed",
+	}
+	ErrForeignInput = &domain.Error{
+		Code:    domain.CodeBadRequest,
+		Message: "transaction spends outputs the wallet does not hold, only their owner can replace it",
+	}
+	ErrNothingToSpend = &domain.Error{
+		Code:    domain.CodeBadRequest,
+		Message: "transaction has no unspent output of the wallet for a child to spend",
+	}
+	ErrFeeRateNotHigher = &domain.Error{
+		Code:    domain.CodeBadRequest,
+		Message: "fee rate must be above the rate the transaction already pays",
+	}
+)
+
+// walletKey is the key deriving a used address of a wallet, nil for a single
+// address, and whether change goes to the address.
+type walletKey struct {
+	pubKey      *btcec.PublicKey
+	path        string
+	fingerprint uint32
+	change      bool
+}
+
+// walletActivity is what a scan of the used addresses of a wallet learns:
+// the key of each address, the height of each transaction touching them and
+// the index after the last used address of each branch.
+type walletActivity struct {
+	keys    map[string]walletKey
+	heights map[string]int32
+	next    []uint32
+}
+
+// stuckTx is an unconfirmed transaction of a wallet with the outputs it
+// spends, its fee and the virtual size it was relayed with.
+type stuckTx struct {
+	txid     string
+	tx       *wire.MsgTx
+	prevOuts []*wire.TxOut
+	fee      int64
+	vsize    int64
+}
+
+func (s *stuckTx) feeRate() float64 {
+	return float64(s.fee) / float64(s.vsize)
+}
+
+// BumpFee builds a PSBT speeding up request.TransactionID, an unconfirmed
+// transaction of the wallet request.From. With domain.FeeBumpRBF it spends
+// the same inputs to the same outputs with less change, paying the fee rate
+// and the BIP-125 absolute and incremental fees. With domain.FeeBumpCPFP a
+// child spends the largest unspent wallet output of the transaction to a
+// change address so that both pay the fee rate together. The fee rate
+// defaults to the normal estimate, at least the incremental relay fee above
+// the rate already paid. Unconfirmed ancestors of the transaction are not
+// taken into account.
+func (a *Adapter) BumpFee(ctx context.Context, request domain.FeeBumpRequest) (*domain.UnsignedTx, error) {
+	ctx, span := tracer.Start(ctx, "bitcoin.BumpFee", trace.WithAttributes(a.spanAttributes()...))
+	tx, err := a.bumpFee(ctx, request)
+	tracing.End(span, err)
+	return tx, err
+}
+
+func (a *Adapter) bumpFee(ctx context.Context, request domain.FeeBumpRequest) (*domain.UnsignedTx, error) {
+	if request.Method != domain.FeeBumpRBF && request.Method != domain.FeeBumpCPFP {
+		return nil, fmt.Errorf("%w: method must be %s or %s", domain.ErrBadRequest, domain.FeeBumpRBF,
+			domain.FeeBumpCPFP)
+	}
+	if _, err := chainhash.NewHashFromStr(request.TransactionID); err != nil {
+		return nil, fmt.Errorf("%w: invalid transaction id %q", domain.ErrBadRequest, request.TransactionID)
+	}
+	if request.FeeRate != 0 && request.FeeRate < MinFeeRate {
+		return nil, ErrFeeRateTooLow
+	}
+	branches, script, single, err := a.utxoSource(request.From)
+	if err != nil {
+		return nil, err
+	}
+	if _, nested := single.(*btcutil.AddressScriptHash); nested {
+		return nil, ErrNestedAddress
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, BuildTimeout)
+	defer cancel()
+
+	client := a.getClient()
+	if client.IsShutdown() {
+		a.connectWithRetry()
+		client = a.getClient()
+	}
+
+	fetcher := a.historyFetcher(client)
+	activity, err := a.walletActivity(ctx, fetcher, branches, script, single)
+	if err != nil {
+		return nil, domain.UpstreamError(err)
+	}
+	height, ok := activity.heights[request.TransactionID]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownTransaction, request.TransactionID)
+	}
+	if height > 0 {
+		return nil, fmt.Errorf("%w: %s", ErrTxConfirmed, request.TransactionID)
+	}
+	stuck, err := fetcher.stuckTx(ctx, request.TransactionID)
+	if err != nil {
+		return nil, domain.UpstreamError(err)
+	}
+
+	feeRate := request.FeeRate
+	if feeRate == 0 {
+		if feeRate, err = a.normalFeeRate(ctx, client); err != nil {
+			return nil, err
+		}
+		feeRate = max(feeRate, math.Ceil(stuck.feeRate()+incrementalRelayFee))
+	} else if feeRate <= stuck.feeRate() {
+		return nil, fmt.Errorf("%w: %s sat/vB paid", ErrFeeRateNotHigher,
+			strconv.FormatFloat(stuck.feeRate(), 'f', 2, 64))
+	}
+
+	if request.Method == domain.FeeBumpRBF {
+		return a.replaceByFee(ctx, fetcher, request.From, stuck, activity, script, feeRate)
+	}
+	var change *changeOutput
+	if single != nil {
+		change, err = addressChange(single)
+	} else {
+		change, err = walletChange(branches, activity.next, script, a.isTestnet)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return a.childPaysForParent(ctx, fetcher, request.From, stuck, activity, change, feeRate)
+}
+
+// replaceByFee builds the BIP-125 replacement of stuck, which must only
+// spend outputs of the wallet from.
+func (a *Adapter) replaceByFee(
+	ctx context.Context, fetcher *historyFetcher, from string, stuck *stuckTx, activity *walletActivity,
+	script scriptType, feeRate float64,
+) (*domain.UnsignedTx, error) {
+	outputs := make([]walletOutput, len(stuck.tx.TxIn))
+	for i, in := range stuck.tx.TxIn {
+		prevOut := stuck.prevOuts[i]
+		addr := fetcher.outputAddress(prevOut.PkScript)
+		key, ok := activity.keys[addr]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrForeignInput, in.PreviousOutPoint)
+		}
+		outputs[i] = walletOutput{
+			UTXO: domain.UTXO{
+				TransactionID:  in.PreviousOutPoint.Hash.String(),
+				Vout:           in.PreviousOutPoint.Index,
+				Value:          prevOut.Value,
+				ScriptPubKey:   hex.EncodeToString(prevOut.PkScript),
+				Address:        addr,
+				DerivationPath: key.path,
+			},
+			pubKey:      key.pubKey,
+			fingerprint: key.fingerprint,
+		}
+	}
+	inputs, err := spendables(outputs)
+	if err != nil {
+		return nil, err
+	}
+
+	// The last output paying the change of the wallet gives up the fee.
+	changeIndex := -1
+	var change *changeOutput
+	for i, out := range stuck.tx.TxOut {
+		if key, ok := activity.keys[fetcher.outputAddress(out.PkScript)]; ok && key.change {
+			changeIndex = i
+		}
+	}
+	if changeIndex >= 0 {
+		out := stuck.tx.TxOut[changeIndex]
+		addr, err := btcutil.DecodeAddress(fetcher.outputAddress(out.PkScript), fetcher.params)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode change address: %w", err)
+		}
+		if change, err = keyChange(addr, activity.keys[addr.EncodeAddress()], script); err != nil {
+			return nil, err
+		}
+	}
+
+	plan, err := replaceTx(stuck.tx, inputs, changeIndex, stuck.fee, feeRate)
+	if err != nil {
+		return nil, err
+	}
+	payload, signed, err := planPSBT(ctx, fetcher, plan, change)
+	if err != nil {
+		return nil, err
+	}
+
+	var paid []domain.Recipient
+	var opReturn []byte
+	var amount int64
+	kept := plan.tx.TxOut
+	if plan.change > 0 {
+		kept = kept[:len(kept)-1]
+	}
+	for _, out := range kept {
+		if txscript.GetScriptClass(out.PkScript) == txscript.NullDataTy {
+			if data, err := txscript.PushedData(out.PkScript); err == nil && len(data) > 0 {
+				opReturn = data[0]
+			}
+			continue
+		}
+		amount += out.Value
+		paid = append(paid, domain.Recipient{
+			Address: fetcher.outputAddress(out.PkScript),
+			Amount:  domain.FormatUnits(big.NewInt(out.Value), btcDecimals),
+		})
+	}
+	var changeAddress string
+	if change != nil {
+		changeAddress = change.address
+	}
+	details := append([]domain.TxDetail{{Label: "Replaces", Value: stuck.txid}},
+		txDetails(plan, paid, opReturn, changeAddress, feeRate)...)
+	details = append(details, domain.TxDetail{
+		Label: "Replaced fee",
+		Value: domain.FormatUnits(big.NewInt(stuck.fee), btcDecimals) + " BTC",
+	})
+
+	tx := &domain.UnsignedTx{
+		From:     from,
+		Amount:   domain.FormatUnits(big.NewInt(amount), btcDecimals),
+		Fee:      domain.FormatUnits(big.NewInt(plan.fee), btcDecimals),
+		Payload:  payload,
+		Encoding: domain.EncodingBase64,
+		Inputs:   signed,
+		Details:  details,
+	}
+	if len(paid) == 1 {
+		tx.To = paid[0].Address
+	} else {
+		tx.Recipients = paid
+	}
+	return tx, nil
+}
+
+// childPaysForParent builds a child spending the largest output of stuck
+// still unspent in the wallet from, paying it to change.
+func (a *Adapter) childPaysForParent(
+	ctx context.Context, fetcher *historyFetcher, from string, stuck *stuckTx, activity *walletActivity,
+	change *changeOutput, feeRate float64,
+) (*domain.UnsignedTx, error) {
+	var outputs []walletOutput
+	for vout, out := range stuck.tx.TxOut {
+		address := fetcher.outputAddress(out.PkScript)
+		key, ok := activity.keys[address]
+		if !ok {
+			continue
+		}
+		addr, err := btcutil.DecodeAddress(address, fetcher.params)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode wallet address: %w", err)
+		}
+		utxos, err := a.walletUnspent(ctx, fetcher.client, addr, key.path)
+		if err != nil {
+			return nil, domain.UpstreamError(err)
+		}
+		for _, utxo := range utxos {
+			if utxo.TransactionID == stuck.txid && int(utxo.Vout) == vout {
+				outputs = append(outputs, walletOutput{UTXO: utxo, pubKey: key.pubKey, fingerprint: key.fingerprint})
+			}
+		}
+	}
+	if len(outputs) == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrNothingToSpend, stuck.txid)
+	}
+	inputs, err := spendables(outputs)
+	if err != nil {
+		return nil, err
+	}
+
+	plan, err := childTx(inputs[0], stuck.fee, stuck.vsize, feeRate, change.script)
+	if err != nil {
+		return nil, err
+	}
+	payload, signed, err := planPSBT(ctx, fetcher, plan, change)
+	if err != nil {
+		return nil, err
+	}
+
+	amount := domain.FormatUnits(big.NewInt(plan.change), btcDecimals)
+	return &domain.UnsignedTx{
+		From:     from,
+		To:       change.address,
+		Amount:   amount,
+		Fee:      domain.FormatUnits(big.NewInt(plan.fee), btcDecimals),
+		Payload:  payload,
+		Encoding: domain.EncodingBase64,
+		Inputs:   signed,
+		Details: []domain.TxDetail{
+			{Label: "Speeds up", Value: stuck.txid},
+			{Label: "To", Value: change.address},
+			{Label: "Amount", Value: amount + " BTC"},
+			{Label: "Size", Value: strconv.FormatInt(plan.vsize, 10) + " vB"},
+			{Label: "Package fee rate", Value: strconv.FormatFloat(feeRate, 'f', -1, 64) + " sat/vB"},
+			{Label: "Parent fee", Value: domain.FormatUnits(big.NewInt(stuck.fee), btcDecimals) + " BTC"},
+			{Label: "Fee", Value: domain.FormatUnits(big.NewInt(plan.fee), btcDecimals) + " BTC"},
+		},
+	}, nil
+}
+
+// replaceTx plans the replacement of orig, which spends inputs and pays its
+// change, if any, to the output at changeIndex. The other outputs are kept
+// and the change pays the fee of the replacement's virtual size at feeRate
+// sat/vB, at least origFee and the incremental relay fee for that size as
+// BIP-125 requires. Change that would be dust is left to the fee.
+func replaceTx(orig *wire.MsgTx, inputs []spendable, changeIndex int, origFee int64, feeRate float64) (*txPlan, error) {
+	pay := &payment{sequence: sequenceReplaceable, lockTime: orig.LockTime}
+	for i, out := range orig.TxOut {
+		if i != changeIndex {
+			pay.outputs = append(pay.outputs, out)
+			pay.amount += out.Value
+		}
+	}
+	var total int64
+	for _, input := range inputs {
+		total += input.Value
+	}
+	fee := func(vsize int64) int64 {
+		return max(int64(math.Ceil(float64(vsize)*feeRate)),
+			origFee+int64(math.Ceil(float64(vsize)*incrementalRelayFee)))
+	}
+
+	tx := unsignedTransaction(inputs, pay)
+	if changeIndex >= 0 {
+		changeScript := orig.TxOut[changeIndex].PkScript
+		vsize := txVsize(inputs, append(slices.Clone(tx.TxOut), wire.NewTxOut(0, changeScript)))
+		fee := fee(vsize)
+		if change := total - pay.amount - fee; change >= dustThreshold(changeScript) {
+			tx.AddTxOut(wire.NewTxOut(change, changeScript))
+			return &txPlan{tx: tx, inputs: inputs, change: change, fee: fee, vsize: vsize}, nil
+		}
+	}
+
+	vsize := txVsize(inputs, tx.TxOut)
+	if needed := fee(vsize); total-pay.amount < needed {
+		return nil, fmt.Errorf("%w: the replacement needs a fee of %s BTC but the change only leaves %s BTC, "+
+			"speed the transaction up with %s instead", domain.ErrInsufficientFunds,
+			domain.FormatUnits(big.NewInt(needed), btcDecimals),
+			domain.FormatUnits(big.NewInt(total-pay.amount), btcDecimals), domain.FeeBumpCPFP)
+	}
+	return &txPlan{tx: tx, inputs: inputs, fee: total - pay.amount, vsize: vsize}, nil
+}
+
+// childTx plans a transaction spending input, an output of a parent paying
+// parentFee for parentVsize, to changeScript with the fee that brings both
+// to feeRate sat/vB, and at least the minimum relay fee of its own.
+func childTx(input spendable, parentFee, parentVsize int64, feeRate float64, changeScript []byte) (*txPlan, error) {
+	inputs := []spendable{input}
+	tx := unsignedTransaction(inputs, &payment{sequence: sequenceReplaceable})
+	vsize := txVsize(inputs, []*wire.TxOut{wire.NewTxOut(0, changeScript)})
+	fee := max(int64(math.Ceil(float64(parentVsize+vsize)*feeRate))-parentFee,
+		int64(math.Ceil(float64(vsize)*MinFeeRate)))
+	value := input.Value - fee
+	if value < dustThreshold(changeScript) {
+		return nil, fmt.Errorf("%w: the child needs a fee of %s BTC but spends %s BTC", domain.ErrInsufficientFunds,
+			domain.FormatUnits(big.NewInt(fee), btcDecimals), domain.FormatUnits(big.NewInt(input.Value), btcDecimals))
+	}
+	tx.AddTxOut(wire.NewTxOut(value, changeScript))
+	return &txPlan{tx: tx, inputs: inputs, change: value, fee: fee, vsize: vsize}, nil
+}
+
+// planPSBT fetches the transactions spent by the inputs of plan that need
+// them and returns its PSBT with the inputs it signs.
+func planPSBT(
+	ctx context.Context, fetcher *historyFetcher, plan *txPlan, change *changeOutput,
+) ([]byte, []domain.UnsignedTxInput, error) {
+	prevTxs := make([]*wire.MsgTx, len(plan.inputs))
+	for i, input := range plan.inputs {
+		if input.kind == inputTaproot {
+			continue
+		}
+		var err error
+		if prevTxs[i], err = fetcher.rawTransaction(ctx, input.TransactionID); err != nil {
+			return nil, nil, domain.UpstreamError(err)
+		}
+	}
+	payload, err := psbtPacket(plan, prevTxs, change)
+	if err != nil {
+		return nil, nil, err
+	}
+	signed, err := signingInputs(plan)
+	if err != nil {
+		return nil, nil, err
+	}
+	return payload, signed, nil
+}
+
+// walletActivity scans the used addresses of the wallet of branches, or the
+// single address, for their keys and transactions. Addresses on the change
+// branch, or every address of wallets without one, take change.
+func (a *Adapter) walletActivity(
+	ctx context.Context, fetcher *historyFetcher, branches []branch, script scriptType, single btcutil.Address,
+) (*walletActivity, error) {
+	activity := &walletActivity{
+		keys:    make(map[string]walletKey),
+		heights: make(map[string]int32),
+		next:    make([]uint32, len(branches)),
+	}
+	record := func(addr btcutil.Address, key walletKey) (bool, error) {
+		entries, err := fetcher.history(ctx, addr)
+		if err != nil || len(entries) == 0 {
+			return false, err
+		}
+		activity.keys[addr.EncodeAddress()] = key
+		for _, entry := range entries {
+			activity.heights[entry.Hash] = entry.Height
+		}
+		return true, nil
+	}
+
+	if single != nil {
+		_, err := record(single, walletKey{change: true})
+		return activity, err
+	}
+	for i, b := range branches {
+		n, err := scanChain(b.root, script, a.isTestnet, func(index int, addr btcutil.Address) (bool, error) {
+			child := uint32(index) //nolint:gosec // bounded by deriveAddresses
+			pubKey, err := childPubKey(b.root, child)
+			if err != nil {
+				return false, err
+			}
+			return record(addr, walletKey{
+				pubKey:      pubKey,
+				path:        childPath(b.path, child),
+				fingerprint: b.fingerprint,
+				change:      len(branches) == 1 || i == ChangeChain,
+			})
+		})
+		if err != nil {
+			return nil, err
+		}
+		activity.next[i] = uint32(n) //nolint:gosec // bounded by deriveAddresses
+	}
+	return activity, nil
+}
+
+// stuckTx fetches txid with the outputs it spends, working out its fee and
+// virtual size.
+func (h *historyFetcher) stuckTx(ctx context.Context, txid string) (*stuckTx, error) {
+	tx, err := h.rawTransaction(ctx, txid)
+	if err != nil {
+		return nil, err
+	}
+	stuck := &stuckTx{txid: txid, tx: tx, prevOuts: make([]*wire.TxOut, len(tx.TxIn)), vsize: signedVsize(tx)}
+	for i, in := range tx.TxIn {
+		prevTx, err := h.rawTransaction(ctx, in.PreviousOutPoint.Hash.String())
+		if err != nil {
+			return nil, err
+		}
+		if int(in.PreviousOutPoint.Index) >= len(prevTx.TxOut) {
+			return nil, fmt.Errorf("input %s of %s references a missing output", in.PreviousOutPoint, txid)
+		}
+		stuck.prevOuts[i] = prevTx.TxOut[in.PreviousOutPoint.Index]
+		stuck.fee += stuck.prevOuts[i].Value
+	}
+	for _, out := range tx.TxOut {
+		stuck.fee -= out.Value
+	}
+	return stuck, nil
+}
+
+// signedVsize is the virtual size of a signed transaction.
+func signedVsize(tx *wire.MsgTx) int64 {
+	weight := tx.SerializeSizeStripped()*(witnessScaleFactor-1) + tx.SerializeSize()
+	return int64((weight + witnessScaleFactor - 1) / witnessScaleFactor)
+}
@@ -0,0 +1,74 @@
+package bitcoin_test
+
+import (
+	"testing"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/adapters/crypto/providers/bitcoin"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplacePSBT(t *testing.T) {
+	t.Parallel()
+
+	recipient, err := bitcoin.ReceiveAddresses(bip84Zpub, 10, 1, false)
+	require.NoError(t, err)
+	request := domain.UnsignedTxRequest{To: recipient[0].Address, Amount: "0.002"}
+
+	orig, packet, origFee, fee, err := bitcoin.ReplacePSBT(testDescriptor, []int64{1_000_000}, request, 2, 10)
+	require.NoError(t, err)
+
+	// The replacement spends the same input to the same recipient and takes
+	// the higher fee out of the change.
+	assert.Equal(t, int64(2*bitcoin.TypicalTxVsize), origFee)
+	assert.Equal(t, int64(10*bitcoin.TypicalTxVsize), fee)
+	tx := packet.UnsignedTx
+	require.Len(t, tx.TxIn, 1)
+	assert.Equal(t, orig.TxIn[0].PreviousOutPoint, tx.TxIn[0].PreviousOutPoint)
+	assert.Equal(t, uint32(wire.MaxTxInSequenceNum-2), tx.TxIn[0].Sequence)
+	require.Len(t, tx.TxOut, 2)
+	assert.Equal(t, orig.TxOut[0], tx.TxOut[0])
+	assert.Equal(t, orig.TxOut[1].PkScript, tx.TxOut[1].PkScript)
+	assert.Equal(t, orig.TxOut[1].Value-(fee-origFee), tx.TxOut[1].Value)
+	require.Len(t, packet.Outputs[1].Bip32Derivation, 1)
+	require.NotNil(t, packet.Inputs[0].NonWitnessUtxo)
+
+	// Just above the original rate the replacement still pays the original
+	// fee and 1 sat/vB for its own size.
+	_, _, origFee, fee, err = bitcoin.ReplacePSBT(testDescriptor, []int64{1_000_000}, request, 10, 10.5)
+	require.NoError(t, err)
+	assert.Equal(t, origFee+bitcoin.TypicalTxVsize, fee)
+}
+
+func TestReplacePSBT_NoChange(t *testing.T) {
+	t.Parallel()
+
+	recipient, err := bitcoin.ReceiveAddresses(bip84Zpub, 10, 1, false)
+	require.NoError(t, err)
+
+	_, _, _, _, err = bitcoin.ReplacePSBT(testDescriptor, []int64{1_000_000},
+		domain.UnsignedTxRequest{To: recipient[0].Address, Amount: domain.AmountMax}, 2, 10)
+	require.ErrorIs(t, err, domain.ErrInsufficientFunds)
+}
+
+func TestChildPSBT(t *testing.T) {
+	t.Parallel()
+
+	// A one input, one output P2WPKH child is 110 vB, so at 10 sat/vB it pays
+	// for 251 vB less the 141 sat the parent pays.
+	packet, fee, err := bitcoin.ChildPSBT(testDescriptor, 100_000, 141, 141, 10)
+	require.NoError(t, err)
+	assert.Equal(t, int64(10*(141+110)-141), fee)
+
+	tx := packet.UnsignedTx
+	require.Len(t, tx.TxIn, 1)
+	require.Len(t, tx.TxOut, 1)
+	assert.Equal(t, 100_000-fee, tx.TxOut[0].Value)
+	require.Len(t, packet.Outputs[0].Bip32Derivation, 1)
+	assert.Equal(t, []uint32{84 + hardened, hardened, hardened, 1, 0}, packet.Outputs[0].Bip32Derivation[0].Bip32Path)
+
+	_, _, err = bitcoin.ChildPSBT(testDescriptor, 2_000, 141, 141, 10)
+	require.ErrorIs(t, err, domain.ErrInsufficientFunds)
+}
@@ -0,0 +1,154 @@
+package bitcoin
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	hd "github.com/btcsuite/btcd/btcutil/hdkeychain"
+)
+
+var ErrUnsupportedDescriptor = errors.New("unsupported output descriptor")
+
+// descriptorScripts maps the script expressions of single key descriptors to
+// the script type they derive, innermost expression last.
+var descriptorScripts = []struct {
+	prefix string
+	script scriptType
+}{
+	{"sh(wpkh(", scriptNestedWitnessPubKeyHash},
+	{"wpkh(", scriptWitnessPubKeyHash},
+	{"tr(", scriptTaproot},
+}
+
+// isDescriptor tells output descriptors apart from addresses and bare keys.
+func isDescriptor(s string) bool {
+	return strings.Contains(s, "(")
+}
+
+// parseDescriptor reads a single key wpkh, sh(wpkh) or tr descriptor such as
+// wpkh([d34db33f/84h/0h/0h]xpub.../<0;1>/*) into the branches it ranges over.
+// A key without derivation steps is read like a bare extended key, so an
+// account key scans both its external and change chains.
+func parseDescriptor(desc string, coinType uint32) ([]branch, scriptType, error) {
+	desc, _, _ = strings.Cut(strings.TrimSpace(desc), "#")
+
+	script, expr, err := descriptorKey(desc)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	origin := ""
+	var fingerprint uint32
+	if strings.HasPrefix(expr, "[") {
+		end := strings.Index(expr, "]")
+		if end < 0 {
+			return nil, 0, fmt.Errorf("%w: unterminated key origin", ErrUnsupportedDescriptor)
+		}
+		fingerprint, origin, err = originPath(expr[1:end])
+		if err != nil {
+			return nil, 0, err
+		}
+		expr = expr[end+1:]
+	}
+
+	steps := strings.Split(expr, "/")
+	key, err := hd.NewKeyFromString(steps[0])
+	if err != nil {
+		return nil, 0, fmt.Errorf("bad xpub: %w", err)
+	}
+	steps = steps[1:]
+
+	if len(steps) == 0 {
+		if origin == "" {
+			origin = accountPath(key, script, coinType)
+		}
+		branches, err := walletBranches(key, origin)
+		for i := range branches {
+			branches[i].fingerprint = fingerprint
+		}
+		return branches, script, err
+	}
+	if steps[len(steps)-1] != "*" {
+		return nil, 0, fmt.Errorf("%w: only ranged descriptors ending in /* are supported", ErrUnsupportedDescriptor)
+	}
+
+	branches := []branch{{root: key, path: origin, fingerprint: fingerprint}}
+	for _, step := range steps[:len(steps)-1] {
+		indexes, err := stepIndexes(step)
+		if err != nil {
+			return nil, 0, err
+		}
+		next := make([]branch, 0, len(branches)*len(indexes))
+		for _, b := range branches {
+			for _, index := range indexes {
+				child, err := b.root.Derive(index)
+				if err != nil {
+					return nil, 0, fmt.Errorf("derive step %d: %w", index, err)
+				}
+				next = append(next, branch{root: child, path: childPath(b.path, index), fingerprint: b.fingerprint})
+			}
+		}
+		branches = next
+	}
+	return branches, script, nil
+}
+
+// descriptorKey strips the script expression around the key expression.
+func descriptorKey(desc string) (scriptType, string, error) {
+	for _, d := range descriptorScripts {
+		if !strings.HasPrefix(desc, d.prefix) {
+			continue
+		}
+		closing := strings.Repeat(")", strings.Count(d.prefix, "("))
+		if !strings.HasSuffix(desc, closing) {
+			return 0, "", fmt.Errorf("%w: unbalanced parentheses", ErrUnsupportedDescriptor)
+		}
+		return d.script, desc[len(d.prefix) : len(desc)-len(closing)], nil
+	}
+	return 0, "", fmt.Errorf("%w: %s", ErrUnsupportedDescriptor, desc)
+}
+
+// originPath turns a key origin such as d34db33f/84h/0h/0h into the master
+// key fingerprint, as PSBTs store it, and the path m/84'/0'/0'.
+func originPath(origin string) (uint32, string, error) {
+	parts := strings.Split(origin, "/")
+	fingerprint, err := hex.DecodeString(parts[0])
+	if err != nil || len(fingerprint) != 4 {
+		return 0, "", fmt.Errorf("%w: bad fingerprint %q", ErrUnsupportedDescriptor, parts[0])
+	}
+	path := "m"
+	for _, part := range parts[1:] {
+		hardened := strings.HasSuffix(part, "h") || strings.HasSuffix(part, "'")
+		index, err := strconv.ParseUint(strings.TrimRight(part, "h'"), 10, 31)
+		if err != nil {
+			return 0, "", fmt.Errorf("%w: bad origin step %q", ErrUnsupportedDescriptor, part)
+		}
+		path += "/" + strconv.FormatUint(index, 10)
+		if hardened {
+			path += "'"
+		}
+	}
+	return binary.LittleEndian.Uint32(fingerprint), path, nil
+}
+
+// stepIndexes parses an unhardened derivation step, either a single index or
+// a BIP389 multipath step such as <0;1>.
+func stepIndexes(step string) ([]uint32, error) {
+	if strings.HasPrefix(step, "<") && strings.HasSuffix(step, ">") {
+		step = step[1 : len(step)-1]
+	}
+	parts := strings.Split(step, ";")
+	indexes := make([]uint32, len(parts))
+	for i, part := range parts {
+		index, err := strconv.ParseUint(part, 10, 31)
+		if err != nil {
+			return nil, fmt.Errorf("%w: bad derivation step %q", ErrUnsupportedDescriptor, part)
+		}
+		indexes[i] = uint32(index)
+	}
+	return indexes, nil
+}
@@ -0,0 +1,176 @@
+package bitcoin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/tracing"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lamengao/go-electrum/electrum"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// GetTransactionDetail decodes txid with the outputs it spends. With wallet
+// set, the inputs and outputs paying the addresses of that xpub are marked
+// owned.
+func (a *Adapter) GetTransactionDetail(ctx context.Context, txid, wallet string) (*domain.TransactionDetail, error) {
+	ctx, span := tracer.Start(ctx, "bitcoin.GetTransactionDetail", trace.WithAttributes(a.spanAttributes()...))
+	detail, err := a.getTransactionDetail(ctx, txid, wallet)
+	tracing.End(span, err)
+	return detail, err
+}
+
+func (a *Adapter) getTransactionDetail(ctx context.Context, txid, wallet string) (*domain.TransactionDetail, error) {
+	if _, err := chainhash.NewHashFromStr(txid); err != nil {
+		return nil, fmt.Errorf("%w: invalid transaction id %q", domain.ErrBadRequest, txid)
+	}
+	owned := make(map[string]bool)
+	if wallet != "" {
+		addresses, _, err := a.walletAddresses(wallet)
+		if err != nil {
+			return nil, err
+		}
+		for _, addr := range addresses {
+			owned[addr.EncodeAddress()] = true
+		}
+	}
+
+	client := a.getClient()
+	if client.IsShutdown() {
+		a.connectWithRetry()
+		client = a.getClient()
+	}
+
+	fetcher := a.historyFetcher(client)
+	msgTx, err := fetcher.rawTransaction(ctx, txid)
+	if err != nil {
+		if rejected(err) {
+			return nil, fmt.Errorf("%w: %s", domain.ErrTransactionNotFound, txid)
+		}
+		return nil, domain.UpstreamError(err)
+	}
+	detail, err := fetcher.detail(ctx, txid, msgTx, owned, a.tipHeight.Load())
+	if err != nil {
+		return nil, domain.UpstreamError(err)
+	}
+	return detail, nil
+}
+
+// detail decodes msgTx. Electrum has no call returning the block of a
+// transaction, so its height is looked up in the history of the script of
+// one of its outputs, or of an output it spends when none has an address.
+func (h *historyFetcher) detail(
+	ctx context.Context, txid string, msgTx *wire.MsgTx, owned map[string]bool, tip int32,
+) (*domain.TransactionDetail, error) {
+	detail := &domain.TransactionDetail{
+		TransactionID: txid,
+		Status:        domain.TransactionPending,
+		Inputs:        make([]domain.TransactionInput, len(msgTx.TxIn)),
+		Outputs:       make([]domain.TransactionOutput, len(msgTx.TxOut)),
+	}
+
+	var scripts [][]byte
+	var totalOut int64
+	for i, out := range msgTx.TxOut {
+		addr := h.outputAddress(out.PkScript)
+		detail.Outputs[i] = domain.TransactionOutput{
+			Index:   uint32(i), //nolint:gosec // bounded by the transaction size
+			Address: addr,
+			Value:   out.Value,
+			Amount:  float64(out.Value) / SatoshiPerBTC,
+			Owned:   owned[addr],
+		}
+		totalOut += out.Value
+		if addr != "" {
+			scripts = append(scripts, out.PkScript)
+		}
+	}
+
+	coinbase := isCoinbase(msgTx)
+	var totalIn int64
+	for i, in := range msgTx.TxIn {
+		detail.Inputs[i].Index = uint32(i) //nolint:gosec // bounded by the transaction size
+		if coinbase {
+			continue
+		}
+
+		prevTx, err := h.rawTransaction(ctx, in.PreviousOutPoint.Hash.String())
+		if err != nil {
+			return nil, err
+		}
+		if int(in.PreviousOutPoint.Index) >= len(prevTx.TxOut) {
+			return nil, fmt.Errorf("input %s of %s references a missing output", in.PreviousOutPoint, txid)
+		}
+		prevOut := prevTx.TxOut[in.PreviousOutPoint.Index]
+		addr := h.outputAddress(prevOut.PkScript)
+		detail.Inputs[i] = domain.TransactionInput{
+			Index:               detail.Inputs[i].Index,
+			PreviousTransaction: in.PreviousOutPoint.Hash.String(),
+			PreviousIndex:       in.PreviousOutPoint.Index,
+			Address:             addr,
+			Value:               prevOut.Value,
+			Amount:              float64(prevOut.Value) / SatoshiPerBTC,
+			Owned:               owned[addr],
+		}
+		totalIn += prevOut.Value
+		scripts = append(scripts, prevOut.PkScript)
+	}
+	if !coinbase {
+		fee := float64(totalIn-totalOut) / SatoshiPerBTC
+		detail.FeeAmount = &fee
+	}
+
+	if len(scripts) == 0 {
+		return detail, nil
+	}
+	height, err := h.transactionHeight(ctx, txid, scripts[0])
+	if err != nil {
+		return nil, err
+	}
+	// Electrum reports 0 or -1 for mempool transactions.
+	if height > 0 {
+		header, err := h.blockHeader(ctx, height)
+		if err != nil {
+			return nil, err
+		}
+		blockHeight := int64(height)
+		detail.Status = domain.TransactionConfirmed
+		detail.BlockHeight = &blockHeight
+		detail.BlockHash = header.BlockHash().String()
+		detail.Timestamp = &header.Timestamp
+		if tip >= height {
+			detail.Confirmations = int64(tip-height) + 1
+		}
+	}
+	return detail, nil
+}
+
+// transactionHeight finds txid in the history of script, returning 0 when it
+// is not listed there yet.
+func (h *historyFetcher) transactionHeight(ctx context.Context, txid string, script []byte) (int32, error) {
+	ctx, span := h.startSpan(ctx, "blockchain.scripthash.get_history")
+	entries, err := h.client.GetHistory(ctx, scriptHash(script))
+	tracing.End(span, err)
+	if err != nil {
+		return 0, fmt.Errorf("get history from electrum: %w", err)
+	}
+	for _, entry := range entries {
+		if entry.Hash == txid {
+			return entry.Height, nil
+		}
+	}
+	return 0, nil
+}
+
+// rejected reports whether the electrum server answered a request with an
+// error rather than the request failing on the way, which for a well formed
+// transaction id means the server does not know the transaction.
+func rejected(err error) bool {
+	var netErr net.Error
+	return !errors.Is(err, electrum.ErrTimeout) && !errors.Is(err, electrum.ErrServerShutdown) &&
+		!errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded) && !errors.As(err, &netErr)
+}
@@ -0,0 +1,246 @@
+package bitcoin
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+	"github.com/btcsuite/btcd/btcutil"
+	hd "github.com/btcsuite/btcd/btcutil/hdkeychain"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lamengao/go-electrum/electrum"
+)
+
+var (
+	ValidateAddress   = validateAddress
+	UnspentOutputs    = unspentOutputs
+	YoungValue        = youngValue
+	AddressScripthash = addressToScripthash
+	SatPerVByte       = satPerVByte
+)
+
+type ListUnspentResult = electrum.ListUnspentResult
+
+// CurrentTip returns the tip of the connected client as balances and history
+// use it.
+func (a *Adapter) CurrentTip(ctx context.Context) (int32, error) {
+	return a.currentTip(ctx, a.getClient())
+}
+
+func ReceiveAddresses(xpub string, start, count int, isTestnet bool) ([]domain.ReceiveAddress, error) {
+	key, err := hd.NewKeyFromString(xpub)
+	if err != nil {
+		return nil, err
+	}
+	script := walletScriptType(key)
+	branches, err := walletBranches(key, accountPath(key, script, chainParams(isTestnet).HDCoinType))
+	if err != nil {
+		return nil, err
+	}
+	return receiveAddresses(branches[0], script, start, count, isTestnet)
+}
+
+// NextUnusedIndex runs the gap limit walk over the external chain of xpub
+// with used deciding which addresses have history.
+func NextUnusedIndex(xpub string, isTestnet bool, used func(address string) bool) (int, error) {
+	key, err := hd.NewKeyFromString(xpub)
+	if err != nil {
+		return 0, err
+	}
+	external, _, err := deriveChainKeys(key)
+	if err != nil {
+		return 0, err
+	}
+	return scanChain(external, walletScriptType(key), isTestnet, func(_ int, addr btcutil.Address) (bool, error) {
+		return used(addr.EncodeAddress()), nil
+	})
+}
+
+// DescriptorAddresses returns the first address of every branch of desc keyed
+// by its derivation path.
+func DescriptorAddresses(desc string, isTestnet bool) (map[string]string, error) {
+	branches, script, err := parseDescriptor(desc, chainParams(isTestnet).HDCoinType)
+	if err != nil {
+		return nil, err
+	}
+	addresses := make(map[string]string, len(branches))
+	for _, b := range branches {
+		derived, err := deriveAddresses(b.root, 0, 1, script, isTestnet)
+		if err != nil {
+			return nil, err
+		}
+		addresses[childPath(b.path, 0)] = derived[0].EncodeAddress()
+	}
+	return addresses, nil
+}
+
+// PlanPSBT plans the transaction of request from the wallet of desc, whose
+// first external addresses hold one output of each value, and returns its
+// PSBT, the inputs it signs and its fee.
+func PlanPSBT(
+	desc string, values []int64, request domain.UnsignedTxRequest, feeRate float64,
+) (*psbt.Packet, []domain.UnsignedTxInput, int64, error) {
+	plan, change, prevTxs, err := testPlan(desc, values, request, feeRate)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	packet, err := testPacket(plan, prevTxs, change)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	signed, err := signingInputs(plan)
+	return packet, signed, plan.fee, err
+}
+
+// ReplacePSBT plans the transaction of request as PlanPSBT does at origRate
+// and then its replacement at feeRate. It returns both transactions, the PSBT
+// of the replacement and the fees of both.
+func ReplacePSBT(
+	desc string, values []int64, request domain.UnsignedTxRequest, origRate, feeRate float64,
+) (*wire.MsgTx, *psbt.Packet, int64, int64, error) {
+	orig, change, prevTxs, err := testPlan(desc, values, request, origRate)
+	if err != nil {
+		return nil, nil, 0, 0, err
+	}
+	changeIndex := -1
+	if orig.change > 0 {
+		changeIndex = len(orig.tx.TxOut) - 1
+	}
+	plan, err := replaceTx(orig.tx, orig.inputs, changeIndex, orig.fee, feeRate)
+	if err != nil {
+		return nil, nil, 0, 0, err
+	}
+	packet, err := testPacket(plan, prevTxs, change)
+	if err != nil {
+		return nil, nil, 0, 0, err
+	}
+	return orig.tx, packet, orig.fee, plan.fee, nil
+}
+
+// ChildPSBT plans a child spending the output of value the wallet of desc
+// receives from a parent paying parentFee for parentVsize, and returns its
+// PSBT and its fee.
+func ChildPSBT(desc string, value, parentFee, parentVsize int64, feeRate float64) (*psbt.Packet, int64, error) {
+	branches, script, outputs, prevTxs, err := testWallet(desc, []int64{value})
+	if err != nil {
+		return nil, 0, err
+	}
+	change, err := walletChange(branches, []uint32{1, 0}, script, false)
+	if err != nil {
+		return nil, 0, err
+	}
+	inputs, err := spendables(outputs)
+	if err != nil {
+		return nil, 0, err
+	}
+	plan, err := childTx(inputs[0], parentFee, parentVsize, feeRate, change.script)
+	if err != nil {
+		return nil, 0, err
+	}
+	packet, err := testPacket(plan, prevTxs, change)
+	return packet, plan.fee, err
+}
+
+func testPlan(
+	desc string, values []int64, request domain.UnsignedTxRequest, feeRate float64,
+) (*txPlan, *changeOutput, map[string]*wire.MsgTx, error) {
+	branches, script, outputs, prevTxs, err := testWallet(desc, values)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	pay, err := newPayment(request, false)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	change, err := walletChange(branches, []uint32{uint32(len(values)), 0}, script, false) //nolint:gosec // test wallets are small
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	inputs, err := spendables(outputs)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if inputs, pay.required, err = selectInputs(inputs, request.RequiredInputs, request.ExcludedInputs); err != nil {
+		return nil, nil, nil, err
+	}
+	var plan *txPlan
+	if pay.sweep {
+		plan, err = sweepTx(inputs, pay, feeRate)
+	} else {
+		plan, err = planTx(inputs, pay, feeRate, change.script)
+	}
+	return plan, change, prevTxs, err
+}
+
+func testPacket(plan *txPlan, prevTxs map[string]*wire.MsgTx, change *changeOutput) (*psbt.Packet, error) {
+	spent := make([]*wire.MsgTx, len(plan.inputs))
+	for i, input := range plan.inputs {
+		spent[i] = prevTxs[input.TransactionID]
+	}
+	payload, err := psbtPacket(plan, spent, change)
+	if err != nil {
+		return nil, err
+	}
+	return psbt.NewFromRawBytes(bytes.NewReader(payload), false)
+}
+
+// WalletOutpoints returns the outpoints of the outputs PlanPSBT gives the
+// wallet of desc, in the order of values.
+func WalletOutpoints(desc string, values []int64) ([]domain.Outpoint, error) {
+	_, _, outputs, _, err := testWallet(desc, values)
+	if err != nil {
+		return nil, err
+	}
+	outpoints := make([]domain.Outpoint, len(outputs))
+	for i, output := range outputs {
+		outpoints[i] = domain.Outpoint{TransactionID: output.TransactionID, Vout: output.Vout}
+	}
+	return outpoints, nil
+}
+
+func testWallet(
+	desc string, values []int64,
+) ([]branch, scriptType, []walletOutput, map[string]*wire.MsgTx, error) {
+	branches, script, err := parseDescriptor(desc, chainParams(false).HDCoinType)
+	if err != nil {
+		return nil, 0, nil, nil, err
+	}
+	outputs := make([]walletOutput, len(values))
+	prevTxs := make(map[string]*wire.MsgTx, len(values))
+	for i, value := range values {
+		index := uint32(i) //nolint:gosec // test wallets are small
+		pubKey, err := childPubKey(branches[0].root, index)
+		if err != nil {
+			return nil, 0, nil, nil, err
+		}
+		addr, err := makeAddress(pubKey, script, false)
+		if err != nil {
+			return nil, 0, nil, nil, err
+		}
+		pkScript, err := txscript.PayToAddrScript(addr)
+		if err != nil {
+			return nil, 0, nil, nil, err
+		}
+		prevTx := wire.NewMsgTx(wire.TxVersion)
+		prevTx.AddTxIn(&wire.TxIn{PreviousOutPoint: wire.OutPoint{Index: index}})
+		prevTx.AddTxOut(wire.NewTxOut(value, pkScript))
+		txid := prevTx.TxHash().String()
+		prevTxs[txid] = prevTx
+		outputs[i] = walletOutput{
+			UTXO: domain.UTXO{
+				TransactionID:  txid,
+				Value:          value,
+				ScriptPubKey:   hex.EncodeToString(pkScript),
+				Address:        addr.EncodeAddress(),
+				DerivationPath: childPath(branches[0].path, index),
+			},
+			pubKey:      pubKey,
+			fingerprint: branches[0].fingerprint,
+		}
+	}
+	return branches, script, outputs, prevTxs, nil
+}
@@ -0,0 +1,407 @@
+package bitcoin
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/tracing"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lamengao/go-electrum/electrum"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// GetTransactions returns the history of every address derived from xpub,
+// newest first. Amounts are netted across the wallet, so a payment with
+// change back to the wallet only reports what actually left it.
+func (a *Adapter) GetTransactions(ctx context.Context, xpub string) ([]domain.Transaction, error) {
+	ctx, span := tracer.Start(ctx, "bitcoin.GetTransactions", trace.WithAttributes(a.spanAttributes()...))
+	txs, err := a.getTransactions(ctx, xpub)
+	tracing.End(span, err)
+	return txs, err
+}
+
+func (a *Adapter) getTransactions(ctx context.Context, xpub string) ([]domain.Transaction, error) {
+	addresses, _, err := a.walletAddresses(xpub)
+	if err != nil {
+		return nil, err
+	}
+
+	client := a.getClient()
+	if client.IsShutdown() {
+		a.connectWithRetry()
+		client = a.getClient()
+	}
+
+	tip, err := a.currentTip(ctx, client)
+	if err != nil {
+		return nil, domain.UpstreamError(err)
+	}
+	txs, err := a.historyFetcher(client).walletTransactions(ctx, addresses, tip)
+	if err != nil {
+		return nil, domain.UpstreamError(err)
+	}
+	return txs, nil
+}
+
+// trackTip follows blockchain.headers.subscribe so confirmations can be
+// computed without asking the server for the tip on every request.
+func (a *Adapter) trackTip(client *electrum.Client) {
+	a.tipMu.Lock()
+	defer a.tipMu.Unlock()
+
+	if _, err := a.subscribeTip(context.Background(), client); err != nil {
+		log.Printf("[bitcoin] header subscription failed: %v", err)
+	}
+}
+
+// currentTip returns the tip trackTip follows. Until the subscription has
+// produced one, client is subscribed to headers again; concurrent callers
+// wait for that subscription rather than making their own.
+func (a *Adapter) currentTip(ctx context.Context, client *electrum.Client) (int32, error) {
+	if tip := a.tipHeight.Load(); tip > 0 {
+		return tip, nil
+	}
+
+	a.tipMu.Lock()
+	defer a.tipMu.Unlock()
+	if tip := a.tipHeight.Load(); tip > 0 {
+		return tip, nil
+	}
+	return a.subscribeTip(ctx, client)
+}
+
+// subscribeTip subscribes client to headers, stores the first as the tip and
+// keeps following the rest. Callers hold tipMu, so each client gets a single
+// follower.
+func (a *Adapter) subscribeTip(ctx context.Context, client *electrum.Client) (int32, error) {
+	ctx, span := tracer.Start(ctx, "electrum.blockchain.headers.subscribe",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(a.spanAttributes()...),
+		trace.WithAttributes(tracing.AttrRPCMethod.String("blockchain.headers.subscribe")),
+	)
+	headers, err := client.SubscribeHeaders(ctx)
+	tracing.End(span, err)
+	if err != nil {
+		return 0, fmt.Errorf("subscribe to headers: %w", err)
+	}
+
+	// SubscribeHeaders queues the current header before returning.
+	header := <-headers
+	if header == nil {
+		return 0, errors.New("subscribe to headers: no header in response")
+	}
+	a.tipHeight.Store(header.Height)
+
+	go func() {
+		for header := range headers {
+			a.tipHeight.Store(header.Height)
+		}
+	}()
+	return header.Height, nil
+}
+
+func chainParams(isTestnet bool) *chaincfg.Params {
+	if isTestnet {
+		return BitcoinTestNetParams
+	}
+	return BitcoinMainNetParams
+}
+
+// historyFetcher resolves the transactions of one wallet. Raw transactions
+// and block times are memoised for the duration of a single request since
+// the same funding transaction is usually spent from several times.
+type historyFetcher struct {
+	client     *electrum.Client
+	params     *chaincfg.Params
+	isTestnet  bool
+	spanAttrs  []attribute.KeyValue
+	rawTxs     map[string]*wire.MsgTx
+	blockTimes map[int32]time.Time
+	firstSeen  *firstSeen
+}
+
+func (a *Adapter) historyFetcher(client *electrum.Client) *historyFetcher {
+	return &historyFetcher{
+		client:     client,
+		params:     chainParams(a.isTestnet),
+		isTestnet:  a.isTestnet,
+		spanAttrs:  a.spanAttributes(),
+		rawTxs:     make(map[string]*wire.MsgTx),
+		blockTimes: make(map[int32]time.Time),
+		firstSeen:  &a.firstSeen,
+	}
+}
+
+func (h *historyFetcher) walletTransactions(
+	ctx context.Context, addresses []btcutil.Address, tip int32,
+) ([]domain.Transaction, error) {
+	owned := make(map[string]bool, len(addresses))
+	heights := make(map[string]int32)
+	for _, addr := range addresses {
+		owned[addr.EncodeAddress()] = true
+
+		entries, err := h.history(ctx, addr)
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range entries {
+			heights[entry.Hash] = entry.Height
+		}
+	}
+
+	txs := make([]domain.Transaction, 0, len(heights))
+	for txid, height := range heights {
+		tx, err := h.transaction(ctx, txid, height, tip, owned)
+		if err != nil {
+			return nil, err
+		}
+		txs = append(txs, tx)
+	}
+
+	sort.Slice(txs, func(i, j int) bool { return txs[i].Timestamp.After(txs[j].Timestamp) })
+	return txs, nil
+}
+
+func (h *historyFetcher) transaction(
+	ctx context.Context, txid string, height, tip int32, owned map[string]bool,
+) (domain.Transaction, error) {
+	msgTx, err := h.rawTransaction(ctx, txid)
+	if err != nil {
+		return domain.Transaction{}, err
+	}
+
+	var received, sent, totalIn, totalOut int64
+	var from, to []string
+	coinbase := isCoinbase(msgTx)
+
+	if !coinbase {
+		for _, in := range msgTx.TxIn {
+			prevTx, err := h.rawTransaction(ctx, in.PreviousOutPoint.Hash.String())
+			if err != nil {
+				return domain.Transaction{}, err
+			}
+			if int(in.PreviousOutPoint.Index) >= len(prevTx.TxOut) {
+				return domain.Transaction{}, fmt.Errorf("input %s of %s references a missing output",
+					in.PreviousOutPoint, txid)
+			}
+
+			prevOut := prevTx.TxOut[in.PreviousOutPoint.Index]
+			totalIn += prevOut.Value
+			addr := h.outputAddress(prevOut.PkScript)
+			if owned[addr] {
+				sent += prevOut.Value
+			}
+			from = appendAddress(from, addr)
+		}
+	}
+
+	for _, out := range msgTx.TxOut {
+		totalOut += out.Value
+		addr := h.outputAddress(out.PkScript)
+		if owned[addr] {
+			received += out.Value
+		}
+		to = appendAddress(to, addr)
+	}
+
+	net := received - sent
+	tx := domain.Transaction{
+		TransactionID: txid,
+		Amount:        float64(absInt64(net)) / SatoshiPerBTC,
+		Direction:     domain.DirectionIncoming,
+		FromAddresses: from,
+		ToAddresses:   to,
+	}
+	if net < 0 {
+		tx.Direction = domain.DirectionOutgoing
+	}
+	if sent > 0 && !coinbase {
+		fee := float64(totalIn-totalOut) / SatoshiPerBTC
+		tx.FeeAmount = &fee
+	}
+
+	// Electrum reports 0 or -1 for mempool transactions.
+	if height > 0 {
+		blockHeight := int64(height)
+		tx.BlockHeight = &blockHeight
+		if tip >= height {
+			tx.Confirmations = int64(tip-height) + 1
+		}
+
+		tx.Timestamp, err = h.blockTime(ctx, height)
+		if err != nil {
+			return domain.Transaction{}, err
+		}
+		h.firstSeen.forget(txid)
+	} else {
+		tx.Timestamp = h.firstSeen.at(txid, time.Now())
+	}
+
+	return tx, nil
+}
+
+// mempoolExpiry is how long nodes keep a transaction in their mempool by
+// default. First seen times older than that are dropped.
+const mempoolExpiry = 14 * 24 * time.Hour
+
+// firstSeen remembers when mempool transactions were first listed. They are
+// dated by it until mined, so they keep their place in the history and their
+// fiat valuation from one request to the next.
+type firstSeen struct {
+	mu    sync.Mutex
+	times map[string]time.Time
+}
+
+// at returns when txid was first seen, now if it was not seen before.
+func (f *firstSeen) at(txid string, now time.Time) time.Time {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if seen, ok := f.times[txid]; ok {
+		return seen
+	}
+	if f.times == nil {
+		f.times = make(map[string]time.Time)
+	}
+	for id, seen := range f.times {
+		if now.Sub(seen) > mempoolExpiry {
+			delete(f.times, id)
+		}
+	}
+	f.times[txid] = now
+	return now
+}
+
+// forget drops txid once it is mined and dated by its block.
+func (f *firstSeen) forget(txid string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	delete(f.times, txid)
+}
+
+func (h *historyFetcher) history(ctx context.Context, addr btcutil.Address) ([]*electrum.GetMempoolResult, error) {
+	sh, err := addressToScripthash(addr.EncodeAddress(), h.isTestnet)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, span := h.startSpan(ctx, "blockchain.scripthash.get_history")
+	entries, err := h.client.GetHistory(ctx, sh)
+	tracing.End(span, err)
+	if err != nil {
+		return nil, fmt.Errorf("get history from electrum: %w", err)
+	}
+	return entries, nil
+}
+
+func (h *historyFetcher) rawTransaction(ctx context.Context, txid string) (*wire.MsgTx, error) {
+	if msgTx, ok := h.rawTxs[txid]; ok {
+		return msgTx, nil
+	}
+
+	ctx, span := h.startSpan(ctx, "blockchain.transaction.get")
+	rawHex, err := h.client.GetRawTransaction(ctx, txid)
+	tracing.End(span, err)
+	if err != nil {
+		return nil, fmt.Errorf("get transaction %s from electrum: %w", txid, err)
+	}
+
+	raw, err := hex.DecodeString(rawHex)
+	if err != nil {
+		return nil, fmt.Errorf("decode transaction %s: %w", txid, err)
+	}
+	msgTx := wire.NewMsgTx(wire.TxVersion)
+	if err := msgTx.Deserialize(bytes.NewReader(raw)); err != nil {
+		return nil, fmt.Errorf("deserialize transaction %s: %w", txid, err)
+	}
+
+	h.rawTxs[txid] = msgTx
+	return msgTx, nil
+}
+
+func (h *historyFetcher) blockTime(ctx context.Context, height int32) (time.Time, error) {
+	if blockTime, ok := h.blockTimes[height]; ok {
+		return blockTime, nil
+	}
+
+	header, err := h.blockHeader(ctx, height)
+	if err != nil {
+		return time.Time{}, err
+	}
+	h.blockTimes[height] = header.Timestamp
+	return header.Timestamp, nil
+}
+
+func (h *historyFetcher) blockHeader(ctx context.Context, height int32) (*wire.BlockHeader, error) {
+	ctx, span := h.startSpan(ctx, "blockchain.block.header")
+	header, err := h.client.GetBlockHeader(ctx, uint32(height))
+	tracing.End(span, err)
+	if err != nil {
+		return nil, fmt.Errorf("get block header %d from electrum: %w", height, err)
+	}
+
+	raw, err := hex.DecodeString(header.Header)
+	if err != nil {
+		return nil, fmt.Errorf("decode block header %d: %w", height, err)
+	}
+	var blockHeader wire.BlockHeader
+	if err := blockHeader.Deserialize(bytes.NewReader(raw)); err != nil {
+		return nil, fmt.Errorf("deserialize block header %d: %w", height, err)
+	}
+	return &blockHeader, nil
+}
+
+func (h *historyFetcher) startSpan(ctx context.Context, method string) (context.Context, trace.Span) {
+	return tracer.Start(ctx, "electrum."+method,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(h.spanAttrs...),
+		trace.WithAttributes(tracing.AttrRPCMethod.String(method)),
+	)
+}
+
+// outputAddress returns the address paid by script, or "" for scripts
+// without one such as OP_RETURN outputs.
+func (h *historyFetcher) outputAddress(script []byte) string {
+	_, addrs, _, err := txscript.ExtractPkScriptAddrs(script, h.params)
+	if err != nil || len(addrs) == 0 {
+		return ""
+	}
+	return addrs[0].EncodeAddress()
+}
+
+func isCoinbase(msgTx *wire.MsgTx) bool {
+	return len(msgTx.TxIn) == 1 && msgTx.TxIn[0].PreviousOutPoint.Index == wire.MaxPrevOutIndex &&
+		msgTx.TxIn[0].PreviousOutPoint.Hash == chainhash.Hash{}
+}
+
+func appendAddress(addresses []string, addr string) []string {
+	if addr == "" {
+		return addresses
+	}
+	for _, existing := range addresses {
+		if existing == addr {
+			return addresses
+		}
+	}
+	return append(addresses, addr)
+}
+
+func absInt64(v int64) int64 {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
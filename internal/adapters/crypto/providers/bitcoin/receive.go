@@ -0,0 +1,123 @@
+package bitcoin
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/tracing"
+	"github.com/btcsuite/btcd/btcutil"
+	hd "github.com/btcsuite/btcd/btcutil/hdkeychain"
+	"github.com/lamengao/go-electrum/electrum"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// GapLimit is the number of consecutive unused addresses after which a wallet
+// is assumed to have no further history, as in BIP44.
+const GapLimit = 20
+
+// GetReceiveAddresses returns count consecutive external addresses of xpub,
+// starting with the first one after the last address that has history.
+func (a *Adapter) GetReceiveAddresses(ctx context.Context, xpub string, count int) ([]domain.ReceiveAddress, error) {
+	ctx, span := tracer.Start(ctx, "bitcoin.GetReceiveAddresses", trace.WithAttributes(a.spanAttributes()...))
+	addresses, err := a.getReceiveAddresses(ctx, xpub, count)
+	tracing.End(span, err)
+	return addresses, err
+}
+
+func (a *Adapter) getReceiveAddresses(ctx context.Context, xpub string, count int) ([]domain.ReceiveAddress, error) {
+	key, err := hd.NewKeyFromString(xpub)
+	if err != nil {
+		return nil, fmt.Errorf("%w: bad xpub: %w", domain.ErrInvalidAddress, err)
+	}
+	script := walletScriptType(key)
+	branches, err := walletBranches(key, accountPath(key, script, chainParams(a.isTestnet).HDCoinType))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidAddress, err)
+	}
+	external := branches[0]
+
+	client := a.getClient()
+	if client.IsShutdown() {
+		a.connectWithRetry()
+		client = a.getClient()
+	}
+
+	next, err := scanChain(external.root, script, a.isTestnet, func(_ int, addr btcutil.Address) (bool, error) {
+		return a.addressUsed(ctx, client, addr)
+	})
+	if err != nil {
+		return nil, domain.UpstreamError(err)
+	}
+
+	return receiveAddresses(external, script, next, count, a.isTestnet)
+}
+
+// addressUsed reports whether addr appears in any confirmed or mempool transaction.
+func (a *Adapter) addressUsed(ctx context.Context, client *electrum.Client, addr btcutil.Address) (bool, error) {
+	sh, err := addressToScripthash(addr.EncodeAddress(), a.isTestnet)
+	if err != nil {
+		return false, err
+	}
+
+	ctx, span := tracer.Start(ctx, "electrum.blockchain.scripthash.get_history",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(a.spanAttributes()...),
+		trace.WithAttributes(tracing.AttrRPCMethod.String("blockchain.scripthash.get_history")),
+	)
+	entries, err := client.GetHistory(ctx, sh)
+	tracing.End(span, err)
+	if err != nil {
+		return false, fmt.Errorf("get history from electrum: %w", err)
+	}
+	return len(entries) > 0, nil
+}
+
+// scanChain walks the addresses of root in windows of GapLimit, asking used
+// about each, and returns the index after the last used one. The walk ends at
+// the first window without any used address.
+func scanChain(
+	root *hd.ExtendedKey, script scriptType, isTestnet bool, used func(int, btcutil.Address) (bool, error),
+) (int, error) {
+	next := 0
+	for start := 0; ; start += GapLimit {
+		addresses, err := deriveAddresses(root, start, GapLimit, script, isTestnet)
+		if err != nil {
+			return 0, err
+		}
+		for i, addr := range addresses {
+			isUsed, err := used(start+i, addr)
+			if err != nil {
+				return 0, err
+			}
+			if isUsed {
+				next = start + i + 1
+			}
+		}
+		if next <= start {
+			return next, nil
+		}
+	}
+}
+
+// receiveAddresses derives count addresses of the external branch starting
+// at index start.
+func receiveAddresses(
+	external branch, script scriptType, start, count int, isTestnet bool,
+) ([]domain.ReceiveAddress, error) {
+	addresses, err := deriveAddresses(external.root, start, count, script, isTestnet)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidAddress, err)
+	}
+
+	results := make([]domain.ReceiveAddress, len(addresses))
+	for i, addr := range addresses {
+		index := uint32(start + i) //nolint:gosec // bounded by deriveAddresses
+		results[i] = domain.ReceiveAddress{
+			Index:          index,
+			Address:        addr.EncodeAddress(),
+			DerivationPath: childPath(external.path, index),
+		}
+	}
+	return results, nil
+}
@@ -0,0 +1,66 @@
+package bitcoin_test
+
+import (
+	"testing"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/adapters/crypto/providers/bitcoin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Account keys of the BIP84 and BIP86 test vectors.
+const (
+	bip84Zpub = "zpub6rFR7y4Q2AijBEqTUquhVz398htDFrtymD9xYYfG1m4wAcvPhXNfE3EfH1r1ADqtfSdVCToUG868RvUUkgDKf31mGDtKsAYz2oz2AGutZYs"
+	bip86Xpub = "xpub6BgBgsespWvERF3LHQu6CnqdvfEvtMcQjYrcRzx53QJjSxarj2afYWcLteoGVky7D3UKDP9QyrLprQ3VCECoY49yfdDEHGCtMMj92pReUsQ"
+)
+
+func TestReceiveAddresses_ScriptType(t *testing.T) {
+	t.Parallel()
+
+	native, err := bitcoin.ReceiveAddresses(bip84Zpub, 0, 2, false)
+	require.NoError(t, err)
+	require.Len(t, native, 2)
+	assert.Equal(t, "bc1qcr8te4kr609gcawutmrza0j4xv80jy8z306fyu", native[0].Address)
+	assert.Equal(t, "m/84'/0'/0'/0/0", native[0].DerivationPath)
+	assert.Equal(t, "bc1qnjg0jd8228aq7egyzacy8cys3knf9xvrerkf9g", native[1].Address)
+	assert.Equal(t, uint32(1), native[1].Index)
+
+	taproot, err := bitcoin.ReceiveAddresses(bip86Xpub, 0, 1, false)
+	require.NoError(t, err)
+	assert.Equal(t, "bc1p5cyxnuxmeuwuvkwfem96lqzszd02n6xdcjrs20cac6yqjjwudpxqkedrcr", taproot[0].Address)
+	assert.Equal(t, "m/86'/0'/0'/0/0", taproot[0].DerivationPath)
+}
+
+func TestNextUnusedIndex(t *testing.T) {
+	t.Parallel()
+
+	addresses, err := bitcoin.ReceiveAddresses(bip84Zpub, 0, 30, false)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		used []int
+		want int
+	}{
+		{"fresh wallet", nil, 0},
+		{"first used", []int{0}, 1},
+		{"gap inside window", []int{0, 5}, 6},
+		{"used in second window", []int{3, 25}, 26},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			used := make(map[string]bool)
+			for _, index := range tt.used {
+				used[addresses[index].Address] = true
+			}
+
+			next, err := bitcoin.NextUnusedIndex(bip84Zpub, false, func(address string) bool { return used[address] })
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, next)
+		})
+	}
+}
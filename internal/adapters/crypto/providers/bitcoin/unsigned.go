@@ -0,0 +1,817 @@
+package bitcoin
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/tracing"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// BuildTimeout bounds all Electrum calls made to build one transaction.
+	BuildTimeout = 30 * time.Second
+
+	// dustRelayFee in sat per kB is what bitcoind charges spending an output
+	// against its value: outputs worth less than that are dust.
+	dustRelayFee = 3_000
+	btcDecimals  = 8
+	// MaxOpReturn is the largest OP_RETURN payload relayed as standard.
+	MaxOpReturn = 80
+
+	// Serialized sizes in bytes of the parts of a transaction signed with
+	// 72 byte signatures, compressed keys and 64 byte Schnorr signatures.
+	txOverhead      = 4 + 4           // version and lock time
+	inputOverhead   = 32 + 4 + 1 + 4  // outpoint, script length and sequence
+	pubKeyHashSig   = 1 + 72 + 1 + 33 // signature and key pushes
+	nestedScriptSig = 1 + 22          // push of the P2WPKH redeem script
+	witnessSize     = 1 + 1 + 72 + 1 + 33
+	taprootWitness  = 1 + 1 + 64
+	segwitMarker    = 2
+	// witnessScaleFactor is the weight of a non-witness byte.
+	witnessScaleFactor = 4
+
+	// Input sequences: BIP-125 replaceable, final but honouring the lock
+	// time, and final.
+	sequenceReplaceable = wire.MaxTxInSequenceNum - 2
+	sequenceLockTime    = wire.MaxTxInSequenceNum - 1
+)
+
+var (
+	ErrNestedAddress = &domain.Error{
+		Code:    domain.CodeInvalidAddress,
+		Message: "P2SH outputs are spent from the extended key or descriptor deriving them",
+	}
+	ErrFeeRateTooLow = &domain.Error{
+		Code:    domain.CodeBadRequest,
+		Message: "fee rate below the minimum relay fee of 1 sat/vB",
+	}
+	ErrBatchOptions = &domain.Error{
+		Code:    domain.CodeBadRequest,
+		Message: "several outputs, OP_RETURN, coin control, RBF and lock time options are built by BuildBatchTx",
+	}
+	ErrUnknownInput = &domain.Error{
+		Code:    domain.CodeBadRequest,
+		Message: "required input is not an unspent output of the sending wallet",
+	}
+	ErrUnsupportedScript = errors.New("unsupported output script")
+)
+
+// inputKind is how an input is signed, which decides its size and what its
+// PSBT input carries.
+type inputKind int
+
+const (
+	inputWitnessPubKeyHash inputKind = iota
+	inputNestedWitnessPubKeyHash
+	inputPubKeyHash
+	inputTaproot
+)
+
+// spendable is a wallet output decoded for spending.
+type spendable struct {
+	walletOutput
+	outpoint wire.OutPoint
+	pkScript []byte
+	kind     inputKind
+}
+
+// witnessProgram returns the P2WPKH script a segwit v0 input is signed
+// with, the redeem script of nested inputs.
+func (s spendable) witnessProgram() ([]byte, error) {
+	if s.kind != inputNestedWitnessPubKeyHash {
+		return s.pkScript, nil
+	}
+	return witnessPubKeyHashScript(s.pubKey)
+}
+
+// changeOutput is where a transaction returns its change. Wallet change
+// carries the key deriving it so signers can recognise it as their own.
+type changeOutput struct {
+	address      string
+	script       []byte
+	redeemScript []byte
+	pubKey       *btcec.PublicKey
+	path         string
+	fingerprint  uint32
+	taproot      bool
+}
+
+// payment is what a transaction pays before change: the recipient outputs
+// worth amount in total and an optional data output, with the sequence and
+// lock time of the transaction. The first required inputs are always spent.
+// A sweep pays everything left after the fee to the single recipient.
+type payment struct {
+	outputs  []*wire.TxOut
+	amount   int64
+	sequence uint32
+	lockTime uint32
+	required int
+	sweep    bool
+}
+
+// txPlan is a transaction paying the recipients from inputs, with change
+// when enough is left after the fee.
+type txPlan struct {
+	tx     *wire.MsgTx
+	inputs []spendable
+	change int64
+	fee    int64
+	vsize  int64
+}
+
+// BuildUnsignedTx builds a PSBT spending the outputs of request.From, an
+// extended public key, a descriptor or a single address, largest first. The
+// change goes to the first unused change address of a wallet or back to a
+// single address. With domain.AmountMax every output worth spending is swept
+// to the recipient without change. The fee is the virtual size at
+// request.FeeRate sat/vB or the normal fee estimate. Requests using batch
+// options are rejected with ErrBatchOptions.
+func (a *Adapter) BuildUnsignedTx(ctx context.Context, request domain.UnsignedTxRequest) (*domain.UnsignedTx, error) {
+	ctx, span := tracer.Start(ctx, "bitcoin.BuildUnsignedTx", trace.WithAttributes(a.spanAttributes()...))
+	if request.IsBatch() {
+		tracing.End(span, ErrBatchOptions)
+		return nil, ErrBatchOptions
+	}
+	tx, err := a.buildUnsignedTx(ctx, request)
+	tracing.End(span, err)
+	return tx, err
+}
+
+// BuildBatchTx builds the PSBT of BuildUnsignedTx with the batch options of
+// request too: several recipients, an OP_RETURN output, required and
+// excluded inputs, opting out of BIP-125 replaceability and a lock time.
+func (a *Adapter) BuildBatchTx(ctx context.Context, request domain.UnsignedTxRequest) (*domain.UnsignedTx, error) {
+	ctx, span := tracer.Start(ctx, "bitcoin.BuildBatchTx", trace.WithAttributes(a.spanAttributes()...))
+	tx, err := a.buildUnsignedTx(ctx, request)
+	tracing.End(span, err)
+	return tx, err
+}
+
+func (a *Adapter) buildUnsignedTx(ctx context.Context, request domain.UnsignedTxRequest) (*domain.UnsignedTx, error) {
+	pay, err := newPayment(request, a.isTestnet)
+	if err != nil {
+		return nil, err
+	}
+	branches, script, single, err := a.utxoSource(request.From)
+	if err != nil {
+		return nil, err
+	}
+	if _, nested := single.(*btcutil.AddressScriptHash); nested {
+		return nil, ErrNestedAddress
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, BuildTimeout)
+	defer cancel()
+
+	client := a.getClient()
+	if client.IsShutdown() {
+		a.connectWithRetry()
+		client = a.getClient()
+	}
+
+	feeRate := request.FeeRate
+	if feeRate == 0 {
+		if feeRate, err = a.normalFeeRate(ctx, client); err != nil {
+			return nil, err
+		}
+	} else if feeRate < MinFeeRate {
+		return nil, ErrFeeRateTooLow
+	}
+
+	var outputs []walletOutput
+	var change *changeOutput
+	if single != nil {
+		utxos, err := a.walletUnspent(ctx, client, single, "")
+		if err != nil {
+			return nil, domain.UpstreamError(err)
+		}
+		for _, utxo := range utxos {
+			outputs = append(outputs, walletOutput{UTXO: utxo})
+		}
+		if change, err = addressChange(single); err != nil {
+			return nil, err
+		}
+	} else {
+		var next []uint32
+		outputs, next, err = a.walletOutputs(ctx, client, branches, script)
+		if err != nil {
+			return nil, domain.UpstreamError(err)
+		}
+		if change, err = walletChange(branches, next, script, a.isTestnet); err != nil {
+			return nil, err
+		}
+	}
+
+	inputs, err := spendables(outputs)
+	if err != nil {
+		return nil, domain.UpstreamError(err)
+	}
+	if inputs, pay.required, err = selectInputs(inputs, request.RequiredInputs, request.ExcludedInputs); err != nil {
+		return nil, err
+	}
+	var plan *txPlan
+	if pay.sweep {
+		plan, err = sweepTx(inputs, pay, feeRate)
+	} else {
+		plan, err = planTx(inputs, pay, feeRate, change.script)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	fetcher := a.historyFetcher(client)
+	prevTxs := make([]*wire.MsgTx, len(plan.inputs))
+	for i, input := range plan.inputs {
+		if input.kind == inputTaproot {
+			continue
+		}
+		if prevTxs[i], err = fetcher.rawTransaction(ctx, input.TransactionID); err != nil {
+			return nil, domain.UpstreamError(err)
+		}
+	}
+	payload, err := psbtPacket(plan, prevTxs, change)
+	if err != nil {
+		return nil, err
+	}
+	signed, err := signingInputs(plan)
+	if err != nil {
+		return nil, err
+	}
+
+	recipients := request.Payees()
+	paid := make([]domain.Recipient, len(recipients))
+	var amount int64
+	for i, recipient := range recipients {
+		amount += plan.tx.TxOut[i].Value
+		paid[i] = domain.Recipient{
+			Address: recipient.Address,
+			Amount:  domain.FormatUnits(big.NewInt(plan.tx.TxOut[i].Value), btcDecimals),
+		}
+	}
+	tx := &domain.UnsignedTx{
+		From:     request.From,
+		Amount:   domain.FormatUnits(big.NewInt(amount), btcDecimals),
+		Fee:      domain.FormatUnits(big.NewInt(plan.fee), btcDecimals),
+		Payload:  payload,
+		Encoding: domain.EncodingBase64,
+		Inputs:   signed,
+		Details:  txDetails(plan, paid, request.OpReturn, change.address, feeRate),
+	}
+	if len(paid) == 1 {
+		tx.To = paid[0].Address
+	} else {
+		tx.Recipients = paid
+	}
+	return tx, nil
+}
+
+// newPayment turns the recipients and options of request into the outputs
+// of a transaction. Only a single recipient can be sent domain.AmountMax,
+// whose output is valued once the fee is known.
+func newPayment(request domain.UnsignedTxRequest, isTestnet bool) (*payment, error) {
+	recipients := request.Payees()
+	pay := &payment{lockTime: request.LockTime, sequence: sequenceReplaceable}
+	if request.DisableRBF {
+		pay.sequence = wire.MaxTxInSequenceNum
+		if request.LockTime != 0 {
+			pay.sequence = sequenceLockTime
+		}
+	}
+
+	for _, recipient := range recipients {
+		toScript, err := recipientScript(recipient.Address, isTestnet)
+		if err != nil {
+			return nil, err
+		}
+		var value int64
+		if domain.IsAmountMax(recipient.Amount) {
+			if len(recipients) > 1 {
+				return nil, fmt.Errorf("%w: %s is only allowed with a single recipient", domain.ErrBadRequest,
+					domain.AmountMax)
+			}
+			pay.sweep = true
+		} else if value, err = parseAmount(recipient.Amount, toScript); err != nil {
+			return nil, err
+		}
+		pay.outputs = append(pay.outputs, wire.NewTxOut(value, toScript))
+		pay.amount += value
+	}
+
+	if len(request.OpReturn) > 0 {
+		if len(request.OpReturn) > MaxOpReturn {
+			return nil, fmt.Errorf("%w: OP_RETURN data is limited to %d bytes", domain.ErrBadRequest, MaxOpReturn)
+		}
+		script, err := txscript.NullDataScript(request.OpReturn)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", domain.ErrBadRequest, err)
+		}
+		pay.outputs = append(pay.outputs, wire.NewTxOut(0, script))
+	}
+	return pay, nil
+}
+
+// parseAmount parses an amount in BTC into satoshi, rejecting dust.
+func parseAmount(amount string, toScript []byte) (int64, error) {
+	units, err := domain.ParseUnits(amount, btcDecimals)
+	if err != nil {
+		return 0, err
+	}
+	if !units.IsInt64() {
+		return 0, fmt.Errorf("%w: amount out of range", domain.ErrBadRequest)
+	}
+	if dust := dustThreshold(toScript); units.Int64() < dust {
+		return 0, fmt.Errorf("%w: amounts below %s BTC are dust", domain.ErrBadRequest,
+			domain.FormatUnits(big.NewInt(dust), btcDecimals))
+	}
+	return units.Int64(), nil
+}
+
+// recipientScript returns the script paying to.
+func recipientScript(to string, isTestnet bool) ([]byte, error) {
+	validation, err := validateAddress(to, isTestnet)
+	if err != nil {
+		return nil, err
+	}
+	if validation.Kind != domain.AddressKindAddress {
+		return nil, fmt.Errorf("%w: send to an address, not an extended key", domain.ErrInvalidAddress)
+	}
+
+	addr, err := btcutil.DecodeAddress(to, chainParams(isTestnet))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidAddress, err)
+	}
+	script, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidAddress, err)
+	}
+	return script, nil
+}
+
+// addressChange returns change to the single address spent from.
+func addressChange(addr btcutil.Address) (*changeOutput, error) {
+	script, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidAddress, err)
+	}
+	return &changeOutput{address: addr.EncodeAddress(), script: script}, nil
+}
+
+// walletChange derives the first unused address of the change branch, the
+// only branch of keys that have no change branch.
+func walletChange(branches []branch, next []uint32, script scriptType, isTestnet bool) (*changeOutput, error) {
+	i := min(int(ChangeChain), len(branches)-1)
+	b := branches[i]
+
+	pubKey, err := childPubKey(b.root, next[i])
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidAddress, err)
+	}
+	addr, err := makeAddress(pubKey, script, isTestnet)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidAddress, err)
+	}
+	return keyChange(addr, walletKey{pubKey: pubKey, path: childPath(b.path, next[i]), fingerprint: b.fingerprint}, script)
+}
+
+// keyChange returns change to addr of a wallet, carrying key when the
+// wallet has one.
+func keyChange(addr btcutil.Address, key walletKey, script scriptType) (*changeOutput, error) {
+	change, err := addressChange(addr)
+	if err != nil || key.pubKey == nil {
+		return change, err
+	}
+	if script == scriptNestedWitnessPubKeyHash {
+		if change.redeemScript, err = witnessPubKeyHashScript(key.pubKey); err != nil {
+			return nil, err
+		}
+	}
+	change.pubKey = key.pubKey
+	change.path = key.path
+	change.fingerprint = key.fingerprint
+	change.taproot = script == scriptTaproot
+	return change, nil
+}
+
+// spendables decodes the outpoints and scripts of outputs, largest first.
+func spendables(outputs []walletOutput) ([]spendable, error) {
+	inputs := make([]spendable, len(outputs))
+	for i, output := range outputs {
+		hash, err := chainhash.NewHashFromStr(output.TransactionID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse transaction id %q: %w", output.TransactionID, err)
+		}
+		script, err := hex.DecodeString(output.ScriptPubKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse script %q: %w", output.ScriptPubKey, err)
+		}
+
+		var kind inputKind
+		switch txscript.GetScriptClass(script) {
+		case txscript.WitnessV0PubKeyHashTy:
+			kind = inputWitnessPubKeyHash
+		case txscript.WitnessV1TaprootTy:
+			kind = inputTaproot
+		case txscript.ScriptHashTy:
+			if output.pubKey == nil {
+				return nil, ErrNestedAddress
+			}
+			kind = inputNestedWitnessPubKeyHash
+		case txscript.PubKeyHashTy:
+			kind = inputPubKeyHash
+		default:
+			return nil, fmt.Errorf("%w: %s", ErrUnsupportedScript, output.ScriptPubKey)
+		}
+
+		inputs[i] = spendable{
+			walletOutput: output,
+			outpoint:     wire.OutPoint{Hash: *hash, Index: output.Vout},
+			pkScript:     script,
+			kind:         kind,
+		}
+	}
+	slices.SortStableFunc(inputs, func(x, y spendable) int {
+		return cmp.Compare(y.Value, x.Value)
+	})
+	return inputs, nil
+}
+
+// selectInputs applies coin control to inputs: excluded outpoints are
+// dropped and required ones moved to the front in the order given. It also
+// returns how many inputs are required.
+func selectInputs(inputs []spendable, required, excluded []domain.Outpoint) ([]spendable, int, error) {
+	skip := make(map[domain.Outpoint]bool, len(required)+len(excluded))
+	for _, outpoint := range excluded {
+		skip[outpoint] = true
+	}
+
+	selected := make([]spendable, 0, len(inputs))
+	for _, outpoint := range required {
+		if skip[outpoint] {
+			return nil, 0, fmt.Errorf("%w: %s is both required and excluded or required twice",
+				domain.ErrBadRequest, outpoint)
+		}
+		i := slices.IndexFunc(inputs, func(input spendable) bool {
+			return input.TransactionID == outpoint.TransactionID && input.Vout == outpoint.Vout
+		})
+		if i < 0 {
+			return nil, 0, fmt.Errorf("%w: %s", ErrUnknownInput, outpoint)
+		}
+		selected = append(selected, inputs[i])
+		skip[outpoint] = true
+	}
+	for _, input := range inputs {
+		if !skip[domain.Outpoint{TransactionID: input.TransactionID, Vout: input.Vout}] {
+			selected = append(selected, input)
+		}
+	}
+	return selected, len(required), nil
+}
+
+// planTx spends the required inputs and then the others in order until they
+// pay the recipients and the fee of the transaction's virtual size at
+// feeRate sat/vB.
+func planTx(inputs []spendable, pay *payment, feeRate float64, changeScript []byte) (*txPlan, error) {
+	var total int64
+	for i, input := range inputs {
+		total += input.Value
+		if i+1 < pay.required || total < pay.amount {
+			continue
+		}
+		if plan := settleFee(inputs[:i+1], total, pay, feeRate, changeScript); plan != nil {
+			return plan, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s BTC available", domain.ErrInsufficientFunds,
+		domain.FormatUnits(big.NewInt(total), btcDecimals))
+}
+
+// settleFee prices the transaction spending inputs worth total, with change
+// unless it would be dust, in which case it is left to the fee. It returns
+// nil when total does not cover the payment and the fee.
+func settleFee(inputs []spendable, total int64, pay *payment, feeRate float64, changeScript []byte) *txPlan {
+	tx := unsignedTransaction(inputs, pay)
+	vsize := txVsize(inputs, append(slices.Clone(tx.TxOut), wire.NewTxOut(0, changeScript)))
+	fee := int64(math.Ceil(float64(vsize) * feeRate))
+	if change := total - pay.amount - fee; change >= dustThreshold(changeScript) {
+		tx.AddTxOut(wire.NewTxOut(change, changeScript))
+		return &txPlan{tx: tx, inputs: inputs, change: change, fee: fee, vsize: vsize}
+	}
+
+	vsize = txVsize(inputs, tx.TxOut)
+	if total-pay.amount < int64(math.Ceil(float64(vsize)*feeRate)) {
+		return nil
+	}
+	return &txPlan{tx: tx, inputs: inputs, fee: total - pay.amount, vsize: vsize}
+}
+
+// sweepTx spends the required inputs and every other input worth more than
+// the fee of spending it at feeRate sat/vB, paying all of it less the fee to
+// the single recipient without change.
+func sweepTx(inputs []spendable, pay *payment, feeRate float64) (*txPlan, error) {
+	var total, available int64
+	worth := make([]spendable, 0, len(inputs))
+	for i, input := range inputs {
+		available += input.Value
+		if i < pay.required || input.Value > int64(math.Ceil(float64(inputVsize(input.kind))*feeRate)) {
+			worth = append(worth, input)
+			total += input.Value
+		}
+	}
+
+	tx := unsignedTransaction(worth, pay)
+	vsize := txVsize(worth, tx.TxOut)
+	fee := int64(math.Ceil(float64(vsize) * feeRate))
+	if len(worth) == 0 || total-fee < dustThreshold(tx.TxOut[0].PkScript) {
+		return nil, fmt.Errorf("%w: %s BTC available, not enough to pay more than dust after fees",
+			domain.ErrInsufficientFunds, domain.FormatUnits(big.NewInt(available), btcDecimals))
+	}
+	tx.TxOut[0].Value = total - fee
+	return &txPlan{tx: tx, inputs: worth, fee: fee, vsize: vsize}, nil
+}
+
+func unsignedTransaction(inputs []spendable, pay *payment) *wire.MsgTx {
+	tx := wire.NewMsgTx(wire.TxVersion)
+	tx.LockTime = pay.lockTime
+	for _, input := range inputs {
+		in := wire.NewTxIn(&input.outpoint, nil, nil)
+		in.Sequence = pay.sequence
+		tx.AddTxIn(in)
+	}
+	for _, output := range pay.outputs {
+		tx.AddTxOut(wire.NewTxOut(output.Value, output.PkScript))
+	}
+	return tx
+}
+
+// txVsize is the virtual size of a transaction spending inputs to outputs
+// once it is signed.
+func txVsize(inputs []spendable, outputs []*wire.TxOut) int64 {
+	base := txOverhead + wire.VarIntSerializeSize(uint64(len(inputs))) + wire.VarIntSerializeSize(uint64(len(outputs)))
+	witness := segwitMarker
+	segwit := false
+	for _, input := range inputs {
+		base += inputOverhead
+		switch input.kind {
+		case inputPubKeyHash:
+			base += pubKeyHashSig
+			witness++ // empty witness
+		case inputNestedWitnessPubKeyHash:
+			base += nestedScriptSig
+			witness += witnessSize
+			segwit = true
+		case inputTaproot:
+			witness += taprootWitness
+			segwit = true
+		default:
+			witness += witnessSize
+			segwit = true
+		}
+	}
+	for _, output := range outputs {
+		base += output.SerializeSize()
+	}
+	if !segwit {
+		witness = 0
+	}
+	return int64((base*witnessScaleFactor + witness + witnessScaleFactor - 1) / witnessScaleFactor)
+}
+
+// inputVsize is the virtual size an input of kind adds to a transaction
+// once it is signed.
+func inputVsize(kind inputKind) int64 {
+	switch kind {
+	case inputPubKeyHash:
+		return inputOverhead + pubKeyHashSig
+	case inputNestedWitnessPubKeyHash:
+		return inputOverhead + nestedScriptSig + (witnessSize+witnessScaleFactor-1)/witnessScaleFactor
+	case inputTaproot:
+		return inputOverhead + (taprootWitness+witnessScaleFactor-1)/witnessScaleFactor
+	default:
+		return inputOverhead + (witnessSize+witnessScaleFactor-1)/witnessScaleFactor
+	}
+}
+
+// dustThreshold is the smallest value an output paying script is relayed
+// with: the cost at dustRelayFee of the output and the input spending it.
+func dustThreshold(script []byte) int64 {
+	size := wire.NewTxOut(0, script).SerializeSize()
+	if txscript.IsWitnessProgram(script) {
+		size += inputOverhead + witnessSize/witnessScaleFactor
+	} else {
+		size += inputOverhead + pubKeyHashSig
+	}
+	return int64(size) * dustRelayFee / 1000
+}
+
+// psbtPacket serializes plan as a BIP174 PSBT. Inputs carry the transaction
+// they spend, segwit inputs also the spent output, and inputs and change of
+// a wallet the key and path deriving them, as BIP371 fields for taproot.
+func psbtPacket(plan *txPlan, prevTxs []*wire.MsgTx, change *changeOutput) ([]byte, error) {
+	packet, err := psbt.NewFromUnsignedTx(plan.tx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create psbt: %w", err)
+	}
+	for i, input := range plan.inputs {
+		in := &packet.Inputs[i]
+		if input.kind != inputPubKeyHash {
+			in.WitnessUtxo = wire.NewTxOut(input.Value, input.pkScript)
+		}
+		if input.kind == inputTaproot {
+			if input.pubKey != nil {
+				in.TaprootInternalKey = schnorr.SerializePubKey(input.pubKey)
+			}
+			in.TaprootBip32Derivation = taprootDerivation(input.pubKey, input.fingerprint, input.DerivationPath)
+			continue
+		}
+		in.NonWitnessUtxo = prevTxs[i]
+		in.SighashType = txscript.SigHashAll
+		if input.kind == inputNestedWitnessPubKeyHash {
+			if in.RedeemScript, err = input.witnessProgram(); err != nil {
+				return nil, err
+			}
+		}
+		in.Bip32Derivation = bip32Derivation(input.pubKey, input.fingerprint, input.DerivationPath)
+	}
+	if plan.change > 0 {
+		out := &packet.Outputs[len(packet.Outputs)-1]
+		if change.taproot {
+			out.TaprootInternalKey = schnorr.SerializePubKey(change.pubKey)
+			out.TaprootBip32Derivation = taprootDerivation(change.pubKey, change.fingerprint, change.path)
+		} else {
+			out.RedeemScript = change.redeemScript
+			out.Bip32Derivation = bip32Derivation(change.pubKey, change.fingerprint, change.path)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := packet.Serialize(&buf); err != nil {
+		return nil, fmt.Errorf("failed to serialize psbt: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// bip32Derivation describes the key at path for a PSBT, nil for keys of a
+// single address and for keys whose position below the master key is not
+// known.
+func bip32Derivation(pubKey *btcec.PublicKey, fingerprint uint32, path string) []*psbt.Bip32Derivation {
+	indexes, ok := bip32Path(path)
+	if pubKey == nil || !ok {
+		return nil
+	}
+	return []*psbt.Bip32Derivation{{
+		PubKey:               pubKey.SerializeCompressed(),
+		MasterKeyFingerprint: fingerprint,
+		Bip32Path:            indexes,
+	}}
+}
+
+// taprootDerivation is bip32Derivation for the x-only internal key of a
+// taproot output.
+func taprootDerivation(pubKey *btcec.PublicKey, fingerprint uint32, path string) []*psbt.TaprootBip32Derivation {
+	indexes, ok := bip32Path(path)
+	if pubKey == nil || !ok {
+		return nil
+	}
+	return []*psbt.TaprootBip32Derivation{{
+		XOnlyPubKey:          schnorr.SerializePubKey(pubKey),
+		MasterKeyFingerprint: fingerprint,
+		Bip32Path:            indexes,
+	}}
+}
+
+// bip32Path parses a path such as m/84'/0'/0'/0/1 into its indexes.
+func bip32Path(path string) ([]uint32, bool) {
+	steps := strings.Split(path, "/")
+	if steps[0] != "m" {
+		return nil, false
+	}
+	indexes := make([]uint32, 0, len(steps)-1)
+	for _, step := range steps[1:] {
+		index, err := strconv.ParseUint(strings.TrimSuffix(step, "'"), 10, 31)
+		if err != nil {
+			return nil, false
+		}
+		if strings.HasSuffix(step, "'") {
+			index += 1 << 31
+		}
+		indexes = append(indexes, uint32(index))
+	}
+	return indexes, true
+}
+
+// signingInputs lists the inputs of plan with the digest each of them is
+// signed over: BIP341 SIGHASH_DEFAULT for taproot inputs, BIP143
+// SIGHASH_ALL for segwit v0 inputs and the legacy digest for P2PKH inputs.
+func signingInputs(plan *txPlan) ([]domain.UnsignedTxInput, error) {
+	prevOuts := txscript.NewMultiPrevOutFetcher(nil)
+	for _, input := range plan.inputs {
+		prevOuts.AddPrevOut(input.outpoint, wire.NewTxOut(input.Value, input.pkScript))
+	}
+	sigHashes := txscript.NewTxSigHashes(plan.tx, prevOuts)
+
+	inputs := make([]domain.UnsignedTxInput, len(plan.inputs))
+	for i, input := range plan.inputs {
+		var hash []byte
+		var err error
+		switch input.kind {
+		case inputPubKeyHash:
+			hash, err = txscript.CalcSignatureHash(input.pkScript, txscript.SigHashAll, plan.tx, i)
+		case inputTaproot:
+			hash, err = txscript.CalcTaprootSignatureHash(sigHashes, txscript.SigHashDefault, plan.tx, i, prevOuts)
+		default:
+			var program []byte
+			if program, err = input.witnessProgram(); err == nil {
+				hash, err = txscript.CalcWitnessSigHash(program, sigHashes, txscript.SigHashAll, plan.tx, i, input.Value)
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		inputs[i] = domain.UnsignedTxInput{
+			TransactionID:  input.TransactionID,
+			Vout:           input.Vout,
+			Value:          input.Value,
+			Address:        input.Address,
+			DerivationPath: input.DerivationPath,
+			SigningHash:    hash,
+		}
+	}
+	return inputs, nil
+}
+
+// txDetails lists what a signing device shows about plan before signing it.
+func txDetails(
+	plan *txPlan, recipients []domain.Recipient, opReturn []byte, changeAddress string, feeRate float64,
+) []domain.TxDetail {
+	var details []domain.TxDetail
+	if len(recipients) == 1 {
+		details = append(details,
+			domain.TxDetail{Label: "To", Value: recipients[0].Address},
+			domain.TxDetail{Label: "Amount", Value: recipients[0].Amount + " BTC"},
+		)
+	} else {
+		for i, recipient := range recipients {
+			details = append(details, domain.TxDetail{
+				Label: "Output " + strconv.Itoa(i+1),
+				Value: recipient.Amount + " BTC to " + recipient.Address,
+			})
+		}
+	}
+	if len(opReturn) > 0 {
+		details = append(details, domain.TxDetail{Label: "OP_RETURN", Value: hex.EncodeToString(opReturn)})
+	}
+	details = append(details, domain.TxDetail{Label: "Inputs", Value: strconv.Itoa(len(plan.inputs))})
+	if plan.change > 0 {
+		details = append(details,
+			domain.TxDetail{Label: "Change", Value: domain.FormatUnits(big.NewInt(plan.change), btcDecimals) + " BTC"},
+			domain.TxDetail{Label: "Change address", Value: changeAddress},
+		)
+	}
+	rbf := "enabled"
+	if plan.tx.TxIn[0].Sequence > sequenceReplaceable {
+		rbf = "disabled"
+	}
+	details = append(details, domain.TxDetail{Label: "Replace-by-fee", Value: rbf})
+	if plan.tx.LockTime != 0 {
+		details = append(details, domain.TxDetail{
+			Label: "Lock time",
+			Value: strconv.FormatUint(uint64(plan.tx.LockTime), 10),
+		})
+	}
+	return append(details,
+		domain.TxDetail{Label: "Size", Value: strconv.FormatInt(plan.vsize, 10) + " vB"},
+		domain.TxDetail{Label: "Fee rate", Value: strconv.FormatFloat(feeRate, 'f', -1, 64) + " sat/vB"},
+		domain.TxDetail{Label: "Fee", Value: domain.FormatUnits(big.NewInt(plan.fee), btcDecimals) + " BTC"},
+	)
+}
+
+// witnessPubKeyHashScript returns the P2WPKH script of pubKey.
+func witnessPubKeyHashScript(pubKey *btcec.PublicKey) ([]byte, error) {
+	script, err := txscript.NewScriptBuilder().
+		AddOp(txscript.OP_0).
+		AddData(btcutil.Hash160(pubKey.SerializeCompressed())).
+		Script()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build witness script: %w", err)
+	}
+	return script, nil
+}
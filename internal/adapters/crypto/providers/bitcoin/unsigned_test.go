@@ -0,0 +1,223 @@
+package bitcoin_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/adapters/crypto/providers/bitcoin"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testDescriptor    = "wpkh([73c5da0a/84h/0h/0h]" + bip84Zpub + "/<0;1>/*)"
+	taprootDescriptor = "tr([73c5da0a/86h/0h/0h]" + bip86Xpub + "/<0;1>/*)"
+	hardened          = 1 << 31
+)
+
+func TestPlanPSBT_Batch(t *testing.T) {
+	t.Parallel()
+
+	recipients, err := bitcoin.ReceiveAddresses(bip84Zpub, 10, 2, false)
+	require.NoError(t, err)
+
+	packet, inputs, fee, err := bitcoin.PlanPSBT(testDescriptor, []int64{50_000, 1_000_000}, domain.UnsignedTxRequest{
+		Recipients: []domain.Recipient{
+			{Address: recipients[0].Address, Amount: "0.002"},
+			{Address: recipients[1].Address, Amount: "0.003"},
+		},
+		OpReturn: []byte("payout batch 7"),
+	}, 2)
+	require.NoError(t, err)
+
+	// The recipients come first in order, then the data output and the change.
+	tx := packet.UnsignedTx
+	require.Len(t, tx.TxIn, 1)
+	require.Len(t, tx.TxOut, 4)
+	assert.Equal(t, int64(200_000), tx.TxOut[0].Value)
+	assert.Equal(t, int64(300_000), tx.TxOut[1].Value)
+	assert.Equal(t, txscript.NullDataTy, txscript.GetScriptClass(tx.TxOut[2].PkScript))
+	assert.Equal(t, int64(0), tx.TxOut[2].Value)
+	assert.Equal(t, 1_000_000-500_000-fee, tx.TxOut[3].Value)
+	require.Len(t, packet.Outputs[3].Bip32Derivation, 1)
+	assert.Equal(t, []uint32{84 + hardened, hardened, hardened, 1, 0}, packet.Outputs[3].Bip32Derivation[0].Bip32Path)
+
+	// Replaceability is signalled by default.
+	assert.Equal(t, uint32(wire.MaxTxInSequenceNum-2), tx.TxIn[0].Sequence)
+	assert.Equal(t, uint32(0), tx.LockTime)
+
+	in := packet.Inputs[0]
+	require.NotNil(t, in.WitnessUtxo)
+	require.NotNil(t, in.NonWitnessUtxo)
+	require.Len(t, in.Bip32Derivation, 1)
+	assert.Equal(t, uint32(0x0ada_c573), in.Bip32Derivation[0].MasterKeyFingerprint)
+	prevOuts := txscript.NewCannedPrevOutputFetcher(in.WitnessUtxo.PkScript, in.WitnessUtxo.Value)
+	hash, err := txscript.CalcWitnessSigHash(in.WitnessUtxo.PkScript, txscript.NewTxSigHashes(tx, prevOuts),
+		txscript.SigHashAll, tx, 0, in.WitnessUtxo.Value)
+	require.NoError(t, err)
+	require.Len(t, inputs, 1)
+	assert.Equal(t, hash, inputs[0].SigningHash)
+}
+
+func TestPlanPSBT_CoinControl(t *testing.T) {
+	t.Parallel()
+
+	recipient, err := bitcoin.ReceiveAddresses(bip84Zpub, 10, 1, false)
+	require.NoError(t, err)
+	values := []int64{50_000, 1_000_000, 300_000}
+	outpoints, err := bitcoin.WalletOutpoints(testDescriptor, values)
+	require.NoError(t, err)
+
+	// The small output is spent first because it is required, and the
+	// largest is left alone because it is excluded.
+	packet, _, _, err := bitcoin.PlanPSBT(testDescriptor, values, domain.UnsignedTxRequest{
+		To:             recipient[0].Address,
+		Amount:         "0.002",
+		RequiredInputs: []domain.Outpoint{outpoints[0]},
+		ExcludedInputs: []domain.Outpoint{outpoints[1]},
+	}, 2)
+	require.NoError(t, err)
+
+	tx := packet.UnsignedTx
+	require.Len(t, tx.TxIn, 2)
+	assert.Equal(t, outpoints[0].TransactionID, tx.TxIn[0].PreviousOutPoint.Hash.String())
+	assert.Equal(t, outpoints[2].TransactionID, tx.TxIn[1].PreviousOutPoint.Hash.String())
+
+	_, _, _, err = bitcoin.PlanPSBT(testDescriptor, values, domain.UnsignedTxRequest{
+		To:             recipient[0].Address,
+		Amount:         "0.004",
+		ExcludedInputs: []domain.Outpoint{outpoints[1]},
+	}, 2)
+	require.ErrorIs(t, err, domain.ErrInsufficientFunds)
+}
+
+func TestPlanPSBT_Taproot(t *testing.T) {
+	t.Parallel()
+
+	recipient, err := bitcoin.ReceiveAddresses(bip84Zpub, 10, 1, false)
+	require.NoError(t, err)
+
+	packet, inputs, _, err := bitcoin.PlanPSBT(taprootDescriptor, []int64{400_000, 300_000},
+		domain.UnsignedTxRequest{To: recipient[0].Address, Amount: "0.005", DisableRBF: true, LockTime: 900_000}, 3)
+	require.NoError(t, err)
+
+	// Without RBF the inputs are final but for the lock time.
+	tx := packet.UnsignedTx
+	require.Len(t, tx.TxIn, 2)
+	assert.Equal(t, uint32(wire.MaxTxInSequenceNum-1), tx.TxIn[0].Sequence)
+	assert.Equal(t, uint32(900_000), tx.LockTime)
+
+	prevOuts := txscript.NewMultiPrevOutFetcher(nil)
+	for _, in := range packet.Inputs {
+		require.NotNil(t, in.WitnessUtxo)
+		assert.Nil(t, in.NonWitnessUtxo)
+		assert.Len(t, in.TaprootInternalKey, 32)
+		require.Len(t, in.TaprootBip32Derivation, 1)
+		assert.Equal(t, uint32(0x0ada_c573), in.TaprootBip32Derivation[0].MasterKeyFingerprint)
+	}
+	for i, in := range packet.Inputs {
+		prevOuts.AddPrevOut(tx.TxIn[i].PreviousOutPoint, in.WitnessUtxo)
+	}
+	assert.Equal(t, []uint32{86 + hardened, hardened, hardened, 1, 0},
+		packet.Outputs[1].TaprootBip32Derivation[0].Bip32Path)
+
+	// The digest returned per input is the BIP341 one of the PSBT.
+	sigHashes := txscript.NewTxSigHashes(tx, prevOuts)
+	for i := range packet.Inputs {
+		hash, err := txscript.CalcTaprootSignatureHash(sigHashes, txscript.SigHashDefault, tx, i, prevOuts)
+		require.NoError(t, err)
+		assert.Equal(t, hash, inputs[i].SigningHash)
+	}
+}
+
+func TestPlanPSBT_Sweep(t *testing.T) {
+	t.Parallel()
+
+	recipient, err := bitcoin.ReceiveAddresses(bip84Zpub, 10, 1, false)
+	require.NoError(t, err)
+
+	packet, _, fee, err := bitcoin.PlanPSBT(testDescriptor, []int64{50_000, 1_000_000, 100}, domain.UnsignedTxRequest{
+		Recipients: []domain.Recipient{{Address: recipient[0].Address, Amount: "max"}},
+	}, 2)
+	require.NoError(t, err)
+
+	tx := packet.UnsignedTx
+	require.Len(t, tx.TxIn, 2)
+	require.Len(t, tx.TxOut, 1)
+	assert.Equal(t, int64(2*178), fee)
+	assert.Equal(t, 1_050_000-fee, tx.TxOut[0].Value)
+}
+
+func TestPlanPSBT_Errors(t *testing.T) {
+	t.Parallel()
+
+	recipients, err := bitcoin.ReceiveAddresses(bip84Zpub, 10, 2, false)
+	require.NoError(t, err)
+	values := []int64{60_000, 70_000}
+	outpoints, err := bitcoin.WalletOutpoints(testDescriptor, values)
+	require.NoError(t, err)
+	unknown := domain.Outpoint{TransactionID: outpoints[0].TransactionID, Vout: 1}
+
+	tests := []struct {
+		name    string
+		request domain.UnsignedTxRequest
+		want    error
+	}{
+		{
+			name:    "insufficient funds",
+			request: domain.UnsignedTxRequest{To: recipients[0].Address, Amount: "0.002"},
+			want:    domain.ErrInsufficientFunds,
+		},
+		{
+			name:    "dust",
+			request: domain.UnsignedTxRequest{To: recipients[0].Address, Amount: "0.00000293"},
+			want:    domain.ErrBadRequest,
+		},
+		{
+			name:    "extended key recipient",
+			request: domain.UnsignedTxRequest{To: bip84Zpub, Amount: "0.0001"},
+			want:    domain.ErrInvalidAddress,
+		},
+		{
+			name: "max with several recipients",
+			request: domain.UnsignedTxRequest{Recipients: []domain.Recipient{
+				{Address: recipients[0].Address, Amount: "max"},
+				{Address: recipients[1].Address, Amount: "0.0001"},
+			}},
+			want: domain.ErrBadRequest,
+		},
+		{
+			name: "OP_RETURN too long",
+			request: domain.UnsignedTxRequest{
+				To: recipients[0].Address, Amount: "0.0001", OpReturn: bytes.Repeat([]byte{1}, bitcoin.MaxOpReturn+1),
+			},
+			want: domain.ErrBadRequest,
+		},
+		{
+			name: "unknown required input",
+			request: domain.UnsignedTxRequest{
+				To: recipients[0].Address, Amount: "0.0001", RequiredInputs: []domain.Outpoint{unknown},
+			},
+			want: bitcoin.ErrUnknownInput,
+		},
+		{
+			name: "required and excluded input",
+			request: domain.UnsignedTxRequest{
+				To: recipients[0].Address, Amount: "0.0001",
+				RequiredInputs: outpoints[:1], ExcludedInputs: outpoints[:1],
+			},
+			want: domain.ErrBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, _, _, err := bitcoin.PlanPSBT(testDescriptor, values, tt.request, 2)
+			require.ErrorIs(t, err, tt.want)
+		})
+	}
+}
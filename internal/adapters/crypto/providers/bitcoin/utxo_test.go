@@ -0,0 +1,98 @@
+package bitcoin_test
+
+import (
+	"testing"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/adapters/crypto/providers/bitcoin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// bip84Xpub is the BIP84 test vector account key in plain xpub encoding, as
+// output descriptors carry it.
+const bip84Xpub = "xpub6CatWdiZiodmUeTDp8LT5or8nmbKNcuyvz7WyksVFkKB4RHwCD3XyuvPEbvqAQY3rAPshWcMLoP2fMFMKHPJ4ZeZXYVUhLv1VMrjPC7PW6V"
+
+func TestParseDescriptor(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		desc string
+		want map[string]string
+	}{
+		{
+			name: "wpkh with origin and checksum",
+			desc: "wpkh([73c5da0a/84h/0h/0h]" + bip84Xpub + "/0/*)#checksum",
+			want: map[string]string{"m/84'/0'/0'/0/0": "bc1qcr8te4kr609gcawutmrza0j4xv80jy8z306fyu"},
+		},
+		{
+			name: "tr with multipath",
+			desc: "tr([73c5da0a/86'/0'/0']" + bip86Xpub + "/<0;1>/*)",
+			want: map[string]string{
+				"m/86'/0'/0'/0/0": "bc1p5cyxnuxmeuwuvkwfem96lqzszd02n6xdcjrs20cac6yqjjwudpxqkedrcr",
+				"m/86'/0'/0'/1/0": "bc1p3qkhfews2uk44qtvauqyr2ttdsw7svhkl9nkm9s9c3x4ax5h60wqwruhk7",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			addresses, err := bitcoin.DescriptorAddresses(tt.desc, false)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, addresses)
+		})
+	}
+}
+
+func TestParseDescriptor_Unsupported(t *testing.T) {
+	t.Parallel()
+
+	for _, desc := range []string{
+		"pkh(" + bip84Xpub + "/0/*)",
+		"wpkh(" + bip84Xpub + "/0/1)",
+		"wpkh(" + bip84Xpub + "/0h/*)",
+		"sh(wpkh(" + bip84Xpub + "/0/*)",
+	} {
+		_, err := bitcoin.DescriptorAddresses(desc, false)
+		require.ErrorIs(t, err, bitcoin.ErrUnsupportedDescriptor, desc)
+	}
+}
+
+func TestUnspentOutputs(t *testing.T) {
+	t.Parallel()
+
+	utxos := bitcoin.UnspentOutputs([]*bitcoin.ListUnspentResult{
+		{Height: 800000, Position: 1, Hash: "aa", Value: 150000},
+		{Height: 0, Position: 0, Hash: "bb", Value: 546},
+	}, "bc1qaddr", "0014ab", "m/84'/0'/0'/0/3", 800005)
+
+	require.Len(t, utxos, 2)
+	assert.Equal(t, "aa", utxos[0].TransactionID)
+	assert.Equal(t, uint32(1), utxos[0].Vout)
+	assert.Equal(t, int64(150000), utxos[0].Value)
+	assert.InDelta(t, 0.0015, utxos[0].Amount, 1e-12)
+	assert.Equal(t, int64(6), utxos[0].Confirmations)
+	require.NotNil(t, utxos[0].BlockHeight)
+	assert.Equal(t, int64(800000), *utxos[0].BlockHeight)
+	assert.Equal(t, "m/84'/0'/0'/0/3", utxos[0].DerivationPath)
+
+	assert.Nil(t, utxos[1].BlockHeight)
+	assert.Equal(t, int64(0), utxos[1].Confirmations)
+}
+
+func TestYoungValue(t *testing.T) {
+	t.Parallel()
+
+	entries := []*bitcoin.ListUnspentResult{
+		{Hash: "aa", Height: 100, Value: 1000},
+		{Hash: "bb", Height: 99, Value: 2000},
+		{Hash: "cc", Height: 97, Value: 4000},
+		{Hash: "dd", Height: 0, Value: 8000},
+	}
+
+	assert.Equal(t, int64(3000), bitcoin.YoungValue(entries, 100, 3))
+	assert.Equal(t, int64(0), bitcoin.YoungValue(entries, 100, 1))
+	assert.Equal(t, int64(7000), bitcoin.YoungValue(entries, 100, 6))
+}
@@ -0,0 +1,88 @@
+package bitcoin_test
+
+import (
+	"testing"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/adapters/crypto/providers/bitcoin"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+	hd "github.com/btcsuite/btcd/btcutil/hdkeychain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testXpub = "xpub661MyMwAqRbcFtXgS5sYJABqqG9YLmC4Q1Rdap9gSE8NqtwybGhePY2gZ29ESFjqJoCu1Rupje8YtGqsefD265TMg7usUDFdp6W1EGMcet8"
+	testXprv = "xprv9s21ZrQH143K3QTDL4LXw2F7HEK3wJUD2nW2nRk4stbPy6cq3jPPqjiChkVvvNKmPGJxWUtg6LnF5kejMRNNU3TGtRBeJgk33yuGBxrMPHi"
+)
+
+func withVersion(t *testing.T, key string, version []byte) string {
+	t.Helper()
+	extended, err := hd.NewKeyFromString(key)
+	require.NoError(t, err)
+	cloned, err := extended.CloneWithVersion(version)
+	require.NoError(t, err)
+	return cloned.String()
+}
+
+func TestValidateAddress(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		address   string
+		isTestnet bool
+		kind      domain.AddressKind
+		format    string
+	}{
+		{"p2pkh", "1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa", false, domain.AddressKindAddress, "p2pkh"},
+		{"p2sh", "3J98t1WpEZ73CNmQviecrnyiWrnqRhWNLy", false, domain.AddressKindAddress, "p2sh"},
+		{"p2wpkh", "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4", false, domain.AddressKindAddress, "p2wpkh"},
+		{"p2tr", "bc1p5cyxnuxmeuwuvkwfem96lqzszd02n6xdcjrs20cac6yqjjwudpxqkedrcr", false, domain.AddressKindAddress, "p2tr"},
+		{"testnet p2wpkh", "tb1qw508d6qejxtdg4y5r3zarvary0c5xw7kxpjzsx", true, domain.AddressKindAddress, "p2wpkh"},
+		{"xpub", testXpub, false, domain.AddressKindExtendedKey, "xpub"},
+		{"zpub", withVersion(t, testXpub, []byte{0x04, 0xb2, 0x47, 0x46}), false, domain.AddressKindExtendedKey, "zpub"},
+		{"tpub", withVersion(t, testXpub, []byte{0x04, 0x35, 0x87, 0xcf}), true, domain.AddressKindExtendedKey, "tpub"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			validation, err := bitcoin.ValidateAddress(tt.address, tt.isTestnet)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.kind, validation.Kind)
+			assert.Equal(t, tt.format, validation.Format)
+			assert.Equal(t, domain.NetworkName(tt.isTestnet), validation.Network)
+		})
+	}
+}
+
+func TestValidateAddress_Invalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		address   string
+		isTestnet bool
+		message   string
+	}{
+		{"testnet address on mainnet", "tb1qw508d6qejxtdg4y5r3zarvary0c5xw7kxpjzsx", false, "testnet address used on mainnet"},
+		{"mainnet address on testnet", "1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa", true, "mainnet address used on testnet"},
+		{"mainnet xpub on testnet", testXpub, true, "mainnet address used on testnet"},
+		{"private key", testXprv, false, "extended private keys are not accepted"},
+		{"bad checksum", "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t5", false, ""},
+		{"garbage", "not-an-address", false, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := bitcoin.ValidateAddress(tt.address, tt.isTestnet)
+
+			require.ErrorIs(t, err, domain.ErrInvalidAddress)
+			assert.Contains(t, err.Error(), tt.message)
+		})
+	}
+}
@@ -0,0 +1,237 @@
+// Package electrumtest provides a local Electrum server for tests. It serves
+// a fixed chain of headers, transactions and unspent outputs keyed by
+// scripthash, so the Bitcoin and Litecoin adapters can be exercised without
+// network access.
+package electrumtest
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lamengao/go-electrum/electrum"
+)
+
+// Server answers the Electrum requests the adapters make. Headers are
+// reported at the tip set with SetTip; blocks without a header added by
+// AddHeader have a zero header.
+type Server struct {
+	listener net.Listener
+
+	mu            sync.Mutex
+	tip           int32
+	failures      int
+	subscriptions int
+	headers       map[int32]string
+	txs           map[string]string
+	history       map[string][]*electrum.GetMempoolResult
+	unspent       map[string][]*electrum.ListUnspentResult
+}
+
+// NewServer starts a server at tip. Close it when done.
+func NewServer(tip int32) (*Server, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, fmt.Errorf("listen: %w", err)
+	}
+	s := &Server{
+		listener: listener,
+		tip:      tip,
+		headers:  make(map[int32]string),
+		txs:      make(map[string]string),
+		history:  make(map[string][]*electrum.GetMempoolResult),
+		unspent:  make(map[string][]*electrum.ListUnspentResult),
+	}
+	go s.accept()
+	return s, nil
+}
+
+// Addr is the host:port the server listens on.
+func (s *Server) Addr() string {
+	return s.listener.Addr().String()
+}
+
+// Close stops accepting connections.
+func (s *Server) Close() {
+	_ = s.listener.Close()
+}
+
+// SetTip sets the height blockchain.headers.subscribe reports.
+func (s *Server) SetTip(tip int32) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.tip = tip
+}
+
+// FailHeaderSubscriptions makes the next n blockchain.headers.subscribe
+// requests fail.
+func (s *Server) FailHeaderSubscriptions(n int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failures = n
+}
+
+// HeaderSubscriptions counts the blockchain.headers.subscribe requests made,
+// failed ones included.
+func (s *Server) HeaderSubscriptions() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.subscriptions
+}
+
+// AddHeader serves header as the block at height.
+func (s *Server) AddHeader(height int32, header wire.BlockHeader) error {
+	var buf bytes.Buffer
+	if err := header.Serialize(&buf); err != nil {
+		return fmt.Errorf("serialize header: %w", err)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.headers[height] = hex.EncodeToString(buf.Bytes())
+	return nil
+}
+
+// AddTransaction serves tx, mined at height or in the mempool when height is
+// 0, and lists it in the history of scripthashes.
+func (s *Server) AddTransaction(tx *wire.MsgTx, height int32, scripthashes ...string) error {
+	var buf bytes.Buffer
+	if err := tx.Serialize(&buf); err != nil {
+		return fmt.Errorf("serialize transaction: %w", err)
+	}
+	txid := tx.TxHash().String()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.txs[txid] = hex.EncodeToString(buf.Bytes())
+	for _, scripthash := range scripthashes {
+		s.history[scripthash] = append(s.history[scripthash], &electrum.GetMempoolResult{Hash: txid, Height: height})
+	}
+	return nil
+}
+
+// AddUnspent adds outputs to the unspent outputs and balance of scripthash.
+// Outputs at height 0 are unconfirmed.
+func (s *Server) AddUnspent(scripthash string, outputs ...*electrum.ListUnspentResult) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.unspent[scripthash] = append(s.unspent[scripthash], outputs...)
+}
+
+func (s *Server) accept() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		go s.serve(conn)
+	}
+}
+
+type request struct {
+	ID     uint64            `json:"id"`
+	Method string            `json:"method"`
+	Params []json.RawMessage `json:"params"`
+}
+
+func (s *Server) serve(conn net.Conn) {
+	defer conn.Close()
+
+	var mu sync.Mutex
+	scanner := bufio.NewScanner(conn)
+	for scanner.Scan() {
+		var req request
+		if err := json.Unmarshal(scanner.Bytes(), &req); err != nil {
+			return
+		}
+		go func() {
+			// The client only waits for a reply once it has sent the request.
+			time.Sleep(time.Millisecond)
+			response := map[string]any{"jsonrpc": "2.0", "id": req.ID}
+			if result, err := s.handle(req); err != nil {
+				response["error"] = err.Error()
+			} else {
+				response["result"] = result
+			}
+			line, _ := json.Marshal(response)
+			mu.Lock()
+			defer mu.Unlock()
+			_, _ = conn.Write(append(line, '\n'))
+		}()
+	}
+}
+
+func (s *Server) handle(req request) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch req.Method {
+	case "blockchain.headers.subscribe":
+		s.subscriptions++
+		if s.failures > 0 {
+			s.failures--
+			return nil, fmt.Errorf("headers unavailable")
+		}
+		return map[string]any{"height": s.tip, "hex": ""}, nil
+	case "blockchain.block.header":
+		var height int32
+		if err := param(req.Params, 0, &height); err != nil {
+			return nil, err
+		}
+		if header, ok := s.headers[height]; ok {
+			return header, nil
+		}
+		return hex.EncodeToString(make([]byte, wire.MaxBlockHeaderPayload)), nil
+	case "blockchain.transaction.get":
+		var txid string
+		if err := param(req.Params, 0, &txid); err != nil {
+			return nil, err
+		}
+		if raw, ok := s.txs[txid]; ok {
+			return raw, nil
+		}
+		return nil, fmt.Errorf("transaction %s not found", txid)
+	}
+
+	var scripthash string
+	if err := param(req.Params, 0, &scripthash); err != nil {
+		return nil, err
+	}
+	switch req.Method {
+	case "blockchain.scripthash.get_history":
+		return nonNil(s.history[scripthash]), nil
+	case "blockchain.scripthash.listunspent":
+		return nonNil(s.unspent[scripthash]), nil
+	case "blockchain.scripthash.get_balance":
+		var confirmed, unconfirmed int64
+		for _, output := range s.unspent[scripthash] {
+			if output.Height > 0 {
+				confirmed += output.Value
+			} else {
+				unconfirmed += output.Value
+			}
+		}
+		return map[string]int64{"confirmed": confirmed, "unconfirmed": unconfirmed}, nil
+	}
+	return nil, fmt.Errorf("unexpected method %s", req.Method)
+}
+
+func param(params []json.RawMessage, index int, v any) error {
+	if index >= len(params) {
+		return fmt.Errorf("missing parameter %d", index)
+	}
+	return json.Unmarshal(params[index], v)
+}
+
+// nonNil keeps empty listings from being served as null.
+func nonNil[T any](items []T) []T {
+	if items == nil {
+		return []T{}
+	}
+	return items
+}
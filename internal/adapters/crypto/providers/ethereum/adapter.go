@@ -8,12 +8,19 @@ import (
 	"sync"
 	"time"
 
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/ports"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/tracing"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/ethclient"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
+var tracer = tracing.Tracer("ethereum")
+
 var (
-	ErrInvalidEthereumAddress = errors.New("invalid Ethereum address format")
+	ErrInvalidEthereumAddress = &domain.Error{Code: domain.CodeInvalidAddress, Message: "invalid Ethereum address format"}
 )
 
 const (
@@ -30,19 +37,60 @@ type Adapter struct {
 	client    *ethclient.Client
 	rpcURL    string
 	connected bool
+	isTestnet bool
+	indexer   ports.TransactionIndexer
+}
+
+// Option configures an Adapter.
+type Option func(*Adapter)
+
+// WithIndexer sets the indexer transaction history is read from. Without one
+// GetTransactions fails, since JSON-RPC cannot list an address's history.
+func WithIndexer(indexer ports.TransactionIndexer) Option {
+	return func(a *Adapter) {
+		a.indexer = indexer
+	}
 }
 
-func NewAdapter(rpcURL string) *Adapter {
+func NewAdapter(rpcURL string, isTestnet bool, opts ...Option) *Adapter {
 	a := &Adapter{
-		rpcURL: rpcURL,
+		rpcURL:    rpcURL,
+		isTestnet: isTestnet,
+	}
+	for _, opt := range opts {
+		opt(a)
 	}
 	a.connectWithRetry()
 	return a
 }
 
-func (a *Adapter) GetBalance(address string) (float64, error) {
+func (a *Adapter) GetBalance(ctx context.Context, address string) (float64, error) {
+	ctx, span := tracer.Start(ctx, "ethereum.GetBalance", trace.WithAttributes(a.spanAttributes()...))
+	breakdown, err := a.getBalance(ctx, address, domain.BalanceOptions{})
+	tracing.End(span, err)
+	if err != nil {
+		return 0, err
+	}
+	return breakdown.Total(), nil
+}
+
+// GetBalanceBreakdown reads the confirmed balance opts.MinConfirmations - 1
+// blocks below the head, or at the head for one confirmation or fewer, and
+// books its difference to the pending state as pending funds.
+func (a *Adapter) GetBalanceBreakdown(
+	ctx context.Context, address string, opts domain.BalanceOptions,
+) (*domain.BalanceBreakdown, error) {
+	ctx, span := tracer.Start(ctx, "ethereum.GetBalanceBreakdown", trace.WithAttributes(a.spanAttributes()...))
+	breakdown, err := a.getBalance(ctx, address, opts)
+	tracing.End(span, err)
+	return breakdown, err
+}
+
+func (a *Adapter) getBalance(
+	ctx context.Context, address string, opts domain.BalanceOptions,
+) (*domain.BalanceBreakdown, error) {
 	if !common.IsHexAddress(address) {
-		return 0, ErrInvalidEthereumAddress
+		return nil, ErrInvalidEthereumAddress
 	}
 
 	addr := common.HexToAddress(address)
@@ -55,19 +103,11 @@ func (a *Adapter) GetBalance(address string) (float64, error) {
 			continue
 		}
 
-		ctx, cancel := context.WithTimeout(context.Background(), BalanceTimeout)
-		balance, err := client.BalanceAt(ctx, addr, nil)
+		rpcCtx, cancel := context.WithTimeout(ctx, BalanceTimeout)
+		breakdown, err := a.fetchBreakdown(rpcCtx, client, addr, opts.MinConfirmations)
 		cancel()
-
 		if err == nil {
-			balanceFloat := new(big.Float)
-			balanceFloat.SetString(balance.String())
-			weiPerEth := new(big.Float)
-			weiPerEth.SetFloat64(WeiPerEther)
-			ethBalance := new(big.Float).Quo(balanceFloat, weiPerEth)
-
-			result, _ := ethBalance.Float64()
-			return result, nil
+			return breakdown, nil
 		}
 
 		lastErr = err
@@ -80,7 +120,62 @@ func (a *Adapter) GetBalance(address string) (float64, error) {
 		time.Sleep(RetryDelay)
 	}
 
-	return 0, lastErr
+	return nil, domain.UpstreamError(lastErr)
+}
+
+func (a *Adapter) fetchBreakdown(
+	ctx context.Context, client *ethclient.Client, addr common.Address, minConfirmations int64,
+) (*domain.BalanceBreakdown, error) {
+	var block *big.Int
+	if minConfirmations > 1 {
+		rpcCtx, span := a.rpcSpan(ctx, "eth_blockNumber")
+		head, err := client.BlockNumber(rpcCtx)
+		tracing.End(span, err)
+		if err != nil {
+			return nil, err
+		}
+		block = confirmedBlock(head, minConfirmations)
+	}
+
+	rpcCtx, span := a.rpcSpan(ctx, "eth_getBalance")
+	confirmed, err := client.BalanceAt(rpcCtx, addr, block)
+	tracing.End(span, err)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcCtx, span = a.rpcSpan(ctx, "eth_getBalance")
+	pending, err := client.PendingBalanceAt(rpcCtx, addr)
+	tracing.End(span, err)
+	if err != nil {
+		return nil, err
+	}
+
+	breakdown := domain.BalanceBreakdown{Confirmed: weiToEther(confirmed)}
+	breakdown.NetPending(weiToEther(new(big.Int).Sub(pending, confirmed)))
+	return &breakdown, nil
+}
+
+// confirmedBlock returns the newest block with minConfirmations confirmations
+// when head is the latest one.
+func confirmedBlock(head uint64, minConfirmations int64) *big.Int {
+	depth := uint64(minConfirmations - 1) //nolint:gosec // callers pass minConfirmations > 1
+	if depth > head {
+		return new(big.Int)
+	}
+	return new(big.Int).SetUint64(head - depth)
+}
+
+func (a *Adapter) rpcSpan(ctx context.Context, method string) (context.Context, trace.Span) {
+	return tracer.Start(ctx, method, trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(a.spanAttributes()...),
+		trace.WithAttributes(tracing.AttrRPCMethod.String(method)),
+	)
+}
+
+func weiToEther(wei *big.Int) float64 {
+	ether, _ := new(big.Float).Quo(new(big.Float).SetInt(wei), big.NewFloat(WeiPerEther)).Float64()
+	return ether
 }
 
 func (a *Adapter) connectWithRetry() {
@@ -118,6 +213,17 @@ func (a *Adapter) getClient() *ethclient.Client {
 	return a.client
 }
 
+// connectedClient returns the current client, reconnecting first when the
+// connection was lost.
+func (a *Adapter) connectedClient() *ethclient.Client {
+	client := a.getClient()
+	if client == nil {
+		a.connectWithRetry()
+		client = a.getClient()
+	}
+	return client
+}
+
 func (a *Adapter) Close() {
 	a.mu.Lock()
 	defer a.mu.Unlock()
@@ -127,3 +233,11 @@ func (a *Adapter) Close() {
 		a.connected = false
 	}
 }
+
+func (a *Adapter) spanAttributes() []attribute.KeyValue {
+	return []attribute.KeyValue{
+		tracing.AttrChainSymbol.String("ETH"),
+		tracing.AttrNetwork.String(tracing.NetworkOf(a.isTestnet)),
+		tracing.AttrEndpoint.String(tracing.Endpoint(a.rpcURL)),
+	}
+}
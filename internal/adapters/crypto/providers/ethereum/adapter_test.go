@@ -0,0 +1,24 @@
+package ethereum_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/adapters/crypto/providers/ethereum"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfirmedBlock(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, big.NewInt(98), ethereum.ConfirmedBlock(100, 3))
+	assert.Equal(t, big.NewInt(0), ethereum.ConfirmedBlock(5, 12))
+}
+
+func TestWeiToEther(t *testing.T) {
+	t.Parallel()
+
+	wei, _ := new(big.Int).SetString("1500000000000000000", 10)
+	assert.InDelta(t, 1.5, ethereum.WeiToEther(wei), 1e-12)
+	assert.InDelta(t, -0.25, ethereum.WeiToEther(big.NewInt(-250000000000000000)), 1e-12)
+}
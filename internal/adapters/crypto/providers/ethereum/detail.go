@@ -0,0 +1,248 @@
+package ethereum
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/tracing"
+	goethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// transferTopic is the first topic of ERC-20 Transfer events. ERC-721
+// emits the same event with the token id as a third indexed topic.
+var transferTopic = crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+
+// GetTransactionDetail decodes txid with its receipt: the ether it sends and
+// the ERC-20 Transfer events it emits as transfers, and every log. With
+// wallet set, the transfer sides of that address are marked owned.
+func (a *Adapter) GetTransactionDetail(ctx context.Context, txid, wallet string) (*domain.TransactionDetail, error) {
+	ctx, span := tracer.Start(ctx, "ethereum.GetTransactionDetail", trace.WithAttributes(a.spanAttributes()...))
+	detail, err := a.getTransactionDetail(ctx, txid, wallet)
+	tracing.End(span, err)
+	return detail, err
+}
+
+func (a *Adapter) getTransactionDetail(ctx context.Context, txid, wallet string) (*domain.TransactionDetail, error) {
+	raw, err := hexutil.Decode(txid)
+	if err != nil || len(raw) != common.HashLength {
+		return nil, fmt.Errorf("%w: invalid transaction id %q", domain.ErrBadRequest, txid)
+	}
+	if wallet != "" && !common.IsHexAddress(wallet) {
+		return nil, ErrInvalidEthereumAddress
+	}
+	hash := common.BytesToHash(raw)
+
+	ctx, cancel := context.WithTimeout(ctx, HistoryTimeout)
+	defer cancel()
+	client := a.connectedClient()
+
+	rpcCtx, span := a.rpcSpan(ctx, "eth_getTransactionByHash")
+	tx, pending, err := client.TransactionByHash(rpcCtx, hash)
+	tracing.End(span, err)
+	if errors.Is(err, goethereum.NotFound) {
+		return nil, fmt.Errorf("%w: %s", domain.ErrTransactionNotFound, txid)
+	}
+	if err != nil {
+		return nil, domain.UpstreamError(err)
+	}
+	from, err := types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx)
+	if err != nil {
+		return nil, domain.UpstreamError(fmt.Errorf("recover sender of %s: %w", txid, err))
+	}
+
+	var receipt *types.Receipt
+	if !pending {
+		rpcCtx, span = a.rpcSpan(ctx, "eth_getTransactionReceipt")
+		receipt, err = client.TransactionReceipt(rpcCtx, hash)
+		tracing.End(span, err)
+		// A transaction whose block was just reorged out has no receipt
+		// until it is mined again.
+		if errors.Is(err, goethereum.NotFound) {
+			receipt, err = nil, nil
+		}
+		if err != nil {
+			return nil, domain.UpstreamError(err)
+		}
+	}
+
+	detail := &domain.TransactionDetail{TransactionID: hash.Hex(), Status: domain.TransactionPending}
+	if receipt == nil {
+		detail.Transfers = detailTransfers(from, tx, nil, nil, wallet)
+		return detail, nil
+	}
+
+	tokens, err := a.transferTokens(ctx, client, receipt.Logs)
+	if err != nil {
+		return nil, err
+	}
+	detail.Transfers = detailTransfers(from, tx, receipt, tokens, wallet)
+	detail.Logs = transactionLogs(receipt.Logs)
+	if err := a.blockDetail(ctx, client, detail, tx, receipt); err != nil {
+		return nil, domain.UpstreamError(err)
+	}
+	return detail, nil
+}
+
+// blockDetail sets what the receipt of a mined transaction tells about it.
+func (a *Adapter) blockDetail(
+	ctx context.Context, client *ethclient.Client, detail *domain.TransactionDetail, tx *types.Transaction,
+	receipt *types.Receipt,
+) error {
+	rpcCtx, span := a.rpcSpan(ctx, "eth_getBlockByHash")
+	header, err := client.HeaderByHash(rpcCtx, receipt.BlockHash)
+	tracing.End(span, err)
+	if err != nil {
+		return err
+	}
+	rpcCtx, span = a.rpcSpan(ctx, "eth_blockNumber")
+	head, err := client.BlockNumber(rpcCtx)
+	tracing.End(span, err)
+	if err != nil {
+		return err
+	}
+
+	detail.Status = domain.TransactionConfirmed
+	if receipt.Status == types.ReceiptStatusFailed {
+		detail.Status = domain.TransactionFailed
+	}
+	blockHeight := receipt.BlockNumber.Int64()
+	detail.BlockHeight = &blockHeight
+	detail.BlockHash = receipt.BlockHash.Hex()
+	timestamp := time.Unix(int64(header.Time), 0).UTC() //nolint:gosec // block times fit in int64
+	detail.Timestamp = &timestamp
+	if head >= receipt.BlockNumber.Uint64() {
+		detail.Confirmations = int64(head-receipt.BlockNumber.Uint64()) + 1 //nolint:gosec // bounded by head
+	}
+	fee := weiToEther(receiptFee(tx, receipt))
+	detail.FeeAmount = &fee
+	return nil
+}
+
+// transferTokens reads the decimals and symbol of every contract emitting an
+// ERC-20 Transfer event in logs. Contracts that are not taken for tokens are
+// left out, so their events are not listed as transfers.
+func (a *Adapter) transferTokens(
+	ctx context.Context, client *ethclient.Client, logs []*types.Log,
+) (map[common.Address]asset, error) {
+	tokens := make(map[common.Address]asset)
+	skipped := make(map[common.Address]bool)
+	for _, log := range logs {
+		if _, _, _, ok := transferEvent(log); !ok || skipped[log.Address] {
+			continue
+		}
+		if _, ok := tokens[log.Address]; ok {
+			continue
+		}
+		token, err := a.tokenAsset(ctx, client, log.Address)
+		if errors.Is(err, ErrInvalidTokenContract) {
+			skipped[log.Address] = true
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		tokens[log.Address] = token
+	}
+	return tokens, nil
+}
+
+// detailTransfers lists the ether tx sends, unless it failed, followed by
+// the Transfer events in receipt emitted by the contracts in tokens. receipt
+// is nil while tx is pending.
+func detailTransfers(
+	from common.Address, tx *types.Transaction, receipt *types.Receipt, tokens map[common.Address]asset,
+	wallet string,
+) []domain.TransactionTransfer {
+	var transfers []domain.TransactionTransfer
+	to := tx.To()
+	if to == nil && receipt != nil {
+		to = &receipt.ContractAddress
+	}
+	failed := receipt != nil && receipt.Status == types.ReceiptStatusFailed
+	if tx.Value().Sign() > 0 && to != nil && !failed {
+		transfers = append(transfers, transfer(from, *to, weiToEther(tx.Value()), ether, wallet))
+	}
+	if receipt == nil {
+		return transfers
+	}
+
+	for _, log := range receipt.Logs {
+		sender, recipient, units, ok := transferEvent(log)
+		if !ok {
+			continue
+		}
+		token, ok := tokens[log.Address]
+		if !ok {
+			continue
+		}
+		transfers = append(transfers, transfer(sender, recipient, unitsToFloat(units, token.decimals), token, wallet))
+	}
+	return transfers
+}
+
+func transfer(from, to common.Address, amount float64, sent asset, wallet string) domain.TransactionTransfer {
+	t := domain.TransactionTransfer{
+		From:      from.Hex(),
+		To:        to.Hex(),
+		Amount:    amount,
+		FromOwned: strings.EqualFold(from.Hex(), wallet),
+		ToOwned:   strings.EqualFold(to.Hex(), wallet),
+	}
+	if sent.contract != nil {
+		t.TokenContract = sent.contract.Hex()
+		t.TokenSymbol = sent.symbol
+	}
+	return t
+}
+
+// transferEvent decodes an ERC-20 Transfer event.
+func transferEvent(log *types.Log) (from, to common.Address, units *big.Int, ok bool) {
+	if len(log.Topics) != 3 || log.Topics[0] != transferTopic || len(log.Data) != common.HashLength {
+		return common.Address{}, common.Address{}, nil, false
+	}
+	return common.BytesToAddress(log.Topics[1].Bytes()), common.BytesToAddress(log.Topics[2].Bytes()),
+		new(big.Int).SetBytes(log.Data), true
+}
+
+func transactionLogs(logs []*types.Log) []domain.TransactionLog {
+	out := make([]domain.TransactionLog, len(logs))
+	for i, log := range logs {
+		topics := make([]string, len(log.Topics))
+		for j, topic := range log.Topics {
+			topics[j] = topic.Hex()
+		}
+		out[i] = domain.TransactionLog{
+			Index:   log.Index,
+			Address: log.Address.Hex(),
+			Topics:  topics,
+			Data:    hexutil.Encode(log.Data),
+		}
+	}
+	return out
+}
+
+// receiptFee is the gas and blob gas paid by a mined transaction. Nodes that
+// predate EIP-1559 leave the effective gas price out of receipts, it then
+// being the gas price of the transaction.
+func receiptFee(tx *types.Transaction, receipt *types.Receipt) *big.Int {
+	gasPrice := receipt.EffectiveGasPrice
+	if gasPrice == nil {
+		gasPrice = tx.GasPrice()
+	}
+	fee := new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(receipt.GasUsed))
+	if receipt.BlobGasPrice != nil {
+		fee.Add(fee, new(big.Int).Mul(receipt.BlobGasPrice, new(big.Int).SetUint64(receipt.BlobGasUsed)))
+	}
+	return fee
+}
@@ -0,0 +1,96 @@
+package ethereum_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/adapters/crypto/providers/ethereum"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func transferLog(contract, from, to string, units int64) *types.Log {
+	return &types.Log{
+		Address: common.HexToAddress(contract),
+		Topics: []common.Hash{
+			ethereum.TransferTopic,
+			common.BytesToHash(common.HexToAddress(from).Bytes()),
+			common.BytesToHash(common.HexToAddress(to).Bytes()),
+		},
+		Data: common.BigToHash(big.NewInt(units)).Bytes(),
+	}
+}
+
+func TestDetailTransfers(t *testing.T) {
+	t.Parallel()
+
+	router := common.HexToAddress(historyRouter)
+	tx := types.NewTx(&types.DynamicFeeTx{To: &router, Value: ether(500)})
+	nft := transferLog(historyRouter, historyOther, historyWallet, 7)
+	nft.Topics = append(nft.Topics, common.Hash{})
+	receipt := &types.Receipt{
+		Status: types.ReceiptStatusSuccessful,
+		Logs: []*types.Log{
+			transferLog(historyUSDC, historyOther, historyWallet, 25_000_000),
+			// ERC-721 transfers index the token id as well.
+			nft,
+			// Contracts not taken for tokens are left out.
+			transferLog(historyRouter, historyWallet, historyOther, 1),
+		},
+	}
+	symbols := map[common.Address]string{common.HexToAddress(historyUSDC): "USDC"}
+
+	transfers := ethereum.DetailTransfers(common.HexToAddress(historyWallet), tx, receipt, symbols, 6,
+		historyWallet)
+
+	assert.Equal(t, []domain.TransactionTransfer{
+		{
+			From: common.HexToAddress(historyWallet).Hex(), To: router.Hex(), Amount: 0.5,
+			FromOwned: true,
+		},
+		{
+			From: common.HexToAddress(historyOther).Hex(), To: common.HexToAddress(historyWallet).Hex(),
+			Amount: 25, TokenContract: common.HexToAddress(historyUSDC).Hex(), TokenSymbol: "USDC", ToOwned: true,
+		},
+	}, transfers)
+}
+
+func TestDetailTransfers_Failed(t *testing.T) {
+	t.Parallel()
+
+	router := common.HexToAddress(historyRouter)
+	tx := types.NewTx(&types.DynamicFeeTx{To: &router, Value: ether(500)})
+	receipt := &types.Receipt{Status: types.ReceiptStatusFailed}
+
+	transfers := ethereum.DetailTransfers(common.HexToAddress(historyWallet), tx, receipt, nil, 0, "")
+
+	assert.Empty(t, transfers)
+}
+
+func TestDetailTransfers_Pending(t *testing.T) {
+	t.Parallel()
+
+	router := common.HexToAddress(historyRouter)
+	tx := types.NewTx(&types.DynamicFeeTx{To: &router, Value: ether(500)})
+
+	transfers := ethereum.DetailTransfers(common.HexToAddress(historyWallet), tx, nil, nil, 0, historyOther)
+
+	assert.Equal(t, []domain.TransactionTransfer{
+		{From: common.HexToAddress(historyWallet).Hex(), To: router.Hex(), Amount: 0.5},
+	}, transfers)
+}
+
+func TestReceiptFee(t *testing.T) {
+	t.Parallel()
+
+	tx := types.NewTx(&types.LegacyTx{GasPrice: big.NewInt(30)})
+	receipt := &types.Receipt{GasUsed: 21000}
+	assert.Equal(t, big.NewInt(630_000), ethereum.ReceiptFee(tx, receipt))
+
+	receipt.EffectiveGasPrice = big.NewInt(20)
+	receipt.BlobGasUsed = 131072
+	receipt.BlobGasPrice = big.NewInt(2)
+	assert.Equal(t, big.NewInt(420_000+262_144), ethereum.ReceiptFee(tx, receipt))
+}
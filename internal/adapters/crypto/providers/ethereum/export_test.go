@@ -0,0 +1,43 @@
+package ethereum
+
+import (
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+var (
+	ValidateAddress = validateAddress
+	ConfirmedBlock  = confirmedBlock
+	WeiToEther      = weiToEther
+)
+
+var FeeEstimates = feeEstimates
+
+var (
+	DynamicFees     = dynamicFees
+	UnsignedPayload = unsignedPayload
+	TransferData    = transferData
+	ABIString       = abiString
+	SweepValue      = sweepValue
+)
+
+var WalletTransactions = walletTransactions
+
+// DetailTransfers runs detailTransfers with every contract in symbols taken
+// for a token of that symbol with the given decimals.
+func DetailTransfers(
+	from common.Address, tx *types.Transaction, receipt *types.Receipt, symbols map[common.Address]string,
+	decimals int, wallet string,
+) []domain.TransactionTransfer {
+	tokens := make(map[common.Address]asset, len(symbols))
+	for contract, symbol := range symbols {
+		tokens[contract] = asset{contract: &contract, symbol: symbol, decimals: decimals}
+	}
+	return detailTransfers(from, tx, receipt, tokens, wallet)
+}
+
+var (
+	TransferTopic = transferTopic
+	ReceiptFee    = receiptFee
+)
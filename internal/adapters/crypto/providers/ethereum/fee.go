@@ -0,0 +1,168 @@
+package ethereum
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/tracing"
+	goethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// TransferGas is the gas of a plain ether transfer, the size fee amounts
+	// are priced for.
+	TransferGas = 21000
+	// FeeHistoryBlocks is the number of recent blocks priority fees are
+	// sampled from.
+	FeeHistoryBlocks = 20
+
+	weiPerGwei = 1e9
+)
+
+var ErrNoFeeHistory = errors.New("node returned no fee history")
+
+// feePercentile is the priority fee percentile of a fee level.
+type feePercentile struct {
+	level      domain.FeeLevel
+	percentile float64
+}
+
+var feePercentiles = []feePercentile{
+	{domain.FeeLevelSlow, 10},
+	{domain.FeeLevelNormal, 50},
+	{domain.FeeLevelFast, 90},
+}
+
+// EstimateFees prices each fee level as the base fee of the next block plus
+// the median priority fee paid at its percentile over the last
+// FeeHistoryBlocks blocks, from eth_feeHistory.
+func (a *Adapter) EstimateFees(ctx context.Context) (*domain.FeeEstimates, error) {
+	ctx, span := tracer.Start(ctx, "ethereum.EstimateFees", trace.WithAttributes(a.spanAttributes()...))
+	fees, err := a.estimateFees(ctx)
+	tracing.End(span, err)
+	return fees, err
+}
+
+func (a *Adapter) estimateFees(ctx context.Context) (*domain.FeeEstimates, error) {
+	rpcCtx, cancel := context.WithTimeout(ctx, BalanceTimeout)
+	defer cancel()
+	history, err := a.feeHistory(rpcCtx, a.connectedClient())
+	if err != nil {
+		return nil, domain.UpstreamError(err)
+	}
+
+	estimates, err := feeEstimates(history)
+	if err != nil {
+		return nil, domain.UpstreamError(err)
+	}
+	return &domain.FeeEstimates{
+		CryptoSymbol: "ETH",
+		RateUnit:     "gwei",
+		SizeUnit:     "gas",
+		TypicalSize:  TransferGas,
+		Estimates:    estimates,
+	}, nil
+}
+
+// feeHistory samples the feePercentiles of the priority fees paid over the
+// last FeeHistoryBlocks blocks.
+func (a *Adapter) feeHistory(ctx context.Context, client *ethclient.Client) (*goethereum.FeeHistory, error) {
+	percentiles := make([]float64, len(feePercentiles))
+	for i, p := range feePercentiles {
+		percentiles[i] = p.percentile
+	}
+
+	rpcCtx, span := a.rpcSpan(ctx, "eth_feeHistory")
+	history, err := client.FeeHistory(rpcCtx, FeeHistoryBlocks, nil, percentiles)
+	tracing.End(span, err)
+	return history, err
+}
+
+// feeEstimates reads the estimates of every level from a fee history taken
+// with the feePercentiles. The last base fee of the history is the one of
+// the next block.
+func feeEstimates(history *goethereum.FeeHistory) ([]domain.FeeEstimate, error) {
+	if len(history.BaseFee) == 0 {
+		return nil, ErrNoFeeHistory
+	}
+	baseFee := weiToGwei(history.BaseFee[len(history.BaseFee)-1])
+
+	estimates := make([]domain.FeeEstimate, len(feePercentiles))
+	for i, p := range feePercentiles {
+		priorityFee := weiToGwei(priorityFee(history, i))
+		rate := baseFee + priorityFee
+
+		estimates[i] = domain.FeeEstimate{
+			Level:       p.level,
+			Rate:        rate,
+			Amount:      rate * TransferGas / weiPerGwei,
+			BaseFee:     &baseFee,
+			PriorityFee: &priorityFee,
+		}
+	}
+	return estimates, nil
+}
+
+// dynamicFees returns the priority fee and max fee per gas in wei of a
+// transaction from a fee history taken with the feePercentiles. The priority
+// fee is the one of the normal level and the max fee leaves room for the base
+// fee to double, unless a positive feeRate sets it in gwei. A feeRate below
+// the base fee of the next block is rejected, as the transaction could not
+// be included.
+func dynamicFees(history *goethereum.FeeHistory, feeRate float64) (*big.Int, *big.Int, error) {
+	if len(history.BaseFee) == 0 {
+		return nil, nil, ErrNoFeeHistory
+	}
+	baseFee := history.BaseFee[len(history.BaseFee)-1]
+
+	normal := slices.IndexFunc(feePercentiles, func(p feePercentile) bool {
+		return p.level == domain.FeeLevelNormal
+	})
+	tip := priorityFee(history, normal)
+
+	if feeRate > 0 {
+		maxFee, _ := new(big.Float).Mul(big.NewFloat(feeRate), big.NewFloat(weiPerGwei)).Int(nil)
+		if maxFee.Cmp(baseFee) < 0 {
+			return nil, nil, fmt.Errorf("%w: fee rate below the current base fee of %s gwei", domain.ErrBadRequest,
+				domain.FormatUnits(baseFee, gweiDecimals))
+		}
+		if tip.Cmp(maxFee) > 0 {
+			tip = maxFee
+		}
+		return tip, maxFee, nil
+	}
+	maxFee := new(big.Int).Add(new(big.Int).Lsh(baseFee, 1), tip)
+	return tip, maxFee, nil
+}
+
+// priorityFee returns the median of the priority fees at the i-th of the
+// feePercentiles over the blocks of history.
+func priorityFee(history *goethereum.FeeHistory, i int) *big.Int {
+	rewards := make([]*big.Int, 0, len(history.Reward))
+	for _, block := range history.Reward {
+		if i < len(block) && block[i] != nil {
+			rewards = append(rewards, block[i])
+		}
+	}
+	return median(rewards)
+}
+
+// median returns the median of values, zero for none.
+func median(values []*big.Int) *big.Int {
+	if len(values) == 0 {
+		return new(big.Int)
+	}
+	sorted := slices.SortedFunc(slices.Values(values), (*big.Int).Cmp)
+	return sorted[len(sorted)/2]
+}
+
+func weiToGwei(wei *big.Int) float64 {
+	gwei, _ := new(big.Float).Quo(new(big.Float).SetInt(wei), big.NewFloat(weiPerGwei)).Float64()
+	return gwei
+}
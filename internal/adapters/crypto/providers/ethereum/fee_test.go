@@ -0,0 +1,41 @@
+package ethereum_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/adapters/crypto/providers/ethereum"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+	goethereum "github.com/ethereum/go-ethereum"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func gwei(n int64) *big.Int {
+	return new(big.Int).Mul(big.NewInt(n), big.NewInt(1e9))
+}
+
+func TestFeeEstimates(t *testing.T) {
+	t.Parallel()
+
+	estimates, err := ethereum.FeeEstimates(&goethereum.FeeHistory{
+		BaseFee: []*big.Int{gwei(8), gwei(9), gwei(10)},
+		Reward: [][]*big.Int{
+			{gwei(1), gwei(2), gwei(5)},
+			{gwei(1), gwei(3), gwei(7)},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, estimates, 3)
+
+	assert.Equal(t, domain.FeeLevelSlow, estimates[0].Level)
+	assert.InDelta(t, 11.0, estimates[0].Rate, 1e-9)
+	assert.InDelta(t, 10.0, *estimates[0].BaseFee, 1e-9)
+	assert.InDelta(t, 1.0, *estimates[0].PriorityFee, 1e-9)
+	assert.InDelta(t, 13.0, estimates[1].Rate, 1e-9)
+	assert.InDelta(t, 17.0, estimates[2].Rate, 1e-9)
+	assert.InDelta(t, 17e-9*ethereum.TransferGas, estimates[2].Amount, 1e-15)
+
+	_, err = ethereum.FeeEstimates(&goethereum.FeeHistory{})
+	require.ErrorIs(t, err, ethereum.ErrNoFeeHistory)
+}
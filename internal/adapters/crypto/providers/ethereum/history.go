@@ -0,0 +1,177 @@
+package ethereum
+
+import (
+	"context"
+	"math/big"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/tracing"
+	"github.com/ethereum/go-ethereum/common"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const HistoryTimeout = 30 * time.Second
+
+var ErrNoIndexer = &domain.Error{
+	Code:    domain.CodeUnsupportedSymbol,
+	Message: "transaction history needs an indexer, none is configured for this network",
+}
+
+// GetTransactions returns the history of address from the configured indexer,
+// newest first. Ether moved by normal and internal transactions is netted per
+// transaction hash, less the fee when the address sent it; every ERC-20 token
+// moved gets an entry of its own under the same hash.
+func (a *Adapter) GetTransactions(ctx context.Context, address string) ([]domain.Transaction, error) {
+	ctx, span := tracer.Start(ctx, "ethereum.GetTransactions", trace.WithAttributes(a.spanAttributes()...))
+	txs, err := a.getTransactions(ctx, address)
+	tracing.End(span, err)
+	return txs, err
+}
+
+func (a *Adapter) getTransactions(ctx context.Context, address string) ([]domain.Transaction, error) {
+	if !common.IsHexAddress(address) {
+		return nil, ErrInvalidEthereumAddress
+	}
+	if a.indexer == nil {
+		return nil, ErrNoIndexer
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, HistoryTimeout)
+	defer cancel()
+
+	transfers, err := a.indexer.GetTransfers(ctx, address)
+	if err != nil {
+		return nil, domain.UpstreamError(err)
+	}
+
+	rpcCtx, span := a.rpcSpan(ctx, "eth_blockNumber")
+	head, err := a.connectedClient().BlockNumber(rpcCtx)
+	tracing.End(span, err)
+	if err != nil {
+		return nil, domain.UpstreamError(err)
+	}
+
+	return walletTransactions(address, transfers, int64(head)), nil //nolint:gosec // block numbers fit in int64
+}
+
+// entry accumulates the transfers of one history entry: the ether moved by a
+// transaction, or one token moved by it.
+type entry struct {
+	first    domain.IndexedTransfer
+	net      *big.Int
+	fee      *big.Int
+	from, to []string
+}
+
+func (e *entry) add(transfer domain.IndexedTransfer, wallet string) {
+	e.from = appendAddress(e.from, transfer.From)
+	e.to = appendAddress(e.to, transfer.To)
+
+	sent := strings.EqualFold(transfer.From, wallet)
+	if sent && transfer.Fee != nil {
+		e.fee.Add(e.fee, transfer.Fee)
+	}
+	if transfer.Failed {
+		return
+	}
+	if sent {
+		e.net.Sub(e.net, transfer.Value)
+	}
+	if strings.EqualFold(transfer.To, wallet) {
+		e.net.Add(e.net, transfer.Value)
+	}
+}
+
+// walletTransactions maps indexer transfers into history entries. Entries
+// that neither moved funds nor cost the wallet a fee are dropped.
+func walletTransactions(wallet string, transfers []domain.IndexedTransfer, head int64) []domain.Transaction {
+	entries := make(map[string]*entry)
+	var keys []string
+	for _, transfer := range transfers {
+		key := transfer.Hash
+		if transfer.Kind == domain.TransferToken {
+			key += "/" + strings.ToLower(transfer.TokenContract)
+		}
+		e, ok := entries[key]
+		if !ok {
+			e = &entry{first: transfer, net: new(big.Int), fee: new(big.Int)}
+			entries[key] = e
+			keys = append(keys, key)
+		}
+		e.add(transfer, wallet)
+	}
+
+	txs := make([]domain.Transaction, 0, len(keys))
+	for _, key := range keys {
+		if tx, ok := entries[key].transaction(head); ok {
+			txs = append(txs, tx)
+		}
+	}
+	sort.SliceStable(txs, func(i, j int) bool { return txs[i].Timestamp.After(txs[j].Timestamp) })
+	return txs
+}
+
+func (e *entry) transaction(head int64) (domain.Transaction, bool) {
+	decimals := etherDecimals
+	if e.first.Kind == domain.TransferToken {
+		decimals = e.first.TokenDecimals
+	}
+
+	net := new(big.Int).Sub(e.net, e.fee)
+	if net.Sign() == 0 && e.fee.Sign() == 0 {
+		return domain.Transaction{}, false
+	}
+
+	tx := domain.Transaction{
+		TransactionID: e.first.Hash,
+		Timestamp:     e.first.Timestamp,
+		Amount:        unitsToFloat(new(big.Int).Abs(net), decimals),
+		Direction:     domain.DirectionIncoming,
+		FromAddresses: e.from,
+		ToAddresses:   e.to,
+	}
+	if e.first.Kind == domain.TransferToken {
+		tx.TokenContract = e.first.TokenContract
+		tx.TokenSymbol = e.first.TokenSymbol
+	}
+	if net.Sign() < 0 {
+		tx.Direction = domain.DirectionOutgoing
+	}
+	if e.fee.Sign() > 0 {
+		fee := weiToEther(e.fee)
+		tx.FeeAmount = &fee
+	}
+
+	if e.first.BlockNumber > 0 {
+		blockHeight := e.first.BlockNumber
+		tx.BlockHeight = &blockHeight
+		if head >= blockHeight {
+			tx.Confirmations = head - blockHeight + 1
+		}
+	}
+	if tx.Timestamp.IsZero() {
+		tx.Timestamp = time.Now()
+	}
+	return tx, true
+}
+
+func unitsToFloat(units *big.Int, decimals int) float64 {
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil)
+	value, _ := new(big.Float).Quo(new(big.Float).SetInt(units), new(big.Float).SetInt(scale)).Float64()
+	return value
+}
+
+func appendAddress(addresses []string, address string) []string {
+	if address == "" {
+		return addresses
+	}
+	for _, known := range addresses {
+		if strings.EqualFold(known, address) {
+			return addresses
+		}
+	}
+	return append(addresses, address)
+}
@@ -0,0 +1,121 @@
+package ethereum_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/adapters/crypto/providers/ethereum"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	historyWallet = "0x1111111111111111111111111111111111111111"
+	historyOther  = "0x2222222222222222222222222222222222222222"
+	historyRouter = "0x3333333333333333333333333333333333333333"
+	historyUSDC   = "0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48"
+)
+
+func ether(milli int64) *big.Int {
+	return new(big.Int).Mul(big.NewInt(milli), big.NewInt(1e15))
+}
+
+func TestWalletTransactions(t *testing.T) {
+	t.Parallel()
+
+	at := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	transfers := []domain.IndexedTransfer{
+		// Received 1 ETH.
+		{
+			Kind: domain.TransferNormal, Hash: "0x01", BlockNumber: 100, Timestamp: at,
+			From: historyOther, To: historyWallet, Value: ether(1000), Fee: ether(1),
+		},
+		// Sent 0.5 ETH to a router that refunded 0.1 ETH through an internal call.
+		{
+			Kind: domain.TransferNormal, Hash: "0x02", BlockNumber: 101, Timestamp: at.Add(time.Hour),
+			From: historyWallet, To: historyRouter, Value: ether(500), Fee: ether(2),
+		},
+		{
+			Kind: domain.TransferInternal, Hash: "0x02", BlockNumber: 101, Timestamp: at.Add(time.Hour),
+			From: historyRouter, To: historyWallet, Value: ether(100),
+		},
+		// A reverted call only costs its fee.
+		{
+			Kind: domain.TransferNormal, Hash: "0x03", BlockNumber: 102, Timestamp: at.Add(2 * time.Hour),
+			From: historyWallet, To: historyRouter, Value: ether(300), Fee: ether(3), Failed: true,
+		},
+		// Sent 25 USDC: the ether entry carries the fee, the token entry the amount.
+		{
+			Kind: domain.TransferNormal, Hash: "0x04", BlockNumber: 103, Timestamp: at.Add(3 * time.Hour),
+			From: historyWallet, To: historyUSDC, Value: new(big.Int), Fee: ether(4),
+		},
+		{
+			Kind: domain.TransferToken, Hash: "0x04", BlockNumber: 103, Timestamp: at.Add(3 * time.Hour),
+			From: historyWallet, To: historyOther, Value: big.NewInt(25_000_000),
+			TokenContract: historyUSDC, TokenSymbol: "USDC", TokenDecimals: 6,
+		},
+		// Someone else called a contract naming the wallet; nothing moved.
+		{
+			Kind: domain.TransferNormal, Hash: "0x05", BlockNumber: 104, Timestamp: at.Add(4 * time.Hour),
+			From: historyOther, To: historyWallet, Value: new(big.Int), Fee: ether(1),
+		},
+		// Pending incoming payment.
+		{
+			Kind: domain.TransferNormal, Hash: "0x06",
+			From: historyOther, To: historyWallet, Value: ether(10), Fee: ether(1),
+		},
+	}
+
+	txs := ethereum.WalletTransactions(historyWallet, transfers, 110)
+	require.Len(t, txs, 6)
+
+	pending := txs[0]
+	assert.Equal(t, "0x06", pending.TransactionID)
+	assert.Nil(t, pending.BlockHeight)
+	assert.Zero(t, pending.Confirmations)
+	assert.Nil(t, pending.FeeAmount)
+	assert.InDelta(t, 0.01, pending.Amount, 1e-12)
+
+	tokenFee := txs[1]
+	assert.Equal(t, "0x04", tokenFee.TransactionID)
+	assert.False(t, tokenFee.IsToken())
+	assert.Equal(t, domain.DirectionOutgoing, tokenFee.Direction)
+	assert.InDelta(t, 0.004, tokenFee.Amount, 1e-12)
+	require.NotNil(t, tokenFee.FeeAmount)
+	assert.InDelta(t, 0.004, *tokenFee.FeeAmount, 1e-12)
+
+	token := txs[2]
+	assert.Equal(t, "0x04", token.TransactionID)
+	assert.True(t, token.IsToken())
+	assert.Equal(t, historyUSDC, token.TokenContract)
+	assert.Equal(t, "USDC", token.TokenSymbol)
+	assert.Equal(t, domain.DirectionOutgoing, token.Direction)
+	assert.InDelta(t, 25.0, token.Amount, 1e-12)
+	assert.Nil(t, token.FeeAmount)
+
+	reverted := txs[3]
+	assert.Equal(t, "0x03", reverted.TransactionID)
+	assert.Equal(t, domain.DirectionOutgoing, reverted.Direction)
+	assert.InDelta(t, 0.003, reverted.Amount, 1e-12)
+
+	swap := txs[4]
+	assert.Equal(t, "0x02", swap.TransactionID)
+	assert.Equal(t, domain.DirectionOutgoing, swap.Direction)
+	assert.InDelta(t, 0.402, swap.Amount, 1e-12)
+	require.NotNil(t, swap.FeeAmount)
+	assert.InDelta(t, 0.002, *swap.FeeAmount, 1e-12)
+	assert.Equal(t, []string{historyWallet, historyRouter}, swap.FromAddresses)
+	assert.Equal(t, []string{historyRouter, historyWallet}, swap.ToAddresses)
+	require.NotNil(t, swap.BlockHeight)
+	assert.Equal(t, int64(101), *swap.BlockHeight)
+	assert.Equal(t, int64(10), swap.Confirmations)
+
+	received := txs[5]
+	assert.Equal(t, "0x01", received.TransactionID)
+	assert.Equal(t, domain.DirectionIncoming, received.Direction)
+	assert.InDelta(t, 1.0, received.Amount, 1e-12)
+	assert.Nil(t, received.FeeAmount)
+	assert.Equal(t, int64(11), received.Confirmations)
+}
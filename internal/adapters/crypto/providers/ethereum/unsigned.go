@@ -0,0 +1,359 @@
+package ethereum
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/tracing"
+	goethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rlp"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// BuildTimeout bounds all node calls made to build one transaction.
+	BuildTimeout = 20 * time.Second
+	// TokenGasMarginPercent is added to the gas estimate of token transfers,
+	// whose gas use can change between estimation and inclusion.
+	TokenGasMarginPercent = 20
+
+	etherDecimals = 18
+	gweiDecimals  = 9
+	// maxTokenDecimals is the number of digits of the largest uint256.
+	maxTokenDecimals = 78
+)
+
+var ErrInvalidTokenContract = &domain.Error{
+	Code:    domain.CodeInvalidAddress,
+	Message: "invalid ERC-20 token contract",
+}
+
+var (
+	transferSelector  = selector("transfer(address,uint256)")
+	balanceOfSelector = selector("balanceOf(address)")
+	decimalsSelector  = selector("decimals()")
+	symbolSelector    = selector("symbol()")
+)
+
+// asset is what a transaction sends: ether, or the tokens of an ERC-20
+// contract.
+type asset struct {
+	contract *common.Address
+	symbol   string
+	decimals int
+}
+
+var ether = asset{symbol: "ETH", decimals: etherDecimals}
+
+// BuildUnsignedTx builds an EIP-1559 transaction sending ether, or with a
+// TokenContract an ERC-20 transfer of that token. The nonce is taken from the
+// pending state, the gas limit from eth_estimateGas and the fee caps from the
+// fee history unless request.FeeRate sets the max fee per gas in gwei. With
+// domain.AmountMax the transaction sends the pending balance less the max
+// fee, or every token held.
+func (a *Adapter) BuildUnsignedTx(ctx context.Context, request domain.UnsignedTxRequest) (*domain.UnsignedTx, error) {
+	ctx, span := tracer.Start(ctx, "ethereum.BuildUnsignedTx", trace.WithAttributes(a.spanAttributes()...))
+	tx, err := a.buildUnsignedTx(ctx, request)
+	tracing.End(span, err)
+	return tx, err
+}
+
+func (a *Adapter) buildUnsignedTx(ctx context.Context, request domain.UnsignedTxRequest) (*domain.UnsignedTx, error) {
+	if !common.IsHexAddress(request.From) || !common.IsHexAddress(request.To) {
+		return nil, ErrInvalidEthereumAddress
+	}
+	if request.TokenContract != "" && !common.IsHexAddress(request.TokenContract) {
+		return nil, ErrInvalidTokenContract
+	}
+	from, to := common.HexToAddress(request.From), common.HexToAddress(request.To)
+
+	ctx, cancel := context.WithTimeout(ctx, BuildTimeout)
+	defer cancel()
+	client := a.connectedClient()
+
+	sent := ether
+	if request.TokenContract != "" {
+		var err error
+		sent, err = a.tokenAsset(ctx, client, common.HexToAddress(request.TokenContract))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var units, balance *big.Int
+	var err error
+	switch {
+	case !request.SendsMax():
+		units, err = domain.ParseUnits(request.Amount, sent.decimals)
+	case sent.contract != nil:
+		units, err = a.tokenBalance(ctx, client, sent, from)
+		if err == nil && units.Sign() == 0 {
+			err = fmt.Errorf("%w: no %s to send", domain.ErrInsufficientFunds, sent.symbol)
+		}
+	default:
+		// The whole balance is estimated with; the fee is taken off it once
+		// the gas is known.
+		balance, err = a.pendingBalance(ctx, client, from)
+		units = balance
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	call := goethereum.CallMsg{From: from, To: &to, Value: units}
+	if sent.contract != nil {
+		if !request.SendsMax() {
+			if err := a.checkTokenBalance(ctx, client, sent, from, units); err != nil {
+				return nil, err
+			}
+		}
+		call = goethereum.CallMsg{From: from, To: sent.contract, Value: new(big.Int), Data: transferData(to, units)}
+	}
+
+	tx, err := a.dynamicFeeTx(ctx, client, call, request.FeeRate)
+	if err != nil {
+		return nil, err
+	}
+
+	fee := new(big.Int).Mul(new(big.Int).SetUint64(tx.Gas), tx.GasFeeCap)
+	if balance != nil {
+		if units, err = sweepValue(balance, fee); err != nil {
+			return nil, err
+		}
+		tx.Value = units
+	} else if err := a.checkBalance(ctx, client, from, new(big.Int).Add(tx.Value, fee)); err != nil {
+		return nil, err
+	}
+
+	payload, err := unsignedPayload(tx)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.UnsignedTx{
+		From:        from.Hex(),
+		To:          to.Hex(),
+		Amount:      domain.FormatUnits(units, sent.decimals),
+		Fee:         domain.FormatUnits(fee, etherDecimals),
+		Payload:     payload,
+		Encoding:    domain.EncodingHex,
+		SigningHash: crypto.Keccak256(payload),
+		Details:     txDetails(tx, from, to, sent, units, fee),
+	}, nil
+}
+
+// dynamicFeeTx fills the chain ID, nonce, fee caps and gas limit of a
+// transaction making call.
+func (a *Adapter) dynamicFeeTx(
+	ctx context.Context, client *ethclient.Client, call goethereum.CallMsg, feeRate float64,
+) (*types.DynamicFeeTx, error) {
+	rpcCtx, span := a.rpcSpan(ctx, "eth_chainId")
+	chainID, err := client.ChainID(rpcCtx)
+	tracing.End(span, err)
+	if err != nil {
+		return nil, domain.UpstreamError(err)
+	}
+
+	rpcCtx, span = a.rpcSpan(ctx, "eth_getTransactionCount")
+	nonce, err := client.PendingNonceAt(rpcCtx, call.From)
+	tracing.End(span, err)
+	if err != nil {
+		return nil, domain.UpstreamError(err)
+	}
+
+	history, err := a.feeHistory(ctx, client)
+	if err != nil {
+		return nil, domain.UpstreamError(err)
+	}
+	tip, maxFee, err := dynamicFees(history, feeRate)
+	if err != nil {
+		return nil, domain.UpstreamError(err)
+	}
+
+	rpcCtx, span = a.rpcSpan(ctx, "eth_estimateGas")
+	gas, err := client.EstimateGas(rpcCtx, call)
+	tracing.End(span, err)
+	if err != nil {
+		if strings.Contains(err.Error(), "insufficient funds") {
+			return nil, fmt.Errorf("%w: %w", domain.ErrInsufficientFunds, err)
+		}
+		return nil, domain.UpstreamError(err)
+	}
+	if call.Data != nil {
+		gas += gas * TokenGasMarginPercent / 100
+	}
+
+	return &types.DynamicFeeTx{
+		ChainID:   chainID,
+		Nonce:     nonce,
+		GasTipCap: tip,
+		GasFeeCap: maxFee,
+		Gas:       gas,
+		To:        call.To,
+		Value:     call.Value,
+		Data:      call.Data,
+	}, nil
+}
+
+// tokenAsset reads the decimals and symbol of an ERC-20 contract. Contracts
+// that do not answer decimals() are not taken for tokens; a missing symbol
+// is shown as "tokens".
+func (a *Adapter) tokenAsset(ctx context.Context, client *ethclient.Client, contract common.Address) (asset, error) {
+	out, err := a.callContract(ctx, client, contract, decimalsSelector)
+	if err != nil {
+		return asset{}, domain.UpstreamError(err)
+	}
+	decimals := new(big.Int).SetBytes(out)
+	if len(out) != 32 || decimals.Cmp(big.NewInt(maxTokenDecimals)) > 0 {
+		return asset{}, fmt.Errorf("%w: %s does not report its decimals", ErrInvalidTokenContract, contract.Hex())
+	}
+
+	symbol := "tokens"
+	if out, err := a.callContract(ctx, client, contract, symbolSelector); err == nil && abiString(out) != "" {
+		symbol = abiString(out)
+	}
+	return asset{contract: &contract, symbol: symbol, decimals: int(decimals.Int64())}, nil
+}
+
+// tokenBalance returns the tokens of sent held by owner.
+func (a *Adapter) tokenBalance(
+	ctx context.Context, client *ethclient.Client, sent asset, owner common.Address,
+) (*big.Int, error) {
+	out, err := a.callContract(ctx, client, *sent.contract,
+		append(bytes.Clone(balanceOfSelector), common.LeftPadBytes(owner.Bytes(), 32)...))
+	if err != nil {
+		return nil, domain.UpstreamError(err)
+	}
+	return new(big.Int).SetBytes(out), nil
+}
+
+func (a *Adapter) checkTokenBalance(
+	ctx context.Context, client *ethclient.Client, sent asset, owner common.Address, units *big.Int,
+) error {
+	balance, err := a.tokenBalance(ctx, client, sent, owner)
+	if err != nil {
+		return err
+	}
+	if balance.Cmp(units) < 0 {
+		return fmt.Errorf("%w: %s %s available", domain.ErrInsufficientFunds,
+			domain.FormatUnits(balance, sent.decimals), sent.symbol)
+	}
+	return nil
+}
+
+// pendingBalance returns the wei owner holds in the pending state.
+func (a *Adapter) pendingBalance(ctx context.Context, client *ethclient.Client, owner common.Address) (*big.Int, error) {
+	rpcCtx, span := a.rpcSpan(ctx, "eth_getBalance")
+	balance, err := client.PendingBalanceAt(rpcCtx, owner)
+	tracing.End(span, err)
+	if err != nil {
+		return nil, domain.UpstreamError(err)
+	}
+	return balance, nil
+}
+
+func (a *Adapter) checkBalance(ctx context.Context, client *ethclient.Client, owner common.Address, need *big.Int) error {
+	balance, err := a.pendingBalance(ctx, client, owner)
+	if err != nil {
+		return err
+	}
+	if balance.Cmp(need) < 0 {
+		return fmt.Errorf("%w: %s ETH available, %s ETH needed including the max fee", domain.ErrInsufficientFunds,
+			domain.FormatUnits(balance, etherDecimals), domain.FormatUnits(need, etherDecimals))
+	}
+	return nil
+}
+
+// sweepValue is what a balance sends once the max fee is set aside.
+func sweepValue(balance, fee *big.Int) (*big.Int, error) {
+	if balance.Cmp(fee) <= 0 {
+		return nil, fmt.Errorf("%w: %s ETH available, the max fee is %s ETH", domain.ErrInsufficientFunds,
+			domain.FormatUnits(balance, etherDecimals), domain.FormatUnits(fee, etherDecimals))
+	}
+	return new(big.Int).Sub(balance, fee), nil
+}
+
+func (a *Adapter) callContract(
+	ctx context.Context, client *ethclient.Client, contract common.Address, data []byte,
+) ([]byte, error) {
+	rpcCtx, span := a.rpcSpan(ctx, "eth_call")
+	out, err := client.CallContract(rpcCtx, goethereum.CallMsg{To: &contract, Data: data}, nil)
+	tracing.End(span, err)
+	return out, err
+}
+
+// unsignedPayload encodes tx the way EIP-1559 signs it: the transaction type
+// followed by the RLP list of its fields without a signature. Its Keccak-256
+// hash is the signing hash.
+func unsignedPayload(tx *types.DynamicFeeTx) ([]byte, error) {
+	fields, err := rlp.EncodeToBytes([]any{
+		tx.ChainID, tx.Nonce, tx.GasTipCap, tx.GasFeeCap, tx.Gas, tx.To, tx.Value, tx.Data, tx.AccessList,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte{types.DynamicFeeTxType}, fields...), nil
+}
+
+// transferData is the calldata of an ERC-20 transfer(to, units) call.
+func transferData(to common.Address, units *big.Int) []byte {
+	data := bytes.Clone(transferSelector)
+	data = append(data, common.LeftPadBytes(to.Bytes(), 32)...)
+	return append(data, common.LeftPadBytes(units.Bytes(), 32)...)
+}
+
+// txDetails lists what a signing device shows about tx before signing it.
+func txDetails(
+	tx *types.DynamicFeeTx, from, to common.Address, sent asset, units, fee *big.Int,
+) []domain.TxDetail {
+	details := []domain.TxDetail{
+		{Label: "Type", Value: "EIP-1559"},
+		{Label: "Chain ID", Value: tx.ChainID.String()},
+		{Label: "From", Value: from.Hex()},
+		{Label: "To", Value: to.Hex()},
+		{Label: "Amount", Value: domain.FormatUnits(units, sent.decimals) + " " + sent.symbol},
+	}
+	if sent.contract != nil {
+		details = append(details, domain.TxDetail{Label: "Token contract", Value: sent.contract.Hex()})
+	}
+	return append(details,
+		domain.TxDetail{Label: "Nonce", Value: strconv.FormatUint(tx.Nonce, 10)},
+		domain.TxDetail{Label: "Gas limit", Value: strconv.FormatUint(tx.Gas, 10)},
+		domain.TxDetail{Label: "Max priority fee per gas", Value: domain.FormatUnits(tx.GasTipCap, gweiDecimals) + " gwei"},
+		domain.TxDetail{Label: "Max fee per gas", Value: domain.FormatUnits(tx.GasFeeCap, gweiDecimals) + " gwei"},
+		domain.TxDetail{Label: "Max fee", Value: domain.FormatUnits(fee, etherDecimals) + " ETH"},
+	)
+}
+
+// abiString decodes a string return value, or the bytes32 some older tokens
+// return instead. Malformed values decode to "".
+func abiString(out []byte) string {
+	if len(out) == 32 {
+		return string(bytes.TrimRight(out, "\x00"))
+	}
+	if len(out) < 64 {
+		return ""
+	}
+	offset := new(big.Int).SetBytes(out[:32])
+	if !offset.IsUint64() || offset.Uint64() > uint64(len(out)-32) {
+		return ""
+	}
+	start := offset.Uint64() + 32
+	length := new(big.Int).SetBytes(out[start-32 : start])
+	if !length.IsUint64() || length.Uint64() > uint64(len(out))-start {
+		return ""
+	}
+	return string(out[start : start+length.Uint64()])
+}
+
+func selector(signature string) []byte {
+	return crypto.Keccak256([]byte(signature))[:4]
+}
@@ -0,0 +1,108 @@
+package ethereum_test
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/adapters/crypto/providers/ethereum"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+	goethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnsignedPayload(t *testing.T) {
+	t.Parallel()
+
+	to := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	tx := &types.DynamicFeeTx{
+		ChainID:   big.NewInt(1),
+		Nonce:     7,
+		GasTipCap: gwei(2),
+		GasFeeCap: gwei(30),
+		Gas:       ethereum.TransferGas,
+		To:        &to,
+		Value:     big.NewInt(1e18),
+	}
+
+	payload, err := ethereum.UnsignedPayload(tx)
+	require.NoError(t, err)
+
+	assert.Equal(t, byte(types.DynamicFeeTxType), payload[0])
+	signingHash := types.LatestSignerForChainID(tx.ChainID).Hash(types.NewTx(tx))
+	assert.Equal(t, signingHash.Bytes(), crypto.Keccak256(payload))
+}
+
+func TestTransferData(t *testing.T) {
+	t.Parallel()
+
+	data := ethereum.TransferData(common.HexToAddress("0x2222222222222222222222222222222222222222"), big.NewInt(1_500_000))
+
+	assert.Equal(t, "a9059cbb"+
+		"0000000000000000000000002222222222222222222222222222222222222222"+
+		"000000000000000000000000000000000000000000000000000000000016e360", hex.EncodeToString(data))
+}
+
+func TestSweepValue(t *testing.T) {
+	t.Parallel()
+
+	fee := new(big.Int).Mul(big.NewInt(ethereum.TransferGas), gwei(30))
+	value, err := ethereum.SweepValue(big.NewInt(1e18), fee)
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(1e18-21_000*30e9), value)
+
+	_, err = ethereum.SweepValue(fee, fee)
+	require.ErrorIs(t, err, domain.ErrInsufficientFunds)
+}
+
+func TestDynamicFees(t *testing.T) {
+	t.Parallel()
+
+	history := &goethereum.FeeHistory{
+		BaseFee: []*big.Int{gwei(9), gwei(10)},
+		Reward:  [][]*big.Int{{gwei(1), gwei(2), gwei(5)}},
+	}
+
+	tip, maxFee, err := ethereum.DynamicFees(history, 0)
+	require.NoError(t, err)
+	assert.Equal(t, gwei(2), tip)
+	assert.Equal(t, gwei(22), maxFee)
+
+	tip, maxFee, err = ethereum.DynamicFees(history, 11)
+	require.NoError(t, err)
+	assert.Equal(t, gwei(2), tip)
+	assert.Equal(t, gwei(11), maxFee)
+
+	tip, maxFee, err = ethereum.DynamicFees(history, 10.5)
+	require.NoError(t, err)
+	assert.Equal(t, gwei(2), tip)
+	assert.Equal(t, big.NewInt(10_500_000_000), maxFee)
+
+	// A max fee below the base fee of the next block is never included.
+	_, _, err = ethereum.DynamicFees(history, 9.5)
+	require.ErrorIs(t, err, domain.ErrBadRequest)
+	assert.ErrorContains(t, err, "10 gwei")
+
+	_, _, err = ethereum.DynamicFees(&goethereum.FeeHistory{}, 0)
+	require.ErrorIs(t, err, ethereum.ErrNoFeeHistory)
+}
+
+func TestABIString(t *testing.T) {
+	t.Parallel()
+
+	encoded, err := hex.DecodeString("0000000000000000000000000000000000000000000000000000000000000020" +
+		"0000000000000000000000000000000000000000000000000000000000000004" +
+		"5553444300000000000000000000000000000000000000000000000000000000")
+	require.NoError(t, err)
+	assert.Equal(t, "USDC", ethereum.ABIString(encoded))
+
+	bytes32 := common.RightPadBytes([]byte("MKR"), 32)
+	assert.Equal(t, "MKR", ethereum.ABIString(bytes32))
+
+	assert.Empty(t, ethereum.ABIString(encoded[:40]))
+	assert.Empty(t, ethereum.ABIString(append(common.LeftPadBytes([]byte{0xff}, 32), encoded[32:]...)))
+}
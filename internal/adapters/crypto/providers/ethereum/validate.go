@@ -0,0 +1,37 @@
+package ethereum
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ValidateAddress checks the hex form and, for mixed case addresses, the
+// EIP-55 checksum. Mainnet and testnet addresses cannot be told apart.
+func (a *Adapter) ValidateAddress(address string) (*domain.AddressValidation, error) {
+	return validateAddress(address, a.isTestnet)
+}
+
+func validateAddress(address string, isTestnet bool) (*domain.AddressValidation, error) {
+	if !common.IsHexAddress(address) {
+		return nil, ErrInvalidEthereumAddress
+	}
+
+	format := "hex"
+	hexPart := strings.TrimPrefix(strings.TrimPrefix(address, "0x"), "0X")
+	if hexPart != strings.ToLower(hexPart) && hexPart != strings.ToUpper(hexPart) {
+		if common.HexToAddress(address).Hex() != "0x"+hexPart {
+			return nil, fmt.Errorf("%w: EIP-55 checksum mismatch", ErrInvalidEthereumAddress)
+		}
+		format = "eip55"
+	}
+
+	return &domain.AddressValidation{
+		Address: address,
+		Kind:    domain.AddressKindAddress,
+		Format:  format,
+		Network: domain.NetworkName(isTestnet),
+	}, nil
+}
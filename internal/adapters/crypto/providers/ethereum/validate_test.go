@@ -0,0 +1,31 @@
+package ethereum_test
+
+import (
+	"testing"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/adapters/crypto/providers/ethereum"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateAddress(t *testing.T) {
+	t.Parallel()
+
+	validation, err := ethereum.ValidateAddress("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", false)
+	require.NoError(t, err)
+	assert.Equal(t, "eip55", validation.Format)
+	assert.Equal(t, domain.NetworkMainnet, validation.Network)
+
+	validation, err = ethereum.ValidateAddress("0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed", true)
+	require.NoError(t, err)
+	assert.Equal(t, "hex", validation.Format)
+	assert.Equal(t, domain.NetworkTestnet, validation.Network)
+
+	_, err = ethereum.ValidateAddress("0x5aaeb6053F3E94C9b9A09f33669435E7Ef1BeAed", false)
+	require.ErrorIs(t, err, domain.ErrInvalidAddress)
+	assert.Contains(t, err.Error(), "EIP-55")
+
+	_, err = ethereum.ValidateAddress("0x1234", false)
+	require.ErrorIs(t, err, domain.ErrInvalidAddress)
+}
@@ -9,9 +9,21 @@ import (
 	"io"
 	"net/http"
 	"sync"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var (
+	ErrUnexpectedStatus = errors.New("unexpected status")
+	// ErrNotFound is returned alongside ErrUnexpectedStatus when the explorer
+	// answers 404.
+	ErrNotFound = errors.New("not found")
 )
 
-var ErrUnexpectedStatus = errors.New("unexpected status")
+var tracer = tracing.Tracer("kaspa")
 
 type Adapter struct {
 	explorerURL string
@@ -26,7 +38,14 @@ func NewAdapter(explorerURL string) *Adapter {
 	}
 }
 
-func (a *Adapter) GetBalance(kpub string) (float64, error) {
+func (a *Adapter) GetBalance(ctx context.Context, kpub string) (float64, error) {
+	ctx, span := tracer.Start(ctx, "kaspa.GetBalance", trace.WithAttributes(a.spanAttributes()...))
+	balance, err := a.getBalance(ctx, kpub)
+	tracing.End(span, err)
+	return balance, err
+}
+
+func (a *Adapter) getBalance(ctx context.Context, kpub string) (float64, error) {
 	a.mu.RLock()
 	addresses, ok := a.cache[kpub]
 	a.mu.RUnlock()
@@ -35,7 +54,7 @@ func (a *Adapter) GetBalance(kpub string) (float64, error) {
 		deriveCount := 1000
 		recv, change, err := deriveAddresses(kpub, deriveCount, deriveCount)
 		if err != nil {
-			return 0, err
+			return 0, fmt.Errorf("%w: %w", domain.ErrInvalidAddress, err)
 		}
 
 		addresses = append(recv, change...) //nolint:gocritic
@@ -45,9 +64,9 @@ func (a *Adapter) GetBalance(kpub string) (float64, error) {
 		a.mu.Unlock()
 	}
 
-	res, err := a.fetchBalances(addresses)
+	res, err := a.fetchBalances(ctx, addresses)
 	if err != nil {
-		return 0, err
+		return 0, domain.UpstreamError(err)
 	}
 
 	var bal float64
@@ -62,7 +81,7 @@ type balanceResponse struct {
 	Balance float64 `json:"balance"`
 }
 
-func (a *Adapter) fetchBalances(addresses []string) ([]balanceResponse, error) {
+func (a *Adapter) fetchBalances(ctx context.Context, addresses []string) ([]balanceResponse, error) {
 	payload := map[string][]string{
 		"addresses": addresses,
 	}
@@ -72,7 +91,12 @@ func (a *Adapter) fetchBalances(addresses []string) ([]balanceResponse, error) {
 		return nil, fmt.Errorf("failed to marshal payload: %w", err)
 	}
 
-	respBody, err := postJSON(a.explorerURL+"/addresses/balances", data)
+	ctx, span := tracer.Start(ctx, "kaspa.POST /addresses/balances", trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(a.spanAttributes()...),
+		trace.WithAttributes(tracing.AttrRPCMethod.String("POST /addresses/balances")),
+	)
+	respBody, err := postJSON(ctx, a.explorerURL+"/addresses/balances", data)
+	tracing.End(span, err)
 	if err != nil {
 		return nil, err
 	}
@@ -85,12 +109,24 @@ func (a *Adapter) fetchBalances(addresses []string) ([]balanceResponse, error) {
 	return result, nil
 }
 
-func postJSON(url string, data []byte) ([]byte, error) {
-	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, url, bytes.NewBuffer(data))
+func postJSON(ctx context.Context, url string, data []byte) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewBuffer(data))
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
 	req.Header.Set("Content-Type", "application/json")
+	return doJSON(req)
+}
+
+func getJSON(ctx context.Context, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	return doJSON(req)
+}
+
+func doJSON(req *http.Request) ([]byte, error) {
 	req.Header.Set("Accept", "application/json")
 
 	client := &http.Client{}
@@ -102,7 +138,11 @@ func postJSON(url string, data []byte) ([]byte, error) {
 
 	if resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("%w %d: %s", ErrUnexpectedStatus, resp.StatusCode, string(body))
+		err := fmt.Errorf("%w %d: %s", ErrUnexpectedStatus, resp.StatusCode, string(body))
+		if resp.StatusCode == http.StatusNotFound {
+			err = fmt.Errorf("%w: %w", ErrNotFound, err)
+		}
+		return nil, err
 	}
 
 	buf, err := io.ReadAll(resp.Body)
@@ -111,3 +151,11 @@ func postJSON(url string, data []byte) ([]byte, error) {
 	}
 	return buf, nil
 }
+
+func (a *Adapter) spanAttributes() []attribute.KeyValue {
+	return []attribute.KeyValue{
+		tracing.AttrChainSymbol.String("KAS"),
+		tracing.AttrNetwork.String(tracing.NetworkMainnet),
+		tracing.AttrEndpoint.String(tracing.Endpoint(a.explorerURL)),
+	}
+}
@@ -15,6 +15,7 @@ const (
 
 var (
 	ErrIndexOutOfRange = errors.New("index out of range for uint32")
+	ErrPubKeyLength    = errors.New("unexpected pubkey length")
 )
 
 func deriveAddresses(xpub string, nRecv, nChange int) ([]string, []string, error) {
@@ -44,41 +45,48 @@ func deriveAddresses(xpub string, nRecv, nChange int) ([]string, []string, error
 	return recvAddrs, changeAddrs, nil
 }
 func derive(branch *hdkeychain.ExtendedKey, count int) ([]string, error) {
-	prefix := util.Bech32PrefixKaspa
-	pubKeyLength := 33
 	addrs := make([]string, 0, count)
 	for i := range count {
 		if i < 0 || i > 0x7FFFFFFF { // Check for uint32 overflow
 			return nil, ErrIndexOutOfRange
 		}
 
-		child, err := branch.Derive(uint32(i))
+		addr, err := deriveAddress(branch, uint32(i))
 		if err != nil {
-			log.Printf("Skipping index %d due to derivation error: %s\n", i, err)
+			log.Printf("Skipping index %d: %s\n", i, err)
 			continue
 		}
 
-		pubKey, err := child.ECPubKey()
-		if err != nil {
-			log.Printf("Skipping index %d due to pubkey error: %s\n", i, err)
-			continue
-		}
+		addrs = append(addrs, addr)
+	}
+	return addrs, nil
+}
 
-		pubKeyBytes := pubKey.SerializeCompressed()
-		if len(pubKeyBytes) != pubKeyLength {
-			log.Printf("Skipping index %d, pubkey length %d not 33\n", i, len(pubKeyBytes))
-			continue
-		}
+// deriveAddress returns the schnorr address of child index of branch.
+func deriveAddress(branch *hdkeychain.ExtendedKey, index uint32) (string, error) {
+	pubKeyLength := 33
 
-		schnorrPubKey := pubKeyBytes[1:]
+	child, err := branch.Derive(index)
+	if err != nil {
+		return "", fmt.Errorf("derivation error: %w", err)
+	}
 
-		addr, err := util.NewAddressPublicKey(schnorrPubKey, prefix)
-		if err != nil {
-			log.Printf("Skipping index %d, failed to create address: %s\n", i, err)
-			continue
-		}
+	pubKey, err := child.ECPubKey()
+	if err != nil {
+		return "", fmt.Errorf("pubkey error: %w", err)
+	}
 
-		addrs = append(addrs, addr.EncodeAddress())
+	pubKeyBytes := pubKey.SerializeCompressed()
+	if len(pubKeyBytes) != pubKeyLength {
+		return "", fmt.Errorf("%w: %d not 33", ErrPubKeyLength, len(pubKeyBytes))
 	}
-	return addrs, nil
+
+	schnorrPubKey := pubKeyBytes[1:]
+
+	addr, err := util.NewAddressPublicKey(schnorrPubKey, util.Bech32PrefixKaspa)
+	if err != nil {
+		return "", fmt.Errorf("failed to create address: %w", err)
+	}
+
+	return addr.EncodeAddress(), nil
 }
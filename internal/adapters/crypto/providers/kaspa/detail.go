@@ -0,0 +1,175 @@
+package kaspa
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/tracing"
+	"github.com/btcsuite/btcd/btcutil/hdkeychain"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// transactionDetail is a transaction as /transactions/{transactionId}
+// serves it with its previous outpoints resolved.
+type transactionDetail struct {
+	TransactionID           string `json:"transaction_id"`
+	BlockTime               int64  `json:"block_time"`
+	IsAccepted              bool   `json:"is_accepted"`
+	AcceptingBlockHash      string `json:"accepting_block_hash"`
+	AcceptingBlockBlueScore int64  `json:"accepting_block_blue_score"`
+	Inputs                  []struct {
+		Index                   uint32        `json:"index"`
+		PreviousOutpointHash    string        `json:"previous_outpoint_hash"`
+		PreviousOutpointIndex   outpointIndex `json:"previous_outpoint_index"`
+		PreviousOutpointAddress string        `json:"previous_outpoint_address"`
+		PreviousOutpointAmount  int64         `json:"previous_outpoint_amount"`
+	} `json:"inputs"`
+	Outputs []struct {
+		Index                  uint32 `json:"index"`
+		Amount                 int64  `json:"amount"`
+		ScriptPublicKeyAddress string `json:"script_public_key_address"`
+	} `json:"outputs"`
+}
+
+// outpointIndex is an output index the explorer serves as a string.
+type outpointIndex uint32
+
+func (i *outpointIndex) UnmarshalJSON(data []byte) error {
+	index, err := strconv.ParseUint(strings.Trim(string(data), `"`), 10, 32)
+	if err != nil {
+		return fmt.Errorf("invalid outpoint index %s: %w", data, err)
+	}
+	*i = outpointIndex(index)
+	return nil
+}
+
+// GetTransactionDetail decodes txid with the outputs it spends. With wallet
+// set to a kaspa: address, or to a kpub whose active addresses are scanned,
+// the inputs and outputs paying it are marked owned. Block heights and
+// confirmations are counted in blue score as in GetTransactions.
+func (a *Adapter) GetTransactionDetail(ctx context.Context, txid, wallet string) (*domain.TransactionDetail, error) {
+	ctx, span := tracer.Start(ctx, "kaspa.GetTransactionDetail", trace.WithAttributes(a.spanAttributes()...))
+	detail, err := a.getTransactionDetail(ctx, txid, wallet)
+	tracing.End(span, err)
+	return detail, err
+}
+
+func (a *Adapter) getTransactionDetail(ctx context.Context, txid, wallet string) (*domain.TransactionDetail, error) {
+	if raw, err := hex.DecodeString(txid); err != nil || len(raw) != 32 {
+		return nil, fmt.Errorf("%w: invalid transaction id %q", domain.ErrBadRequest, txid)
+	}
+	var addresses []string
+	if wallet != "" {
+		if _, err := validateAddress(wallet); err != nil {
+			return nil, err
+		}
+		addresses = []string{wallet}
+		if key, err := hdkeychain.NewKeyFromString(wallet); err == nil {
+			if addresses, err = a.usedAddresses(ctx, key); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	tx, err := a.fetchTransaction(ctx, txid)
+	if errors.Is(err, ErrNotFound) {
+		return nil, fmt.Errorf("%w: %s", domain.ErrTransactionNotFound, txid)
+	}
+	if err != nil {
+		return nil, domain.UpstreamError(err)
+	}
+	blueScore, err := a.fetchVirtualBlueScore(ctx)
+	if err != nil {
+		return nil, domain.UpstreamError(err)
+	}
+	return decodeTransaction(tx, addresses, blueScore), nil
+}
+
+func (a *Adapter) fetchTransaction(ctx context.Context, txid string) (*transactionDetail, error) {
+	query := url.Values{
+		"inputs":                     {"true"},
+		"outputs":                    {"true"},
+		"resolve_previous_outpoints": {"light"},
+	}
+
+	ctx, span := tracer.Start(ctx, "kaspa.GET /transactions/{transactionId}", trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(a.spanAttributes()...),
+		trace.WithAttributes(tracing.AttrRPCMethod.String("GET /transactions/{transactionId}")),
+	)
+	respBody, err := getJSON(ctx, a.explorerURL+"/transactions/"+url.PathEscape(txid)+"?"+query.Encode())
+	tracing.End(span, err)
+	if err != nil {
+		return nil, err
+	}
+
+	var tx transactionDetail
+	if err := json.Unmarshal(respBody, &tx); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+	return &tx, nil
+}
+
+func decodeTransaction(tx *transactionDetail, addresses []string, blueScore int64) *domain.TransactionDetail {
+	owned := make(map[string]bool, len(addresses))
+	for _, addr := range addresses {
+		owned[addr] = true
+	}
+
+	detail := &domain.TransactionDetail{
+		TransactionID: tx.TransactionID,
+		Status:        domain.TransactionPending,
+		Inputs:        make([]domain.TransactionInput, len(tx.Inputs)),
+		Outputs:       make([]domain.TransactionOutput, len(tx.Outputs)),
+	}
+	var totalIn, totalOut int64
+	for i, in := range tx.Inputs {
+		detail.Inputs[i] = domain.TransactionInput{
+			Index:               in.Index,
+			PreviousTransaction: in.PreviousOutpointHash,
+			PreviousIndex:       uint32(in.PreviousOutpointIndex),
+			Address:             in.PreviousOutpointAddress,
+			Value:               in.PreviousOutpointAmount,
+			Amount:              float64(in.PreviousOutpointAmount) / SompiPerKAS,
+			Owned:               owned[in.PreviousOutpointAddress],
+		}
+		totalIn += in.PreviousOutpointAmount
+	}
+	for i, out := range tx.Outputs {
+		detail.Outputs[i] = domain.TransactionOutput{
+			Index:   out.Index,
+			Address: out.ScriptPublicKeyAddress,
+			Value:   out.Amount,
+			Amount:  float64(out.Amount) / SompiPerKAS,
+			Owned:   owned[out.ScriptPublicKeyAddress],
+		}
+		totalOut += out.Amount
+	}
+
+	// Coinbase transactions have no inputs and pay no fee.
+	if len(tx.Inputs) > 0 && totalIn >= totalOut {
+		fee := float64(totalIn-totalOut) / SompiPerKAS
+		detail.FeeAmount = &fee
+	}
+	if tx.BlockTime > 0 {
+		blockTime := time.UnixMilli(tx.BlockTime)
+		detail.Timestamp = &blockTime
+	}
+	if tx.IsAccepted && tx.AcceptingBlockBlueScore > 0 {
+		score := tx.AcceptingBlockBlueScore
+		detail.Status = domain.TransactionConfirmed
+		detail.BlockHeight = &score
+		detail.BlockHash = tx.AcceptingBlockHash
+		if blueScore >= score {
+			detail.Confirmations = blueScore - score + 1
+		}
+	}
+	return detail
+}
@@ -0,0 +1,90 @@
+package kaspa_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/adapters/crypto/providers/kaspa"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	detailTxID   = "aa00000000000000000000000000000000000000000000000000000000000001"
+	detailPrevTx = "bb00000000000000000000000000000000000000000000000000000000000002"
+)
+
+// detailServer serves detailTxID, spending output 1 of detailPrevTx held by
+// owner and paying change back to it, as a transaction accepted at blue score
+// 5000.
+func detailServer(t *testing.T, owner string) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/info/virtual-chain-blue-score":
+			fmt.Fprint(w, `{"blueScore":5009}`)
+		case r.URL.Path == "/transactions/"+detailTxID:
+			assert.Equal(t, "light", r.URL.Query().Get("resolve_previous_outpoints"))
+			fmt.Fprintf(w, `{"transaction_id":%q,"block_time":1700000000000,"is_accepted":true,`+
+				`"accepting_block_hash":"cc","accepting_block_blue_score":5000,`+
+				`"inputs":[{"index":0,"previous_outpoint_hash":%q,"previous_outpoint_index":"1",`+
+				`"previous_outpoint_address":%q,"previous_outpoint_amount":300000000}],`+
+				`"outputs":[{"index":0,"amount":200000000,"script_public_key_address":%q},`+
+				`{"index":1,"amount":99990000,"script_public_key_address":%q}]}`,
+				detailTxID, detailPrevTx, owner, externalAddress, owner)
+		case strings.HasPrefix(r.URL.Path, "/transactions/"):
+			http.Error(w, `{"detail":"Transaction not found"}`, http.StatusNotFound)
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestAdapter_GetTransactionDetail(t *testing.T) {
+	t.Parallel()
+
+	owner, err := kaspa.ChangeAddress(testXpub, 0)
+	require.NoError(t, err)
+	adapter := kaspa.NewAdapter(detailServer(t, owner).URL)
+
+	detail, err := adapter.GetTransactionDetail(t.Context(), detailTxID, owner)
+	require.NoError(t, err)
+	assert.Equal(t, detailTxID, detail.TransactionID)
+	assert.Equal(t, domain.TransactionConfirmed, detail.Status)
+	require.NotNil(t, detail.BlockHeight)
+	assert.Equal(t, int64(5000), *detail.BlockHeight)
+	assert.Equal(t, "cc", detail.BlockHash)
+	assert.Equal(t, int64(10), detail.Confirmations)
+	require.NotNil(t, detail.Timestamp)
+	assert.Equal(t, int64(1700000000), detail.Timestamp.Unix())
+	require.NotNil(t, detail.FeeAmount)
+	assert.InDelta(t, 0.0001, *detail.FeeAmount, 1e-12)
+
+	assert.Equal(t, []domain.TransactionInput{{
+		PreviousTransaction: detailPrevTx, PreviousIndex: 1, Address: owner,
+		Value: 300000000, Amount: 3, Owned: true,
+	}}, detail.Inputs)
+	assert.Equal(t, []domain.TransactionOutput{
+		{Index: 0, Address: externalAddress, Value: 200000000, Amount: 2},
+		{Index: 1, Address: owner, Value: 99990000, Amount: 0.9999, Owned: true},
+	}, detail.Outputs)
+}
+
+func TestAdapter_GetTransactionDetail_NotFound(t *testing.T) {
+	t.Parallel()
+
+	adapter := kaspa.NewAdapter(detailServer(t, externalAddress).URL)
+
+	_, err := adapter.GetTransactionDetail(t.Context(), detailPrevTx, "")
+	require.ErrorIs(t, err, domain.ErrTransactionNotFound)
+
+	_, err = adapter.GetTransactionDetail(t.Context(), "xyz", "")
+	require.ErrorIs(t, err, domain.ErrBadRequest)
+}
@@ -0,0 +1,15 @@
+package kaspa
+
+import "github.com/btcsuite/btcd/btcutil/hdkeychain"
+
+var ValidateAddress = validateAddress
+
+// ChangeAddress derives the change address at index of kpub.
+func ChangeAddress(kpub string, index uint32) (string, error) {
+	key, err := hdkeychain.NewKeyFromString(kpub)
+	if err != nil {
+		return "", err
+	}
+	address, _, err := changeOutput(key, index)
+	return address, err
+}
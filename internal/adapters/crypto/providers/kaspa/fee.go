@@ -0,0 +1,98 @@
+package kaspa
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/tracing"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TypicalTxMass is the compute mass of a one input, two output transaction,
+// the size fee amounts are priced for.
+const TypicalTxMass = 2036
+
+var ErrNoFeeBuckets = errors.New("fee estimate has no buckets")
+
+type feeBucket struct {
+	Feerate          float64 `json:"feerate"`
+	EstimatedSeconds float64 `json:"estimatedSeconds"`
+}
+
+type feeEstimateResponse struct {
+	PriorityBucket feeBucket   `json:"priorityBucket"`
+	NormalBuckets  []feeBucket `json:"normalBuckets"`
+	LowBuckets     []feeBucket `json:"lowBuckets"`
+}
+
+// EstimateFees reads the fee rate buckets of the node's fee estimator from
+// GET /info/fee-estimate: the first low bucket is slow, the first normal
+// bucket normal and the priority bucket fast.
+func (a *Adapter) EstimateFees(ctx context.Context) (*domain.FeeEstimates, error) {
+	ctx, span := tracer.Start(ctx, "kaspa.EstimateFees", trace.WithAttributes(a.spanAttributes()...))
+	fees, err := a.estimateFees(ctx)
+	tracing.End(span, err)
+	return fees, err
+}
+
+func (a *Adapter) estimateFees(ctx context.Context) (*domain.FeeEstimates, error) {
+	ctx, span := tracer.Start(ctx, "kaspa.GET /info/fee-estimate", trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(a.spanAttributes()...),
+		trace.WithAttributes(tracing.AttrRPCMethod.String("GET /info/fee-estimate")),
+	)
+	respBody, err := getJSON(ctx, a.explorerURL+"/info/fee-estimate")
+	tracing.End(span, err)
+	if err != nil {
+		return nil, domain.UpstreamError(err)
+	}
+
+	var result feeEstimateResponse
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, domain.UpstreamError(fmt.Errorf("failed to unmarshal response: %w", err))
+	}
+
+	estimates, err := feeEstimates(result)
+	if err != nil {
+		return nil, domain.UpstreamError(err)
+	}
+	return &domain.FeeEstimates{
+		CryptoSymbol: "KAS",
+		RateUnit:     "sompi/gram",
+		SizeUnit:     "grams",
+		TypicalSize:  TypicalTxMass,
+		Estimates:    estimates,
+	}, nil
+}
+
+func feeEstimates(result feeEstimateResponse) ([]domain.FeeEstimate, error) {
+	if len(result.NormalBuckets) == 0 {
+		return nil, ErrNoFeeBuckets
+	}
+	slow := result.NormalBuckets[0]
+	if len(result.LowBuckets) > 0 {
+		slow = result.LowBuckets[0]
+	}
+
+	buckets := []struct {
+		level  domain.FeeLevel
+		bucket feeBucket
+	}{
+		{domain.FeeLevelSlow, slow},
+		{domain.FeeLevelNormal, result.NormalBuckets[0]},
+		{domain.FeeLevelFast, result.PriorityBucket},
+	}
+	estimates := make([]domain.FeeEstimate, len(buckets))
+	for i, b := range buckets {
+		estimates[i] = domain.FeeEstimate{
+			Level:            b.level,
+			Rate:             b.bucket.Feerate,
+			Amount:           b.bucket.Feerate * TypicalTxMass / SompiPerKAS,
+			EstimatedSeconds: int64(math.Ceil(b.bucket.EstimatedSeconds)),
+		}
+	}
+	return estimates, nil
+}
@@ -0,0 +1,40 @@
+package kaspa_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/adapters/crypto/providers/kaspa"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdapter_EstimateFees(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/info/fee-estimate", r.URL.Path)
+		fmt.Fprint(w, `{"priorityBucket":{"feerate":3,"estimatedSeconds":0.9},`+
+			`"normalBuckets":[{"feerate":2,"estimatedSeconds":5.2},{"feerate":1.5,"estimatedSeconds":30}],`+
+			`"lowBuckets":[{"feerate":1,"estimatedSeconds":60}]}`)
+	}))
+	t.Cleanup(server.Close)
+
+	fees, err := kaspa.NewAdapter(server.URL).EstimateFees(t.Context())
+	require.NoError(t, err)
+
+	assert.Equal(t, "sompi/gram", fees.RateUnit)
+	assert.Equal(t, int64(kaspa.TypicalTxMass), fees.TypicalSize)
+	require.Len(t, fees.Estimates, 3)
+	assert.Equal(t, domain.FeeLevelSlow, fees.Estimates[0].Level)
+	assert.InDelta(t, 1.0, fees.Estimates[0].Rate, 1e-9)
+	assert.Equal(t, int64(60), fees.Estimates[0].EstimatedSeconds)
+	assert.Equal(t, domain.FeeLevelNormal, fees.Estimates[1].Level)
+	assert.InDelta(t, 2.0, fees.Estimates[1].Rate, 1e-9)
+	assert.Equal(t, int64(6), fees.Estimates[1].EstimatedSeconds)
+	assert.Equal(t, domain.FeeLevelFast, fees.Estimates[2].Level)
+	assert.InDelta(t, 3*kaspa.TypicalTxMass/kaspa.SompiPerKAS, fees.Estimates[2].Amount, 1e-12)
+}
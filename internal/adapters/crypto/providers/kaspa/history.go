@@ -0,0 +1,265 @@
+package kaspa
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/tracing"
+	"github.com/btcsuite/btcd/btcutil/hdkeychain"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// HistoryPageSize is the number of transactions read per call of
+// /addresses/{address}/full-transactions, the most the explorer serves.
+const HistoryPageSize = 500
+
+// GetTransactions returns the history of a kaspa: address or of every active
+// address on the receive and change branches of a kpub, newest first.
+// Transactions touching several addresses of the wallet are listed once with
+// the amount netted across the wallet. Block heights and confirmations are
+// counted in blue score against the virtual chain.
+func (a *Adapter) GetTransactions(ctx context.Context, address string) ([]domain.Transaction, error) {
+	ctx, span := tracer.Start(ctx, "kaspa.GetTransactions", trace.WithAttributes(a.spanAttributes()...))
+	txs, err := a.getTransactions(ctx, address)
+	tracing.End(span, err)
+	return txs, err
+}
+
+func (a *Adapter) getTransactions(ctx context.Context, address string) ([]domain.Transaction, error) {
+	if _, err := validateAddress(address); err != nil {
+		return nil, err
+	}
+
+	addresses := []string{address}
+	if key, err := hdkeychain.NewKeyFromString(address); err == nil {
+		if addresses, err = a.usedAddresses(ctx, key); err != nil {
+			return nil, err
+		}
+	}
+
+	blueScore, err := a.fetchVirtualBlueScore(ctx)
+	if err != nil {
+		return nil, domain.UpstreamError(err)
+	}
+
+	var fetched []fullTransaction
+	for _, addr := range addresses {
+		txs, err := a.fetchFullTransactions(ctx, addr)
+		if err != nil {
+			return nil, domain.UpstreamError(err)
+		}
+		fetched = append(fetched, txs...)
+	}
+	return walletTransactions(addresses, fetched, blueScore), nil
+}
+
+// usedAddresses returns every active address on the receive and change
+// branches of key.
+func (a *Adapter) usedAddresses(ctx context.Context, key *hdkeychain.ExtendedKey) ([]string, error) {
+	var used []string
+	for _, branchIndex := range []uint32{0, 1} {
+		branch, err := key.Derive(branchIndex)
+		if err != nil {
+			return nil, fmt.Errorf("%w: failed to derive branch %d: %w", domain.ErrInvalidAddress, branchIndex, err)
+		}
+
+		_, err = scanBranch(branch, func(_ int, addresses []string) (map[string]bool, error) {
+			active, err := a.fetchActive(ctx, addresses)
+			if err != nil {
+				return nil, err
+			}
+			for _, addr := range addresses {
+				if active[addr] {
+					used = append(used, addr)
+				}
+			}
+			return active, nil
+		})
+		if err != nil {
+			return nil, domain.UpstreamError(err)
+		}
+	}
+	return used, nil
+}
+
+type fullTransaction struct {
+	TransactionID           string `json:"transaction_id"`
+	BlockTime               int64  `json:"block_time"`
+	IsAccepted              bool   `json:"is_accepted"`
+	AcceptingBlockBlueScore int64  `json:"accepting_block_blue_score"`
+	Inputs                  []struct {
+		PreviousOutpointAddress string `json:"previous_outpoint_address"`
+		PreviousOutpointAmount  int64  `json:"previous_outpoint_amount"`
+	} `json:"inputs"`
+	Outputs []struct {
+		Amount                 int64  `json:"amount"`
+		ScriptPublicKeyAddress string `json:"script_public_key_address"`
+	} `json:"outputs"`
+}
+
+// fetchFullTransactions returns every transaction of one address, with the
+// address and amount of the outputs its inputs spend.
+func (a *Adapter) fetchFullTransactions(ctx context.Context, address string) ([]fullTransaction, error) {
+	var txs []fullTransaction
+	for offset := 0; ; offset += HistoryPageSize {
+		query := url.Values{
+			"limit":                      {strconv.Itoa(HistoryPageSize)},
+			"offset":                     {strconv.Itoa(offset)},
+			"resolve_previous_outpoints": {"light"},
+		}
+
+		spanCtx, span := tracer.Start(ctx, "kaspa.GET /addresses/{address}/full-transactions",
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(a.spanAttributes()...),
+			trace.WithAttributes(tracing.AttrRPCMethod.String("GET /addresses/{address}/full-transactions")),
+		)
+		respBody, err := getJSON(spanCtx,
+			a.explorerURL+"/addresses/"+url.PathEscape(address)+"/full-transactions?"+query.Encode())
+		tracing.End(span, err)
+		if err != nil {
+			return nil, err
+		}
+
+		var page []fullTransaction
+		if err := json.Unmarshal(respBody, &page); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+		}
+		txs = append(txs, page...)
+		if len(page) < HistoryPageSize {
+			return txs, nil
+		}
+	}
+}
+
+// walletTransactions merges the transactions fetched per address into one
+// entry per transaction ID, netted across the owned addresses. Entries are
+// ordered by block time and then by transaction ID, so the order, and with
+// it every offset into the listing, does not depend on which address a
+// transaction was fetched through.
+func walletTransactions(addresses []string, fetched []fullTransaction, blueScore int64) []domain.Transaction {
+	owned := make(map[string]bool, len(addresses))
+	for _, addr := range addresses {
+		owned[addr] = true
+	}
+
+	seen := make(map[string]bool, len(fetched))
+	var merged []fullTransaction
+	for _, tx := range fetched {
+		if !seen[tx.TransactionID] {
+			seen[tx.TransactionID] = true
+			merged = append(merged, tx)
+		}
+	}
+	sort.Slice(merged, func(i, j int) bool {
+		if merged[i].BlockTime != merged[j].BlockTime {
+			return merged[i].BlockTime > merged[j].BlockTime
+		}
+		return merged[i].TransactionID < merged[j].TransactionID
+	})
+
+	txs := make([]domain.Transaction, len(merged))
+	for i, tx := range merged {
+		txs[i] = walletTransaction(tx, owned, blueScore)
+	}
+	return txs
+}
+
+func walletTransaction(tx fullTransaction, owned map[string]bool, blueScore int64) domain.Transaction {
+	var received, sent, totalIn, totalOut int64
+	var from, to []string
+	for _, in := range tx.Inputs {
+		totalIn += in.PreviousOutpointAmount
+		if owned[in.PreviousOutpointAddress] {
+			sent += in.PreviousOutpointAmount
+		}
+		from = appendAddress(from, in.PreviousOutpointAddress)
+	}
+	for _, out := range tx.Outputs {
+		totalOut += out.Amount
+		if owned[out.ScriptPublicKeyAddress] {
+			received += out.Amount
+		}
+		to = appendAddress(to, out.ScriptPublicKeyAddress)
+	}
+
+	net := received - sent
+	entry := domain.Transaction{
+		TransactionID: tx.TransactionID,
+		Timestamp:     time.Now(),
+		Amount:        float64(absInt64(net)) / SompiPerKAS,
+		Direction:     domain.DirectionIncoming,
+		FromAddresses: from,
+		ToAddresses:   to,
+	}
+	if net < 0 {
+		entry.Direction = domain.DirectionOutgoing
+	}
+	// Coinbase transactions have no inputs and pay no fee.
+	if sent > 0 && totalIn >= totalOut {
+		fee := float64(totalIn-totalOut) / SompiPerKAS
+		entry.FeeAmount = &fee
+	}
+	if tx.BlockTime > 0 {
+		entry.Timestamp = time.UnixMilli(tx.BlockTime)
+	}
+
+	// Transactions are final once a chain block accepts them; until then they
+	// have no confirmations.
+	if tx.IsAccepted && tx.AcceptingBlockBlueScore > 0 {
+		score := tx.AcceptingBlockBlueScore
+		entry.BlockHeight = &score
+		if blueScore >= score {
+			entry.Confirmations = blueScore - score + 1
+		}
+	}
+	return entry
+}
+
+type blueScoreResponse struct {
+	BlueScore int64 `json:"blueScore"`
+}
+
+// fetchVirtualBlueScore returns the blue score of the virtual chain, which
+// the accepting block blue scores of transactions are counted against.
+func (a *Adapter) fetchVirtualBlueScore(ctx context.Context) (int64, error) {
+	ctx, span := tracer.Start(ctx, "kaspa.GET /info/virtual-chain-blue-score", trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(a.spanAttributes()...),
+		trace.WithAttributes(tracing.AttrRPCMethod.String("GET /info/virtual-chain-blue-score")),
+	)
+	respBody, err := getJSON(ctx, a.explorerURL+"/info/virtual-chain-blue-score")
+	tracing.End(span, err)
+	if err != nil {
+		return 0, err
+	}
+
+	var result blueScoreResponse
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return 0, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+	return result.BlueScore, nil
+}
+
+func appendAddress(addresses []string, addr string) []string {
+	if addr == "" {
+		return addresses
+	}
+	for _, existing := range addresses {
+		if existing == addr {
+			return addresses
+		}
+	}
+	return append(addresses, addr)
+}
+
+func absInt64(v int64) int64 {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
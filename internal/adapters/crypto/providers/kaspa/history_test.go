@@ -0,0 +1,127 @@
+package kaspa_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/adapters/crypto/providers/kaspa"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const externalAddress = "kaspa:qr0lr4ml9fn3chekrqmjdkergxl93l4wrk3dankcgvjq776s9wn9jkdskewva"
+
+// historyServer serves the explorer routes used by GetTransactions. Addresses
+// with an entry in history are active and list those transactions.
+func historyServer(t *testing.T, history map[string]string) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/info/virtual-chain-blue-score":
+			fmt.Fprint(w, `{"blueScore":5009}`)
+		case r.URL.Path == "/addresses/active":
+			var payload struct {
+				Addresses []string `json:"addresses"`
+			}
+			if !assert.NoError(t, json.NewDecoder(r.Body).Decode(&payload)) {
+				return
+			}
+			out := make([]map[string]any, len(payload.Addresses))
+			for i, addr := range payload.Addresses {
+				_, active := history[addr]
+				out[i] = map[string]any{"address": addr, "active": active}
+			}
+			assert.NoError(t, json.NewEncoder(w).Encode(out))
+		case strings.HasSuffix(r.URL.Path, "/full-transactions"):
+			assert.Equal(t, "500", r.URL.Query().Get("limit"))
+			assert.Equal(t, "0", r.URL.Query().Get("offset"))
+			assert.Equal(t, "light", r.URL.Query().Get("resolve_previous_outpoints"))
+			addr := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/addresses/"), "/full-transactions")
+			fmt.Fprintf(w, `[%s]`, history[addr])
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func fullTransaction(id string, blockTime int64, accepted bool, inputs, outputs map[string]int64) string {
+	var ins, outs []string
+	for addr, amount := range inputs {
+		ins = append(ins, fmt.Sprintf(`{"previous_outpoint_address":%q,"previous_outpoint_amount":%d}`, addr, amount))
+	}
+	for addr, amount := range outputs {
+		outs = append(outs, fmt.Sprintf(`{"script_public_key_address":%q,"amount":%d}`, addr, amount))
+	}
+	return fmt.Sprintf(`{"transaction_id":%q,"block_time":%d,"is_accepted":%t,"accepting_block_blue_score":5000,`+
+		`"inputs":[%s],"outputs":[%s]}`, id, blockTime, accepted, strings.Join(ins, ","), strings.Join(outs, ","))
+}
+
+func TestAdapter_GetTransactions(t *testing.T) {
+	t.Parallel()
+
+	receive, err := kaspa.NewAdapter(activeServer(t, nil).URL).GetReceiveAddresses(t.Context(), testXpub, 3)
+	require.NoError(t, err)
+	change, err := kaspa.ChangeAddress(testXpub, 0)
+	require.NoError(t, err)
+
+	funding := fullTransaction("aa", 2000, true,
+		map[string]int64{externalAddress: 300000000},
+		map[string]int64{receive[0].Address: 200000000, externalAddress: 99990000})
+	payment := fullTransaction("cc", 3000, true,
+		map[string]int64{receive[0].Address: 200000000},
+		map[string]int64{externalAddress: 150000000, change: 49990000})
+	pending := fullTransaction("bb", 3000, false,
+		map[string]int64{externalAddress: 100010000},
+		map[string]int64{receive[2].Address: 100000000})
+
+	// The payment is listed through both wallet addresses it touches.
+	adapter := kaspa.NewAdapter(historyServer(t, map[string]string{
+		receive[0].Address: payment + "," + funding,
+		receive[2].Address: pending,
+		change:             payment,
+	}).URL)
+
+	txs, err := adapter.GetTransactions(t.Context(), testXpub)
+	require.NoError(t, err)
+	require.Len(t, txs, 3)
+
+	// Ties in block time are ordered by transaction ID.
+	assert.Equal(t, "bb", txs[0].TransactionID)
+	assert.Equal(t, domain.DirectionIncoming, txs[0].Direction)
+	assert.InDelta(t, 1.0, txs[0].Amount, 1e-12)
+	assert.Nil(t, txs[0].BlockHeight)
+	assert.Zero(t, txs[0].Confirmations)
+	assert.Nil(t, txs[0].FeeAmount)
+
+	assert.Equal(t, "cc", txs[1].TransactionID)
+	assert.Equal(t, domain.DirectionOutgoing, txs[1].Direction)
+	assert.InDelta(t, 1.5001, txs[1].Amount, 1e-12)
+	require.NotNil(t, txs[1].FeeAmount)
+	assert.InDelta(t, 0.0001, *txs[1].FeeAmount, 1e-12)
+	require.NotNil(t, txs[1].BlockHeight)
+	assert.Equal(t, int64(5000), *txs[1].BlockHeight)
+	assert.Equal(t, int64(10), txs[1].Confirmations)
+	assert.Equal(t, int64(3000), txs[1].Timestamp.UnixMilli())
+	assert.Equal(t, []string{receive[0].Address}, txs[1].FromAddresses)
+
+	assert.Equal(t, "aa", txs[2].TransactionID)
+	assert.Equal(t, domain.DirectionIncoming, txs[2].Direction)
+	assert.InDelta(t, 2.0, txs[2].Amount, 1e-12)
+	assert.Nil(t, txs[2].FeeAmount)
+
+	single, err := adapter.GetTransactions(t.Context(), receive[2].Address)
+	require.NoError(t, err)
+	require.Len(t, single, 1)
+	assert.Equal(t, "bb", single[0].TransactionID)
+
+	_, err = adapter.GetTransactions(t.Context(), "kaspa:invalid")
+	require.ErrorIs(t, err, domain.ErrInvalidAddress)
+}
@@ -0,0 +1,157 @@
+package kaspa
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/tracing"
+	"github.com/btcsuite/btcd/btcutil/hdkeychain"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// GapLimit is the number of consecutive unused addresses after which a
+	// wallet is assumed to have no further history, as in BIP44.
+	GapLimit = 20
+	// CoinType is the registered BIP44 coin type of Kaspa.
+	CoinType = 111111
+
+	accountDepth = 3
+)
+
+// GetReceiveAddresses returns count consecutive receive addresses of kpub,
+// starting with the first one after the last address that has history.
+func (a *Adapter) GetReceiveAddresses(ctx context.Context, kpub string, count int) ([]domain.ReceiveAddress, error) {
+	ctx, span := tracer.Start(ctx, "kaspa.GetReceiveAddresses", trace.WithAttributes(a.spanAttributes()...))
+	addresses, err := a.getReceiveAddresses(ctx, kpub, count)
+	tracing.End(span, err)
+	return addresses, err
+}
+
+func (a *Adapter) getReceiveAddresses(ctx context.Context, kpub string, count int) ([]domain.ReceiveAddress, error) {
+	key, err := hdkeychain.NewKeyFromString(kpub)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid xpub: %w", domain.ErrInvalidAddress, err)
+	}
+	recvBranch, err := key.Derive(0)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to derive receive branch: %w", domain.ErrInvalidAddress, err)
+	}
+
+	next, err := scanBranch(recvBranch, func(_ int, addresses []string) (map[string]bool, error) {
+		return a.fetchActive(ctx, addresses)
+	})
+	if err != nil {
+		return nil, domain.UpstreamError(err)
+	}
+
+	return receiveAddresses(key, recvBranch, next, count)
+}
+
+type activeResponse struct {
+	Address string `json:"address"`
+	Active  bool   `json:"active"`
+}
+
+// fetchActive reports which of addresses have ever been used on chain.
+func (a *Adapter) fetchActive(ctx context.Context, addresses []string) (map[string]bool, error) {
+	payload := map[string][]string{
+		"addresses": addresses,
+	}
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal payload: %w", err)
+	}
+
+	ctx, span := tracer.Start(ctx, "kaspa.POST /addresses/active", trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(a.spanAttributes()...),
+		trace.WithAttributes(tracing.AttrRPCMethod.String("POST /addresses/active")),
+	)
+	respBody, err := postJSON(ctx, a.explorerURL+"/addresses/active", data)
+	tracing.End(span, err)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []activeResponse
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	active := make(map[string]bool, len(result))
+	for _, r := range result {
+		active[r.Address] = r.Active
+	}
+	return active, nil
+}
+
+// scanBranch walks branch in windows of GapLimit addresses, asking used which
+// addresses of the window starting at index start are active, and returns the
+// index after the last used one. The walk ends at the first window without
+// any used address.
+func scanBranch(
+	branch *hdkeychain.ExtendedKey, used func(start int, addresses []string) (map[string]bool, error),
+) (int, error) {
+	next := 0
+	for start := 0; ; start += GapLimit {
+		addresses := make([]string, GapLimit)
+		for i := range addresses {
+			addr, err := deriveAddress(branch, uint32(start+i)) //nolint:gosec // bounded by the gap limit walk
+			if err != nil {
+				return 0, err
+			}
+			addresses[i] = addr
+		}
+
+		active, err := used(start, addresses)
+		if err != nil {
+			return 0, err
+		}
+		for i, addr := range addresses {
+			if active[addr] {
+				next = start + i + 1
+			}
+		}
+		if next <= start {
+			return next, nil
+		}
+	}
+}
+
+// receiveAddresses derives count receive addresses of branch starting at
+// index start. Paths are BIP44 paths for account level keys and relative to
+// the key otherwise.
+func receiveAddresses(
+	key, branch *hdkeychain.ExtendedKey, start, count int,
+) ([]domain.ReceiveAddress, error) {
+	results := make([]domain.ReceiveAddress, count)
+	for i := range results {
+		if start+i > 0x7FFFFFFF {
+			return nil, ErrIndexOutOfRange
+		}
+		index := uint32(start + i) //nolint:gosec // checked above
+		addr, err := deriveAddress(branch, index)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", domain.ErrInvalidAddress, err)
+		}
+		results[i] = domain.ReceiveAddress{
+			Index:          index,
+			Address:        addr,
+			DerivationPath: derivationPath(key, 0, index),
+		}
+	}
+	return results, nil
+}
+
+// derivationPath returns the path of the address at index on the receive (0)
+// or change (1) branch of key.
+func derivationPath(key *hdkeychain.ExtendedKey, branch, index uint32) string {
+	if key.Depth() != accountDepth {
+		return fmt.Sprintf("%d/%d", branch, index)
+	}
+	account := key.ChildIndex() &^ hdkeychain.HardenedKeyStart
+	return fmt.Sprintf("m/44'/%d'/%d'/%d/%d", CoinType, account, branch, index)
+}
@@ -0,0 +1,80 @@
+package kaspa_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/adapters/crypto/providers/kaspa"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// activeServer answers /addresses/active with active set for the addresses in used.
+func activeServer(t *testing.T, used map[string]bool) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/addresses/active", r.URL.Path)
+
+		var payload struct {
+			Addresses []string `json:"addresses"`
+		}
+		if !assert.NoError(t, json.NewDecoder(r.Body).Decode(&payload)) {
+			return
+		}
+
+		type entry struct {
+			Address string `json:"address"`
+			Active  bool   `json:"active"`
+		}
+		out := make([]entry, len(payload.Addresses))
+		for i, addr := range payload.Addresses {
+			out[i] = entry{Address: addr, Active: used[addr]}
+		}
+		assert.NoError(t, json.NewEncoder(w).Encode(out))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestAdapter_GetReceiveAddresses(t *testing.T) {
+	t.Parallel()
+
+	fresh := kaspa.NewAdapter(activeServer(t, nil).URL)
+	addresses, err := fresh.GetReceiveAddresses(t.Context(), testXpub, 25)
+	require.NoError(t, err)
+	require.Len(t, addresses, 25)
+	assert.Equal(t, uint32(0), addresses[0].Index)
+	assert.Equal(t, "0/0", addresses[0].DerivationPath)
+	assert.Contains(t, addresses[0].Address, "kaspa:")
+
+	used := map[string]bool{addresses[0].Address: true, addresses[3].Address: true, addresses[22].Address: true}
+	adapter := kaspa.NewAdapter(activeServer(t, used).URL)
+
+	next, err := adapter.GetReceiveAddresses(t.Context(), testXpub, 2)
+	require.NoError(t, err)
+	require.Len(t, next, 2)
+	assert.Equal(t, uint32(23), next[0].Index)
+	assert.Equal(t, addresses[23].Address, next[0].Address)
+	assert.Equal(t, addresses[24], next[1])
+}
+
+func TestAdapter_GetReceiveAddresses_Errors(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	t.Cleanup(server.Close)
+	adapter := kaspa.NewAdapter(server.URL)
+
+	_, err := adapter.GetReceiveAddresses(t.Context(), "kpub-invalid", 1)
+	require.ErrorIs(t, err, domain.ErrInvalidAddress)
+
+	_, err = adapter.GetReceiveAddresses(t.Context(), testXpub, 1)
+	require.Error(t, err)
+	assert.Equal(t, domain.CodeProviderUnavailable, domain.CodeOf(err))
+}
@@ -0,0 +1,515 @@
+package kaspa
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/tracing"
+	"github.com/btcsuite/btcd/btcutil/hdkeychain"
+	"github.com/kaspanet/kaspad/cmd/kaspawallet/libkaspawallet/serialization"
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/consensushashing"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/constants"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/subnetworks"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/utxo"
+	"github.com/kaspanet/kaspad/util"
+	"github.com/kaspanet/kaspad/util/txmass"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// BuildTimeout bounds all explorer calls made to build one transaction.
+	BuildTimeout = 30 * time.Second
+	// MaxStandardTxMass is the largest mass nodes relay a transaction with. It
+	// bounds both the compute mass and the KIP-9 storage mass.
+	MaxStandardTxMass = 100_000
+
+	massPerTxByte           = 1
+	massPerScriptPubKeyByte = 10
+	massPerSigOp            = 1000
+	// storageMassParameter is the KIP-9 constant scaling inverse output
+	// values to storage mass.
+	storageMassParameter = constants.SompiPerKaspa * 10_000
+	// minChange is the smallest change output whose storage mass alone stays
+	// within MaxStandardTxMass. Less change is left to the fee.
+	minChange = storageMassParameter / MaxStandardTxMass
+	// signatureScriptSize is the size of the signature script spending a
+	// Schnorr pay-to-pubkey output: a push of the signature and sighash type.
+	signatureScriptSize = 1 + 64 + 1
+	// feeRounds bounds the rounds in which the fee and the change output it
+	// is taken from settle.
+	feeRounds   = 8
+	kasDecimals = 8
+
+	opData32        = 0x20
+	opData33        = 0x21
+	opEqual         = 0x87
+	opBlake2b       = 0xaa
+	opCheckSigECDSA = 0xab
+	opCheckSig      = 0xac
+)
+
+var (
+	ErrKpubRequired = &domain.Error{
+		Code:    domain.CodeInvalidAddress,
+		Message: "KAS transactions are built from the kpub of the wallet",
+	}
+	ErrStorageMass = &domain.Error{
+		Code:    domain.CodeBadRequest,
+		Message: "outputs too small for the KIP-9 storage mass limit",
+	}
+	ErrComputeMass = &domain.Error{
+		Code:    domain.CodeBadRequest,
+		Message: "transaction needs more inputs than fit in the standard mass limit",
+	}
+	ErrFeeNotSettled = errors.New("fee did not settle")
+)
+
+var massCalculator = txmass.NewCalculator(massPerTxByte, massPerScriptPubKeyByte, massPerSigOp)
+
+// spendable is a wallet output decoded for spending.
+type spendable struct {
+	walletOutput
+	outpoint externalapi.DomainOutpoint
+	entry    externalapi.UTXOEntry
+}
+
+// txPlan is a transaction paying the recipient from inputs, with change when
+// enough is left after the fee.
+type txPlan struct {
+	tx     *externalapi.DomainTransaction
+	inputs []spendable
+	change uint64
+	fee    uint64
+	mass   uint64
+}
+
+// BuildUnsignedTx builds a transaction spending the outputs of the kpub in
+// request.From, largest first, with change to its first unused change
+// address, or with domain.AmountMax every output worth spending without
+// change. The fee is the overall mass, the larger of the compute mass and
+// the KIP-9 storage mass, at request.FeeRate sompi per gram or the normal
+// fee estimate. The payload is a kaspawallet partially signed transaction.
+func (a *Adapter) BuildUnsignedTx(ctx context.Context, request domain.UnsignedTxRequest) (*domain.UnsignedTx, error) {
+	ctx, span := tracer.Start(ctx, "kaspa.BuildUnsignedTx", trace.WithAttributes(a.spanAttributes()...))
+	tx, err := a.buildUnsignedTx(ctx, request)
+	tracing.End(span, err)
+	return tx, err
+}
+
+func (a *Adapter) buildUnsignedTx(ctx context.Context, request domain.UnsignedTxRequest) (*domain.UnsignedTx, error) {
+	validation, err := validateAddress(request.From)
+	if err != nil {
+		return nil, err
+	}
+	if validation.Kind != domain.AddressKindExtendedKey {
+		return nil, ErrKpubRequired
+	}
+	key, err := hdkeychain.NewKeyFromString(request.From)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidAddress, err)
+	}
+	toScript, err := payToAddress(request.To)
+	if err != nil {
+		return nil, err
+	}
+	var amount uint64
+	if !request.SendsMax() {
+		units, err := domain.ParseUnits(request.Amount, kasDecimals)
+		if err != nil {
+			return nil, err
+		}
+		if !units.IsUint64() {
+			return nil, fmt.Errorf("%w: amount out of range", domain.ErrBadRequest)
+		}
+		amount = units.Uint64()
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, BuildTimeout)
+	defer cancel()
+
+	feeRate := request.FeeRate
+	if feeRate == 0 {
+		if feeRate, err = a.normalFeeRate(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	virtualScore, err := a.fetchVirtualDaaScore(ctx)
+	if err != nil {
+		return nil, domain.UpstreamError(err)
+	}
+	outputs, nextChange, err := a.walletUTXOs(ctx, key, virtualScore)
+	if err != nil {
+		return nil, err
+	}
+	changeAddress, changeScript, err := changeOutput(key, nextChange)
+	if err != nil {
+		return nil, err
+	}
+
+	inputs, err := spendables(outputs)
+	if err != nil {
+		return nil, domain.UpstreamError(err)
+	}
+	var plan *txPlan
+	if request.SendsMax() {
+		plan, err = sweepTx(inputs, feeRate, toScript)
+	} else {
+		plan, err = planTx(inputs, amount, feeRate, toScript, changeScript)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	payload, err := partiallySignedTx(key, plan)
+	if err != nil {
+		return nil, err
+	}
+	signed, err := signingInputs(plan)
+	if err != nil {
+		return nil, err
+	}
+	units := new(big.Int).SetUint64(plan.tx.Outputs[0].Value)
+	return &domain.UnsignedTx{
+		From:     request.From,
+		To:       request.To,
+		Amount:   domain.FormatUnits(units, kasDecimals),
+		Fee:      domain.FormatUnits(new(big.Int).SetUint64(plan.fee), kasDecimals),
+		Payload:  payload,
+		Encoding: domain.EncodingHex,
+		Inputs:   signed,
+		Details:  txDetails(plan, request.To, units, changeAddress, feeRate),
+	}, nil
+}
+
+// normalFeeRate returns the normal fee rate of the node's fee estimator.
+func (a *Adapter) normalFeeRate(ctx context.Context) (float64, error) {
+	fees, err := a.estimateFees(ctx)
+	if err != nil {
+		return 0, err
+	}
+	for _, estimate := range fees.Estimates {
+		if estimate.Level == domain.FeeLevelNormal {
+			return estimate.Rate, nil
+		}
+	}
+	return 0, domain.UpstreamError(ErrNoFeeBuckets)
+}
+
+// planTx spends inputs in order until they pay amount to toScript and the
+// fee of the overall mass at feeRate sompi per gram. Inputs are added while
+// the storage mass of the transaction is over the limit, as larger inputs
+// lower it.
+func planTx(
+	inputs []spendable, amount uint64, feeRate float64, toScript, changeScript *externalapi.ScriptPublicKey,
+) (*txPlan, error) {
+	var total uint64
+	var lastErr error
+	for i, input := range inputs {
+		total += input.entry.Amount()
+		if total < amount {
+			continue
+		}
+		plan, err := settleFee(inputs[:i+1], total, amount, feeRate, toScript, changeScript)
+		if err == nil {
+			return plan, nil
+		}
+		if !errors.Is(err, ErrStorageMass) && !errors.Is(err, domain.ErrInsufficientFunds) {
+			return nil, err
+		}
+		lastErr = err
+	}
+	if errors.Is(lastErr, ErrStorageMass) {
+		return nil, lastErr
+	}
+	return nil, fmt.Errorf("%w: %s KAS available", domain.ErrInsufficientFunds,
+		domain.FormatUnits(new(big.Int).SetUint64(total), kasDecimals))
+}
+
+// settleFee prices the transaction spending inputs worth total. The fee is
+// taken from the change, which is left to the fee when it would be smaller
+// than minChange, and raised until it pays for the mass of the transaction
+// it leaves.
+func settleFee(
+	inputs []spendable, total, amount uint64, feeRate float64, toScript, changeScript *externalapi.ScriptPublicKey,
+) (*txPlan, error) {
+	var fee uint64
+	for range feeRounds {
+		if total < amount+fee {
+			return nil, domain.ErrInsufficientFunds
+		}
+		change := total - amount - fee
+		if change < minChange {
+			change = 0
+		}
+
+		tx := unsignedTransaction(inputs, amount, toScript, change, changeScript)
+		mass, err := overallMass(tx)
+		if err != nil {
+			return nil, err
+		}
+		needed := uint64(math.Ceil(float64(mass) * feeRate))
+		if needed <= total-amount-change && (change == 0 || needed <= fee) {
+			return &txPlan{tx: tx, inputs: inputs, change: change, fee: total - amount - change, mass: mass}, nil
+		}
+		fee = needed
+	}
+	return nil, ErrFeeNotSettled
+}
+
+// sweepTx spends every input worth more than the fee of its own mass at
+// feeRate sompi per gram, paying all of it less the fee to toScript without
+// change. The smallest inputs are left out when there are more than fit in
+// the compute mass limit.
+func sweepTx(inputs []spendable, feeRate float64, toScript *externalapi.ScriptPublicKey) (*txPlan, error) {
+	var available uint64
+	for _, input := range inputs {
+		available += input.entry.Amount()
+	}
+	insufficient := fmt.Errorf("%w: %s KAS available, not enough to pay the fee", domain.ErrInsufficientFunds,
+		domain.FormatUnits(new(big.Int).SetUint64(available), kasDecimals))
+	if len(inputs) == 0 {
+		return nil, insufficient
+	}
+
+	base := computeMass(unsignedTransaction(nil, 0, toScript, 0, nil))
+	perInput := computeMass(unsignedTransaction(inputs[:1], 0, toScript, 0, nil)) - base
+	worth := make([]spendable, 0, len(inputs))
+	var total uint64
+	for _, input := range inputs {
+		if len(worth) == int((MaxStandardTxMass-base)/perInput) {
+			break
+		}
+		if input.entry.Amount() > uint64(math.Ceil(float64(perInput)*feeRate)) {
+			worth = append(worth, input)
+			total += input.entry.Amount()
+		}
+	}
+
+	var fee uint64
+	for range feeRounds {
+		if len(worth) == 0 || total <= fee {
+			return nil, insufficient
+		}
+		tx := unsignedTransaction(worth, total-fee, toScript, 0, nil)
+		mass, err := overallMass(tx)
+		if err != nil {
+			return nil, err
+		}
+		needed := uint64(math.Ceil(float64(mass) * feeRate))
+		if needed <= fee {
+			return &txPlan{tx: tx, inputs: worth, fee: fee, mass: mass}, nil
+		}
+		fee = needed
+	}
+	return nil, ErrFeeNotSettled
+}
+
+// computeMass is the compute mass tx has once signed.
+func computeMass(tx *externalapi.DomainTransaction) uint64 {
+	return massCalculator.CalculateTransactionMass(withSignatures(tx))
+}
+
+// withSignatures returns a copy of tx with signature scripts of the size
+// signing gives its inputs.
+func withSignatures(tx *externalapi.DomainTransaction) *externalapi.DomainTransaction {
+	signed := tx.Clone()
+	for _, input := range signed.Inputs {
+		input.SignatureScript = make([]byte, signatureScriptSize)
+	}
+	return signed
+}
+
+// overallMass is the mass tx has once signed: the larger of its compute mass
+// and its KIP-9 storage mass.
+func overallMass(tx *externalapi.DomainTransaction) (uint64, error) {
+	signed := withSignatures(tx)
+	compute := massCalculator.CalculateTransactionMass(signed)
+	if compute > MaxStandardTxMass {
+		return 0, fmt.Errorf("%w: %d inputs have a mass of %d grams, the limit is %d",
+			ErrComputeMass, len(tx.Inputs), compute, MaxStandardTxMass)
+	}
+	storage := massCalculator.CalculateTransactionStorageMass(signed)
+	if storage > MaxStandardTxMass {
+		return 0, fmt.Errorf("%w: storage mass of %d grams, the limit is %d; outputs below %s KAS need inputs of similar size",
+			ErrStorageMass, storage, MaxStandardTxMass, domain.FormatUnits(big.NewInt(minChange), kasDecimals))
+	}
+	return max(compute, storage), nil
+}
+
+func unsignedTransaction(
+	inputs []spendable, amount uint64, toScript *externalapi.ScriptPublicKey,
+	change uint64, changeScript *externalapi.ScriptPublicKey,
+) *externalapi.DomainTransaction {
+	txInputs := make([]*externalapi.DomainTransactionInput, len(inputs))
+	for i, input := range inputs {
+		txInputs[i] = &externalapi.DomainTransactionInput{
+			PreviousOutpoint: input.outpoint,
+			SigOpCount:       1,
+			UTXOEntry:        input.entry,
+		}
+	}
+	outputs := []*externalapi.DomainTransactionOutput{{Value: amount, ScriptPublicKey: toScript}}
+	if change > 0 {
+		outputs = append(outputs, &externalapi.DomainTransactionOutput{Value: change, ScriptPublicKey: changeScript})
+	}
+	return &externalapi.DomainTransaction{
+		Version:      constants.MaxTransactionVersion,
+		Inputs:       txInputs,
+		Outputs:      outputs,
+		SubnetworkID: subnetworks.SubnetworkIDNative,
+	}
+}
+
+// spendables decodes the outpoints and scripts of outputs, largest first.
+func spendables(outputs []walletOutput) ([]spendable, error) {
+	inputs := make([]spendable, len(outputs))
+	for i, output := range outputs {
+		id, err := externalapi.NewDomainTransactionIDFromString(output.TransactionID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse transaction id %q: %w", output.TransactionID, err)
+		}
+		script, err := hex.DecodeString(output.ScriptPubKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse script %q: %w", output.ScriptPubKey, err)
+		}
+		inputs[i] = spendable{
+			walletOutput: output,
+			outpoint:     externalapi.DomainOutpoint{TransactionID: *id, Index: output.Vout},
+			entry: utxo.NewUTXOEntry(uint64(output.Value), //nolint:gosec // explorer amounts are never negative
+				&externalapi.ScriptPublicKey{Script: script}, false, uint64(*output.BlockHeight)), //nolint:gosec // DAA scores are never negative
+		}
+	}
+	slices.SortStableFunc(inputs, func(x, y spendable) int {
+		return -cmpUint64(x.entry.Amount(), y.entry.Amount())
+	})
+	return inputs, nil
+}
+
+// changeOutput derives the change address at index of key.
+func changeOutput(key *hdkeychain.ExtendedKey, index uint32) (string, *externalapi.ScriptPublicKey, error) {
+	branch, err := key.Derive(1)
+	if err != nil {
+		return "", nil, fmt.Errorf("%w: failed to derive change branch: %w", domain.ErrInvalidAddress, err)
+	}
+	address, err := deriveAddress(branch, index)
+	if err != nil {
+		return "", nil, fmt.Errorf("%w: %w", domain.ErrInvalidAddress, err)
+	}
+	script, err := payToAddress(address)
+	return address, script, err
+}
+
+// payToAddress returns the standard script paying address, as kaspad's
+// txscript.PayToAddrScript builds it.
+func payToAddress(address string) (*externalapi.ScriptPublicKey, error) {
+	addr, err := util.DecodeAddress(address, util.Bech32PrefixKaspa)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidAddress, err)
+	}
+
+	var script []byte
+	switch addr.(type) {
+	case *util.AddressPublicKey:
+		script = slices.Concat([]byte{opData32}, addr.ScriptAddress(), []byte{opCheckSig})
+	case *util.AddressPublicKeyECDSA:
+		script = slices.Concat([]byte{opData33}, addr.ScriptAddress(), []byte{opCheckSigECDSA})
+	case *util.AddressScriptHash:
+		script = slices.Concat([]byte{opBlake2b, opData32}, addr.ScriptAddress(), []byte{opEqual})
+	default:
+		return nil, fmt.Errorf("%w: unsupported address type %T", domain.ErrInvalidAddress, addr)
+	}
+	return &externalapi.ScriptPublicKey{Script: script, Version: constants.MaxScriptPublicKeyVersion}, nil
+}
+
+// partiallySignedTx serializes plan the way kaspawallet sign reads it: each
+// input carries its output, its path relative to key and the extended public
+// key derived at that path that signs it.
+func partiallySignedTx(key *hdkeychain.ExtendedKey, plan *txPlan) ([]byte, error) {
+	inputs := make([]*serialization.PartiallySignedInput, len(plan.inputs))
+	for i, input := range plan.inputs {
+		branch, err := key.Derive(input.branch)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", domain.ErrInvalidAddress, err)
+		}
+		child, err := branch.Derive(input.index)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", domain.ErrInvalidAddress, err)
+		}
+		inputs[i] = &serialization.PartiallySignedInput{
+			PrevOutput: &externalapi.DomainTransactionOutput{
+				Value:           input.entry.Amount(),
+				ScriptPublicKey: input.entry.ScriptPublicKey(),
+			},
+			MinimumSignatures:    1,
+			PubKeySignaturePairs: []*serialization.PubKeySignaturePair{{ExtendedPublicKey: child.String()}},
+			DerivationPath:       fmt.Sprintf("m/%d/%d", input.branch, input.index),
+		}
+	}
+	return serialization.SerializePartiallySignedTransaction(&serialization.PartiallySignedTransaction{
+		Tx:                    plan.tx,
+		PartiallySignedInputs: inputs,
+	})
+}
+
+// signingInputs lists the inputs of plan with the SIGHASH_ALL Schnorr
+// digest each of them is signed over.
+func signingInputs(plan *txPlan) ([]domain.UnsignedTxInput, error) {
+	reused := &consensushashing.SighashReusedValues{}
+	inputs := make([]domain.UnsignedTxInput, len(plan.inputs))
+	for i, input := range plan.inputs {
+		hash, err := consensushashing.CalculateSignatureHashSchnorr(plan.tx, i, consensushashing.SigHashAll, reused)
+		if err != nil {
+			return nil, err
+		}
+		inputs[i] = domain.UnsignedTxInput{
+			TransactionID:  input.TransactionID,
+			Vout:           input.Vout,
+			Value:          input.Value,
+			Address:        input.Address,
+			DerivationPath: input.DerivationPath,
+			SigningHash:    hash.ByteSlice(),
+		}
+	}
+	return inputs, nil
+}
+
+// txDetails lists what a signing device shows about plan before signing it.
+func txDetails(plan *txPlan, to string, units *big.Int, changeAddress string, feeRate float64) []domain.TxDetail {
+	details := []domain.TxDetail{
+		{Label: "To", Value: to},
+		{Label: "Amount", Value: domain.FormatUnits(units, kasDecimals) + " KAS"},
+		{Label: "Inputs", Value: strconv.Itoa(len(plan.inputs))},
+	}
+	if plan.change > 0 {
+		details = append(details,
+			domain.TxDetail{Label: "Change", Value: domain.FormatUnits(new(big.Int).SetUint64(plan.change), kasDecimals) + " KAS"},
+			domain.TxDetail{Label: "Change address", Value: changeAddress},
+		)
+	}
+	return append(details,
+		domain.TxDetail{Label: "Mass", Value: strconv.FormatUint(plan.mass, 10) + " grams"},
+		domain.TxDetail{Label: "Fee rate", Value: strconv.FormatFloat(feeRate, 'f', -1, 64) + " sompi/gram"},
+		domain.TxDetail{Label: "Fee", Value: domain.FormatUnits(new(big.Int).SetUint64(plan.fee), kasDecimals) + " KAS"},
+	)
+}
+
+func cmpUint64(x, y uint64) int {
+	switch {
+	case x < y:
+		return -1
+	case x > y:
+		return 1
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,231 @@
+package kaspa_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/adapters/crypto/providers/kaspa"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+	"github.com/kaspanet/kaspad/cmd/kaspawallet/libkaspawallet/serialization"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/consensushashing"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/utxo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testRecipient = "kaspa:qr0lr4ml9fn3chekrqmjdkergxl93l4wrk3dankcgvjq776s9wn9jkdskewva"
+
+// walletServer serves the explorer routes used by BuildUnsignedTx. Addresses
+// with an entry in utxos are active and own one output of each listed value.
+func walletServer(t *testing.T, utxos map[string][]uint64) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/info/blockdag":
+			fmt.Fprint(w, `{"virtualDaaScore":"1010"}`)
+		case r.URL.Path == "/info/fee-estimate":
+			fmt.Fprint(w, `{"priorityBucket":{"feerate":3,"estimatedSeconds":1},`+
+				`"normalBuckets":[{"feerate":2,"estimatedSeconds":5}],"lowBuckets":[]}`)
+		case r.URL.Path == "/addresses/active":
+			var payload struct {
+				Addresses []string `json:"addresses"`
+			}
+			if !assert.NoError(t, json.NewDecoder(r.Body).Decode(&payload)) {
+				return
+			}
+			out := make([]map[string]any, len(payload.Addresses))
+			for i, addr := range payload.Addresses {
+				_, active := utxos[addr]
+				out[i] = map[string]any{"address": addr, "active": active}
+			}
+			assert.NoError(t, json.NewEncoder(w).Encode(out))
+		case strings.HasSuffix(r.URL.Path, "/utxos"):
+			addr := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/addresses/"), "/utxos")
+			entries := make([]string, len(utxos[addr]))
+			for i, value := range utxos[addr] {
+				entries[i] = fmt.Sprintf(`{"address":%q,"outpoint":{"transactionId":"%064x","index":%d},`+
+					`"utxoEntry":{"amount":"%d","scriptPublicKey":{"scriptPublicKey":"20%064xac"},`+
+					`"blockDaaScore":"1000","isCoinbase":false}}`, addr, value, i, value, i+1)
+			}
+			fmt.Fprint(w, "["+strings.Join(entries, ",")+"]")
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func receiveAddresses(t *testing.T, count int) []domain.ReceiveAddress {
+	t.Helper()
+
+	addresses, err := kaspa.NewAdapter(activeServer(t, nil).URL).GetReceiveAddresses(t.Context(), testXpub, count)
+	require.NoError(t, err)
+	return addresses
+}
+
+func detail(tx *domain.UnsignedTx, label string) string {
+	for _, d := range tx.Details {
+		if d.Label == label {
+			return d.Value
+		}
+	}
+	return ""
+}
+
+func TestAdapter_BuildUnsignedTx(t *testing.T) {
+	t.Parallel()
+
+	addresses := receiveAddresses(t, 2)
+	adapter := kaspa.NewAdapter(walletServer(t, map[string][]uint64{
+		addresses[0].Address: {50_000_000},
+		addresses[1].Address: {200_000_000},
+	}).URL)
+
+	tx, err := adapter.BuildUnsignedTx(t.Context(), domain.UnsignedTxRequest{
+		From:   testXpub,
+		To:     testRecipient,
+		Amount: "1.5",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "1.5", tx.Amount)
+	assert.Equal(t, domain.EncodingHex, tx.Encoding)
+	assert.Nil(t, tx.SigningHash)
+	assert.Equal(t, "2 sompi/gram", detail(tx, "Fee rate"))
+	changeAddress, err := kaspa.ChangeAddress(testXpub, 0)
+	require.NoError(t, err)
+	assert.Equal(t, changeAddress, detail(tx, "Change address"))
+
+	// The largest output pays on its own; the change output is what leaves
+	// the storage mass above the compute mass of 2036 grams.
+	require.Len(t, tx.Inputs, 1)
+	assert.Equal(t, addresses[1].Address, tx.Inputs[0].Address)
+	assert.Equal(t, "0/1", tx.Inputs[0].DerivationPath)
+	mass, err := strconv.ParseUint(strings.TrimSuffix(detail(tx, "Mass"), " grams"), 10, 64)
+	require.NoError(t, err)
+	assert.Greater(t, mass, uint64(kaspa.TypicalTxMass))
+	fee, err := domain.ParseUnits(tx.Fee, 8)
+	require.NoError(t, err)
+	assert.Equal(t, 2*mass, fee.Uint64())
+
+	pstx, err := serialization.DeserializePartiallySignedTransaction(tx.Payload)
+	require.NoError(t, err)
+	require.Len(t, pstx.Tx.Outputs, 2)
+	assert.Equal(t, uint64(150_000_000), pstx.Tx.Outputs[0].Value)
+	assert.Equal(t, uint64(200_000_000-150_000_000)-fee.Uint64(), pstx.Tx.Outputs[1].Value)
+	require.Len(t, pstx.PartiallySignedInputs, 1)
+	assert.Equal(t, "m/0/1", pstx.PartiallySignedInputs[0].DerivationPath)
+
+	// The digest returned per input is the one kaspawallet signs the payload over.
+	input := pstx.PartiallySignedInputs[0]
+	pstx.Tx.Inputs[0].UTXOEntry = utxo.NewUTXOEntry(input.PrevOutput.Value, input.PrevOutput.ScriptPublicKey, false, 0)
+	pstx.Tx.Inputs[0].SigOpCount = 1
+	hash, err := consensushashing.CalculateSignatureHashSchnorr(
+		pstx.Tx, 0, consensushashing.SigHashAll, &consensushashing.SighashReusedValues{})
+	require.NoError(t, err)
+	assert.Equal(t, hash.ByteSlice(), tx.Inputs[0].SigningHash)
+}
+
+func TestAdapter_BuildUnsignedTx_SmallChange(t *testing.T) {
+	t.Parallel()
+
+	addresses := receiveAddresses(t, 1)
+	adapter := kaspa.NewAdapter(walletServer(t, map[string][]uint64{addresses[0].Address: {100_000_000}}).URL)
+
+	tx, err := adapter.BuildUnsignedTx(t.Context(), domain.UnsignedTxRequest{
+		From:    testXpub,
+		To:      testRecipient,
+		Amount:  "0.99",
+		FeeRate: 1,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "0.01", tx.Fee)
+	assert.Empty(t, detail(tx, "Change"))
+	pstx, err := serialization.DeserializePartiallySignedTransaction(tx.Payload)
+	require.NoError(t, err)
+	assert.Len(t, pstx.Tx.Outputs, 1)
+}
+
+func TestAdapter_BuildUnsignedTx_Max(t *testing.T) {
+	t.Parallel()
+
+	addresses := receiveAddresses(t, 2)
+	adapter := kaspa.NewAdapter(walletServer(t, map[string][]uint64{
+		addresses[0].Address: {50_000_000, 500},
+		addresses[1].Address: {200_000_000},
+	}).URL)
+
+	tx, err := adapter.BuildUnsignedTx(t.Context(), domain.UnsignedTxRequest{
+		From:    testXpub,
+		To:      testRecipient,
+		Amount:  domain.AmountMax,
+		FeeRate: 1,
+	})
+	require.NoError(t, err)
+
+	// The 500 sompi output is worth less than the fee of spending it.
+	require.Len(t, tx.Inputs, 2)
+	assert.Empty(t, detail(tx, "Change"))
+	fee, err := domain.ParseUnits(tx.Fee, 8)
+	require.NoError(t, err)
+	mass, err := strconv.ParseUint(strings.TrimSuffix(detail(tx, "Mass"), " grams"), 10, 64)
+	require.NoError(t, err)
+	assert.Equal(t, mass, fee.Uint64())
+
+	pstx, err := serialization.DeserializePartiallySignedTransaction(tx.Payload)
+	require.NoError(t, err)
+	require.Len(t, pstx.Tx.Outputs, 1)
+	assert.Equal(t, 250_000_000-fee.Uint64(), pstx.Tx.Outputs[0].Value)
+	assert.Equal(t, domain.FormatUnits(new(big.Int).SetUint64(pstx.Tx.Outputs[0].Value), 8), tx.Amount)
+}
+
+func TestAdapter_BuildUnsignedTx_Errors(t *testing.T) {
+	t.Parallel()
+
+	addresses := receiveAddresses(t, 1)
+	adapter := kaspa.NewAdapter(walletServer(t, map[string][]uint64{addresses[0].Address: {100_000_000}}).URL)
+
+	tests := []struct {
+		name    string
+		request domain.UnsignedTxRequest
+		want    error
+	}{
+		{
+			name:    "storage mass of a small output",
+			request: domain.UnsignedTxRequest{From: testXpub, To: testRecipient, Amount: "0.001", FeeRate: 1},
+			want:    kaspa.ErrStorageMass,
+		},
+		{
+			name:    "insufficient funds",
+			request: domain.UnsignedTxRequest{From: testXpub, To: testRecipient, Amount: "1", FeeRate: 1},
+			want:    domain.ErrInsufficientFunds,
+		},
+		{
+			name:    "single address",
+			request: domain.UnsignedTxRequest{From: addresses[0].Address, To: testRecipient, Amount: "0.5"},
+			want:    kaspa.ErrKpubRequired,
+		},
+		{
+			name:    "invalid recipient",
+			request: domain.UnsignedTxRequest{From: testXpub, To: "kaspa:invalid", Amount: "0.5"},
+			want:    domain.ErrInvalidAddress,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := adapter.BuildUnsignedTx(t.Context(), tt.request)
+			require.ErrorIs(t, err, tt.want)
+		})
+	}
+}
@@ -0,0 +1,200 @@
+package kaspa
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/tracing"
+	"github.com/btcsuite/btcd/btcutil/hdkeychain"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// GetUTXOs lists the unspent outputs of a kaspa: address or of every active
+// address on the receive and change branches of a kpub. Confirmations are
+// counted in DAA score against the virtual chain.
+func (a *Adapter) GetUTXOs(ctx context.Context, address string) ([]domain.UTXO, error) {
+	ctx, span := tracer.Start(ctx, "kaspa.GetUTXOs", trace.WithAttributes(a.spanAttributes()...))
+	utxos, err := a.getUTXOs(ctx, address)
+	tracing.End(span, err)
+	return utxos, err
+}
+
+func (a *Adapter) getUTXOs(ctx context.Context, address string) ([]domain.UTXO, error) {
+	if _, err := validateAddress(address); err != nil {
+		return nil, err
+	}
+
+	virtualScore, err := a.fetchVirtualDaaScore(ctx)
+	if err != nil {
+		return nil, domain.UpstreamError(err)
+	}
+
+	key, err := hdkeychain.NewKeyFromString(address)
+	if err != nil {
+		utxos, err := a.fetchUTXOs(ctx, address, "", virtualScore)
+		if err != nil {
+			return nil, domain.UpstreamError(err)
+		}
+		return utxos, nil
+	}
+
+	outputs, _, err := a.walletUTXOs(ctx, key, virtualScore)
+	if err != nil {
+		return nil, err
+	}
+	utxos := make([]domain.UTXO, len(outputs))
+	for i, output := range outputs {
+		utxos[i] = output.UTXO
+	}
+	return utxos, nil
+}
+
+// walletOutput is an unspent output of a kpub with the branch and index of
+// the address holding it.
+type walletOutput struct {
+	domain.UTXO
+	branch, index uint32
+}
+
+// walletUTXOs lists the unspent outputs of every active address on the
+// receive and change branches of key, and returns the index of the first
+// unused change address.
+func (a *Adapter) walletUTXOs(
+	ctx context.Context, key *hdkeychain.ExtendedKey, virtualScore int64,
+) ([]walletOutput, uint32, error) {
+	outputs := make([]walletOutput, 0)
+	var nextChange int
+	for _, branchIndex := range []uint32{0, 1} {
+		branch, err := key.Derive(branchIndex)
+		if err != nil {
+			return nil, 0, fmt.Errorf("%w: failed to derive branch %d: %w", domain.ErrInvalidAddress, branchIndex, err)
+		}
+
+		next, err := scanBranch(branch, func(start int, addresses []string) (map[string]bool, error) {
+			active, err := a.fetchActive(ctx, addresses)
+			if err != nil {
+				return nil, err
+			}
+			for i, addr := range addresses {
+				if !active[addr] {
+					continue
+				}
+				index := uint32(start + i) //nolint:gosec // bounded by the gap limit walk
+				utxos, err := a.fetchUTXOs(ctx, addr, derivationPath(key, branchIndex, index), virtualScore)
+				if err != nil {
+					return nil, err
+				}
+				for _, utxo := range utxos {
+					outputs = append(outputs, walletOutput{UTXO: utxo, branch: branchIndex, index: index})
+				}
+			}
+			return active, nil
+		})
+		if err != nil {
+			return nil, 0, domain.UpstreamError(err)
+		}
+		if branchIndex == 1 {
+			nextChange = next
+		}
+	}
+	if nextChange > 0x7FFFFFFF {
+		return nil, 0, ErrIndexOutOfRange
+	}
+	return outputs, uint32(nextChange), nil //nolint:gosec // checked above
+}
+
+type utxoResponse struct {
+	Address  string `json:"address"`
+	Outpoint struct {
+		TransactionID string `json:"transactionId"`
+		Index         uint32 `json:"index"`
+	} `json:"outpoint"`
+	UtxoEntry struct {
+		Amount          string `json:"amount"`
+		ScriptPublicKey struct {
+			ScriptPublicKey string `json:"scriptPublicKey"`
+		} `json:"scriptPublicKey"`
+		BlockDaaScore string `json:"blockDaaScore"`
+	} `json:"utxoEntry"`
+}
+
+// fetchUTXOs returns the unspent outputs of one address.
+func (a *Adapter) fetchUTXOs(ctx context.Context, address, path string, virtualScore int64) ([]domain.UTXO, error) {
+	ctx, span := tracer.Start(ctx, "kaspa.GET /addresses/{address}/utxos", trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(a.spanAttributes()...),
+		trace.WithAttributes(tracing.AttrRPCMethod.String("GET /addresses/{address}/utxos")),
+	)
+	respBody, err := getJSON(ctx, a.explorerURL+"/addresses/"+url.PathEscape(address)+"/utxos")
+	tracing.End(span, err)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []utxoResponse
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return unspentOutputs(result, path, virtualScore)
+}
+
+func unspentOutputs(entries []utxoResponse, path string, virtualScore int64) ([]domain.UTXO, error) {
+	utxos := make([]domain.UTXO, len(entries))
+	for i, entry := range entries {
+		value, err := strconv.ParseInt(entry.UtxoEntry.Amount, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse amount %q: %w", entry.UtxoEntry.Amount, err)
+		}
+		score, err := strconv.ParseInt(entry.UtxoEntry.BlockDaaScore, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse block DAA score %q: %w", entry.UtxoEntry.BlockDaaScore, err)
+		}
+
+		utxos[i] = domain.UTXO{
+			TransactionID:  entry.Outpoint.TransactionID,
+			Vout:           entry.Outpoint.Index,
+			Value:          value,
+			Amount:         float64(value) / SompiPerKAS,
+			BlockHeight:    &score,
+			ScriptPubKey:   entry.UtxoEntry.ScriptPublicKey.ScriptPublicKey,
+			Address:        entry.Address,
+			DerivationPath: path,
+		}
+		if virtualScore >= score {
+			utxos[i].Confirmations = virtualScore - score + 1
+		}
+	}
+	return utxos, nil
+}
+
+type blockDagResponse struct {
+	VirtualDaaScore string `json:"virtualDaaScore"`
+}
+
+// fetchVirtualDaaScore returns the DAA score of the virtual block, the Kaspa
+// counterpart of the chain tip height.
+func (a *Adapter) fetchVirtualDaaScore(ctx context.Context) (int64, error) {
+	ctx, span := tracer.Start(ctx, "kaspa.GET /info/blockdag", trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(a.spanAttributes()...),
+		trace.WithAttributes(tracing.AttrRPCMethod.String("GET /info/blockdag")),
+	)
+	respBody, err := getJSON(ctx, a.explorerURL+"/info/blockdag")
+	tracing.End(span, err)
+	if err != nil {
+		return 0, err
+	}
+
+	var result blockDagResponse
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return 0, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+	score, err := strconv.ParseInt(result.VirtualDaaScore, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse virtual DAA score %q: %w", result.VirtualDaaScore, err)
+	}
+	return score, nil
+}
@@ -0,0 +1,84 @@
+package kaspa_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/adapters/crypto/providers/kaspa"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// utxoServer serves the explorer routes used by GetUTXOs. Addresses with an
+// entry in utxos are active and own one output of that many sompi.
+func utxoServer(t *testing.T, utxos map[string]int64) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/info/blockdag":
+			fmt.Fprint(w, `{"virtualDaaScore":"1010"}`)
+		case r.URL.Path == "/addresses/active":
+			var payload struct {
+				Addresses []string `json:"addresses"`
+			}
+			if !assert.NoError(t, json.NewDecoder(r.Body).Decode(&payload)) {
+				return
+			}
+			out := make([]map[string]any, len(payload.Addresses))
+			for i, addr := range payload.Addresses {
+				_, active := utxos[addr]
+				out[i] = map[string]any{"address": addr, "active": active}
+			}
+			assert.NoError(t, json.NewEncoder(w).Encode(out))
+		case strings.HasSuffix(r.URL.Path, "/utxos"):
+			addr := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/addresses/"), "/utxos")
+			value, ok := utxos[addr]
+			if !ok {
+				fmt.Fprint(w, `[]`)
+				return
+			}
+			fmt.Fprintf(w, `[{"address":%q,"outpoint":{"transactionId":"tx1","index":2},`+
+				`"utxoEntry":{"amount":"%d","scriptPublicKey":{"scriptPublicKey":"20abac"},`+
+				`"blockDaaScore":"1000","isCoinbase":false}}]`, addr, value)
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestAdapter_GetUTXOs(t *testing.T) {
+	t.Parallel()
+
+	addresses, err := kaspa.NewAdapter(activeServer(t, nil).URL).GetReceiveAddresses(t.Context(), testXpub, 2)
+	require.NoError(t, err)
+
+	adapter := kaspa.NewAdapter(utxoServer(t, map[string]int64{addresses[1].Address: 150000000}).URL)
+
+	utxos, err := adapter.GetUTXOs(t.Context(), testXpub)
+	require.NoError(t, err)
+	require.Len(t, utxos, 1)
+	assert.Equal(t, "tx1", utxos[0].TransactionID)
+	assert.Equal(t, uint32(2), utxos[0].Vout)
+	assert.Equal(t, int64(150000000), utxos[0].Value)
+	assert.InDelta(t, 1.5, utxos[0].Amount, 1e-12)
+	assert.Equal(t, int64(11), utxos[0].Confirmations)
+	assert.Equal(t, "20abac", utxos[0].ScriptPubKey)
+	assert.Equal(t, addresses[1].Address, utxos[0].Address)
+	assert.Equal(t, "0/1", utxos[0].DerivationPath)
+
+	single, err := adapter.GetUTXOs(t.Context(), addresses[1].Address)
+	require.NoError(t, err)
+	require.Len(t, single, 1)
+	assert.Empty(t, single[0].DerivationPath)
+
+	_, err = adapter.GetUTXOs(t.Context(), "kaspa:invalid")
+	require.ErrorIs(t, err, domain.ErrInvalidAddress)
+}
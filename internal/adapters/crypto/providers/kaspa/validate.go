@@ -0,0 +1,81 @@
+package kaspa
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+	"github.com/btcsuite/btcd/btcutil/hdkeychain"
+	"github.com/kaspanet/kaspad/util"
+)
+
+const (
+	kpubVersion = 0x038f332e
+	ktubVersion = 0x0390a241
+	xpubVersion = 0x0488b21e
+)
+
+// ValidateAddress accepts kaspa: bech32 addresses and kpub or xpub extended
+// public keys. The adapter only serves mainnet.
+func (a *Adapter) ValidateAddress(address string) (*domain.AddressValidation, error) {
+	return validateAddress(address)
+}
+
+func validateAddress(address string) (*domain.AddressValidation, error) {
+	if key, err := hdkeychain.NewKeyFromString(address); err == nil {
+		return validateExtendedKey(address, key)
+	}
+
+	addr, err := util.DecodeAddress(address, util.Bech32PrefixUnknown)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidAddress, err)
+	}
+	if addr.Prefix() != util.Bech32PrefixKaspa {
+		return nil, fmt.Errorf("%w: %s address used on %s", domain.ErrInvalidAddress, addr.Prefix(), domain.NetworkMainnet)
+	}
+
+	return &domain.AddressValidation{
+		Address: address,
+		Kind:    domain.AddressKindAddress,
+		Format:  addressFormat(addr),
+		Network: domain.NetworkMainnet,
+	}, nil
+}
+
+func validateExtendedKey(address string, key *hdkeychain.ExtendedKey) (*domain.AddressValidation, error) {
+	if key.IsPrivate() {
+		return nil, fmt.Errorf("%w: extended private keys are not accepted", domain.ErrInvalidAddress)
+	}
+
+	var format string
+	switch binary.BigEndian.Uint32(key.Version()) {
+	case kpubVersion:
+		format = "kpub"
+	case xpubVersion:
+		format = "xpub"
+	case ktubVersion:
+		return nil, domain.NetworkMismatchError(false)
+	default:
+		return nil, fmt.Errorf("%w: unknown extended public key version %x", domain.ErrInvalidAddress, key.Version())
+	}
+
+	return &domain.AddressValidation{
+		Address: address,
+		Kind:    domain.AddressKindExtendedKey,
+		Format:  format,
+		Network: domain.NetworkMainnet,
+	}, nil
+}
+
+func addressFormat(addr util.Address) string {
+	switch addr.(type) {
+	case *util.AddressPublicKey:
+		return "schnorr"
+	case *util.AddressPublicKeyECDSA:
+		return "ecdsa"
+	case *util.AddressScriptHash:
+		return "p2sh"
+	default:
+		return "unknown"
+	}
+}
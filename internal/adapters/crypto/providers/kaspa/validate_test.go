@@ -0,0 +1,45 @@
+package kaspa_test
+
+import (
+	"testing"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/adapters/crypto/providers/kaspa"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+	"github.com/btcsuite/btcd/btcutil/hdkeychain"
+	"github.com/kaspanet/kaspad/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testXpub = "xpub661MyMwAqRbcFtXgS5sYJABqqG9YLmC4Q1Rdap9gSE8NqtwybGhePY2gZ29ESFjqJoCu1Rupje8YtGqsefD265TMg7usUDFdp6W1EGMcet8"
+
+func TestValidateAddress(t *testing.T) {
+	t.Parallel()
+
+	mainnet, err := util.NewAddressPublicKey(make([]byte, util.PublicKeySize), util.Bech32PrefixKaspa)
+	require.NoError(t, err)
+	testnet, err := util.NewAddressPublicKey(make([]byte, util.PublicKeySize), util.Bech32PrefixKaspaTest)
+	require.NoError(t, err)
+
+	validation, err := kaspa.ValidateAddress(mainnet.String())
+	require.NoError(t, err)
+	assert.Equal(t, "schnorr", validation.Format)
+
+	_, err = kaspa.ValidateAddress(testnet.String())
+	require.ErrorIs(t, err, domain.ErrInvalidAddress)
+	assert.Contains(t, err.Error(), "kaspatest address used on mainnet")
+
+	key, err := hdkeychain.NewKeyFromString(testXpub)
+	require.NoError(t, err)
+	kpub, err := key.CloneWithVersion([]byte{0x03, 0x8f, 0x33, 0x2e})
+	require.NoError(t, err)
+	validation, err = kaspa.ValidateAddress(kpub.String())
+	require.NoError(t, err)
+	assert.Equal(t, domain.AddressKindExtendedKey, validation.Kind)
+	assert.Equal(t, "kpub", validation.Format)
+
+	ktub, err := key.CloneWithVersion([]byte{0x03, 0x90, 0xa2, 0x41})
+	require.NoError(t, err)
+	_, err = kaspa.ValidateAddress(ktub.String())
+	require.ErrorIs(t, err, domain.ErrInvalidAddress)
+}
@@ -3,16 +3,24 @@ package litecoin
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log"
 	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/tracing"
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/lamengao/go-electrum/electrum"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
+var tracer = tracing.Tracer("litecoin")
+
 var (
-	ErrInvalidLitecoinAddress = errors.New("invalid Litecoin address format")
+	ErrInvalidLitecoinAddress = &domain.Error{Code: domain.CodeInvalidAddress, Message: "invalid Litecoin address format"}
 )
 
 const (
@@ -29,14 +37,17 @@ const (
 type Adapter struct {
 	mu              sync.RWMutex
 	electrumClient  *electrum.Client
-	addresses       map[string][]btcutil.Address
+	addresses       map[string]walletAddresses
 	electrumAddress string
 	isTestnet       bool
+	tipHeight       atomic.Int32
+	tipMu           sync.Mutex
+	firstSeen       firstSeen
 }
 
 func NewAdapter(addr string, isTestnet bool) *Adapter {
 	a := &Adapter{
-		addresses:       make(map[string][]btcutil.Address),
+		addresses:       make(map[string]walletAddresses),
 		electrumAddress: addr,
 		isTestnet:       isTestnet,
 	}
@@ -44,18 +55,41 @@ func NewAdapter(addr string, isTestnet bool) *Adapter {
 	return a
 }
 
-func (a *Adapter) GetBalance(xpub string) (float64, error) {
-	addresses, ok := a.addresses[xpub]
-	if !ok {
-		external, change, err := deriveLitecoinAddresses(xpub, DefaultExternalCount, DefaultChangeCount, a.isTestnet)
-		if err != nil {
-			return 0, err
-		}
-		combined := make([]btcutil.Address, 0, len(external)+len(change))
-		combined = append(combined, external...)
-		combined = append(combined, change...)
-		addresses = combined
-		a.addresses[xpub] = addresses
+func (a *Adapter) GetBalance(ctx context.Context, xpub string) (float64, error) {
+	ctx, span := tracer.Start(ctx, "litecoin.GetBalance", trace.WithAttributes(a.spanAttributes()...))
+	breakdown, err := a.getBalance(ctx, xpub, domain.BalanceOptions{})
+	tracing.End(span, err)
+	if err != nil {
+		return 0, err
+	}
+	return breakdown.Total(), nil
+}
+
+// GetBalanceBreakdown splits the balance of xpub into confirmed and pending
+// funds. Outputs need opts.MinConfirmations confirmations, at least one, to
+// count as confirmed. Coins the wallet moved into MWEB are reported apart
+// from the balance, as they cannot be spent on the canonical chain.
+func (a *Adapter) GetBalanceBreakdown(
+	ctx context.Context, xpub string, opts domain.BalanceOptions,
+) (*domain.BalanceBreakdown, error) {
+	ctx, span := tracer.Start(ctx, "litecoin.GetBalanceBreakdown", trace.WithAttributes(a.spanAttributes()...))
+	breakdown, err := a.getBalance(ctx, xpub, opts)
+	if err == nil {
+		breakdown.MWEB, err = a.mwebBalance(ctx, xpub)
+	}
+	tracing.End(span, err)
+	if err != nil {
+		return nil, err
+	}
+	return breakdown, nil
+}
+
+func (a *Adapter) getBalance(
+	ctx context.Context, xpub string, opts domain.BalanceOptions,
+) (*domain.BalanceBreakdown, error) {
+	addresses, change, err := a.walletAddresses(xpub)
+	if err != nil {
+		return nil, err
 	}
 
 	var lastErr error
@@ -66,7 +100,15 @@ func (a *Adapter) GetBalance(xpub string) (float64, error) {
 			continue
 		}
 
-		balance, err := getXpubBalance(client, addresses, a.isTestnet)
+		var tip int32
+		if opts.MinConfirmations > 1 {
+			if tip, err = a.currentTip(ctx, client); err != nil {
+				return nil, domain.UpstreamError(err)
+			}
+		}
+
+		balance, err := getXpubBalance(ctx, client, addresses, change, a.isTestnet,
+			opts.MinConfirmations, tip, a.spanAttributes()...)
 		if err == nil {
 			return balance, nil
 		}
@@ -81,7 +123,31 @@ func (a *Adapter) GetBalance(xpub string) (float64, error) {
 		time.Sleep(RetryDelay)
 	}
 
-	return 0, lastErr
+	return nil, domain.UpstreamError(lastErr)
+}
+
+// walletAddresses are the addresses derived from one xpub, the external
+// branch first. The last change of them are on the change branch, which keys
+// below the account level do not have.
+type walletAddresses struct {
+	addresses []btcutil.Address
+	change    int
+}
+
+func (a *Adapter) walletAddresses(xpub string) ([]btcutil.Address, int, error) {
+	if wallet, ok := a.addresses[xpub]; ok {
+		return wallet.addresses, wallet.change, nil
+	}
+
+	external, change, err := deriveLitecoinAddresses(xpub, DefaultExternalCount, DefaultChangeCount, a.isTestnet)
+	if err != nil {
+		return nil, 0, fmt.Errorf("%w: %w", domain.ErrInvalidAddress, err)
+	}
+	addresses := make([]btcutil.Address, 0, len(external)+len(change))
+	addresses = append(addresses, external...)
+	addresses = append(addresses, change...)
+	a.addresses[xpub] = walletAddresses{addresses: addresses, change: len(change)}
+	return addresses, len(change), nil
 }
 
 func (a *Adapter) connectWithRetry() {
@@ -92,6 +158,7 @@ func (a *Adapter) connectWithRetry() {
 			a.mu.Lock()
 			a.electrumClient = client
 			a.mu.Unlock()
+			a.trackTip(client)
 			return
 		}
 		log.Printf("[litecoin] electrum connection failed: %v, retrying in 5s...", err)
@@ -104,3 +171,11 @@ func (a *Adapter) getClient() *electrum.Client {
 	defer a.mu.RUnlock()
 	return a.electrumClient
 }
+
+func (a *Adapter) spanAttributes() []attribute.KeyValue {
+	return []attribute.KeyValue{
+		tracing.AttrChainSymbol.String("LTC"),
+		tracing.AttrNetwork.String(tracing.NetworkOf(a.isTestnet)),
+		tracing.AttrEndpoint.String(a.electrumAddress),
+	}
+}
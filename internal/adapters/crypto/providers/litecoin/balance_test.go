@@ -0,0 +1,84 @@
+package litecoin_test
+
+import (
+	"testing"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/adapters/crypto/providers/electrumtest"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/adapters/crypto/providers/litecoin"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+	hd "github.com/btcsuite/btcd/btcutil/hdkeychain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// firstScripthash returns the scripthash of the first external address of xpub.
+func firstScripthash(t *testing.T, xpub string) string {
+	t.Helper()
+
+	addresses, err := litecoin.ReceiveAddresses(xpub, 0, 1, false)
+	require.NoError(t, err)
+	scripthash, err := litecoin.AddressScripthash(addresses[0].Address, false)
+	require.NoError(t, err)
+	return scripthash
+}
+
+func TestAdapter_GetBalanceBreakdown_YoungOutputs(t *testing.T) {
+	t.Parallel()
+
+	account, err := hd.NewKeyFromString(bip84Zpub)
+	require.NoError(t, err)
+	externalKey, err := account.Derive(0)
+	require.NoError(t, err)
+	changeKey, err := account.Derive(1)
+	require.NoError(t, err)
+
+	// Both outputs have two confirmations at tip 100.
+	server, err := electrumtest.NewServer(100)
+	require.NoError(t, err)
+	t.Cleanup(server.Close)
+	server.AddUnspent(firstScripthash(t, externalKey.String()),
+		&litecoin.ListUnspentResult{Height: 99, Hash: "aa", Value: 5000})
+	server.AddUnspent(firstScripthash(t, changeKey.String()),
+		&litecoin.ListUnspentResult{Height: 99, Hash: "bb", Value: 3000})
+
+	tests := []struct {
+		name     string
+		xpub     string
+		expected domain.BalanceBreakdown
+	}{
+		{
+			// The young change output is the wallet's own and stays confirmed.
+			name:     "account key",
+			xpub:     bip84Zpub,
+			expected: domain.BalanceBreakdown{Confirmed: 0.00003, PendingIncoming: 0.00005},
+		},
+		{
+			// Keys below the account level have no change branch.
+			name:     "external chain key",
+			xpub:     externalKey.String(),
+			expected: domain.BalanceBreakdown{PendingIncoming: 0.00005},
+		},
+		{
+			name:     "change chain key",
+			xpub:     changeKey.String(),
+			expected: domain.BalanceBreakdown{PendingIncoming: 0.00003},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			adapter := litecoin.NewAdapter(server.Addr(), false)
+			breakdown, err := adapter.GetBalanceBreakdown(t.Context(), tt.xpub, domain.BalanceOptions{MinConfirmations: 3})
+			require.NoError(t, err)
+			assert.InDelta(t, tt.expected.Confirmed, breakdown.Confirmed, 1e-12)
+			assert.InDelta(t, tt.expected.PendingIncoming, breakdown.PendingIncoming, 1e-12)
+			assert.Zero(t, breakdown.PendingOutgoing)
+
+			breakdown, err = adapter.GetBalanceBreakdown(t.Context(), tt.xpub, domain.BalanceOptions{MinConfirmations: 1})
+			require.NoError(t, err)
+			assert.InDelta(t, tt.expected.Confirmed+tt.expected.PendingIncoming, breakdown.Confirmed, 1e-12)
+			assert.Zero(t, breakdown.PendingIncoming)
+		})
+	}
+}
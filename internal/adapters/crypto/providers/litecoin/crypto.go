@@ -3,16 +3,22 @@ package litecoin
 import (
 	"context"
 	"crypto/sha256"
+	"encoding/binary"
 	"encoding/hex"
 	"errors"
 	"fmt"
 
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/tracing"
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcutil"
 	hd "github.com/btcsuite/btcd/btcutil/hdkeychain"
 	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
 	"github.com/lamengao/go-electrum/electrum"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 const (
@@ -25,10 +31,37 @@ const (
 var (
 	ErrIndexOutOfRange = errors.New("index out of range for uint32")
 
-	LitecoinMainNetParams = &chaincfg.MainNetParams
-	LitecoinTestNetParams = &chaincfg.TestNet3Params
+	LitecoinMainNetParams = litecoinParams(chaincfg.MainNetParams, "litecoin", 0xdbb6c0fb,
+		0x30, 0x32, "ltc", [4]byte{0x01, 0x9d, 0xa4, 0x62}, [4]byte{0x01, 0x9d, 0x9c, 0xfe}, 2)
+	LitecoinTestNetParams = litecoinParams(chaincfg.TestNet3Params, "litecoin-testnet4", 0xf1c8d2fd,
+		0x6f, 0x3a, "tltc", [4]byte{0x04, 0x35, 0x87, 0xcf}, [4]byte{0x04, 0x35, 0x83, 0x94}, 1)
 )
 
+// The Litecoin networks are registered so bech32 ltc1 and tltc1 addresses decode.
+func init() {
+	for _, params := range []*chaincfg.Params{LitecoinMainNetParams, LitecoinTestNetParams} {
+		if err := chaincfg.Register(params); err != nil {
+			panic(fmt.Sprintf("register %s params: %v", params.Name, err))
+		}
+	}
+}
+
+func litecoinParams(
+	base chaincfg.Params, name string, net wire.BitcoinNet, pubKeyHashAddrID, scriptHashAddrID byte,
+	bech32HRP string, hdPublicKeyID, hdPrivateKeyID [4]byte, hdCoinType uint32,
+) *chaincfg.Params {
+	params := base
+	params.Name = name
+	params.Net = net
+	params.PubKeyHashAddrID = pubKeyHashAddrID
+	params.ScriptHashAddrID = scriptHashAddrID
+	params.Bech32HRPSegwit = bech32HRP
+	params.HDPublicKeyID = hdPublicKeyID
+	params.HDPrivateKeyID = hdPrivateKeyID
+	params.HDCoinType = hdCoinType
+	return &params
+}
+
 func addressToScripthash(addr string, isTestnet bool) (string, error) {
 	params := LitecoinMainNetParams
 	if isTestnet {
@@ -43,11 +76,42 @@ func addressToScripthash(addr string, isTestnet bool) (string, error) {
 	if err != nil {
 		return "", fmt.Errorf("failed to create script: %w", err)
 	}
+	return scriptHash(script), nil
+}
+
+// scriptHash is the electrum script hash of an output script: its SHA-256,
+// byte reversed and hex encoded.
+func scriptHash(script []byte) string {
 	h := sha256.Sum256(script)
 	for i, j := 0, len(h)-1; i < j; i, j = i+1, j-1 {
 		h[i], h[j] = h[j], h[i]
 	}
-	return hex.EncodeToString(h[:]), nil
+	return hex.EncodeToString(h[:])
+}
+
+// scriptType is the output script the addresses of a wallet are derived as.
+type scriptType int
+
+const (
+	scriptWitnessPubKeyHash scriptType = iota
+	scriptNestedWitnessPubKeyHash
+)
+
+// purpose returns the BIP43 purpose of the derivation scheme for the script type.
+func (s scriptType) purpose() uint32 {
+	if s == scriptNestedWitnessPubKeyHash {
+		return 49
+	}
+	return 84
+}
+
+// walletScriptType honours the SLIP-132 version of key: Mtub, ypub and upub
+// derive nested segwit. Every other version keeps deriving native segwit.
+func walletScriptType(key *hd.ExtendedKey) scriptType {
+	if version, ok := extendedKeyVersions[binary.BigEndian.Uint32(key.Version())]; ok {
+		return version.script
+	}
+	return scriptWitnessPubKeyHash
 }
 
 func deriveLitecoinAddresses(
@@ -62,13 +126,14 @@ func deriveLitecoinAddresses(
 	if err != nil {
 		return nil, nil, err
 	}
+	script := walletScriptType(key)
 
-	external, err := deriveAddresses(extRoot, externalCount, isTestnet)
+	external, err := deriveAddresses(extRoot, 0, externalCount, script, isTestnet)
 	if err != nil {
 		return nil, nil, fmt.Errorf("derive external addresses: %w", err)
 	}
 
-	change, err := deriveAddresses(chRoot, changeCount, isTestnet)
+	change, err := deriveAddresses(chRoot, 0, changeCount, script, isTestnet)
 	if err != nil {
 		return nil, nil, fmt.Errorf("derive change addresses: %w", err)
 	}
@@ -102,13 +167,60 @@ func deriveChainKeys(key *hd.ExtendedKey) (*hd.ExtendedKey, *hd.ExtendedKey, err
 	return extRoot, chRoot, nil
 }
 
-func deriveAddresses(root *hd.ExtendedKey, count int, isTestnet bool) ([]btcutil.Address, error) {
+// branch is one chain of wallet addresses: root derives the address at each
+// index and path is the derivation path of root, empty when it is unknown.
+// fingerprint is the master key fingerprint of a descriptor key origin, zero
+// when there is none.
+type branch struct {
+	root        *hd.ExtendedKey
+	path        string
+	fingerprint uint32
+}
+
+// walletBranches returns the external and change branches of an account key,
+// or the key itself as the only branch for keys at any other depth. path is
+// the derivation path of key.
+func walletBranches(key *hd.ExtendedKey, path string) ([]branch, error) {
+	external, change, err := deriveChainKeys(key)
+	if err != nil {
+		return nil, err
+	}
+	if change == nil {
+		return []branch{{root: external, path: path}}, nil
+	}
+	return []branch{
+		{root: external, path: childPath(path, ExternalChain)},
+		{root: change, path: childPath(path, ChangeChain)},
+	}, nil
+}
+
+// accountPath returns the BIP44 style path of an account level key. Keys at
+// any other depth have no known position in the tree and get an empty path.
+func accountPath(key *hd.ExtendedKey, script scriptType, coinType uint32) string {
+	if key.Depth() != AccountDepth {
+		return ""
+	}
+	account := key.ChildIndex() &^ hd.HardenedKeyStart
+	return fmt.Sprintf("m/%d'/%d'/%d'", script.purpose(), coinType, account)
+}
+
+// childPath appends index to path, or returns the bare index for an unknown path.
+func childPath(path string, index uint32) string {
+	if path == "" {
+		return fmt.Sprintf("%d", index)
+	}
+	return fmt.Sprintf("%s/%d", path, index)
+}
+
+func deriveAddresses(
+	root *hd.ExtendedKey, start, count int, script scriptType, isTestnet bool,
+) ([]btcutil.Address, error) {
 	if root == nil {
 		return nil, nil
 	}
 
 	addresses := make([]btcutil.Address, 0, count)
-	for i := range count {
+	for i := start; i < start+count; i++ {
 		if i < 0 || i > 0x7FFFFFFF { // Check for uint32 overflow
 			return nil, ErrIndexOutOfRange
 		}
@@ -123,7 +235,7 @@ func deriveAddresses(root *hd.ExtendedKey, count int, isTestnet bool) ([]btcutil
 			return nil, fmt.Errorf("get public key for child %d: %w", i, err)
 		}
 
-		addr, err := makeLitecoinAddress(pub, isTestnet)
+		addr, err := makeLitecoinAddress(pub, script, isTestnet)
 		if err != nil {
 			return nil, fmt.Errorf("make litecoin address for child %d: %w", i, err)
 		}
@@ -134,7 +246,16 @@ func deriveAddresses(root *hd.ExtendedKey, count int, isTestnet bool) ([]btcutil
 	return addresses, nil
 }
 
-func makeLitecoinAddress(pub *btcec.PublicKey, isTestnet bool) (*btcutil.AddressWitnessPubKeyHash, error) {
+// childPubKey derives the public key at index of root.
+func childPubKey(root *hd.ExtendedKey, index uint32) (*btcec.PublicKey, error) {
+	child, err := root.Derive(index)
+	if err != nil {
+		return nil, fmt.Errorf("derive child %d: %w", index, err)
+	}
+	return child.ECPubKey()
+}
+
+func makeLitecoinAddress(pub *btcec.PublicKey, script scriptType, isTestnet bool) (btcutil.Address, error) {
 	params := LitecoinMainNetParams
 	if isTestnet {
 		params = LitecoinTestNetParams
@@ -145,35 +266,96 @@ func makeLitecoinAddress(pub *btcec.PublicKey, isTestnet bool) (*btcutil.Address
 	if err != nil {
 		return nil, fmt.Errorf("create witness pubkey hash address: %w", err)
 	}
-	return addr, nil
+	if script != scriptNestedWitnessPubKeyHash {
+		return addr, nil
+	}
+
+	redeemScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		return nil, fmt.Errorf("create redeem script: %w", err)
+	}
+	nested, err := btcutil.NewAddressScriptHash(redeemScript, params)
+	if err != nil {
+		return nil, fmt.Errorf("create script hash address: %w", err)
+	}
+	return nested, nil
 }
 
-func getXpubBalance(node *electrum.Client, addresses []btcutil.Address, isTestnet bool) (float64, error) {
-	totalSats := int64(0)
+// getXpubBalance splits the balance of addresses into confirmed and pending
+// funds. Electrum reports mempool changes per address as one signed
+// unconfirmed amount; with minConfirmations above one, confirmed outputs
+// younger than that are counted as pending incoming as well. The last change
+// addresses are on the change branch; the wallet's own change is not
+// incoming, so their young outputs stay confirmed.
+func getXpubBalance(
+	ctx context.Context, node *electrum.Client, addresses []btcutil.Address, change int, isTestnet bool,
+	minConfirmations int64, tip int32, spanAttrs ...attribute.KeyValue,
+) (*domain.BalanceBreakdown, error) {
+	var confirmed, incoming, outgoing int64
 
-	for _, addr := range addresses {
-		sats, err := getAddressBalance(node, addr, isTestnet)
+	for i, addr := range addresses {
+		sh, err := addressToScripthash(addr.EncodeAddress(), isTestnet)
+		if err != nil {
+			return nil, err
+		}
+		balance, err := getAddressBalance(ctx, node, sh, spanAttrs...)
 		if err != nil {
-			return 0, err
+			return nil, err
+		}
+
+		settled := int64(balance.Confirmed)
+		if minConfirmations > 1 && settled > 0 && i < len(addresses)-change {
+			entries, err := listUnspent(ctx, node, sh, spanAttrs...)
+			if err != nil {
+				return nil, err
+			}
+			young := youngValue(entries, tip, minConfirmations)
+			settled -= young
+			incoming += young
+		}
+		confirmed += settled
+
+		if pending := int64(balance.Unconfirmed); pending > 0 {
+			incoming += pending
+		} else {
+			outgoing -= pending
 		}
-		totalSats += sats
 	}
 
-	return float64(totalSats) / SatoshiPerLTC, nil
+	return &domain.BalanceBreakdown{
+		Confirmed:       float64(confirmed) / SatoshiPerLTC,
+		PendingIncoming: float64(incoming) / SatoshiPerLTC,
+		PendingOutgoing: float64(outgoing) / SatoshiPerLTC,
+	}, nil
 }
 
-func getAddressBalance(node *electrum.Client, addr btcutil.Address, isTestnet bool) (int64, error) {
-	sh, err := addressToScripthash(addr.EncodeAddress(), isTestnet)
-	if err != nil {
-		return 0, err
+// youngValue sums the confirmed outputs of entries that have fewer than
+// minConfirmations confirmations at tip.
+func youngValue(entries []*electrum.ListUnspentResult, tip int32, minConfirmations int64) int64 {
+	var value int64
+	for _, entry := range entries {
+		if entry.Height == 0 || int64(entry.Height) > int64(tip) {
+			continue
+		}
+		if int64(tip)-int64(entry.Height)+1 < minConfirmations {
+			value += entry.Value
+		}
 	}
+	return value
+}
 
-	balResp, err := node.GetBalance(context.Background(), sh)
+func getAddressBalance(
+	ctx context.Context, node *electrum.Client, scripthash string, spanAttrs ...attribute.KeyValue,
+) (*electrum.GetBalanceResult, error) {
+	ctx, span := tracer.Start(ctx, "electrum.blockchain.scripthash.get_balance",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(spanAttrs...),
+		trace.WithAttributes(tracing.AttrRPCMethod.String("blockchain.scripthash.get_balance")),
+	)
+	balResp, err := node.GetBalance(ctx, scripthash)
+	tracing.End(span, err)
 	if err != nil {
-		return 0, fmt.Errorf("get balance from electrum: %w", err)
+		return nil, fmt.Errorf("get balance from electrum: %w", err)
 	}
-
-	confirmed := balResp.Confirmed
-	unconfirmed := balResp.Unconfirmed
-	return int64(confirmed) + int64(unconfirmed), nil
+	return &balResp, nil
 }
@@ -0,0 +1,176 @@
+package litecoin
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+	"github.com/btcsuite/btcd/btcutil"
+	hd "github.com/btcsuite/btcd/btcutil/hdkeychain"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lamengao/go-electrum/electrum"
+)
+
+var (
+	ValidateAddress   = validateAddress
+	UnspentOutputs    = unspentOutputs
+	YoungValue        = youngValue
+	AddressScripthash = addressToScripthash
+	SatPerVByte       = satPerVByte
+
+	DecodeTransaction = decodeTransaction
+	IsHogEx           = isHogEx
+	PegInValue        = pegInValue
+)
+
+type ListUnspentResult = electrum.ListUnspentResult
+
+// CurrentTip returns the tip of the connected client as balances and history
+// use it.
+func (a *Adapter) CurrentTip(ctx context.Context) (int32, error) {
+	return a.currentTip(ctx, a.getClient())
+}
+
+func ReceiveAddresses(xpub string, start, count int, isTestnet bool) ([]domain.ReceiveAddress, error) {
+	key, err := hd.NewKeyFromString(xpub)
+	if err != nil {
+		return nil, err
+	}
+	script := walletScriptType(key)
+	branches, err := walletBranches(key, accountPath(key, script, chainParams(isTestnet).HDCoinType))
+	if err != nil {
+		return nil, err
+	}
+	return receiveAddresses(branches[0], script, start, count, isTestnet)
+}
+
+// NextUnusedIndex runs the gap limit walk over the external chain of xpub
+// with used deciding which addresses have history.
+func NextUnusedIndex(xpub string, isTestnet bool, used func(address string) bool) (int, error) {
+	key, err := hd.NewKeyFromString(xpub)
+	if err != nil {
+		return 0, err
+	}
+	external, _, err := deriveChainKeys(key)
+	if err != nil {
+		return 0, err
+	}
+	return scanChain(external, walletScriptType(key), isTestnet, func(_ int, addr btcutil.Address) (bool, error) {
+		return used(addr.EncodeAddress()), nil
+	})
+}
+
+// DescriptorAddresses returns the first address of every branch of desc keyed
+// by its derivation path.
+func DescriptorAddresses(desc string, isTestnet bool) (map[string]string, error) {
+	branches, script, err := parseDescriptor(desc, chainParams(isTestnet).HDCoinType)
+	if err != nil {
+		return nil, err
+	}
+	addresses := make(map[string]string, len(branches))
+	for _, b := range branches {
+		derived, err := deriveAddresses(b.root, 0, 1, script, isTestnet)
+		if err != nil {
+			return nil, err
+		}
+		addresses[childPath(b.path, 0)] = derived[0].EncodeAddress()
+	}
+	return addresses, nil
+}
+
+// PlanPSBT plans a transaction paying amount to to from the wallet of desc,
+// whose first external addresses hold one output of each value, and returns
+// its PSBT, the inputs it signs and its fee.
+func PlanPSBT(
+	desc string, values []int64, to string, amount int64, feeRate float64,
+) (*psbt.Packet, []domain.UnsignedTxInput, int64, error) {
+	return planTestPSBT(desc, values, to, func(inputs []spendable, toScript, changeScript []byte) (*txPlan, error) {
+		return planTx(inputs, amount, feeRate, toScript, changeScript)
+	})
+}
+
+// SweepPSBT is PlanPSBT sending everything the wallet holds after fees.
+func SweepPSBT(
+	desc string, values []int64, to string, feeRate float64,
+) (*psbt.Packet, []domain.UnsignedTxInput, int64, error) {
+	return planTestPSBT(desc, values, to, func(inputs []spendable, toScript, _ []byte) (*txPlan, error) {
+		return sweepTx(inputs, feeRate, toScript)
+	})
+}
+
+func planTestPSBT(
+	desc string, values []int64, to string, plan func(inputs []spendable, toScript, changeScript []byte) (*txPlan, error),
+) (*psbt.Packet, []domain.UnsignedTxInput, int64, error) {
+	branches, script, err := parseDescriptor(desc, chainParams(false).HDCoinType)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	toScript, err := recipientScript(to, false)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+
+	outputs := make([]walletOutput, len(values))
+	prevTxs := make(map[string]*wire.MsgTx, len(values))
+	for i, value := range values {
+		index := uint32(i) //nolint:gosec // test wallets are small
+		pubKey, err := childPubKey(branches[0].root, index)
+		if err != nil {
+			return nil, nil, 0, err
+		}
+		addr, err := makeLitecoinAddress(pubKey, script, false)
+		if err != nil {
+			return nil, nil, 0, err
+		}
+		pkScript, err := txscript.PayToAddrScript(addr)
+		if err != nil {
+			return nil, nil, 0, err
+		}
+		prevTx := wire.NewMsgTx(wire.TxVersion)
+		prevTx.AddTxIn(&wire.TxIn{PreviousOutPoint: wire.OutPoint{Index: index}})
+		prevTx.AddTxOut(wire.NewTxOut(value, pkScript))
+		txid := prevTx.TxHash().String()
+		prevTxs[txid] = prevTx
+		outputs[i] = walletOutput{
+			UTXO: domain.UTXO{
+				TransactionID:  txid,
+				Value:          value,
+				ScriptPubKey:   hex.EncodeToString(pkScript),
+				Address:        addr.EncodeAddress(),
+				DerivationPath: childPath(branches[0].path, index),
+			},
+			pubKey:      pubKey,
+			fingerprint: branches[0].fingerprint,
+		}
+	}
+	change, err := walletChange(branches, []uint32{uint32(len(values)), 0}, script, false) //nolint:gosec // test wallets are small
+	if err != nil {
+		return nil, nil, 0, err
+	}
+
+	inputs, err := spendables(outputs)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	planned, err := plan(inputs, toScript, change.script)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	spent := make([]*wire.MsgTx, len(planned.inputs))
+	for i, input := range planned.inputs {
+		spent[i] = prevTxs[input.TransactionID]
+	}
+	payload, err := psbtPacket(planned, spent, change)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	packet, err := psbt.NewFromRawBytes(bytes.NewReader(payload), false)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	signed, err := signingInputs(planned)
+	return packet, signed, planned.fee, err
+}
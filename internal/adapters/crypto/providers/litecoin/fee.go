@@ -0,0 +1,111 @@
+package litecoin
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/tracing"
+	"github.com/lamengao/go-electrum/electrum"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// TypicalTxVsize is the virtual size of a one input, two output P2WPKH
+	// transaction, the size fee amounts are priced for.
+	TypicalTxVsize = 141
+	// MinFeeRate in litoshi/vB is used when the node has too little data to
+	// estimate a target.
+	MinFeeRate = 1.0
+
+	blockInterval = 150
+)
+
+// feeTargets are the confirmation targets in blocks of each fee level.
+var feeTargets = []struct {
+	level  domain.FeeLevel
+	blocks uint32
+}{
+	{domain.FeeLevelSlow, 144},
+	{domain.FeeLevelNormal, 6},
+	{domain.FeeLevelFast, 2},
+}
+
+// EstimateFees asks the Electrum server for the fee rate of each confirmation
+// target through blockchain.estimatefee.
+func (a *Adapter) EstimateFees(ctx context.Context) (*domain.FeeEstimates, error) {
+	ctx, span := tracer.Start(ctx, "litecoin.EstimateFees", trace.WithAttributes(a.spanAttributes()...))
+	fees, err := a.estimateFees(ctx)
+	tracing.End(span, err)
+	return fees, err
+}
+
+func (a *Adapter) estimateFees(ctx context.Context) (*domain.FeeEstimates, error) {
+	client := a.getClient()
+	if client.IsShutdown() {
+		a.connectWithRetry()
+		client = a.getClient()
+	}
+
+	estimates := make([]domain.FeeEstimate, len(feeTargets))
+	floor := MinFeeRate
+	for i, target := range feeTargets {
+		rate, err := a.estimateFeeRate(ctx, client, target.blocks)
+		if err != nil {
+			return nil, err
+		}
+		// A faster target never gets a lower rate than a slower one.
+		estimates[i] = feeEstimate(target.level, target.blocks, max(rate, floor))
+		floor = estimates[i].Rate
+	}
+
+	return &domain.FeeEstimates{
+		CryptoSymbol: "LTC",
+		RateUnit:     "litoshi/vB",
+		SizeUnit:     "vbytes",
+		TypicalSize:  TypicalTxVsize,
+		Estimates:    estimates,
+	}, nil
+}
+
+// normalFeeRate returns the rate of the normal fee level, at least MinFeeRate.
+func (a *Adapter) normalFeeRate(ctx context.Context, client *electrum.Client) (float64, error) {
+	for _, target := range feeTargets {
+		if target.level == domain.FeeLevelNormal {
+			rate, err := a.estimateFeeRate(ctx, client, target.blocks)
+			return max(rate, MinFeeRate), err
+		}
+	}
+	return MinFeeRate, nil
+}
+
+// estimateFeeRate returns the fee rate in litoshi/vB the server estimates for
+// confirmation within blocks, zero when it has no estimate.
+func (a *Adapter) estimateFeeRate(ctx context.Context, client *electrum.Client, blocks uint32) (float64, error) {
+	ctx, span := tracer.Start(ctx, "electrum.blockchain.estimatefee",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(a.spanAttributes()...),
+		trace.WithAttributes(tracing.AttrRPCMethod.String("blockchain.estimatefee")),
+	)
+	perKB, err := client.GetFee(ctx, blocks)
+	tracing.End(span, err)
+	if err != nil {
+		return 0, domain.UpstreamError(fmt.Errorf("estimate fee from electrum: %w", err))
+	}
+	return satPerVByte(perKB), nil
+}
+
+// satPerVByte converts an estimatefee answer in coins per kilobyte. Negative
+// answers mean the server has no estimate for the target and give zero.
+func satPerVByte(perKB float32) float64 {
+	return max(float64(perKB)*SatoshiPerLTC/1000, 0)
+}
+
+func feeEstimate(level domain.FeeLevel, blocks uint32, rate float64) domain.FeeEstimate {
+	return domain.FeeEstimate{
+		Level:            level,
+		Rate:             rate,
+		Amount:           rate * TypicalTxVsize / SatoshiPerLTC,
+		EstimatedSeconds: int64(blocks) * blockInterval,
+	}
+}
@@ -0,0 +1,15 @@
+package litecoin_test
+
+import (
+	"testing"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/adapters/crypto/providers/litecoin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSatPerVByte(t *testing.T) {
+	t.Parallel()
+
+	assert.InDelta(t, 12.5, litecoin.SatPerVByte(0.000125), 1e-6)
+	assert.InDelta(t, 0.0, litecoin.SatPerVByte(-1), 1e-9)
+}
@@ -0,0 +1,140 @@
+package litecoin_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/adapters/crypto/providers/electrumtest"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/adapters/crypto/providers/litecoin"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/adapters/provider"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/adapters/rates/static"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/ports"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// coinbaseTx returns a coinbase transaction paying value to the first
+// external address of xpub, along with the scripthash of that address.
+func coinbaseTx(t *testing.T, xpub string, value int64) (*wire.MsgTx, string) {
+	t.Helper()
+
+	addresses, err := litecoin.ReceiveAddresses(xpub, 0, 1, false)
+	require.NoError(t, err)
+	addr, err := btcutil.DecodeAddress(addresses[0].Address, litecoin.LitecoinMainNetParams)
+	require.NoError(t, err)
+	pkScript, err := txscript.PayToAddrScript(addr)
+	require.NoError(t, err)
+
+	tx := wire.NewMsgTx(wire.TxVersion)
+	tx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: wire.OutPoint{Index: wire.MaxPrevOutIndex},
+		SignatureScript:  []byte{byte(value), byte(value >> 8)},
+	})
+	tx.AddTxOut(wire.NewTxOut(value, pkScript))
+	scripthash, err := litecoin.AddressScripthash(addresses[0].Address, false)
+	require.NoError(t, err)
+	return tx, scripthash
+}
+
+func TestAdapter_GetTransactions_NoTipYet(t *testing.T) {
+	t.Parallel()
+
+	server, err := electrumtest.NewServer(100)
+	require.NoError(t, err)
+	t.Cleanup(server.Close)
+	// The subscription made on connecting fails, so no tip is tracked.
+	server.FailHeaderSubscriptions(1)
+	tx, scripthash := coinbaseTx(t, bip84Zpub, 5000)
+	require.NoError(t, server.AddTransaction(tx, 99, scripthash))
+	require.NoError(t, server.AddHeader(99, wire.BlockHeader{Timestamp: time.Unix(1700000000, 0)}))
+
+	adapter := litecoin.NewAdapter(server.Addr(), false)
+	txs, err := adapter.GetTransactions(t.Context(), bip84Zpub)
+	require.NoError(t, err)
+	require.Len(t, txs, 1)
+	assert.Equal(t, tx.TxHash().String(), txs[0].TransactionID)
+	require.NotNil(t, txs[0].BlockHeight)
+	assert.Equal(t, int64(99), *txs[0].BlockHeight)
+	assert.Equal(t, int64(2), txs[0].Confirmations)
+	assert.Equal(t, int64(1700000000), txs[0].Timestamp.Unix())
+	assert.Equal(t, 2, server.HeaderSubscriptions())
+}
+
+func TestAdapter_CurrentTip_SingleSubscription(t *testing.T) {
+	t.Parallel()
+
+	server, err := electrumtest.NewServer(100)
+	require.NoError(t, err)
+	t.Cleanup(server.Close)
+	server.FailHeaderSubscriptions(1)
+	adapter := litecoin.NewAdapter(server.Addr(), false)
+
+	var wg sync.WaitGroup
+	tips := make([]int32, 10)
+	errs := make([]error, len(tips))
+	for i := range tips {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			tips[i], errs[i] = adapter.CurrentTip(t.Context())
+		}()
+	}
+	wg.Wait()
+
+	for i := range tips {
+		require.NoError(t, errs[i])
+		assert.Equal(t, int32(100), tips[i])
+	}
+	// The failed subscription made on connecting and a single retry.
+	assert.Equal(t, 2, server.HeaderSubscriptions())
+}
+
+func TestAdapter_GetTransactions_PagesAcrossMempool(t *testing.T) {
+	t.Parallel()
+
+	server, err := electrumtest.NewServer(100)
+	require.NoError(t, err)
+	t.Cleanup(server.Close)
+	mined, scripthash := coinbaseTx(t, bip84Zpub, 5000)
+	require.NoError(t, server.AddTransaction(mined, 99, scripthash))
+	require.NoError(t, server.AddHeader(99, wire.BlockHeader{Timestamp: time.Unix(1700000000, 0)}))
+	pending := make([]string, 2)
+	for i := range pending {
+		tx, _ := coinbaseTx(t, bip84Zpub, int64(6000+i))
+		require.NoError(t, server.AddTransaction(tx, 0, scripthash))
+		pending[i] = tx.TxHash().String()
+	}
+
+	adapter := litecoin.NewAdapter(server.Addr(), false)
+	first, err := adapter.GetTransactions(t.Context(), bip84Zpub)
+	require.NoError(t, err)
+
+	// Mempool transactions keep the time they were first seen at, so the
+	// order of the history and the cursors into it hold across requests.
+	history := provider.NewAdapter(static.NewAdapter(nil), nil, map[string]ports.CryptoProvider{"LTC": adapter})
+	var listed []domain.Transaction
+	page := &domain.TransactionPage{}
+	for range 3 {
+		page, err = history.GetTransactions(t.Context(), "LTC", bip84Zpub, "",
+			domain.PageRequest{Limit: 1, Cursor: page.NextCursor})
+		require.NoError(t, err)
+		listed = append(listed, page.Transactions...)
+	}
+	assert.Empty(t, page.NextCursor)
+
+	require.Len(t, listed, 3)
+	assert.ElementsMatch(t, pending, []string{listed[0].TransactionID, listed[1].TransactionID})
+	assert.Equal(t, mined.TxHash().String(), listed[2].TransactionID)
+	for _, tx := range first {
+		for _, again := range listed {
+			if tx.TransactionID == again.TransactionID {
+				assert.True(t, tx.Timestamp.Equal(again.Timestamp))
+			}
+		}
+	}
+}
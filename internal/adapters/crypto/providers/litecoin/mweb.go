@@ -0,0 +1,245 @@
+package litecoin
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+)
+
+// MWEB, the MimbleWimble extension block, keeps its coins out of the
+// canonical UTXO set. Coins enter it through peg-in outputs and leave it
+// through the peg-out outputs of the HogEx, the integration transaction that
+// closes every block and whose first output, the HogAddr, holds every coin in
+// the extension block.
+const (
+	// PegoutMaturity is the number of confirmations a peg-out needs before
+	// it can be spent.
+	PegoutMaturity = 6
+
+	// witnessFlag and mwebFlag are the bits of the flag byte after the
+	// segwit marker: mwebFlag marks extension block data following the
+	// witnesses.
+	witnessFlag = 0x01
+	mwebFlag    = 0x08
+
+	hogAddrVersion = 8
+	pegInVersion   = 9
+	mwebProgramLen = 32
+)
+
+// mwebAddressPrefixes are the human readable parts of MWEB stealth addresses.
+var mwebAddressPrefixes = []string{"ltcmweb1", "tmweb1"}
+
+var ErrMWEBAddress = &domain.Error{
+	Code:    domain.CodeInvalidAddress,
+	Message: "MWEB addresses are paid from the extension block, which PSBTs cannot spend to",
+}
+
+// isMWEBAddress reports whether address is an MWEB stealth address.
+func isMWEBAddress(address string) bool {
+	lower := strings.ToLower(address)
+	for _, prefix := range mwebAddressPrefixes {
+		if strings.HasPrefix(lower, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// isMWEBScript reports whether script is a witness program of the given
+// extension block version.
+func isMWEBScript(script []byte, version int) bool {
+	v, program, err := txscript.ExtractWitnessProgramInfo(script)
+	return err == nil && v == version && len(program) == mwebProgramLen
+}
+
+// isHogEx reports whether msgTx is the integration transaction of a block,
+// the only one paying the HogAddr. Its other outputs are peg-outs.
+func isHogEx(msgTx *wire.MsgTx) bool {
+	return len(msgTx.TxOut) > 0 && isMWEBScript(msgTx.TxOut[0].PkScript, hogAddrVersion)
+}
+
+// pegInValue sums the outputs of msgTx moving coins into the extension block.
+func pegInValue(msgTx *wire.MsgTx) int64 {
+	var value int64
+	for _, out := range msgTx.TxOut {
+		if isMWEBScript(out.PkScript, pegInVersion) {
+			value += out.Value
+		}
+	}
+	return value
+}
+
+// decodeTransaction parses a transaction as litecoind serializes it. When the
+// flag byte has mwebFlag set, extension block data follows the witnesses;
+// it has no part in the canonical inputs and outputs and is skipped, the
+// lock time being the final four bytes either way.
+func decodeTransaction(raw []byte) (*wire.MsgTx, error) {
+	msgTx := wire.NewMsgTx(wire.TxVersion)
+	if len(raw) < 10 || raw[4] != 0 || raw[5]&mwebFlag == 0 {
+		if err := msgTx.Deserialize(bytes.NewReader(raw)); err != nil {
+			return nil, err
+		}
+		return msgTx, nil
+	}
+
+	msgTx.Version = int32(binary.LittleEndian.Uint32(raw[:4])) //nolint:gosec // version is a signed field
+	msgTx.LockTime = binary.LittleEndian.Uint32(raw[len(raw)-4:])
+	r := bytes.NewReader(raw[6 : len(raw)-4])
+
+	inputs, err := wire.ReadVarInt(r, 0)
+	if err != nil {
+		return nil, err
+	}
+	for range inputs {
+		in := &wire.TxIn{}
+		if _, err := io.ReadFull(r, in.PreviousOutPoint.Hash[:chainhash.HashSize]); err != nil {
+			return nil, err
+		}
+		if err := binary.Read(r, binary.LittleEndian, &in.PreviousOutPoint.Index); err != nil {
+			return nil, err
+		}
+		if in.SignatureScript, err = wire.ReadVarBytes(r, 0, wire.MaxMessagePayload, "signature script"); err != nil {
+			return nil, err
+		}
+		if err := binary.Read(r, binary.LittleEndian, &in.Sequence); err != nil {
+			return nil, err
+		}
+		msgTx.TxIn = append(msgTx.TxIn, in)
+	}
+
+	outputs, err := wire.ReadVarInt(r, 0)
+	if err != nil {
+		return nil, err
+	}
+	for range outputs {
+		out := &wire.TxOut{}
+		if err := binary.Read(r, binary.LittleEndian, &out.Value); err != nil {
+			return nil, err
+		}
+		if out.PkScript, err = wire.ReadVarBytes(r, 0, wire.MaxMessagePayload, "pk script"); err != nil {
+			return nil, err
+		}
+		msgTx.TxOut = append(msgTx.TxOut, out)
+	}
+
+	if raw[5]&witnessFlag == 0 {
+		return msgTx, nil
+	}
+	for _, in := range msgTx.TxIn {
+		items, err := wire.ReadVarInt(r, 0)
+		if err != nil {
+			return nil, err
+		}
+		for range items {
+			item, err := wire.ReadVarBytes(r, 0, wire.MaxMessagePayload, "witness item")
+			if err != nil {
+				return nil, err
+			}
+			in.Witness = append(in.Witness, item)
+		}
+	}
+	return msgTx, nil
+}
+
+// mwebBalance returns the coins the history of xpub leaves in the extension
+// block, in whole LTC.
+func (a *Adapter) mwebBalance(ctx context.Context, xpub string) (float64, error) {
+	addresses, _, err := a.walletAddresses(xpub)
+	if err != nil {
+		return 0, err
+	}
+
+	client := a.getClient()
+	if client.IsShutdown() {
+		a.connectWithRetry()
+		client = a.getClient()
+	}
+
+	pegged, err := a.historyFetcher(client).mwebBalance(ctx, addresses)
+	if err != nil {
+		return 0, domain.UpstreamError(err)
+	}
+	return float64(pegged) / SatoshiPerLTC, nil
+}
+
+// mwebBalance nets the coins the wallet pegged into the extension block
+// against the peg-outs it received back. Transfers inside the extension
+// block are invisible on the canonical chain, so this is what the wallet's
+// canonical history leaves there rather than a balance read from MWEB.
+func (h *historyFetcher) mwebBalance(ctx context.Context, addresses []btcutil.Address) (int64, error) {
+	owned := make(map[string]bool, len(addresses))
+	for _, addr := range addresses {
+		owned[addr.EncodeAddress()] = true
+	}
+
+	seen := make(map[string]bool)
+	var pegged int64
+	for _, addr := range addresses {
+		entries, err := h.history(ctx, addr)
+		if err != nil {
+			return 0, err
+		}
+		for _, entry := range entries {
+			if seen[entry.Hash] {
+				continue
+			}
+			seen[entry.Hash] = true
+
+			msgTx, err := h.rawTransaction(ctx, entry.Hash)
+			if err != nil {
+				return 0, err
+			}
+			if isHogEx(msgTx) {
+				for _, out := range msgTx.TxOut[1:] {
+					if owned[h.outputAddress(out.PkScript)] {
+						pegged -= out.Value
+					}
+				}
+				continue
+			}
+
+			pegIn := pegInValue(msgTx)
+			if pegIn == 0 {
+				continue
+			}
+			spends, err := h.spendsFrom(ctx, msgTx, owned)
+			if err != nil {
+				return 0, err
+			}
+			if spends {
+				pegged += pegIn
+			}
+		}
+	}
+	return max(pegged, 0), nil
+}
+
+// spendsFrom reports whether any input of msgTx spends an output of owned.
+func (h *historyFetcher) spendsFrom(ctx context.Context, msgTx *wire.MsgTx, owned map[string]bool) (bool, error) {
+	if isCoinbase(msgTx) {
+		return false, nil
+	}
+	for _, in := range msgTx.TxIn {
+		prevTx, err := h.rawTransaction(ctx, in.PreviousOutPoint.Hash.String())
+		if err != nil {
+			return false, err
+		}
+		if int(in.PreviousOutPoint.Index) >= len(prevTx.TxOut) {
+			return false, fmt.Errorf("input %s of %s references a missing output", in.PreviousOutPoint, msgTx.TxHash())
+		}
+		if owned[h.outputAddress(prevTx.TxOut[in.PreviousOutPoint.Index].PkScript)] {
+			return true, nil
+		}
+	}
+	return false, nil
+}
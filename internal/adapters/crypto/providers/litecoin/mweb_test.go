@@ -0,0 +1,82 @@
+package litecoin_test
+
+import (
+	"bytes"
+	"slices"
+	"testing"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/adapters/crypto/providers/litecoin"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mwebScript(t *testing.T, version byte) []byte {
+	t.Helper()
+
+	script, err := txscript.NewScriptBuilder().
+		AddOp(txscript.OP_1 - 1 + version).
+		AddData(bytes.Repeat([]byte{version}, 32)).
+		Script()
+	require.NoError(t, err)
+	return script
+}
+
+// withMWEB serializes msgTx the way litecoind does when it carries extension
+// block data: the mweb bit in the flag byte and data ahead of the lock time.
+func withMWEB(t *testing.T, msgTx *wire.MsgTx, data []byte) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	if msgTx.HasWitness() {
+		require.NoError(t, msgTx.Serialize(&buf))
+	} else {
+		require.NoError(t, msgTx.SerializeNoWitness(&buf))
+		raw := buf.Bytes()
+		buf.Reset()
+		buf.Write(slices.Concat(raw[:4], []byte{0, 0}, raw[4:]))
+	}
+	raw := buf.Bytes()
+	raw[5] |= 0x08
+	return slices.Concat(raw[:len(raw)-4], data, raw[len(raw)-4:])
+}
+
+func TestDecodeTransaction(t *testing.T) {
+	t.Parallel()
+
+	pegIn := wire.NewMsgTx(wire.TxVersion)
+	pegIn.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: wire.OutPoint{Index: 1},
+		Witness:          wire.TxWitness{{1, 2, 3}, {4, 5}},
+		Sequence:         wire.MaxTxInSequenceNum,
+	})
+	pegIn.AddTxOut(wire.NewTxOut(40_000, mwebScript(t, 9)))
+	pegIn.AddTxOut(wire.NewTxOut(10_000, []byte{txscript.OP_0, 0x14}))
+	pegIn.LockTime = 1234
+
+	decoded, err := litecoin.DecodeTransaction(withMWEB(t, pegIn, []byte{1, 0xaa, 0xbb, 0xcc}))
+	require.NoError(t, err)
+	assert.Equal(t, pegIn.TxHash(), decoded.TxHash())
+	assert.Equal(t, pegIn.TxIn[0].Witness, decoded.TxIn[0].Witness)
+	assert.Equal(t, uint32(1234), decoded.LockTime)
+	assert.Equal(t, int64(40_000), litecoin.PegInValue(decoded))
+	assert.False(t, litecoin.IsHogEx(decoded))
+
+	hogEx := wire.NewMsgTx(wire.TxVersion)
+	hogEx.AddTxIn(&wire.TxIn{PreviousOutPoint: wire.OutPoint{Index: 0}})
+	hogEx.AddTxOut(wire.NewTxOut(5_000_000, mwebScript(t, 8)))
+	hogEx.AddTxOut(wire.NewTxOut(20_000, []byte{txscript.OP_0, 0x14}))
+
+	decoded, err = litecoin.DecodeTransaction(withMWEB(t, hogEx, []byte{0}))
+	require.NoError(t, err)
+	assert.Equal(t, hogEx.TxHash(), decoded.TxHash())
+	assert.True(t, litecoin.IsHogEx(decoded))
+	assert.Zero(t, litecoin.PegInValue(decoded))
+
+	var plain bytes.Buffer
+	require.NoError(t, pegIn.Serialize(&plain))
+	decoded, err = litecoin.DecodeTransaction(plain.Bytes())
+	require.NoError(t, err)
+	assert.Equal(t, pegIn.TxHash(), decoded.TxHash())
+}
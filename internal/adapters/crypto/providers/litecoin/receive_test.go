@@ -0,0 +1,37 @@
+package litecoin_test
+
+import (
+	"testing"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/adapters/crypto/providers/litecoin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// bip84Zpub is the account key of the BIP84 test vector. Litecoin wallets
+// export the same zpub version, so it derives native segwit addresses.
+const bip84Zpub = "zpub6rFR7y4Q2AijBEqTUquhVz398htDFrtymD9xYYfG1m4wAcvPhXNfE3EfH1r1ADqtfSdVCToUG868RvUUkgDKf31mGDtKsAYz2oz2AGutZYs"
+
+func TestReceiveAddresses(t *testing.T) {
+	t.Parallel()
+
+	addresses, err := litecoin.ReceiveAddresses(bip84Zpub, 3, 2, false)
+	require.NoError(t, err)
+	require.Len(t, addresses, 2)
+	assert.Equal(t, uint32(3), addresses[0].Index)
+	assert.Equal(t, "ltc1qgl5vlg0zdl7yvprgxj9fevsc6q6x5dmcq2tutp", addresses[0].Address)
+	assert.Equal(t, "m/84'/2'/0'/0/3", addresses[0].DerivationPath)
+	assert.Equal(t, "m/84'/2'/0'/0/4", addresses[1].DerivationPath)
+}
+
+func TestNextUnusedIndex(t *testing.T) {
+	t.Parallel()
+
+	addresses, err := litecoin.ReceiveAddresses(bip84Zpub, 0, 30, false)
+	require.NoError(t, err)
+
+	used := map[string]bool{addresses[2].Address: true, addresses[21].Address: true}
+	next, err := litecoin.NextUnusedIndex(bip84Zpub, false, func(address string) bool { return used[address] })
+	require.NoError(t, err)
+	assert.Equal(t, 22, next)
+}
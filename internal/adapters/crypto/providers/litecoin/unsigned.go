@@ -0,0 +1,644 @@
+package litecoin
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/tracing"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// BuildTimeout bounds all Electrum calls made to build one transaction.
+	BuildTimeout = 30 * time.Second
+
+	// dustRelayFee in litoshi per kB is what litecoind charges spending an
+	// output against its value: outputs worth less than that are dust.
+	dustRelayFee = 30_000
+	ltcDecimals  = 8
+
+	// Serialized sizes in bytes of the parts of a transaction signed with
+	// 72 byte signatures and compressed keys.
+	txOverhead      = 4 + 4           // version and lock time
+	inputOverhead   = 32 + 4 + 1 + 4  // outpoint, script length and sequence
+	pubKeyHashSig   = 1 + 72 + 1 + 33 // signature and key pushes
+	nestedScriptSig = 1 + 22          // push of the P2WPKH redeem script
+	witnessSize     = 1 + 1 + 72 + 1 + 33
+	segwitMarker    = 2
+	// witnessScaleFactor is the weight of a non-witness byte.
+	witnessScaleFactor = 4
+)
+
+var (
+	ErrNestedAddress = &domain.Error{
+		Code:    domain.CodeInvalidAddress,
+		Message: "P2SH outputs are spent from the extended key or descriptor deriving them",
+	}
+	ErrFeeRateTooLow = &domain.Error{
+		Code:    domain.CodeBadRequest,
+		Message: "fee rate below the minimum relay fee of 1 litoshi/vB",
+	}
+	ErrUnsupportedScript = errors.New("unsupported output script")
+)
+
+// inputKind is how an input is signed, which decides its size and what its
+// PSBT input carries.
+type inputKind int
+
+const (
+	inputWitnessPubKeyHash inputKind = iota
+	inputNestedWitnessPubKeyHash
+	inputPubKeyHash
+)
+
+// spendable is a wallet output decoded for spending.
+type spendable struct {
+	walletOutput
+	outpoint wire.OutPoint
+	pkScript []byte
+	kind     inputKind
+}
+
+// witnessProgram returns the P2WPKH script a segwit input is signed with,
+// the redeem script of nested inputs.
+func (s spendable) witnessProgram() ([]byte, error) {
+	if s.kind != inputNestedWitnessPubKeyHash {
+		return s.pkScript, nil
+	}
+	return witnessPubKeyHashScript(s.pubKey)
+}
+
+// changeOutput is where a transaction returns its change. Wallet change
+// carries the key deriving it so signers can recognise it as their own.
+type changeOutput struct {
+	address      string
+	script       []byte
+	redeemScript []byte
+	pubKey       *btcec.PublicKey
+	path         string
+	fingerprint  uint32
+}
+
+// txPlan is a transaction paying the recipient from inputs, with change when
+// enough is left after the fee.
+type txPlan struct {
+	tx     *wire.MsgTx
+	inputs []spendable
+	change int64
+	fee    int64
+	vsize  int64
+}
+
+// BuildUnsignedTx builds a PSBT spending the outputs of request.From, an
+// extended public key, a descriptor or a single address, largest first. The
+// change goes to the first unused change address of a wallet or back to a
+// single address. With domain.AmountMax every output worth spending is swept
+// to request.To without change. Peg-outs from MWEB are left alone until they mature. The
+// fee is the virtual size at request.FeeRate litoshi/vB or the normal fee
+// estimate.
+func (a *Adapter) BuildUnsignedTx(ctx context.Context, request domain.UnsignedTxRequest) (*domain.UnsignedTx, error) {
+	ctx, span := tracer.Start(ctx, "litecoin.BuildUnsignedTx", trace.WithAttributes(a.spanAttributes()...))
+	tx, err := a.buildUnsignedTx(ctx, request)
+	tracing.End(span, err)
+	return tx, err
+}
+
+func (a *Adapter) buildUnsignedTx(ctx context.Context, request domain.UnsignedTxRequest) (*domain.UnsignedTx, error) {
+	toScript, err := recipientScript(request.To, a.isTestnet)
+	if err != nil {
+		return nil, err
+	}
+	branches, script, single, err := a.utxoSource(request.From)
+	if err != nil {
+		return nil, err
+	}
+	if _, nested := single.(*btcutil.AddressScriptHash); nested {
+		return nil, ErrNestedAddress
+	}
+	var amount int64
+	if !request.SendsMax() {
+		if amount, err = parseAmount(request.Amount, toScript); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, BuildTimeout)
+	defer cancel()
+
+	client := a.getClient()
+	if client.IsShutdown() {
+		a.connectWithRetry()
+		client = a.getClient()
+	}
+
+	feeRate := request.FeeRate
+	if feeRate == 0 {
+		if feeRate, err = a.normalFeeRate(ctx, client); err != nil {
+			return nil, err
+		}
+	} else if feeRate < MinFeeRate {
+		return nil, ErrFeeRateTooLow
+	}
+
+	var outputs []walletOutput
+	var change *changeOutput
+	if single != nil {
+		utxos, err := a.walletUnspent(ctx, client, single, "")
+		if err != nil {
+			return nil, domain.UpstreamError(err)
+		}
+		for _, utxo := range utxos {
+			outputs = append(outputs, walletOutput{UTXO: utxo})
+		}
+		if change, err = addressChange(single); err != nil {
+			return nil, err
+		}
+	} else {
+		var next []uint32
+		outputs, next, err = a.walletOutputs(ctx, client, branches, script)
+		if err != nil {
+			return nil, domain.UpstreamError(err)
+		}
+		if change, err = walletChange(branches, next, script, a.isTestnet); err != nil {
+			return nil, err
+		}
+	}
+
+	fetcher := a.historyFetcher(client)
+	outputs, immature, err := maturePegouts(ctx, fetcher, outputs)
+	if err != nil {
+		return nil, domain.UpstreamError(err)
+	}
+	inputs, err := spendables(outputs)
+	if err != nil {
+		return nil, domain.UpstreamError(err)
+	}
+	var plan *txPlan
+	if request.SendsMax() {
+		plan, err = sweepTx(inputs, feeRate, toScript)
+	} else {
+		plan, err = planTx(inputs, amount, feeRate, toScript, change.script)
+	}
+	if errors.Is(err, domain.ErrInsufficientFunds) && immature > 0 {
+		return nil, fmt.Errorf("%w; %s LTC of MWEB peg-outs needs %d confirmations first", err,
+			domain.FormatUnits(big.NewInt(immature), ltcDecimals), PegoutMaturity)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	prevTxs := make([]*wire.MsgTx, len(plan.inputs))
+	for i, input := range plan.inputs {
+		if prevTxs[i], err = fetcher.rawTransaction(ctx, input.TransactionID); err != nil {
+			return nil, domain.UpstreamError(err)
+		}
+	}
+	payload, err := psbtPacket(plan, prevTxs, change)
+	if err != nil {
+		return nil, err
+	}
+	signed, err := signingInputs(plan)
+	if err != nil {
+		return nil, err
+	}
+	units := big.NewInt(plan.tx.TxOut[0].Value)
+	return &domain.UnsignedTx{
+		From:     request.From,
+		To:       request.To,
+		Amount:   domain.FormatUnits(units, ltcDecimals),
+		Fee:      domain.FormatUnits(big.NewInt(plan.fee), ltcDecimals),
+		Payload:  payload,
+		Encoding: domain.EncodingBase64,
+		Inputs:   signed,
+		Details:  txDetails(plan, request.To, units, change.address, feeRate, immature),
+	}, nil
+}
+
+// parseAmount parses an amount in LTC into litoshi, rejecting dust.
+func parseAmount(amount string, toScript []byte) (int64, error) {
+	units, err := domain.ParseUnits(amount, ltcDecimals)
+	if err != nil {
+		return 0, err
+	}
+	if !units.IsInt64() {
+		return 0, fmt.Errorf("%w: amount out of range", domain.ErrBadRequest)
+	}
+	if dust := dustThreshold(toScript); units.Int64() < dust {
+		return 0, fmt.Errorf("%w: amounts below %s LTC are dust", domain.ErrBadRequest,
+			domain.FormatUnits(big.NewInt(dust), ltcDecimals))
+	}
+	return units.Int64(), nil
+}
+
+// recipientScript returns the script paying to. Mainnet P2SH addresses with
+// Bitcoin's 3 prefix pay the same script as their M counterparts.
+func recipientScript(to string, isTestnet bool) ([]byte, error) {
+	if isMWEBAddress(to) {
+		return nil, ErrMWEBAddress
+	}
+	validation, err := validateAddress(to, isTestnet)
+	if err != nil {
+		return nil, err
+	}
+	if validation.Kind != domain.AddressKindAddress {
+		return nil, fmt.Errorf("%w: send to an address, not an extended key", domain.ErrInvalidAddress)
+	}
+
+	addr, err := btcutil.DecodeAddress(to, chainParams(isTestnet))
+	if err != nil && !isTestnet {
+		addr, err = btcutil.DecodeAddress(to, &chaincfg.MainNetParams)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidAddress, err)
+	}
+	script, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidAddress, err)
+	}
+	return script, nil
+}
+
+// addressChange returns change to the single address spent from.
+func addressChange(addr btcutil.Address) (*changeOutput, error) {
+	script, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidAddress, err)
+	}
+	return &changeOutput{address: addr.EncodeAddress(), script: script}, nil
+}
+
+// walletChange derives the first unused address of the change branch, the
+// only branch of keys that have no change branch.
+func walletChange(branches []branch, next []uint32, script scriptType, isTestnet bool) (*changeOutput, error) {
+	i := min(int(ChangeChain), len(branches)-1)
+	b := branches[i]
+
+	pubKey, err := childPubKey(b.root, next[i])
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidAddress, err)
+	}
+	addr, err := makeLitecoinAddress(pubKey, script, isTestnet)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidAddress, err)
+	}
+	change, err := addressChange(addr)
+	if err != nil {
+		return nil, err
+	}
+	if script == scriptNestedWitnessPubKeyHash {
+		if change.redeemScript, err = witnessPubKeyHashScript(pubKey); err != nil {
+			return nil, err
+		}
+	}
+	change.pubKey = pubKey
+	change.path = childPath(b.path, next[i])
+	change.fingerprint = b.fingerprint
+	return change, nil
+}
+
+// maturePegouts drops the outputs of HogEx transactions with fewer than
+// PegoutMaturity confirmations, returning the value left out. Only outputs
+// in young blocks are looked up.
+func maturePegouts(ctx context.Context, h *historyFetcher, outputs []walletOutput) ([]walletOutput, int64, error) {
+	mature := make([]walletOutput, 0, len(outputs))
+	var immature int64
+	for _, output := range outputs {
+		if output.BlockHeight != nil && output.Confirmations < PegoutMaturity {
+			msgTx, err := h.rawTransaction(ctx, output.TransactionID)
+			if err != nil {
+				return nil, 0, err
+			}
+			if isHogEx(msgTx) {
+				immature += output.Value
+				continue
+			}
+		}
+		mature = append(mature, output)
+	}
+	return mature, immature, nil
+}
+
+// spendables decodes the outpoints and scripts of outputs, largest first.
+func spendables(outputs []walletOutput) ([]spendable, error) {
+	inputs := make([]spendable, len(outputs))
+	for i, output := range outputs {
+		hash, err := chainhash.NewHashFromStr(output.TransactionID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse transaction id %q: %w", output.TransactionID, err)
+		}
+		script, err := hex.DecodeString(output.ScriptPubKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse script %q: %w", output.ScriptPubKey, err)
+		}
+
+		var kind inputKind
+		switch txscript.GetScriptClass(script) {
+		case txscript.WitnessV0PubKeyHashTy:
+			kind = inputWitnessPubKeyHash
+		case txscript.ScriptHashTy:
+			if output.pubKey == nil {
+				return nil, ErrNestedAddress
+			}
+			kind = inputNestedWitnessPubKeyHash
+		case txscript.PubKeyHashTy:
+			kind = inputPubKeyHash
+		default:
+			return nil, fmt.Errorf("%w: %s", ErrUnsupportedScript, output.ScriptPubKey)
+		}
+
+		inputs[i] = spendable{
+			walletOutput: output,
+			outpoint:     wire.OutPoint{Hash: *hash, Index: output.Vout},
+			pkScript:     script,
+			kind:         kind,
+		}
+	}
+	slices.SortStableFunc(inputs, func(x, y spendable) int {
+		return cmp.Compare(y.Value, x.Value)
+	})
+	return inputs, nil
+}
+
+// planTx spends inputs in order until they pay amount to toScript and the
+// fee of the transaction's virtual size at feeRate litoshi/vB.
+func planTx(inputs []spendable, amount int64, feeRate float64, toScript, changeScript []byte) (*txPlan, error) {
+	var total int64
+	for i, input := range inputs {
+		total += input.Value
+		if total < amount {
+			continue
+		}
+		if plan := settleFee(inputs[:i+1], total, amount, feeRate, toScript, changeScript); plan != nil {
+			return plan, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s LTC available", domain.ErrInsufficientFunds,
+		domain.FormatUnits(big.NewInt(total), ltcDecimals))
+}
+
+// settleFee prices the transaction spending inputs worth total, with change
+// unless it would be dust, in which case it is left to the fee. It returns
+// nil when total does not cover amount and the fee.
+func settleFee(inputs []spendable, total, amount int64, feeRate float64, toScript, changeScript []byte) *txPlan {
+	tx := unsignedTransaction(inputs, amount, toScript)
+	vsize := txVsize(inputs, append(slices.Clone(tx.TxOut), wire.NewTxOut(0, changeScript)))
+	fee := int64(math.Ceil(float64(vsize) * feeRate))
+	if change := total - amount - fee; change >= dustThreshold(changeScript) {
+		tx.AddTxOut(wire.NewTxOut(change, changeScript))
+		return &txPlan{tx: tx, inputs: inputs, change: change, fee: fee, vsize: vsize}
+	}
+
+	vsize = txVsize(inputs, tx.TxOut)
+	if total-amount < int64(math.Ceil(float64(vsize)*feeRate)) {
+		return nil
+	}
+	return &txPlan{tx: tx, inputs: inputs, fee: total - amount, vsize: vsize}
+}
+
+// sweepTx spends every input worth more than the fee of spending it at
+// feeRate litoshi/vB, paying all of it less the fee to toScript without
+// change.
+func sweepTx(inputs []spendable, feeRate float64, toScript []byte) (*txPlan, error) {
+	var total, available int64
+	worth := make([]spendable, 0, len(inputs))
+	for _, input := range inputs {
+		available += input.Value
+		if input.Value > int64(math.Ceil(float64(inputVsize(input.kind))*feeRate)) {
+			worth = append(worth, input)
+			total += input.Value
+		}
+	}
+
+	tx := unsignedTransaction(worth, 0, toScript)
+	vsize := txVsize(worth, tx.TxOut)
+	fee := int64(math.Ceil(float64(vsize) * feeRate))
+	if len(worth) == 0 || total-fee < dustThreshold(toScript) {
+		return nil, fmt.Errorf("%w: %s LTC available, not enough to pay more than dust after fees",
+			domain.ErrInsufficientFunds, domain.FormatUnits(big.NewInt(available), ltcDecimals))
+	}
+	tx.TxOut[0].Value = total - fee
+	return &txPlan{tx: tx, inputs: worth, fee: fee, vsize: vsize}, nil
+}
+
+func unsignedTransaction(inputs []spendable, amount int64, toScript []byte) *wire.MsgTx {
+	tx := wire.NewMsgTx(wire.TxVersion)
+	for _, input := range inputs {
+		tx.AddTxIn(wire.NewTxIn(&input.outpoint, nil, nil))
+	}
+	tx.AddTxOut(wire.NewTxOut(amount, toScript))
+	return tx
+}
+
+// txVsize is the virtual size of a transaction spending inputs to outputs
+// once it is signed.
+func txVsize(inputs []spendable, outputs []*wire.TxOut) int64 {
+	base := txOverhead + wire.VarIntSerializeSize(uint64(len(inputs))) + wire.VarIntSerializeSize(uint64(len(outputs)))
+	witness := segwitMarker
+	segwit := false
+	for _, input := range inputs {
+		base += inputOverhead
+		switch input.kind {
+		case inputPubKeyHash:
+			base += pubKeyHashSig
+			witness++ // empty witness
+		case inputNestedWitnessPubKeyHash:
+			base += nestedScriptSig
+			witness += witnessSize
+			segwit = true
+		default:
+			witness += witnessSize
+			segwit = true
+		}
+	}
+	for _, output := range outputs {
+		base += output.SerializeSize()
+	}
+	if !segwit {
+		witness = 0
+	}
+	return int64((base*witnessScaleFactor + witness + witnessScaleFactor - 1) / witnessScaleFactor)
+}
+
+// inputVsize is the virtual size an input of kind adds to a transaction
+// once it is signed.
+func inputVsize(kind inputKind) int64 {
+	switch kind {
+	case inputPubKeyHash:
+		return inputOverhead + pubKeyHashSig
+	case inputNestedWitnessPubKeyHash:
+		return inputOverhead + nestedScriptSig + (witnessSize+witnessScaleFactor-1)/witnessScaleFactor
+	default:
+		return inputOverhead + (witnessSize+witnessScaleFactor-1)/witnessScaleFactor
+	}
+}
+
+// dustThreshold is the smallest value an output paying script is relayed
+// with: the cost at dustRelayFee of the output and the input spending it.
+func dustThreshold(script []byte) int64 {
+	size := wire.NewTxOut(0, script).SerializeSize()
+	if txscript.IsWitnessProgram(script) {
+		size += inputOverhead + witnessSize/witnessScaleFactor
+	} else {
+		size += inputOverhead + pubKeyHashSig
+	}
+	return int64(size) * dustRelayFee / 1000
+}
+
+// psbtPacket serializes plan as a BIP174 PSBT. Every input carries the
+// transaction it spends, segwit inputs also the spent output, and inputs and
+// change of a wallet the key and path deriving them.
+func psbtPacket(plan *txPlan, prevTxs []*wire.MsgTx, change *changeOutput) ([]byte, error) {
+	packet, err := psbt.NewFromUnsignedTx(plan.tx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create psbt: %w", err)
+	}
+	for i, input := range plan.inputs {
+		in := &packet.Inputs[i]
+		in.NonWitnessUtxo = prevTxs[i]
+		in.SighashType = txscript.SigHashAll
+		if input.kind != inputPubKeyHash {
+			in.WitnessUtxo = wire.NewTxOut(input.Value, input.pkScript)
+		}
+		if input.kind == inputNestedWitnessPubKeyHash {
+			if in.RedeemScript, err = input.witnessProgram(); err != nil {
+				return nil, err
+			}
+		}
+		in.Bip32Derivation = bip32Derivation(input.pubKey, input.fingerprint, input.DerivationPath)
+	}
+	if plan.change > 0 {
+		out := &packet.Outputs[1]
+		out.RedeemScript = change.redeemScript
+		out.Bip32Derivation = bip32Derivation(change.pubKey, change.fingerprint, change.path)
+	}
+
+	var buf bytes.Buffer
+	if err := packet.Serialize(&buf); err != nil {
+		return nil, fmt.Errorf("failed to serialize psbt: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// bip32Derivation describes the key at path for a PSBT, nil for keys of a
+// single address and for keys whose position below the master key is not
+// known.
+func bip32Derivation(pubKey *btcec.PublicKey, fingerprint uint32, path string) []*psbt.Bip32Derivation {
+	steps := strings.Split(path, "/")
+	if pubKey == nil || steps[0] != "m" {
+		return nil
+	}
+	indexes := make([]uint32, 0, len(steps)-1)
+	for _, step := range steps[1:] {
+		index, err := strconv.ParseUint(strings.TrimSuffix(step, "'"), 10, 31)
+		if err != nil {
+			return nil
+		}
+		if strings.HasSuffix(step, "'") {
+			index += 1 << 31
+		}
+		indexes = append(indexes, uint32(index))
+	}
+	return []*psbt.Bip32Derivation{{
+		PubKey:               pubKey.SerializeCompressed(),
+		MasterKeyFingerprint: fingerprint,
+		Bip32Path:            indexes,
+	}}
+}
+
+// signingInputs lists the inputs of plan with the SIGHASH_ALL digest each of
+// them is signed over: BIP143 for segwit inputs and the legacy digest for
+// P2PKH inputs.
+func signingInputs(plan *txPlan) ([]domain.UnsignedTxInput, error) {
+	prevOuts := txscript.NewMultiPrevOutFetcher(nil)
+	for _, input := range plan.inputs {
+		prevOuts.AddPrevOut(input.outpoint, wire.NewTxOut(input.Value, input.pkScript))
+	}
+	sigHashes := txscript.NewTxSigHashes(plan.tx, prevOuts)
+
+	inputs := make([]domain.UnsignedTxInput, len(plan.inputs))
+	for i, input := range plan.inputs {
+		var hash []byte
+		if input.kind == inputPubKeyHash {
+			var err error
+			if hash, err = txscript.CalcSignatureHash(input.pkScript, txscript.SigHashAll, plan.tx, i); err != nil {
+				return nil, err
+			}
+		} else {
+			program, err := input.witnessProgram()
+			if err != nil {
+				return nil, err
+			}
+			hash, err = txscript.CalcWitnessSigHash(program, sigHashes, txscript.SigHashAll, plan.tx, i, input.Value)
+			if err != nil {
+				return nil, err
+			}
+		}
+		inputs[i] = domain.UnsignedTxInput{
+			TransactionID:  input.TransactionID,
+			Vout:           input.Vout,
+			Value:          input.Value,
+			Address:        input.Address,
+			DerivationPath: input.DerivationPath,
+			SigningHash:    hash,
+		}
+	}
+	return inputs, nil
+}
+
+// txDetails lists what a signing device shows about plan before signing it.
+func txDetails(
+	plan *txPlan, to string, units *big.Int, changeAddress string, feeRate float64, immature int64,
+) []domain.TxDetail {
+	details := []domain.TxDetail{
+		{Label: "To", Value: to},
+		{Label: "Amount", Value: domain.FormatUnits(units, ltcDecimals) + " LTC"},
+		{Label: "Inputs", Value: strconv.Itoa(len(plan.inputs))},
+	}
+	if plan.change > 0 {
+		details = append(details,
+			domain.TxDetail{Label: "Change", Value: domain.FormatUnits(big.NewInt(plan.change), ltcDecimals) + " LTC"},
+			domain.TxDetail{Label: "Change address", Value: changeAddress},
+		)
+	}
+	if immature > 0 {
+		details = append(details, domain.TxDetail{
+			Label: "Immature MWEB peg-outs",
+			Value: domain.FormatUnits(big.NewInt(immature), ltcDecimals) + " LTC",
+		})
+	}
+	return append(details,
+		domain.TxDetail{Label: "Size", Value: strconv.FormatInt(plan.vsize, 10) + " vB"},
+		domain.TxDetail{Label: "Fee rate", Value: strconv.FormatFloat(feeRate, 'f', -1, 64) + " litoshi/vB"},
+		domain.TxDetail{Label: "Fee", Value: domain.FormatUnits(big.NewInt(plan.fee), ltcDecimals) + " LTC"},
+	)
+}
+
+// witnessPubKeyHashScript returns the P2WPKH script of pubKey.
+func witnessPubKeyHashScript(pubKey *btcec.PublicKey) ([]byte, error) {
+	script, err := txscript.NewScriptBuilder().
+		AddOp(txscript.OP_0).
+		AddData(btcutil.Hash160(pubKey.SerializeCompressed())).
+		Script()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build witness script: %w", err)
+	}
+	return script, nil
+}
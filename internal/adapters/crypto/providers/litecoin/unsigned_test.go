@@ -0,0 +1,123 @@
+package litecoin_test
+
+import (
+	"testing"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/adapters/crypto/providers/litecoin"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testDescriptor = "wpkh([73c5da0a/84h/2h/0h]" + bip84Zpub + "/<0;1>/*)"
+	hardened       = 1 << 31
+)
+
+func TestPlanPSBT(t *testing.T) {
+	t.Parallel()
+
+	recipient, err := litecoin.ReceiveAddresses(bip84Zpub, 10, 1, false)
+	require.NoError(t, err)
+
+	packet, inputs, fee, err := litecoin.PlanPSBT(testDescriptor, []int64{50_000, 1_000_000},
+		recipient[0].Address, 600_000, 2)
+	require.NoError(t, err)
+
+	// The larger output pays on its own, priced for one P2WPKH input and
+	// two P2WPKH outputs.
+	assert.Equal(t, int64(2*litecoin.TypicalTxVsize), fee)
+	tx := packet.UnsignedTx
+	require.Len(t, tx.TxIn, 1)
+	require.Len(t, tx.TxOut, 2)
+	assert.Equal(t, int64(600_000), tx.TxOut[0].Value)
+	assert.Equal(t, 1_000_000-600_000-fee, tx.TxOut[1].Value)
+
+	in := packet.Inputs[0]
+	require.NotNil(t, in.WitnessUtxo)
+	assert.Equal(t, int64(1_000_000), in.WitnessUtxo.Value)
+	require.NotNil(t, in.NonWitnessUtxo)
+	assert.Equal(t, tx.TxIn[0].PreviousOutPoint.Hash, in.NonWitnessUtxo.TxHash())
+	require.Len(t, in.Bip32Derivation, 1)
+	assert.Equal(t, uint32(0x0ada_c573), in.Bip32Derivation[0].MasterKeyFingerprint)
+	assert.Equal(t, []uint32{84 + hardened, 2 + hardened, hardened, 0, 1}, in.Bip32Derivation[0].Bip32Path)
+	require.Len(t, packet.Outputs[1].Bip32Derivation, 1)
+	assert.Equal(t, []uint32{84 + hardened, 2 + hardened, hardened, 1, 0}, packet.Outputs[1].Bip32Derivation[0].Bip32Path)
+
+	// The digest returned per input is the BIP143 one of the PSBT.
+	require.Len(t, inputs, 1)
+	assert.Equal(t, "m/84'/2'/0'/0/1", inputs[0].DerivationPath)
+	prevOuts := txscript.NewCannedPrevOutputFetcher(in.WitnessUtxo.PkScript, in.WitnessUtxo.Value)
+	hash, err := txscript.CalcWitnessSigHash(in.WitnessUtxo.PkScript, txscript.NewTxSigHashes(tx, prevOuts),
+		txscript.SigHashAll, tx, 0, in.WitnessUtxo.Value)
+	require.NoError(t, err)
+	assert.Equal(t, hash, inputs[0].SigningHash)
+}
+
+func TestPlanPSBT_DustChange(t *testing.T) {
+	t.Parallel()
+
+	recipient, err := litecoin.ReceiveAddresses(bip84Zpub, 10, 1, false)
+	require.NoError(t, err)
+
+	packet, _, fee, err := litecoin.PlanPSBT(testDescriptor, []int64{100_000}, recipient[0].Address, 99_600, 2)
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(400), fee)
+	assert.Len(t, packet.UnsignedTx.TxOut, 1)
+}
+
+func TestPlanPSBT_Errors(t *testing.T) {
+	t.Parallel()
+
+	recipient, err := litecoin.ReceiveAddresses(bip84Zpub, 10, 1, false)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		to     string
+		amount int64
+		want   error
+	}{
+		{name: "insufficient funds", to: recipient[0].Address, amount: 100_000, want: domain.ErrInsufficientFunds},
+		{
+			name:   "MWEB recipient",
+			to:     "ltcmweb1qq0yq03ewm830ugmkkvrvjmyyeslcpwk8ayd7k27qx63sryy6kx3ksqm3k6jd24ld3r5dp5lzx7rm7uyxfujf8sn7v4nlxeqwrcq6k6xxwqdc6tl3",
+			amount: 50_000,
+			want:   litecoin.ErrMWEBAddress,
+		},
+		{name: "extended key recipient", to: bip84Zpub, amount: 50_000, want: domain.ErrInvalidAddress},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, _, _, err := litecoin.PlanPSBT(testDescriptor, []int64{60_000}, tt.to, tt.amount, 2)
+			require.ErrorIs(t, err, tt.want)
+		})
+	}
+}
+
+func TestSweepPSBT(t *testing.T) {
+	t.Parallel()
+
+	recipient, err := litecoin.ReceiveAddresses(bip84Zpub, 10, 1, false)
+	require.NoError(t, err)
+
+	// At 2 litoshi/vB an input costs 136 litoshi, so the 100 litoshi output
+	// is left behind.
+	packet, inputs, fee, err := litecoin.SweepPSBT(testDescriptor, []int64{50_000, 1_000_000, 100},
+		recipient[0].Address, 2)
+	require.NoError(t, err)
+
+	tx := packet.UnsignedTx
+	require.Len(t, tx.TxIn, 2)
+	require.Len(t, inputs, 2)
+	require.Len(t, tx.TxOut, 1)
+	assert.Equal(t, int64(2*178), fee)
+	assert.Equal(t, 1_050_000-fee, tx.TxOut[0].Value)
+
+	_, _, _, err = litecoin.SweepPSBT(testDescriptor, []int64{600, 100}, recipient[0].Address, 2)
+	require.ErrorIs(t, err, domain.ErrInsufficientFunds)
+}
@@ -0,0 +1,196 @@
+package litecoin
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/tracing"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	hd "github.com/btcsuite/btcd/btcutil/hdkeychain"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/lamengao/go-electrum/electrum"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// GetUTXOs lists the unspent outputs of an address, an extended public key or
+// a single key output descriptor. Keys are scanned branch by branch up to the
+// gap limit and only addresses with history are asked for their outputs.
+func (a *Adapter) GetUTXOs(ctx context.Context, address string) ([]domain.UTXO, error) {
+	ctx, span := tracer.Start(ctx, "litecoin.GetUTXOs", trace.WithAttributes(a.spanAttributes()...))
+	utxos, err := a.getUTXOs(ctx, address)
+	tracing.End(span, err)
+	return utxos, err
+}
+
+func (a *Adapter) getUTXOs(ctx context.Context, address string) ([]domain.UTXO, error) {
+	branches, script, single, err := a.utxoSource(address)
+	if err != nil {
+		return nil, err
+	}
+
+	client := a.getClient()
+	if client.IsShutdown() {
+		a.connectWithRetry()
+		client = a.getClient()
+	}
+
+	if single != nil {
+		utxos, err := a.walletUnspent(ctx, client, single, "")
+		if err != nil {
+			return nil, domain.UpstreamError(err)
+		}
+		return utxos, nil
+	}
+
+	outputs, _, err := a.walletOutputs(ctx, client, branches, script)
+	if err != nil {
+		return nil, domain.UpstreamError(err)
+	}
+	utxos := make([]domain.UTXO, len(outputs))
+	for i, output := range outputs {
+		utxos[i] = output.UTXO
+	}
+	return utxos, nil
+}
+
+// walletOutput is an unspent output of a wallet branch with the key of the
+// address it pays and the master key fingerprint of its branch.
+type walletOutput struct {
+	domain.UTXO
+	pubKey      *btcec.PublicKey
+	fingerprint uint32
+}
+
+// walletOutputs scans branches up to the gap limit for the outputs of their
+// used addresses. It also returns the index after the last used address of
+// each branch.
+func (a *Adapter) walletOutputs(
+	ctx context.Context, client *electrum.Client, branches []branch, script scriptType,
+) ([]walletOutput, []uint32, error) {
+	outputs := make([]walletOutput, 0)
+	next := make([]uint32, len(branches))
+	for i, b := range branches {
+		n, err := scanChain(b.root, script, a.isTestnet, func(index int, addr btcutil.Address) (bool, error) {
+			used, err := a.addressUsed(ctx, client, addr)
+			if err != nil || !used {
+				return used, err
+			}
+			child := uint32(index) //nolint:gosec // bounded by deriveAddresses
+			utxos, err := a.walletUnspent(ctx, client, addr, childPath(b.path, child))
+			if err != nil || len(utxos) == 0 {
+				return true, err
+			}
+			pubKey, err := childPubKey(b.root, child)
+			if err != nil {
+				return false, err
+			}
+			for _, utxo := range utxos {
+				outputs = append(outputs, walletOutput{UTXO: utxo, pubKey: pubKey, fingerprint: b.fingerprint})
+			}
+			return true, nil
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		next[i] = uint32(n) //nolint:gosec // bounded by deriveAddresses
+	}
+	return outputs, next, nil
+}
+
+// utxoSource resolves address into the branches of a wallet, or into a
+// single address when it is neither a key nor a descriptor.
+func (a *Adapter) utxoSource(address string) ([]branch, scriptType, btcutil.Address, error) {
+	coinType := chainParams(a.isTestnet).HDCoinType
+
+	if isDescriptor(address) {
+		branches, script, err := parseDescriptor(address, coinType)
+		if err != nil {
+			return nil, 0, nil, fmt.Errorf("%w: %w", domain.ErrInvalidAddress, err)
+		}
+		return branches, script, nil, nil
+	}
+
+	if key, err := hd.NewKeyFromString(address); err == nil {
+		script := walletScriptType(key)
+		branches, err := walletBranches(key, accountPath(key, script, coinType))
+		if err != nil {
+			return nil, 0, nil, fmt.Errorf("%w: %w", domain.ErrInvalidAddress, err)
+		}
+		return branches, script, nil, nil
+	}
+
+	if _, err := validateAddress(address, a.isTestnet); err != nil {
+		return nil, 0, nil, err
+	}
+	addr, err := btcutil.DecodeAddress(address, chainParams(a.isTestnet))
+	if err != nil {
+		return nil, 0, nil, fmt.Errorf("%w: %w", domain.ErrInvalidAddress, err)
+	}
+	return nil, 0, addr, nil
+}
+
+// walletUnspent returns the outputs paying addr, confirmed or in the mempool.
+func (a *Adapter) walletUnspent(
+	ctx context.Context, client *electrum.Client, addr btcutil.Address, path string,
+) ([]domain.UTXO, error) {
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create script: %w", err)
+	}
+	sh, err := addressToScripthash(addr.EncodeAddress(), a.isTestnet)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := listUnspent(ctx, client, sh, a.spanAttributes()...)
+	if err != nil {
+		return nil, err
+	}
+
+	return unspentOutputs(entries, addr.EncodeAddress(), hex.EncodeToString(pkScript), path, a.tipHeight.Load()), nil
+}
+
+func listUnspent(
+	ctx context.Context, client *electrum.Client, scripthash string, spanAttrs ...attribute.KeyValue,
+) ([]*electrum.ListUnspentResult, error) {
+	ctx, span := tracer.Start(ctx, "electrum.blockchain.scripthash.listunspent",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(spanAttrs...),
+		trace.WithAttributes(tracing.AttrRPCMethod.String("blockchain.scripthash.listunspent")),
+	)
+	entries, err := client.ListUnspent(ctx, scripthash)
+	tracing.End(span, err)
+	if err != nil {
+		return nil, fmt.Errorf("list unspent from electrum: %w", err)
+	}
+	return entries, nil
+}
+
+// unspentOutputs maps listunspent entries of one address; entries at height
+// zero are still in the mempool and have no confirmations.
+func unspentOutputs(entries []*electrum.ListUnspentResult, address, script, path string, tip int32) []domain.UTXO {
+	utxos := make([]domain.UTXO, len(entries))
+	for i, entry := range entries {
+		utxos[i] = domain.UTXO{
+			TransactionID:  entry.Hash,
+			Vout:           entry.Position,
+			Value:          entry.Value,
+			Amount:         float64(entry.Value) / SatoshiPerLTC,
+			ScriptPubKey:   script,
+			Address:        address,
+			DerivationPath: path,
+		}
+		if entry.Height > 0 {
+			height := int64(entry.Height)
+			utxos[i].BlockHeight = &height
+			if int64(tip) >= height {
+				utxos[i].Confirmations = int64(tip) - height + 1
+			}
+		}
+	}
+	return utxos
+}
@@ -0,0 +1,56 @@
+package litecoin_test
+
+import (
+	"testing"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/adapters/crypto/providers/litecoin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDescriptor(t *testing.T) {
+	t.Parallel()
+
+	receive, err := litecoin.ReceiveAddresses(bip84Zpub, 0, 1, false)
+	require.NoError(t, err)
+
+	addresses, err := litecoin.DescriptorAddresses("wpkh([73c5da0a/84h/2h/0h]"+bip84Zpub+"/<0;1>/*)", false)
+	require.NoError(t, err)
+	assert.Len(t, addresses, 2)
+	assert.Equal(t, receive[0].Address, addresses["m/84'/2'/0'/0/0"])
+	assert.Contains(t, addresses, "m/84'/2'/0'/1/0")
+
+	nested, err := litecoin.DescriptorAddresses("sh(wpkh("+bip84Zpub+"))", false)
+	require.NoError(t, err)
+	assert.Regexp(t, "^M", nested["m/49'/2'/0'/0/0"])
+
+	_, err = litecoin.DescriptorAddresses("tr("+bip84Zpub+"/0/*)", false)
+	require.ErrorIs(t, err, litecoin.ErrUnsupportedDescriptor)
+}
+
+func TestUnspentOutputs(t *testing.T) {
+	t.Parallel()
+
+	utxos := litecoin.UnspentOutputs([]*litecoin.ListUnspentResult{
+		{Height: 2500000, Position: 0, Hash: "cc", Value: 2500000},
+	}, "ltc1qaddr", "0014cd", "m/84'/2'/0'/0/0", 2500002)
+
+	require.Len(t, utxos, 1)
+	assert.InDelta(t, 0.025, utxos[0].Amount, 1e-12)
+	assert.Equal(t, int64(3), utxos[0].Confirmations)
+}
+
+func TestYoungValue(t *testing.T) {
+	t.Parallel()
+
+	entries := []*litecoin.ListUnspentResult{
+		{Hash: "aa", Height: 100, Value: 1000},
+		{Hash: "bb", Height: 99, Value: 2000},
+		{Hash: "cc", Height: 97, Value: 4000},
+		{Hash: "dd", Height: 0, Value: 8000},
+	}
+
+	assert.Equal(t, int64(3000), litecoin.YoungValue(entries, 100, 3))
+	assert.Equal(t, int64(0), litecoin.YoungValue(entries, 100, 1))
+	assert.Equal(t, int64(7000), litecoin.YoungValue(entries, 100, 6))
+}
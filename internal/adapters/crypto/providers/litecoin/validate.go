@@ -0,0 +1,115 @@
+package litecoin
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+	"github.com/btcsuite/btcd/btcutil"
+	hd "github.com/btcsuite/btcd/btcutil/hdkeychain"
+	"github.com/btcsuite/btcd/chaincfg"
+)
+
+type extendedKeyVersion struct {
+	format    string
+	isTestnet bool
+	script    scriptType
+}
+
+// extendedKeyVersions maps the public key versions Litecoin wallets export,
+// both the Litecoin specific and the Bitcoin style ones, to their prefix.
+var extendedKeyVersions = map[uint32]extendedKeyVersion{
+	0x0488b21e: {"xpub", false, scriptWitnessPubKeyHash},
+	0x049d7cb2: {"ypub", false, scriptNestedWitnessPubKeyHash},
+	0x04b24746: {"zpub", false, scriptWitnessPubKeyHash},
+	0x019da462: {"Ltub", false, scriptWitnessPubKeyHash},
+	0x01b26ef6: {"Mtub", false, scriptNestedWitnessPubKeyHash},
+	0x043587cf: {"tpub", true, scriptWitnessPubKeyHash},
+	0x044a5262: {"upub", true, scriptNestedWitnessPubKeyHash},
+	0x045f1cf6: {"vpub", true, scriptWitnessPubKeyHash},
+	0x0436f6e1: {"ttub", true, scriptWitnessPubKeyHash},
+}
+
+// ValidateAddress checks an address or extended public key against the
+// adapter's network without contacting the node.
+func (a *Adapter) ValidateAddress(address string) (*domain.AddressValidation, error) {
+	return validateAddress(address, a.isTestnet)
+}
+
+// validateAddress accepts base58 and bech32 addresses and extended public
+// keys of the given network. Legacy mainnet P2SH addresses starting with 3
+// share Bitcoin's prefix and are accepted as well.
+func validateAddress(address string, isTestnet bool) (*domain.AddressValidation, error) {
+	if key, err := hd.NewKeyFromString(address); err == nil {
+		return validateExtendedKey(address, key, isTestnet)
+	}
+
+	params, otherParams := chainParams(isTestnet), chainParams(!isTestnet)
+	addr, err := btcutil.DecodeAddress(address, params)
+	if err == nil && addr.IsForNet(params) {
+		return addressValidation(address, addressFormat(addr), isTestnet), nil
+	}
+	if !isTestnet {
+		if legacy, legacyErr := btcutil.DecodeAddress(address, &chaincfg.MainNetParams); legacyErr == nil {
+			if _, ok := legacy.(*btcutil.AddressScriptHash); ok {
+				return addressValidation(address, "p2sh", isTestnet), nil
+			}
+		}
+	}
+	if other, otherErr := btcutil.DecodeAddress(address, otherParams); otherErr == nil && other.IsForNet(otherParams) {
+		return nil, domain.NetworkMismatchError(isTestnet)
+	}
+	if err == nil {
+		err = fmt.Errorf("address is not for %s", params.Name)
+	}
+	return nil, fmt.Errorf("%w: %w", domain.ErrInvalidAddress, err)
+}
+
+func validateExtendedKey(address string, key *hd.ExtendedKey, isTestnet bool) (*domain.AddressValidation, error) {
+	if key.IsPrivate() {
+		return nil, fmt.Errorf("%w: extended private keys are not accepted", domain.ErrInvalidAddress)
+	}
+
+	version, ok := extendedKeyVersions[binary.BigEndian.Uint32(key.Version())]
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown extended public key version %x", domain.ErrInvalidAddress, key.Version())
+	}
+	if version.isTestnet != isTestnet {
+		return nil, domain.NetworkMismatchError(isTestnet)
+	}
+
+	return &domain.AddressValidation{
+		Address: address,
+		Kind:    domain.AddressKindExtendedKey,
+		Format:  version.format,
+		Network: domain.NetworkName(isTestnet),
+	}, nil
+}
+
+func addressValidation(address, format string, isTestnet bool) *domain.AddressValidation {
+	return &domain.AddressValidation{
+		Address: address,
+		Kind:    domain.AddressKindAddress,
+		Format:  format,
+		Network: domain.NetworkName(isTestnet),
+	}
+}
+
+func addressFormat(addr btcutil.Address) string {
+	switch addr.(type) {
+	case *btcutil.AddressPubKeyHash:
+		return "p2pkh"
+	case *btcutil.AddressScriptHash:
+		return "p2sh"
+	case *btcutil.AddressWitnessPubKeyHash:
+		return "p2wpkh"
+	case *btcutil.AddressWitnessScriptHash:
+		return "p2wsh"
+	case *btcutil.AddressTaproot:
+		return "p2tr"
+	case *btcutil.AddressPubKey:
+		return "p2pk"
+	default:
+		return "unknown"
+	}
+}
@@ -0,0 +1,46 @@
+package litecoin_test
+
+import (
+	"testing"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/adapters/crypto/providers/litecoin"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateAddress(t *testing.T) {
+	t.Parallel()
+
+	hash := make([]byte, 20)
+	segwit, err := btcutil.NewAddressWitnessPubKeyHash(hash, litecoin.LitecoinMainNetParams)
+	require.NoError(t, err)
+	legacy, err := btcutil.NewAddressPubKeyHash(hash, litecoin.LitecoinMainNetParams)
+	require.NoError(t, err)
+	testnet, err := btcutil.NewAddressWitnessPubKeyHash(hash, litecoin.LitecoinTestNetParams)
+	require.NoError(t, err)
+	bitcoinSegwit, err := btcutil.NewAddressWitnessPubKeyHash(hash, &chaincfg.MainNetParams)
+	require.NoError(t, err)
+
+	validation, err := litecoin.ValidateAddress(segwit.EncodeAddress(), false)
+	require.NoError(t, err)
+	assert.Equal(t, "p2wpkh", validation.Format)
+	assert.Contains(t, segwit.EncodeAddress(), "ltc1")
+
+	validation, err = litecoin.ValidateAddress(legacy.EncodeAddress(), false)
+	require.NoError(t, err)
+	assert.Equal(t, "p2pkh", validation.Format)
+
+	validation, err = litecoin.ValidateAddress("3J98t1WpEZ73CNmQviecrnyiWrnqRhWNLy", false)
+	require.NoError(t, err)
+	assert.Equal(t, "p2sh", validation.Format)
+
+	_, err = litecoin.ValidateAddress(testnet.EncodeAddress(), false)
+	require.ErrorIs(t, err, domain.ErrInvalidAddress)
+	assert.Contains(t, err.Error(), "testnet address used on mainnet")
+
+	_, err = litecoin.ValidateAddress(bitcoinSegwit.EncodeAddress(), false)
+	require.ErrorIs(t, err, domain.ErrInvalidAddress)
+}
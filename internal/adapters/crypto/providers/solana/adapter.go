@@ -7,12 +7,18 @@ import (
 	"sync"
 	"time"
 
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/tracing"
 	"github.com/gagliardetto/solana-go"
 	"github.com/gagliardetto/solana-go/rpc"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
+var tracer = tracing.Tracer("solana")
+
 var (
-	ErrInvalidSolanaAddress = errors.New("invalid Solana address format")
+	ErrInvalidSolanaAddress = &domain.Error{Code: domain.CodeInvalidAddress, Message: "invalid Solana address format"}
 )
 
 const (
@@ -29,21 +35,48 @@ type Adapter struct {
 	client    *rpc.Client
 	rpcURL    string
 	connected bool
+	isTestnet bool
 }
 
-func NewAdapter(rpcURL string) *Adapter {
+func NewAdapter(rpcURL string, isTestnet bool) *Adapter {
 	a := &Adapter{
-		rpcURL: rpcURL,
+		rpcURL:    rpcURL,
+		isTestnet: isTestnet,
 	}
 	a.connectWithRetry()
 	return a
 }
 
-func (a *Adapter) GetBalance(address string) (float64, error) {
+func (a *Adapter) GetBalance(ctx context.Context, address string) (float64, error) {
+	ctx, span := tracer.Start(ctx, "solana.GetBalance", trace.WithAttributes(a.spanAttributes()...))
+	breakdown, err := a.getBalance(ctx, address, domain.BalanceOptions{})
+	tracing.End(span, err)
+	if err != nil {
+		return 0, err
+	}
+	return breakdown.Total(), nil
+}
+
+// GetBalanceBreakdown reads the confirmed balance at opts.Commitment,
+// finalized by default, and books its difference to the processed balance as
+// pending funds.
+func (a *Adapter) GetBalanceBreakdown(
+	ctx context.Context, address string, opts domain.BalanceOptions,
+) (*domain.BalanceBreakdown, error) {
+	ctx, span := tracer.Start(ctx, "solana.GetBalanceBreakdown", trace.WithAttributes(a.spanAttributes()...))
+	breakdown, err := a.getBalance(ctx, address, opts)
+	tracing.End(span, err)
+	return breakdown, err
+}
+
+func (a *Adapter) getBalance(
+	ctx context.Context, address string, opts domain.BalanceOptions,
+) (*domain.BalanceBreakdown, error) {
 	pubkey, err := solana.PublicKeyFromBase58(address)
 	if err != nil {
-		return 0, ErrInvalidSolanaAddress
+		return nil, ErrInvalidSolanaAddress
 	}
+	commitment := commitmentType(opts.Commitment)
 
 	var lastErr error
 	for i := range MaxRetryAttempts {
@@ -53,13 +86,18 @@ func (a *Adapter) GetBalance(address string) (float64, error) {
 			continue
 		}
 
-		ctx, cancel := context.WithTimeout(context.Background(), BalanceTimeout)
-		balance, err := client.GetBalance(ctx, pubkey, rpc.CommitmentFinalized)
+		rpcCtx, cancel := context.WithTimeout(ctx, BalanceTimeout)
+		confirmed, err := a.fetchBalance(rpcCtx, client, pubkey, commitment)
+		processed := confirmed
+		if err == nil && commitment != rpc.CommitmentProcessed {
+			processed, err = a.fetchBalance(rpcCtx, client, pubkey, rpc.CommitmentProcessed)
+		}
 		cancel()
 
 		if err == nil {
-			solBalance := float64(balance.Value) / LamportsPerSol
-			return solBalance, nil
+			breakdown := domain.BalanceBreakdown{Confirmed: float64(confirmed) / LamportsPerSol}
+			breakdown.NetPending((float64(processed) - float64(confirmed)) / LamportsPerSol)
+			return &breakdown, nil
 		}
 
 		lastErr = err
@@ -72,7 +110,39 @@ func (a *Adapter) GetBalance(address string) (float64, error) {
 		time.Sleep(RetryDelay)
 	}
 
-	return 0, lastErr
+	return nil, domain.UpstreamError(lastErr)
+}
+
+// fetchBalance returns the lamports held by pubkey at commitment.
+func (a *Adapter) fetchBalance(
+	ctx context.Context, client *rpc.Client, pubkey solana.PublicKey, commitment rpc.CommitmentType,
+) (uint64, error) {
+	ctx, span := a.rpcSpan(ctx, "getBalance")
+	balance, err := client.GetBalance(ctx, pubkey, commitment)
+	tracing.End(span, err)
+	if err != nil {
+		return 0, err
+	}
+	return balance.Value, nil
+}
+
+// commitmentType maps a domain commitment to the RPC one, finalized when unset.
+func commitmentType(c domain.Commitment) rpc.CommitmentType {
+	switch c {
+	case domain.CommitmentProcessed:
+		return rpc.CommitmentProcessed
+	case domain.CommitmentConfirmed:
+		return rpc.CommitmentConfirmed
+	default:
+		return rpc.CommitmentFinalized
+	}
+}
+
+func (a *Adapter) rpcSpan(ctx context.Context, method string) (context.Context, trace.Span) {
+	return tracer.Start(ctx, method, trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(a.spanAttributes()...),
+		trace.WithAttributes(tracing.AttrRPCMethod.String(method)),
+	)
 }
 
 func (a *Adapter) connectWithRetry() {
@@ -109,9 +179,28 @@ func (a *Adapter) getClient() *rpc.Client {
 	return a.client
 }
 
+// connectedClient returns the current client, reconnecting first when the
+// connection was lost.
+func (a *Adapter) connectedClient() *rpc.Client {
+	client := a.getClient()
+	if client == nil {
+		a.connectWithRetry()
+		client = a.getClient()
+	}
+	return client
+}
+
 func (a *Adapter) Close() {
 	a.mu.Lock()
 	defer a.mu.Unlock()
 	a.client = nil
 	a.connected = false
 }
+
+func (a *Adapter) spanAttributes() []attribute.KeyValue {
+	return []attribute.KeyValue{
+		tracing.AttrChainSymbol.String("SOL"),
+		tracing.AttrNetwork.String(tracing.NetworkOf(a.isTestnet)),
+		tracing.AttrEndpoint.String(tracing.Endpoint(a.rpcURL)),
+	}
+}
@@ -0,0 +1,42 @@
+package solana_test
+
+import (
+	"testing"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/adapters/crypto/providers/solana"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommitmentType(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, rpc.CommitmentFinalized, solana.CommitmentType(""))
+	assert.Equal(t, rpc.CommitmentFinalized, solana.CommitmentType(domain.CommitmentFinalized))
+	assert.Equal(t, rpc.CommitmentConfirmed, solana.CommitmentType(domain.CommitmentConfirmed))
+	assert.Equal(t, rpc.CommitmentProcessed, solana.CommitmentType(domain.CommitmentProcessed))
+}
+
+func TestFeeEstimates(t *testing.T) {
+	t.Parallel()
+
+	estimates := solana.FeeEstimates([]rpc.PriorizationFeeResult{
+		{Slot: 1, PrioritizationFee: 0},
+		{Slot: 2, PrioritizationFee: 1000},
+		{Slot: 3, PrioritizationFee: 500},
+		{Slot: 4, PrioritizationFee: 20000},
+		{Slot: 5, PrioritizationFee: 100},
+	})
+
+	require.Len(t, estimates, 3)
+	assert.Equal(t, domain.FeeLevelSlow, estimates[0].Level)
+	assert.InDelta(t, 100.0, estimates[0].Rate, 1e-9)
+	assert.InDelta(t, 500.0, estimates[1].Rate, 1e-9)
+	assert.InDelta(t, 1000.0, estimates[2].Rate, 1e-9)
+	assert.InDelta(t, (5000+1000*0.2)/1e9, estimates[2].Amount, 1e-15)
+
+	empty := solana.FeeEstimates(nil)
+	assert.InDelta(t, 5000/1e9, empty[1].Amount, 1e-15)
+}
@@ -0,0 +1,188 @@
+package solana
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/tracing"
+	"github.com/gagliardetto/solana-go"
+	computebudget "github.com/gagliardetto/solana-go/programs/compute-budget"
+	"github.com/gagliardetto/solana-go/programs/system"
+	"github.com/gagliardetto/solana-go/programs/token"
+	"github.com/gagliardetto/solana-go/rpc"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// programNames names the programs instructions are commonly made to.
+var programNames = map[solana.PublicKey]string{
+	solana.SystemProgramID:                    "system",
+	solana.TokenProgramID:                     "spl-token",
+	solana.Token2022ProgramID:                 "spl-token-2022",
+	solana.SPLAssociatedTokenAccountProgramID: "spl-associated-token-account",
+	solana.MemoProgramID:                      "spl-memo",
+	solana.ComputeBudget:                      "compute-budget",
+	solana.StakeProgramID:                     "stake",
+	solana.VoteProgramID:                      "vote",
+	solana.AddressLookupTableProgramID:        "address-lookup-table",
+}
+
+// GetTransactionDetail decodes the transaction of signature txid: every
+// instruction, and the System and SPL token transfers among them. With
+// wallet set, the transfer sides of that address are marked owned.
+func (a *Adapter) GetTransactionDetail(ctx context.Context, txid, wallet string) (*domain.TransactionDetail, error) {
+	ctx, span := tracer.Start(ctx, "solana.GetTransactionDetail", trace.WithAttributes(a.spanAttributes()...))
+	detail, err := a.getTransactionDetail(ctx, txid, wallet)
+	tracing.End(span, err)
+	return detail, err
+}
+
+func (a *Adapter) getTransactionDetail(ctx context.Context, txid, wallet string) (*domain.TransactionDetail, error) {
+	signature, err := solana.SignatureFromBase58(txid)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid transaction id %q", domain.ErrBadRequest, txid)
+	}
+	var owner solana.PublicKey
+	if wallet != "" {
+		if owner, err = solana.PublicKeyFromBase58(wallet); err != nil {
+			return nil, ErrInvalidSolanaAddress
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, HistoryTimeout)
+	defer cancel()
+	client := a.connectedClient()
+
+	result, err := a.transaction(ctx, client, signature)
+	if errors.Is(err, rpc.ErrNotFound) {
+		return nil, fmt.Errorf("%w: %s", domain.ErrTransactionNotFound, txid)
+	}
+	if err != nil {
+		return nil, domain.UpstreamError(err)
+	}
+
+	rpcCtx, span := a.rpcSpan(ctx, "getSlot")
+	head, err := client.GetSlot(rpcCtx, rpc.CommitmentConfirmed)
+	tracing.End(span, err)
+	if err != nil {
+		return nil, domain.UpstreamError(err)
+	}
+	noRewards := false
+	rpcCtx, span = a.rpcSpan(ctx, "getBlock")
+	block, err := client.GetBlockWithOpts(rpcCtx, result.Slot, &rpc.GetBlockOpts{
+		TransactionDetails: rpc.TransactionDetailsNone,
+		Rewards:            &noRewards,
+		Commitment:         rpc.CommitmentConfirmed,
+	})
+	tracing.End(span, err)
+	if err != nil {
+		return nil, domain.UpstreamError(err)
+	}
+
+	detail, err := transactionDetail(owner, signature.String(), result, head)
+	if err != nil {
+		return nil, domain.UpstreamError(err)
+	}
+	detail.BlockHash = block.Blockhash.String()
+	return detail, nil
+}
+
+// transactionDetail decodes one transaction. The transfers of a failed
+// transaction are left out, since only its fee was taken.
+func transactionDetail(
+	wallet solana.PublicKey, signature string, result *rpc.GetTransactionResult, head uint64,
+) (*domain.TransactionDetail, error) {
+	if result.Transaction == nil || result.Meta == nil {
+		return nil, fmt.Errorf("transaction %s has no metadata", signature)
+	}
+	tx, err := result.Transaction.GetTransaction()
+	if err != nil {
+		return nil, fmt.Errorf("decode transaction %s: %w", signature, err)
+	}
+	meta := result.Meta
+
+	keys := make([]solana.PublicKey, 0, len(tx.Message.AccountKeys)+
+		len(meta.LoadedAddresses.Writable)+len(meta.LoadedAddresses.ReadOnly))
+	keys = append(keys, tx.Message.AccountKeys...)
+	keys = append(keys, meta.LoadedAddresses.Writable...)
+	keys = append(keys, meta.LoadedAddresses.ReadOnly...)
+
+	fee := float64(meta.Fee) / LamportsPerSol
+	blockHeight := int64(result.Slot) //nolint:gosec // slots fit in int64
+	detail := &domain.TransactionDetail{
+		TransactionID: signature,
+		Status:        domain.TransactionConfirmed,
+		BlockHeight:   &blockHeight,
+		FeeAmount:     &fee,
+		Instructions:  transactionInstructions(tx, meta, keys),
+	}
+	if result.BlockTime != nil {
+		blockTime := result.BlockTime.Time()
+		detail.Timestamp = &blockTime
+	}
+	if head >= result.Slot {
+		detail.Confirmations = int64(head-result.Slot) + 1 //nolint:gosec // slots fit in int64
+	}
+	if meta.Err != nil {
+		detail.Status = domain.TransactionFailed
+		return detail, nil
+	}
+
+	for _, m := range transfers(tx, meta, keys) {
+		transfer := domain.TransactionTransfer{
+			From:      m.from.String(),
+			To:        m.to.String(),
+			Amount:    unitsToFloat(new(big.Int).SetUint64(m.units), m.decimals),
+			FromOwned: !wallet.IsZero() && m.from.Equals(wallet),
+			ToOwned:   !wallet.IsZero() && m.to.Equals(wallet),
+		}
+		if !m.mint.IsZero() {
+			transfer.TokenContract = m.mint.String()
+		}
+		detail.Transfers = append(detail.Transfers, transfer)
+	}
+	return detail, nil
+}
+
+func transactionInstructions(
+	tx *solana.Transaction, meta *rpc.TransactionMeta, keys []solana.PublicKey,
+) []domain.TransactionInstruction {
+	var out []domain.TransactionInstruction
+	for _, instruction := range instructions(tx, meta) {
+		decoded := domain.TransactionInstruction{
+			Index:  instruction.index,
+			Parent: instruction.parent,
+			Data:   instruction.Data.String(),
+		}
+		if int(instruction.ProgramIDIndex) < len(keys) {
+			program := keys[instruction.ProgramIDIndex]
+			decoded.ProgramID = program.String()
+			decoded.Program = programNames[program]
+			decoded.Type = instructionType(program, instruction.Data)
+		}
+		for _, index := range instruction.Accounts {
+			if int(index) < len(keys) {
+				decoded.Accounts = append(decoded.Accounts, keys[index].String())
+			}
+		}
+		out = append(out, decoded)
+	}
+	return out
+}
+
+// instructionType names an instruction of the System, SPL token and compute
+// budget programs from its discriminator, or returns "" for other programs.
+func instructionType(program solana.PublicKey, data []byte) string {
+	switch {
+	case program.Equals(solana.SystemProgramID) && len(data) >= 4:
+		return system.InstructionIDToName(binary.LittleEndian.Uint32(data))
+	case (program.Equals(solana.TokenProgramID) || program.Equals(solana.Token2022ProgramID)) && len(data) >= 1:
+		return token.InstructionIDToName(data[0])
+	case program.Equals(solana.ComputeBudget) && len(data) >= 1:
+		return computebudget.InstructionIDToName(data[0])
+	}
+	return ""
+}
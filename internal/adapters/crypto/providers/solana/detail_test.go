@@ -0,0 +1,59 @@
+package solana_test
+
+import (
+	"testing"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/adapters/crypto/providers/solana"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+	solanago "github.com/gagliardetto/solana-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdapter_GetTransactionDetail(t *testing.T) {
+	t.Parallel()
+
+	signature := solanago.Signature{1}
+	adapter := solana.NewAdapter(historyNode(t, []solanago.Signature{signature}).URL, false)
+
+	detail, err := adapter.GetTransactionDetail(t.Context(), signature.String(), recipient.String())
+	require.NoError(t, err)
+	assert.Equal(t, signature.String(), detail.TransactionID)
+	assert.Equal(t, domain.TransactionConfirmed, detail.Status)
+	require.NotNil(t, detail.BlockHeight)
+	assert.Equal(t, int64(historySlot), *detail.BlockHeight)
+	assert.Equal(t, solanago.Hash{7}.String(), detail.BlockHash)
+	assert.Equal(t, int64(headSlot-historySlot+1), detail.Confirmations)
+	require.NotNil(t, detail.Timestamp)
+	assert.Equal(t, int64(1700000000), detail.Timestamp.Unix())
+	require.NotNil(t, detail.FeeAmount)
+	assert.InDelta(t, 0.000005, *detail.FeeAmount, 1e-12)
+
+	assert.Equal(t, []domain.TransactionTransfer{
+		{From: sender.String(), To: recipient.String(), Amount: 1, ToOwned: true},
+		{From: sender.String(), To: recipient.String(), Amount: 2.5, TokenContract: mintKey.String(), ToOwned: true},
+	}, detail.Transfers)
+
+	require.Len(t, detail.Instructions, 2)
+	assert.Equal(t, 0, detail.Instructions[0].Index)
+	assert.Nil(t, detail.Instructions[0].Parent)
+	assert.Equal(t, solanago.SystemProgramID.String(), detail.Instructions[0].ProgramID)
+	assert.Equal(t, "system", detail.Instructions[0].Program)
+	assert.Equal(t, "Transfer", detail.Instructions[0].Type)
+	assert.Equal(t, []string{sender.String(), recipient.String()}, detail.Instructions[0].Accounts)
+	assert.Equal(t, "spl-token", detail.Instructions[1].Program)
+	assert.Equal(t, "TransferChecked", detail.Instructions[1].Type)
+	assert.Len(t, detail.Instructions[1].Accounts, 4)
+}
+
+func TestAdapter_GetTransactionDetail_Invalid(t *testing.T) {
+	t.Parallel()
+
+	adapter := solana.NewAdapter(historyNode(t, nil).URL, false)
+
+	_, err := adapter.GetTransactionDetail(t.Context(), "42", "")
+	require.ErrorIs(t, err, domain.ErrBadRequest)
+
+	_, err = adapter.GetTransactionDetail(t.Context(), solanago.Signature{1}.String(), "not-base58")
+	require.ErrorIs(t, err, solana.ErrInvalidSolanaAddress)
+}
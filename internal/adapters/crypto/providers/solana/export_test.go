@@ -0,0 +1,8 @@
+package solana
+
+var (
+	ValidateAddress  = validateAddress
+	CommitmentType   = commitmentType
+	FeeEstimates     = feeEstimates
+	ComputeUnitPrice = computeUnitPrice
+)
@@ -0,0 +1,86 @@
+package solana
+
+import (
+	"context"
+	"slices"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/tracing"
+	"github.com/gagliardetto/solana-go/rpc"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// SignatureFeeLamports is the base fee charged per transaction signature.
+	SignatureFeeLamports = 5000
+	// TypicalComputeUnits is the default compute unit limit of a transaction
+	// without a ComputeBudget instruction, the size fee amounts are priced for.
+	TypicalComputeUnits = 200_000
+
+	microLamportsPerLamport = 1e6
+)
+
+// feePercentiles are the priority fee percentiles of each fee level.
+var feePercentiles = []struct {
+	level      domain.FeeLevel
+	percentile int
+}{
+	{domain.FeeLevelSlow, 25},
+	{domain.FeeLevelNormal, 50},
+	{domain.FeeLevelFast, 75},
+}
+
+// EstimateFees prices each fee level from the priority fees paid in recent
+// slots, from getRecentPrioritizationFees, on top of the signature fee.
+func (a *Adapter) EstimateFees(ctx context.Context) (*domain.FeeEstimates, error) {
+	ctx, span := tracer.Start(ctx, "solana.EstimateFees", trace.WithAttributes(a.spanAttributes()...))
+	fees, err := a.estimateFees(ctx)
+	tracing.End(span, err)
+	return fees, err
+}
+
+func (a *Adapter) estimateFees(ctx context.Context) (*domain.FeeEstimates, error) {
+	client := a.connectedClient()
+
+	rpcCtx, cancel := context.WithTimeout(ctx, BalanceTimeout)
+	defer cancel()
+	rpcCtx, span := a.rpcSpan(rpcCtx, "getRecentPrioritizationFees")
+	recent, err := client.GetRecentPrioritizationFees(rpcCtx, nil)
+	tracing.End(span, err)
+	if err != nil {
+		return nil, domain.UpstreamError(err)
+	}
+
+	return &domain.FeeEstimates{
+		CryptoSymbol: "SOL",
+		RateUnit:     "micro-lamports/CU",
+		SizeUnit:     "compute units",
+		TypicalSize:  TypicalComputeUnits,
+		Estimates:    feeEstimates(recent),
+	}, nil
+}
+
+// feeEstimates takes the feePercentiles of the recent priority fees. Rate is
+// the priority fee per compute unit; Amount adds the signature fee.
+func feeEstimates(recent []rpc.PriorizationFeeResult) []domain.FeeEstimate {
+	fees := make([]uint64, len(recent))
+	for i, r := range recent {
+		fees[i] = r.PrioritizationFee
+	}
+	slices.Sort(fees)
+
+	estimates := make([]domain.FeeEstimate, len(feePercentiles))
+	for i, p := range feePercentiles {
+		var rate float64
+		if len(fees) > 0 {
+			rate = float64(fees[p.percentile*(len(fees)-1)/100])
+		}
+		priority := rate * TypicalComputeUnits / microLamportsPerLamport
+		estimates[i] = domain.FeeEstimate{
+			Level:  p.level,
+			Rate:   rate,
+			Amount: (SignatureFeeLamports + priority) / LamportsPerSol,
+		}
+	}
+	return estimates
+}
@@ -0,0 +1,494 @@
+package solana
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/tracing"
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/programs/system"
+	"github.com/gagliardetto/solana-go/programs/token"
+	"github.com/gagliardetto/solana-go/rpc"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// HistoryTimeout bounds all node calls made for one page of history.
+	HistoryTimeout = 60 * time.Second
+	// MaxHistoryTransactions caps the signatures GetTransactions lists. Older
+	// history is reached by paging with GetTransactionPage.
+	MaxHistoryTransactions = 500
+
+	// signaturesPerCall is the most signatures getSignaturesForAddress
+	// returns in one call.
+	signaturesPerCall = 1000
+)
+
+var ErrInvalidCursor = &domain.Error{
+	Code:    domain.CodeBadRequest,
+	Message: "invalid cursor, expected a transaction signature",
+}
+
+// GetTransactions returns the newest MaxHistoryTransactions signatures of
+// address as history entries, newest first.
+func (a *Adapter) GetTransactions(ctx context.Context, address string) ([]domain.Transaction, error) {
+	ctx, span := tracer.Start(ctx, "solana.GetTransactions", trace.WithAttributes(a.spanAttributes()...))
+	page, err := a.getTransactionPage(ctx, address, domain.PageRequest{Limit: MaxHistoryTransactions})
+	tracing.End(span, err)
+	if err != nil {
+		return nil, err
+	}
+	return page.Transactions, nil
+}
+
+// GetTransactionPage returns the history entries of page.Limit signatures of
+// address, starting below the signature in page.Cursor or page.Offset
+// signatures below the newest one. Lamports the address gained or lost make
+// one entry per signature, with the fee when the address paid it; every SPL
+// token it gained or lost makes an entry of its own under the same signature.
+// Signatures that changed neither are listed by the node but have no entry, so
+// a page can hold fewer entries than signatures. NextCursor is the oldest
+// signature of the page.
+func (a *Adapter) GetTransactionPage(
+	ctx context.Context, address string, page domain.PageRequest,
+) (*domain.TransactionPage, error) {
+	ctx, span := tracer.Start(ctx, "solana.GetTransactionPage", trace.WithAttributes(a.spanAttributes()...))
+	result, err := a.getTransactionPage(ctx, address, page)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (a *Adapter) getTransactionPage(
+	ctx context.Context, address string, page domain.PageRequest,
+) (*domain.TransactionPage, error) {
+	wallet, err := solana.PublicKeyFromBase58(address)
+	if err != nil {
+		return nil, ErrInvalidSolanaAddress
+	}
+
+	var before solana.Signature
+	skip := page.Offset
+	if page.Cursor != "" {
+		if before, err = solana.SignatureFromBase58(page.Cursor); err != nil {
+			return nil, ErrInvalidCursor
+		}
+		skip = 0
+	}
+	if page.Limit <= 0 {
+		return &domain.TransactionPage{}, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, HistoryTimeout)
+	defer cancel()
+	client := a.connectedClient()
+
+	// One signature more than the page tells whether there is a next page.
+	signatures, err := a.signatures(ctx, client, wallet, before, skip+page.Limit+1)
+	if err != nil {
+		return nil, domain.UpstreamError(err)
+	}
+	signatures = signatures[min(skip, len(signatures)):]
+
+	result := &domain.TransactionPage{Transactions: []domain.Transaction{}}
+	if len(signatures) > page.Limit {
+		signatures = signatures[:page.Limit]
+		result.NextCursor = signatures[len(signatures)-1].Signature.String()
+	}
+	if len(signatures) == 0 {
+		return result, nil
+	}
+
+	rpcCtx, span := a.rpcSpan(ctx, "getSlot")
+	head, err := client.GetSlot(rpcCtx, rpc.CommitmentConfirmed)
+	tracing.End(span, err)
+	if err != nil {
+		return nil, domain.UpstreamError(err)
+	}
+
+	for _, signature := range signatures {
+		tx, err := a.transaction(ctx, client, signature.Signature)
+		if errors.Is(err, rpc.ErrNotFound) {
+			// The node no longer keeps the ledger this old.
+			continue
+		}
+		if err != nil {
+			return nil, domain.UpstreamError(err)
+		}
+
+		entries, err := walletEntries(wallet, signature.Signature.String(), tx, head)
+		if err != nil {
+			return nil, domain.UpstreamError(err)
+		}
+		result.Transactions = append(result.Transactions, entries...)
+	}
+	return result, nil
+}
+
+// signatures returns up to n signatures of wallet older than before, or the
+// newest ones when before is zero, newest first.
+func (a *Adapter) signatures(
+	ctx context.Context, client *rpc.Client, wallet solana.PublicKey, before solana.Signature, n int,
+) ([]*rpc.TransactionSignature, error) {
+	var signatures []*rpc.TransactionSignature
+	for len(signatures) < n {
+		limit := min(n-len(signatures), signaturesPerCall)
+
+		rpcCtx, span := a.rpcSpan(ctx, "getSignaturesForAddress")
+		batch, err := client.GetSignaturesForAddressWithOpts(rpcCtx, wallet, &rpc.GetSignaturesForAddressOpts{
+			Limit:      &limit,
+			Before:     before,
+			Commitment: rpc.CommitmentConfirmed,
+		})
+		tracing.End(span, err)
+		if err != nil {
+			return nil, err
+		}
+
+		signatures = append(signatures, batch...)
+		if len(batch) < limit {
+			break
+		}
+		before = batch[len(batch)-1].Signature
+	}
+	return signatures, nil
+}
+
+func (a *Adapter) transaction(
+	ctx context.Context, client *rpc.Client, signature solana.Signature,
+) (*rpc.GetTransactionResult, error) {
+	version := uint64(0)
+	rpcCtx, span := a.rpcSpan(ctx, "getTransaction")
+	tx, err := client.GetTransaction(rpcCtx, signature, &rpc.GetTransactionOpts{
+		Encoding:                       solana.EncodingBase64,
+		Commitment:                     rpc.CommitmentConfirmed,
+		MaxSupportedTransactionVersion: &version,
+	})
+	tracing.End(span, err)
+	return tx, err
+}
+
+// tokenHolding is what one owner held of one mint before and after a
+// transaction, in the smallest unit of the mint.
+type tokenHolding struct {
+	mint      solana.PublicKey
+	decimals  uint8
+	pre, post *big.Int
+	from, to  []string
+}
+
+// walletEntries maps one transaction into the history entries of wallet: the
+// net lamports it gained or lost, and the net amount of every mint it holds
+// that changed. Balances are read from the transaction metadata, so failed
+// transactions only cost the fee and program-driven transfers such as swaps
+// are netted too.
+func walletEntries(
+	wallet solana.PublicKey, signature string, result *rpc.GetTransactionResult, head uint64,
+) ([]domain.Transaction, error) {
+	if result.Transaction == nil || result.Meta == nil {
+		return nil, fmt.Errorf("transaction %s has no metadata", signature)
+	}
+	tx, err := result.Transaction.GetTransaction()
+	if err != nil {
+		return nil, fmt.Errorf("decode transaction %s: %w", signature, err)
+	}
+	meta := result.Meta
+
+	keys := make([]solana.PublicKey, 0, len(tx.Message.AccountKeys)+
+		len(meta.LoadedAddresses.Writable)+len(meta.LoadedAddresses.ReadOnly))
+	keys = append(keys, tx.Message.AccountKeys...)
+	keys = append(keys, meta.LoadedAddresses.Writable...)
+	keys = append(keys, meta.LoadedAddresses.ReadOnly...)
+
+	base := domain.Transaction{TransactionID: signature, Timestamp: time.Now()}
+	if result.BlockTime != nil {
+		base.Timestamp = result.BlockTime.Time()
+	}
+	blockHeight := int64(result.Slot) //nolint:gosec // slots fit in int64
+	base.BlockHeight = &blockHeight
+	if head >= result.Slot {
+		base.Confirmations = int64(head-result.Slot) + 1 //nolint:gosec // slots fit in int64
+	}
+
+	moves := transfers(tx, meta, keys)
+	var txs []domain.Transaction
+
+	if entry, ok := lamportEntry(base, wallet, keys, meta, moves); ok {
+		txs = append(txs, entry)
+	}
+
+	for _, holding := range tokenHoldings(wallet, meta, moves) {
+		net := new(big.Int).Sub(holding.post, holding.pre)
+		if net.Sign() == 0 {
+			continue
+		}
+		entry := base
+		entry.Amount = unitsToFloat(new(big.Int).Abs(net), holding.decimals)
+		entry.Direction = domain.DirectionIncoming
+		if net.Sign() < 0 {
+			entry.Direction = domain.DirectionOutgoing
+		}
+		entry.FromAddresses, entry.ToAddresses = holding.from, holding.to
+		entry.TokenContract = holding.mint.String()
+		txs = append(txs, entry)
+	}
+	return txs, nil
+}
+
+// lamportEntry fills tx in as the SOL entry of wallet. It is dropped when the
+// lamports of wallet did not change and wallet did not pay the fee.
+func lamportEntry(
+	tx domain.Transaction, wallet solana.PublicKey, keys []solana.PublicKey, meta *rpc.TransactionMeta, moves []move,
+) (domain.Transaction, bool) {
+	index := -1
+	for i, key := range keys {
+		if key.Equals(wallet) {
+			index = i
+			break
+		}
+	}
+	if index < 0 || index >= len(meta.PreBalances) || index >= len(meta.PostBalances) {
+		return domain.Transaction{}, false
+	}
+
+	// The fee payer is always the first account of the message.
+	feePayer := index == 0
+	net := int64(meta.PostBalances[index]) - int64(meta.PreBalances[index]) //nolint:gosec // lamports fit in int64
+	if net == 0 && !feePayer {
+		return domain.Transaction{}, false
+	}
+
+	tx.Amount = float64(absInt64(net)) / LamportsPerSol
+	tx.Direction = domain.DirectionIncoming
+	if net < 0 {
+		tx.Direction = domain.DirectionOutgoing
+	}
+	if feePayer {
+		fee := float64(meta.Fee) / LamportsPerSol
+		tx.FeeAmount = &fee
+	}
+	for _, m := range moves {
+		if m.mint.IsZero() && (m.from.Equals(wallet) || m.to.Equals(wallet)) {
+			tx.FromAddresses = appendAddress(tx.FromAddresses, m.from.String())
+			tx.ToAddresses = appendAddress(tx.ToAddresses, m.to.String())
+		}
+	}
+	return tx, true
+}
+
+// tokenHoldings sums the token balances of every account wallet owns per
+// mint, in the order the mints appear in the metadata, and attaches the SPL
+// transfers of that mint to or from wallet.
+func tokenHoldings(wallet solana.PublicKey, meta *rpc.TransactionMeta, moves []move) []*tokenHolding {
+	var holdings []*tokenHolding
+	byMint := make(map[solana.PublicKey]*tokenHolding)
+
+	add := func(balances []rpc.TokenBalance, post bool) {
+		for _, balance := range balances {
+			if balance.Owner == nil || !balance.Owner.Equals(wallet) || balance.UiTokenAmount == nil {
+				continue
+			}
+			amount, ok := new(big.Int).SetString(balance.UiTokenAmount.Amount, 10)
+			if !ok {
+				continue
+			}
+
+			holding, ok := byMint[balance.Mint]
+			if !ok {
+				holding = &tokenHolding{
+					mint:     balance.Mint,
+					decimals: balance.UiTokenAmount.Decimals,
+					pre:      new(big.Int),
+					post:     new(big.Int),
+				}
+				byMint[balance.Mint] = holding
+				holdings = append(holdings, holding)
+			}
+			if post {
+				holding.post.Add(holding.post, amount)
+			} else {
+				holding.pre.Add(holding.pre, amount)
+			}
+		}
+	}
+	add(meta.PreTokenBalances, false)
+	add(meta.PostTokenBalances, true)
+
+	for _, m := range moves {
+		holding, ok := byMint[m.mint]
+		if !ok || m.mint.IsZero() || !(m.from.Equals(wallet) || m.to.Equals(wallet)) {
+			continue
+		}
+		holding.from = appendAddress(holding.from, m.from.String())
+		holding.to = appendAddress(holding.to, m.to.String())
+	}
+	return holdings
+}
+
+// move is a System transfer of units lamports, with a zero mint, or an SPL
+// transfer of units of mint between the owners of the token accounts
+// involved.
+type move struct {
+	from, to solana.PublicKey
+	mint     solana.PublicKey
+	units    uint64
+	decimals uint8
+}
+
+// transfers decodes the System and SPL token transfers of tx, including those
+// made by inner instructions. Other instructions are skipped.
+func transfers(tx *solana.Transaction, meta *rpc.TransactionMeta, keys []solana.PublicKey) []move {
+	// Token accounts are resolved to their owner and mint from the balances
+	// the node reports for them.
+	tokenAccounts := make(map[solana.PublicKey]rpc.TokenBalance)
+	for _, balances := range [][]rpc.TokenBalance{meta.PreTokenBalances, meta.PostTokenBalances} {
+		for _, balance := range balances {
+			if int(balance.AccountIndex) < len(keys) {
+				tokenAccounts[keys[balance.AccountIndex]] = balance
+			}
+		}
+	}
+	owner := func(account solana.PublicKey) solana.PublicKey {
+		if balance, ok := tokenAccounts[account]; ok && balance.Owner != nil {
+			return *balance.Owner
+		}
+		return account
+	}
+
+	var moves []move
+	for _, instruction := range instructions(tx, meta) {
+		program, accounts, ok := resolveAccounts(instruction.CompiledInstruction, keys)
+		if !ok {
+			continue
+		}
+
+		switch {
+		case program.Equals(solana.SystemProgramID):
+			decoded, err := system.DecodeInstruction(accounts, instruction.Data)
+			if err != nil {
+				continue
+			}
+			switch impl := decoded.Impl.(type) {
+			case *system.Transfer:
+				moves = append(moves, move{
+					from:     impl.GetFundingAccount().PublicKey,
+					to:       impl.GetRecipientAccount().PublicKey,
+					units:    *impl.Lamports,
+					decimals: lamportDecimals,
+				})
+			case *system.TransferWithSeed:
+				moves = append(moves, move{
+					from:     impl.GetFundingAccount().PublicKey,
+					to:       impl.GetRecipientAccount().PublicKey,
+					units:    *impl.Lamports,
+					decimals: lamportDecimals,
+				})
+			}
+
+		case program.Equals(solana.TokenProgramID) || program.Equals(solana.Token2022ProgramID):
+			decoded, err := token.DecodeInstruction(accounts, instruction.Data)
+			if err != nil {
+				continue
+			}
+			switch impl := decoded.Impl.(type) {
+			case *token.Transfer:
+				source := impl.GetSourceAccount().PublicKey
+				destination := impl.GetDestinationAccount().PublicKey
+				balance, ok := tokenAccounts[destination]
+				if !ok {
+					balance = tokenAccounts[source]
+				}
+				m := move{from: owner(source), to: owner(destination), mint: balance.Mint, units: *impl.Amount}
+				if balance.UiTokenAmount != nil {
+					m.decimals = balance.UiTokenAmount.Decimals
+				}
+				moves = append(moves, m)
+			case *token.TransferChecked:
+				moves = append(moves, move{
+					from:     owner(impl.GetSourceAccount().PublicKey),
+					to:       owner(impl.GetDestinationAccount().PublicKey),
+					mint:     impl.GetMintAccount().PublicKey,
+					units:    *impl.Amount,
+					decimals: *impl.Decimals,
+				})
+			}
+		}
+	}
+	return moves
+}
+
+// instruction is an instruction of a transaction with where it sits: Index
+// among the top-level instructions, or among the inner instructions invoked
+// by the top-level instruction parent.
+type instruction struct {
+	rpc.CompiledInstruction
+	index  int
+	parent *int
+}
+
+// instructions lists the instructions of tx, each top-level one followed by
+// the inner instructions it invoked.
+func instructions(tx *solana.Transaction, meta *rpc.TransactionMeta) []instruction {
+	inner := make(map[int][]rpc.CompiledInstruction, len(meta.InnerInstructions))
+	for _, group := range meta.InnerInstructions {
+		inner[int(group.Index)] = append(inner[int(group.Index)], group.Instructions...)
+	}
+
+	var out []instruction
+	for i, compiled := range tx.Message.Instructions {
+		out = append(out, instruction{
+			CompiledInstruction: rpc.CompiledInstruction{
+				ProgramIDIndex: compiled.ProgramIDIndex,
+				Accounts:       compiled.Accounts,
+				Data:           compiled.Data,
+			},
+			index: i,
+		})
+		for j, invoked := range inner[i] {
+			out = append(out, instruction{CompiledInstruction: invoked, index: j, parent: &i})
+		}
+	}
+	return out
+}
+
+// resolveAccounts looks up the program and accounts of instruction in keys.
+func resolveAccounts(
+	instruction rpc.CompiledInstruction, keys []solana.PublicKey,
+) (solana.PublicKey, []*solana.AccountMeta, bool) {
+	if int(instruction.ProgramIDIndex) >= len(keys) {
+		return solana.PublicKey{}, nil, false
+	}
+	accounts := make([]*solana.AccountMeta, len(instruction.Accounts))
+	for i, index := range instruction.Accounts {
+		if int(index) >= len(keys) {
+			return solana.PublicKey{}, nil, false
+		}
+		accounts[i] = &solana.AccountMeta{PublicKey: keys[index]}
+	}
+	return keys[instruction.ProgramIDIndex], accounts, true
+}
+
+func unitsToFloat(units *big.Int, decimals uint8) float64 {
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil)
+	value, _ := new(big.Float).Quo(new(big.Float).SetInt(units), new(big.Float).SetInt(scale)).Float64()
+	return value
+}
+
+func appendAddress(addresses []string, address string) []string {
+	for _, known := range addresses {
+		if known == address {
+			return addresses
+		}
+	}
+	return append(addresses, address)
+}
+
+func absInt64(v int64) int64 {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
@@ -0,0 +1,197 @@
+package solana_test
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/adapters/crypto/providers/solana"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+	solanago "github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/programs/system"
+	"github.com/gagliardetto/solana-go/programs/token"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	historySlot = 1000
+	headSlot    = 1009
+)
+
+// paymentTransaction returns a transaction in which sender pays recipient one
+// SOL and 2.5 tokens of mintKey, with the getTransaction metadata of it.
+func paymentTransaction(t *testing.T) (string, string) {
+	t.Helper()
+
+	source, _, err := solanago.FindAssociatedTokenAddress(sender, mintKey)
+	require.NoError(t, err)
+	destination, _, err := solanago.FindAssociatedTokenAddress(recipient, mintKey)
+	require.NoError(t, err)
+
+	tx, err := solanago.NewTransaction([]solanago.Instruction{
+		system.NewTransferInstruction(1e9, sender, recipient).Build(),
+		token.NewTransferCheckedInstruction(2_500_000, 6, source, mintKey, destination, sender, nil).Build(),
+	}, nonce, solanago.TransactionPayer(sender))
+	require.NoError(t, err)
+	raw, err := tx.MarshalBinary()
+	require.NoError(t, err)
+
+	index := make(map[solanago.PublicKey]int)
+	for i, key := range tx.Message.AccountKeys {
+		index[key] = i
+	}
+	pre := make([]uint64, len(tx.Message.AccountKeys))
+	post := make([]uint64, len(tx.Message.AccountKeys))
+	pre[index[sender]], post[index[sender]] = 3e9, 3e9-1e9-5000
+	pre[index[recipient]], post[index[recipient]] = 0, 1e9
+
+	tokenBalance := func(account, owner solanago.PublicKey, amount string) string {
+		return fmt.Sprintf(`{"accountIndex":%d,"mint":%q,"owner":%q,"uiTokenAmount":{"amount":%q,"decimals":6}}`,
+			index[account], mintKey, owner, amount)
+	}
+	balances, err := json.Marshal(map[string][]uint64{"preBalances": pre, "postBalances": post})
+	require.NoError(t, err)
+	meta := fmt.Sprintf(`{"err":null,"fee":5000,%s,"preTokenBalances":[%s,%s],"postTokenBalances":[%s,%s],`+
+		`"innerInstructions":[],"loadedAddresses":{"readonly":[],"writable":[]}}`,
+		balances[1:len(balances)-1],
+		tokenBalance(source, sender, "5000000"), tokenBalance(destination, recipient, "0"),
+		tokenBalance(source, sender, "2500000"), tokenBalance(destination, recipient, "2500000"))
+
+	return base64.StdEncoding.EncodeToString(raw), meta
+}
+
+// historyNode answers getSignaturesForAddress with signatures, newest first,
+// and getTransaction with the payment transaction for each of them.
+// getBlock reports the block of every slot with the hash Hash{7}.
+func historyNode(t *testing.T, signatures []solanago.Signature) *httptest.Server {
+	t.Helper()
+
+	raw, meta := paymentTransaction(t)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request struct {
+			ID     json.RawMessage   `json:"id"`
+			Method string            `json:"method"`
+			Params []json.RawMessage `json:"params"`
+		}
+		if !assert.NoError(t, json.NewDecoder(r.Body).Decode(&request)) {
+			return
+		}
+
+		var result string
+		switch request.Method {
+		case "getVersion":
+			result = `{"solana-core":"2.1.0"}`
+		case "getSlot":
+			result = fmt.Sprint(headSlot)
+		case "getSignaturesForAddress":
+			var opts struct {
+				Limit  int    `json:"limit"`
+				Before string `json:"before"`
+			}
+			require.NoError(t, json.Unmarshal(request.Params[1], &opts))
+			start := 0
+			for i, signature := range signatures {
+				if signature.String() == opts.Before {
+					start = i + 1
+				}
+			}
+			page := signatures[start:min(start+opts.Limit, len(signatures))]
+			entries := make([]map[string]any, len(page))
+			for i, signature := range page {
+				entries[i] = map[string]any{"signature": signature.String(), "slot": historySlot, "err": nil}
+			}
+			encoded, err := json.Marshal(entries)
+			require.NoError(t, err)
+			result = string(encoded)
+		case "getBlock":
+			result = fmt.Sprintf(`{"blockhash":%q,"previousBlockhash":%q,"parentSlot":%d,"blockHeight":900}`,
+				solanago.Hash{7}, solanago.Hash{6}, historySlot-1)
+		case "getTransaction":
+			result = fmt.Sprintf(`{"slot":%d,"blockTime":1700000000,"transaction":[%q,"base64"],"meta":%s}`,
+				historySlot, raw, meta)
+		default:
+			t.Errorf("unexpected method %s", request.Method)
+			return
+		}
+		fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"result":%s}`, request.ID, result)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestAdapter_GetTransactionPage(t *testing.T) {
+	t.Parallel()
+
+	signatures := []solanago.Signature{{3}, {2}, {1}}
+	adapter := solana.NewAdapter(historyNode(t, signatures).URL, false)
+
+	page, err := adapter.GetTransactionPage(t.Context(), sender.String(), domain.PageRequest{Limit: 2})
+	require.NoError(t, err)
+	assert.Nil(t, page.TotalCount)
+	assert.Equal(t, signatures[1].String(), page.NextCursor)
+	// Every signature has a SOL and a token entry.
+	require.Len(t, page.Transactions, 4)
+
+	sol := page.Transactions[0]
+	assert.Equal(t, signatures[0].String(), sol.TransactionID)
+	assert.Equal(t, domain.DirectionOutgoing, sol.Direction)
+	assert.InDelta(t, 1.000005, sol.Amount, 1e-12)
+	require.NotNil(t, sol.FeeAmount)
+	assert.InDelta(t, 0.000005, *sol.FeeAmount, 1e-12)
+	assert.Equal(t, []string{sender.String()}, sol.FromAddresses)
+	assert.Equal(t, []string{recipient.String()}, sol.ToAddresses)
+	require.NotNil(t, sol.BlockHeight)
+	assert.Equal(t, int64(historySlot), *sol.BlockHeight)
+	assert.Equal(t, int64(headSlot-historySlot+1), sol.Confirmations)
+	assert.Equal(t, int64(1700000000), sol.Timestamp.Unix())
+
+	tokenEntry := page.Transactions[1]
+	assert.Equal(t, signatures[0].String(), tokenEntry.TransactionID)
+	assert.Equal(t, mintKey.String(), tokenEntry.TokenContract)
+	assert.Equal(t, domain.DirectionOutgoing, tokenEntry.Direction)
+	assert.InDelta(t, 2.5, tokenEntry.Amount, 1e-12)
+	assert.Nil(t, tokenEntry.FeeAmount)
+	assert.Equal(t, []string{recipient.String()}, tokenEntry.ToAddresses)
+
+	page, err = adapter.GetTransactionPage(t.Context(), sender.String(),
+		domain.PageRequest{Cursor: page.NextCursor, Limit: 2})
+	require.NoError(t, err)
+	assert.Empty(t, page.NextCursor)
+	require.Len(t, page.Transactions, 2)
+	assert.Equal(t, signatures[2].String(), page.Transactions[0].TransactionID)
+
+	page, err = adapter.GetTransactionPage(t.Context(), sender.String(), domain.PageRequest{Offset: 1, Limit: 5})
+	require.NoError(t, err)
+	assert.Empty(t, page.NextCursor)
+	require.Len(t, page.Transactions, 4)
+	assert.Equal(t, signatures[1].String(), page.Transactions[0].TransactionID)
+
+	_, err = adapter.GetTransactionPage(t.Context(), sender.String(), domain.PageRequest{Cursor: "42", Limit: 5})
+	require.ErrorIs(t, err, domain.ErrBadRequest)
+}
+
+func TestAdapter_GetTransactions_Incoming(t *testing.T) {
+	t.Parallel()
+
+	adapter := solana.NewAdapter(historyNode(t, []solanago.Signature{{1}}).URL, false)
+
+	txs, err := adapter.GetTransactions(t.Context(), recipient.String())
+	require.NoError(t, err)
+	require.Len(t, txs, 2)
+
+	assert.Equal(t, domain.DirectionIncoming, txs[0].Direction)
+	assert.InDelta(t, 1.0, txs[0].Amount, 1e-12)
+	assert.Nil(t, txs[0].FeeAmount)
+
+	assert.Equal(t, domain.DirectionIncoming, txs[1].Direction)
+	assert.InDelta(t, 2.5, txs[1].Amount, 1e-12)
+	assert.Equal(t, []string{sender.String()}, txs[1].FromAddresses)
+	assert.Equal(t, []string{recipient.String()}, txs[1].ToAddresses)
+
+	_, err = adapter.GetTransactions(t.Context(), "not-base58")
+	require.ErrorIs(t, err, solana.ErrInvalidSolanaAddress)
+}
@@ -0,0 +1,462 @@
+package solana
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"time"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/tracing"
+	bin "github.com/gagliardetto/binary"
+	"github.com/gagliardetto/solana-go"
+	associatedtokenaccount "github.com/gagliardetto/solana-go/programs/associated-token-account"
+	computebudget "github.com/gagliardetto/solana-go/programs/compute-budget"
+	"github.com/gagliardetto/solana-go/programs/system"
+	"github.com/gagliardetto/solana-go/programs/token"
+	"github.com/gagliardetto/solana-go/rpc"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// BuildTimeout bounds all node calls made to build one transaction.
+	BuildTimeout = 20 * time.Second
+
+	// tokenAccountSize is the size of an SPL token account, which sets the
+	// rent of a created associated token account.
+	tokenAccountSize = 165
+	lamportDecimals  = 9
+	// nonceInitialized is the state of a nonce account holding a nonce.
+	nonceInitialized = 1
+)
+
+var (
+	ErrInvalidTokenMint = &domain.Error{
+		Code:    domain.CodeInvalidAddress,
+		Message: "invalid SPL token mint",
+	}
+	ErrInvalidNonceAccount = &domain.Error{
+		Code:    domain.CodeBadRequest,
+		Message: "invalid durable nonce account",
+	}
+	ErrNoFeeForMessage = errors.New("node could not price the message")
+)
+
+// transfer is the part of a transaction that moves the funds. Lamports is the
+// SOL sent and Rent the lamports locked in accounts the transfer creates.
+type transfer struct {
+	instructions []solana.Instruction
+	lamports     uint64
+	rent         uint64
+	amount       string
+	details      []domain.TxDetail
+}
+
+// BuildUnsignedTx builds the message of a System transfer, or with a
+// TokenContract an SPL transfer of that mint that creates the associated
+// token account of the recipient when it is missing. With domain.AmountMax
+// a System transfer sends the balance less the fee and the rent-exempt
+// reserve of the sender, and an SPL transfer every token. The message uses
+// the durable nonce of request.NonceAccount when set and a recent blockhash
+// otherwise; the signer signs it as is, so there is no signing hash. A
+// positive request.FeeRate sets a priority fee in micro-lamports per
+// compute unit. SOL sent to an account that does not exist yet must cover
+// its rent-exempt minimum.
+func (a *Adapter) BuildUnsignedTx(ctx context.Context, request domain.UnsignedTxRequest) (*domain.UnsignedTx, error) {
+	ctx, span := tracer.Start(ctx, "solana.BuildUnsignedTx", trace.WithAttributes(a.spanAttributes()...))
+	tx, err := a.buildUnsignedTx(ctx, request)
+	tracing.End(span, err)
+	return tx, err
+}
+
+func (a *Adapter) buildUnsignedTx(ctx context.Context, request domain.UnsignedTxRequest) (*domain.UnsignedTx, error) {
+	from, err := solana.PublicKeyFromBase58(request.From)
+	if err != nil {
+		return nil, ErrInvalidSolanaAddress
+	}
+	to, err := solana.PublicKeyFromBase58(request.To)
+	if err != nil {
+		return nil, ErrInvalidSolanaAddress
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, BuildTimeout)
+	defer cancel()
+	client := a.connectedClient()
+
+	instructions, blockhash, details, err := a.lifetime(ctx, client, from, request.NonceAccount)
+	if err != nil {
+		return nil, err
+	}
+	if price := computeUnitPrice(request.FeeRate); price > 0 {
+		instructions = append(instructions, computebudget.NewSetComputeUnitPriceInstruction(price).Build())
+		details = append(details, domain.TxDetail{
+			Label: "Priority fee", Value: strconv.FormatUint(price, 10) + " micro-lamports/CU",
+		})
+	}
+
+	var sent *transfer
+	switch {
+	case request.TokenContract != "":
+		sent, err = a.tokenTransfer(ctx, client, from, to, request)
+	case request.SendsMax():
+		// The fee does not depend on the lamports moved, so the message is
+		// priced before the amount is known.
+		sent = solTransfer(from, to, 0)
+	default:
+		var lamports uint64
+		if lamports, err = parseUnits(request.Amount, lamportDecimals); err == nil {
+			sent = solTransfer(from, to, lamports)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	message, err := compileMessage(append(instructions, sent.instructions...), blockhash, from)
+	if err != nil {
+		return nil, err
+	}
+	fee, err := a.feeForMessage(ctx, client, message)
+	if err != nil {
+		return nil, err
+	}
+
+	if request.TokenContract == "" && request.SendsMax() {
+		lamports, reserve, err := a.sweepLamports(ctx, client, from, fee)
+		if err != nil {
+			return nil, err
+		}
+		sent = solTransfer(from, to, lamports)
+		sent.details = append(sent.details, domain.TxDetail{Label: "Rent-exempt reserve", Value: formatLamports(reserve) + " SOL"})
+		if message, err = compileMessage(append(instructions, sent.instructions...), blockhash, from); err != nil {
+			return nil, err
+		}
+	} else if err := a.checkBalance(ctx, client, from, sent.lamports+sent.rent+fee); err != nil {
+		return nil, err
+	}
+	if request.TokenContract == "" {
+		if err := a.checkRecipient(ctx, client, to, sent.lamports); err != nil {
+			return nil, err
+		}
+	}
+
+	details = append(sent.details, details...)
+	return &domain.UnsignedTx{
+		From:     from.String(),
+		To:       to.String(),
+		Amount:   sent.amount,
+		Fee:      formatLamports(fee),
+		Payload:  message,
+		Encoding: domain.EncodingBase64,
+		Details: append(details,
+			domain.TxDetail{Label: "Fee", Value: formatLamports(fee) + " SOL"}),
+	}, nil
+}
+
+// lifetime returns the blockhash a message is valid under and the
+// instructions it starts with: the durable nonce of nonceAccount, advanced
+// by authority, or the latest blockhash without any.
+func (a *Adapter) lifetime(
+	ctx context.Context, client *rpc.Client, authority solana.PublicKey, nonceAccount string,
+) ([]solana.Instruction, solana.Hash, []domain.TxDetail, error) {
+	if nonceAccount == "" {
+		rpcCtx, span := a.rpcSpan(ctx, "getLatestBlockhash")
+		latest, err := client.GetLatestBlockhash(rpcCtx, rpc.CommitmentConfirmed)
+		tracing.End(span, err)
+		if err != nil {
+			return nil, solana.Hash{}, nil, domain.UpstreamError(err)
+		}
+		return nil, latest.Value.Blockhash, []domain.TxDetail{
+			{Label: "Recent blockhash", Value: latest.Value.Blockhash.String()},
+			{Label: "Valid until block height", Value: strconv.FormatUint(latest.Value.LastValidBlockHeight, 10)},
+		}, nil
+	}
+
+	account, err := solana.PublicKeyFromBase58(nonceAccount)
+	if err != nil {
+		return nil, solana.Hash{}, nil, ErrInvalidNonceAccount
+	}
+	nonce, err := a.durableNonce(ctx, client, account, authority)
+	if err != nil {
+		return nil, solana.Hash{}, nil, err
+	}
+	advance := system.NewAdvanceNonceAccountInstruction(account, solana.SysVarRecentBlockHashesPubkey, authority)
+	return []solana.Instruction{advance.Build()}, nonce, []domain.TxDetail{
+		{Label: "Nonce account", Value: account.String()},
+		{Label: "Durable nonce", Value: nonce.String()},
+	}, nil
+}
+
+// durableNonce reads the nonce stored in account, which authority must be
+// allowed to advance.
+func (a *Adapter) durableNonce(
+	ctx context.Context, client *rpc.Client, account, authority solana.PublicKey,
+) (solana.Hash, error) {
+	info, err := a.accountInfo(ctx, client, account)
+	if errors.Is(err, rpc.ErrNotFound) {
+		return solana.Hash{}, fmt.Errorf("%w: %s does not exist", ErrInvalidNonceAccount, account)
+	}
+	if err != nil {
+		return solana.Hash{}, domain.UpstreamError(err)
+	}
+
+	var nonce system.NonceAccount
+	if !info.Value.Owner.Equals(solana.SystemProgramID) ||
+		bin.NewBinDecoder(info.GetBinary()).Decode(&nonce) != nil || nonce.State != nonceInitialized {
+		return solana.Hash{}, fmt.Errorf("%w: %s holds no nonce", ErrInvalidNonceAccount, account)
+	}
+	if !nonce.AuthorizedPubkey.Equals(authority) {
+		return solana.Hash{}, fmt.Errorf("%w: %s is not the authority of %s", ErrInvalidNonceAccount, authority, account)
+	}
+	return solana.Hash(nonce.Nonce), nil
+}
+
+// compileMessage serializes the message of instructions paid for by payer.
+func compileMessage(instructions []solana.Instruction, blockhash solana.Hash, payer solana.PublicKey) ([]byte, error) {
+	tx, err := solana.NewTransaction(instructions, blockhash, solana.TransactionPayer(payer))
+	if err != nil {
+		return nil, err
+	}
+	return tx.Message.MarshalBinary()
+}
+
+// sweepLamports returns what owner can send after fee while keeping the
+// rent-exempt minimum of an account without data, and that minimum.
+func (a *Adapter) sweepLamports(
+	ctx context.Context, client *rpc.Client, owner solana.PublicKey, fee uint64,
+) (uint64, uint64, error) {
+	balance, err := a.fetchBalance(ctx, client, owner, rpc.CommitmentConfirmed)
+	if err != nil {
+		return 0, 0, domain.UpstreamError(err)
+	}
+	rpcCtx, span := a.rpcSpan(ctx, "getMinimumBalanceForRentExemption")
+	reserve, err := client.GetMinimumBalanceForRentExemption(rpcCtx, 0, rpc.CommitmentConfirmed)
+	tracing.End(span, err)
+	if err != nil {
+		return 0, 0, domain.UpstreamError(err)
+	}
+	if balance <= fee+reserve {
+		return 0, 0, fmt.Errorf("%w: %s SOL available, %s SOL needed for the fee and rent-exempt reserve",
+			domain.ErrInsufficientFunds, formatLamports(balance), formatLamports(fee+reserve))
+	}
+	return balance - fee - reserve, reserve, nil
+}
+
+// checkRecipient rejects sending to an account that does not exist yet fewer
+// lamports than the rent-exempt minimum of an account without data, which
+// the runtime refuses to create it with.
+func (a *Adapter) checkRecipient(ctx context.Context, client *rpc.Client, to solana.PublicKey, lamports uint64) error {
+	_, err := a.accountInfo(ctx, client, to)
+	switch {
+	case err == nil:
+		return nil
+	case !errors.Is(err, rpc.ErrNotFound):
+		return domain.UpstreamError(err)
+	}
+
+	rpcCtx, span := a.rpcSpan(ctx, "getMinimumBalanceForRentExemption")
+	minimum, err := client.GetMinimumBalanceForRentExemption(rpcCtx, 0, rpc.CommitmentConfirmed)
+	tracing.End(span, err)
+	if err != nil {
+		return domain.UpstreamError(err)
+	}
+	if lamports < minimum {
+		return fmt.Errorf("%w: %s does not exist yet and is only created with at least %s SOL",
+			domain.ErrBadRequest, to, formatLamports(minimum))
+	}
+	return nil
+}
+
+func solTransfer(from, to solana.PublicKey, lamports uint64) *transfer {
+	return &transfer{
+		instructions: []solana.Instruction{system.NewTransferInstruction(lamports, from, to).Build()},
+		lamports:     lamports,
+		amount:       formatLamports(lamports),
+		details: []domain.TxDetail{
+			{Label: "Type", Value: "System transfer"},
+			{Label: "From", Value: from.String()},
+			{Label: "To", Value: to.String()},
+			{Label: "Amount", Value: formatLamports(lamports) + " SOL"},
+		},
+	}
+}
+
+// tokenTransfer moves request.Amount tokens of request.TokenContract, or
+// all of them with domain.AmountMax, between the associated token accounts
+// of from and to, creating the one of to at the expense of from when it does
+// not exist yet.
+func (a *Adapter) tokenTransfer(
+	ctx context.Context, client *rpc.Client, from, to solana.PublicKey, request domain.UnsignedTxRequest,
+) (*transfer, error) {
+	mint, decimals, err := a.tokenMint(ctx, client, request.TokenContract)
+	if err != nil {
+		return nil, err
+	}
+	source, _, err := solana.FindAssociatedTokenAddress(from, mint)
+	if err != nil {
+		return nil, err
+	}
+	destination, _, err := solana.FindAssociatedTokenAddress(to, mint)
+	if err != nil {
+		return nil, err
+	}
+
+	balance, err := a.tokenBalance(ctx, client, source)
+	if err != nil {
+		return nil, err
+	}
+	units := balance
+	if !request.SendsMax() {
+		if units, err = parseUnits(request.Amount, int(decimals)); err != nil {
+			return nil, err
+		}
+	}
+	if units == 0 || balance < units {
+		return nil, fmt.Errorf("%w: %s tokens available", domain.ErrInsufficientFunds,
+			domain.FormatUnits(new(big.Int).SetUint64(balance), int(decimals)))
+	}
+
+	sent := &transfer{
+		amount: domain.FormatUnits(new(big.Int).SetUint64(units), int(decimals)),
+		details: []domain.TxDetail{
+			{Label: "Type", Value: "SPL token transfer"},
+			{Label: "From", Value: from.String()},
+			{Label: "To", Value: to.String()},
+			{Label: "Amount", Value: domain.FormatUnits(new(big.Int).SetUint64(units), int(decimals)) + " tokens"},
+			{Label: "Token mint", Value: mint.String()},
+		},
+	}
+
+	_, err = a.accountInfo(ctx, client, destination)
+	switch {
+	case errors.Is(err, rpc.ErrNotFound):
+		rpcCtx, span := a.rpcSpan(ctx, "getMinimumBalanceForRentExemption")
+		sent.rent, err = client.GetMinimumBalanceForRentExemption(rpcCtx, tokenAccountSize, rpc.CommitmentConfirmed)
+		tracing.End(span, err)
+		if err != nil {
+			return nil, domain.UpstreamError(err)
+		}
+		sent.instructions = append(sent.instructions, associatedtokenaccount.NewCreateInstruction(from, to, mint).Build())
+		sent.details = append(sent.details,
+			domain.TxDetail{Label: "Creates token account", Value: destination.String()},
+			domain.TxDetail{Label: "Account rent", Value: formatLamports(sent.rent) + " SOL"},
+		)
+	case err != nil:
+		return nil, domain.UpstreamError(err)
+	}
+
+	sent.instructions = append(sent.instructions,
+		token.NewTransferCheckedInstruction(units, decimals, source, mint, destination, from, nil).Build())
+	return sent, nil
+}
+
+// tokenMint reads the decimals of an SPL token mint.
+func (a *Adapter) tokenMint(ctx context.Context, client *rpc.Client, address string) (solana.PublicKey, uint8, error) {
+	mint, err := solana.PublicKeyFromBase58(address)
+	if err != nil {
+		return solana.PublicKey{}, 0, ErrInvalidTokenMint
+	}
+
+	info, err := a.accountInfo(ctx, client, mint)
+	if errors.Is(err, rpc.ErrNotFound) {
+		return solana.PublicKey{}, 0, fmt.Errorf("%w: %s does not exist", ErrInvalidTokenMint, mint)
+	}
+	if err != nil {
+		return solana.PublicKey{}, 0, domain.UpstreamError(err)
+	}
+
+	var data token.Mint
+	if !info.Value.Owner.Equals(solana.TokenProgramID) || bin.NewBinDecoder(info.GetBinary()).Decode(&data) != nil {
+		return solana.PublicKey{}, 0, fmt.Errorf("%w: %s is not a mint of the SPL token program", ErrInvalidTokenMint, mint)
+	}
+	return mint, data.Decimals, nil
+}
+
+// tokenBalance returns the tokens held by account, none when it does not
+// exist.
+func (a *Adapter) tokenBalance(ctx context.Context, client *rpc.Client, account solana.PublicKey) (uint64, error) {
+	info, err := a.accountInfo(ctx, client, account)
+	switch {
+	case errors.Is(err, rpc.ErrNotFound):
+		return 0, nil
+	case err != nil:
+		return 0, domain.UpstreamError(err)
+	}
+	var data token.Account
+	if err := bin.NewBinDecoder(info.GetBinary()).Decode(&data); err != nil {
+		return 0, domain.UpstreamError(err)
+	}
+	return data.Amount, nil
+}
+
+func (a *Adapter) checkBalance(ctx context.Context, client *rpc.Client, owner solana.PublicKey, need uint64) error {
+	balance, err := a.fetchBalance(ctx, client, owner, rpc.CommitmentConfirmed)
+	if err != nil {
+		return domain.UpstreamError(err)
+	}
+	if balance < need {
+		return fmt.Errorf("%w: %s SOL available, %s SOL needed including fee and rent", domain.ErrInsufficientFunds,
+			formatLamports(balance), formatLamports(need))
+	}
+	return nil
+}
+
+// feeForMessage prices a serialized message with getFeeForMessage.
+func (a *Adapter) feeForMessage(ctx context.Context, client *rpc.Client, message []byte) (uint64, error) {
+	rpcCtx, span := a.rpcSpan(ctx, "getFeeForMessage")
+	fee, err := client.GetFeeForMessage(rpcCtx, base64.StdEncoding.EncodeToString(message), rpc.CommitmentConfirmed)
+	if err == nil && fee.Value == nil {
+		err = ErrNoFeeForMessage
+	}
+	tracing.End(span, err)
+	if err != nil {
+		return 0, domain.UpstreamError(err)
+	}
+	return *fee.Value, nil
+}
+
+func (a *Adapter) accountInfo(
+	ctx context.Context, client *rpc.Client, account solana.PublicKey,
+) (*rpc.GetAccountInfoResult, error) {
+	rpcCtx, span := a.rpcSpan(ctx, "getAccountInfo")
+	info, err := client.GetAccountInfoWithOpts(rpcCtx, account, &rpc.GetAccountInfoOpts{
+		Encoding:   solana.EncodingBase64,
+		Commitment: rpc.CommitmentConfirmed,
+	})
+	if errors.Is(err, rpc.ErrNotFound) {
+		tracing.End(span, nil)
+		return nil, err
+	}
+	tracing.End(span, err)
+	return info, err
+}
+
+// parseUnits parses amount into base units that fit a uint64.
+func parseUnits(amount string, decimals int) (uint64, error) {
+	units, err := domain.ParseUnits(amount, decimals)
+	if err != nil {
+		return 0, err
+	}
+	if !units.IsUint64() {
+		return 0, fmt.Errorf("%w: amount %s is too large", domain.ErrBadRequest, amount)
+	}
+	return units.Uint64(), nil
+}
+
+func formatLamports(lamports uint64) string {
+	return domain.FormatUnits(new(big.Int).SetUint64(lamports), lamportDecimals)
+}
+
+// computeUnitPrice rounds a fee rate in micro-lamports per compute unit up
+// to the whole micro-lamports SetComputeUnitPrice takes, 0 for no priority
+// fee.
+func computeUnitPrice(feeRate float64) uint64 {
+	if feeRate <= 0 {
+		return 0
+	}
+	return uint64(math.Ceil(feeRate))
+}
@@ -0,0 +1,257 @@
+package solana_test
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/adapters/crypto/providers/solana"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+	bin "github.com/gagliardetto/binary"
+	solanago "github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/programs/system"
+	"github.com/gagliardetto/solana-go/programs/token"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	sender    = solanago.MustPublicKeyFromBase58("9WzDXwBbmkg8ZTbNMqUxvQRAyrZzDsGYdLVL9zYtAWWM")
+	recipient = solanago.MustPublicKeyFromBase58("4Nd1mBQtrMJVYVfKf2PJy9NZUZdTAsp7D4xWLs4gDB4T")
+	nonceKey  = solanago.MustPublicKeyFromBase58("7Np41oeYqPefeNQEHSv1UDhYrehxin3NStELsSKCT4K2")
+	mintKey   = solanago.MustPublicKeyFromBase58("EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v")
+	nonce     = solanago.MustHashFromBase58("GfVcyD4kkTrj4bKc7WA9sZCin9JDbdT4Zkd3EittNR1W")
+)
+
+// account is the state the fake RPC node reports for an address.
+type account struct {
+	owner solanago.PublicKey
+	data  interface{ MarshalWithEncoder(*bin.Encoder) error }
+}
+
+// fakeNode answers the JSON-RPC methods used to build a transaction from
+// accounts, with balance lamports for every wallet.
+func fakeNode(t *testing.T, accounts map[solanago.PublicKey]account, balance uint64) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request struct {
+			ID     json.RawMessage   `json:"id"`
+			Method string            `json:"method"`
+			Params []json.RawMessage `json:"params"`
+		}
+		if !assert.NoError(t, json.NewDecoder(r.Body).Decode(&request)) {
+			return
+		}
+
+		var result string
+		switch request.Method {
+		case "getVersion":
+			result = `{"solana-core":"2.1.0"}`
+		case "getLatestBlockhash":
+			result = `{"context":{"slot":1},"value":{"blockhash":"` + nonce.String() + `","lastValidBlockHeight":150}}`
+		case "getFeeForMessage":
+			result = `{"context":{"slot":1},"value":5000}`
+		case "getBalance":
+			result = fmt.Sprintf(`{"context":{"slot":1},"value":%d}`, balance)
+		case "getMinimumBalanceForRentExemption":
+			result = `2039280`
+		case "getAccountInfo":
+			var address string
+			require.NoError(t, json.Unmarshal(request.Params[0], &address))
+			acc, ok := accounts[solanago.MustPublicKeyFromBase58(address)]
+			if !ok {
+				result = `{"context":{"slot":1},"value":null}`
+				break
+			}
+			var data bytes.Buffer
+			require.NoError(t, acc.data.MarshalWithEncoder(bin.NewBinEncoder(&data)))
+			result = fmt.Sprintf(`{"context":{"slot":1},"value":{"data":[%q,"base64"],"executable":false,`+
+				`"lamports":1000000,"owner":%q,"rentEpoch":0,"space":%d}}`,
+				base64.StdEncoding.EncodeToString(data.Bytes()), acc.owner, data.Len())
+		default:
+			t.Errorf("unexpected method %s", request.Method)
+			return
+		}
+		fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"result":%s}`, request.ID, result)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func nonceAccount(authority solanago.PublicKey) account {
+	return account{owner: solanago.SystemProgramID, data: system.NonceAccount{
+		Version:          1,
+		State:            1,
+		AuthorizedPubkey: authority,
+		Nonce:            solanago.PublicKey(nonce),
+	}}
+}
+
+func decodeMessage(t *testing.T, payload []byte) (*solanago.Message, []solanago.PublicKey) {
+	t.Helper()
+
+	var message solanago.Message
+	require.NoError(t, message.UnmarshalWithDecoder(bin.NewBinDecoder(payload)))
+	programs := make([]solanago.PublicKey, len(message.Instructions))
+	for i, instruction := range message.Instructions {
+		programs[i] = message.AccountKeys[instruction.ProgramIDIndex]
+	}
+	return &message, programs
+}
+
+func TestAdapter_BuildUnsignedTx_DurableNonce(t *testing.T) {
+	t.Parallel()
+
+	server := fakeNode(t, map[solanago.PublicKey]account{nonceKey: nonceAccount(sender)}, 2e9)
+
+	tx, err := solana.NewAdapter(server.URL, false).BuildUnsignedTx(t.Context(), domain.UnsignedTxRequest{
+		From:         sender.String(),
+		To:           recipient.String(),
+		Amount:       "1.25",
+		FeeRate:      1000,
+		NonceAccount: nonceKey.String(),
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "1.25", tx.Amount)
+	assert.Equal(t, "0.000005", tx.Fee)
+	assert.Equal(t, domain.EncodingBase64, tx.Encoding)
+	assert.Nil(t, tx.SigningHash)
+	assert.Contains(t, tx.Details, domain.TxDetail{Label: "Durable nonce", Value: nonce.String()})
+
+	message, programs := decodeMessage(t, tx.Payload)
+	assert.Equal(t, nonce, message.RecentBlockhash)
+	assert.Equal(t, sender, message.AccountKeys[0])
+	assert.Equal(t, []solanago.PublicKey{
+		solanago.SystemProgramID, solanago.ComputeBudget, solanago.SystemProgramID,
+	}, programs)
+	// AdvanceNonceAccount has to be the first instruction of the message.
+	assert.Equal(t, []byte{4, 0, 0, 0}, []byte(message.Instructions[0].Data))
+}
+
+func TestAdapter_BuildUnsignedTx_TokenTransfer(t *testing.T) {
+	t.Parallel()
+
+	source, _, err := solanago.FindAssociatedTokenAddress(sender, mintKey)
+	require.NoError(t, err)
+	server := fakeNode(t, map[solanago.PublicKey]account{
+		mintKey: {owner: solanago.TokenProgramID, data: token.Mint{Decimals: 6, IsInitialized: true}},
+		source: {owner: solanago.TokenProgramID, data: token.Account{
+			Mint: mintKey, Owner: sender, Amount: 5_000_000, State: token.Initialized,
+		}},
+	}, 1e8)
+
+	tx, err := solana.NewAdapter(server.URL, false).BuildUnsignedTx(t.Context(), domain.UnsignedTxRequest{
+		From:          sender.String(),
+		To:            recipient.String(),
+		Amount:        "2.5",
+		TokenContract: mintKey.String(),
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "2.5", tx.Amount)
+	assert.Contains(t, tx.Details, domain.TxDetail{Label: "Account rent", Value: "0.00203928 SOL"})
+	assert.Contains(t, tx.Details, domain.TxDetail{Label: "Valid until block height", Value: "150"})
+
+	_, programs := decodeMessage(t, tx.Payload)
+	assert.Equal(t, []solanago.PublicKey{solanago.SPLAssociatedTokenAccountProgramID, solanago.TokenProgramID}, programs)
+
+	_, err = solana.NewAdapter(server.URL, false).BuildUnsignedTx(t.Context(), domain.UnsignedTxRequest{
+		From:          sender.String(),
+		To:            recipient.String(),
+		Amount:        "5.000001",
+		TokenContract: mintKey.String(),
+	})
+	require.ErrorIs(t, err, domain.ErrInsufficientFunds)
+}
+
+func TestAdapter_BuildUnsignedTx_Max(t *testing.T) {
+	t.Parallel()
+
+	source, _, err := solanago.FindAssociatedTokenAddress(sender, mintKey)
+	require.NoError(t, err)
+	server := fakeNode(t, map[solanago.PublicKey]account{
+		mintKey: {owner: solanago.TokenProgramID, data: token.Mint{Decimals: 6, IsInitialized: true}},
+		source: {owner: solanago.TokenProgramID, data: token.Account{
+			Mint: mintKey, Owner: sender, Amount: 5_000_000, State: token.Initialized,
+		}},
+	}, 1e9)
+	adapter := solana.NewAdapter(server.URL, false)
+
+	tx, err := adapter.BuildUnsignedTx(t.Context(), domain.UnsignedTxRequest{
+		From: sender.String(), To: recipient.String(), Amount: domain.AmountMax,
+	})
+	require.NoError(t, err)
+
+	// The balance less the fee and the rent-exempt reserve.
+	assert.Equal(t, "0.99795572", tx.Amount)
+	assert.Equal(t, "0.000005", tx.Fee)
+	assert.Contains(t, tx.Details, domain.TxDetail{Label: "Rent-exempt reserve", Value: "0.00203928 SOL"})
+	message, _ := decodeMessage(t, tx.Payload)
+	// A System transfer is its instruction index and the lamports moved.
+	assert.Equal(t, uint64(997_955_720), binary.LittleEndian.Uint64(message.Instructions[0].Data[4:]))
+
+	tx, err = adapter.BuildUnsignedTx(t.Context(), domain.UnsignedTxRequest{
+		From: sender.String(), To: recipient.String(), Amount: "MAX", TokenContract: mintKey.String(),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "5", tx.Amount)
+
+	_, err = solana.NewAdapter(fakeNode(t, nil, 2_000_000).URL, false).BuildUnsignedTx(t.Context(),
+		domain.UnsignedTxRequest{From: sender.String(), To: recipient.String(), Amount: domain.AmountMax})
+	require.ErrorIs(t, err, domain.ErrInsufficientFunds)
+}
+
+func TestAdapter_BuildUnsignedTx_Errors(t *testing.T) {
+	t.Parallel()
+
+	server := fakeNode(t, map[solanago.PublicKey]account{nonceKey: nonceAccount(recipient)}, 1e9)
+	adapter := solana.NewAdapter(server.URL, false)
+
+	_, err := adapter.BuildUnsignedTx(t.Context(), domain.UnsignedTxRequest{
+		From: sender.String(), To: recipient.String(), Amount: "0.1", NonceAccount: nonceKey.String(),
+	})
+	require.ErrorIs(t, err, solana.ErrInvalidNonceAccount)
+
+	_, err = adapter.BuildUnsignedTx(t.Context(), domain.UnsignedTxRequest{
+		From: sender.String(), To: recipient.String(), Amount: "1",
+	})
+	require.ErrorIs(t, err, domain.ErrInsufficientFunds)
+
+	_, err = adapter.BuildUnsignedTx(t.Context(), domain.UnsignedTxRequest{
+		From: sender.String(), To: recipient.String(), Amount: "0.0000000001",
+	})
+	require.ErrorIs(t, err, domain.ErrBadRequest)
+
+	// recipient does not exist, so it needs the rent-exempt minimum.
+	_, err = adapter.BuildUnsignedTx(t.Context(), domain.UnsignedTxRequest{
+		From: sender.String(), To: recipient.String(), Amount: "0.002",
+	})
+	require.ErrorIs(t, err, domain.ErrBadRequest)
+	assert.ErrorContains(t, err, "0.00203928 SOL")
+
+	_, err = adapter.BuildUnsignedTx(t.Context(), domain.UnsignedTxRequest{
+		From: sender.String(), To: nonceKey.String(), Amount: "0.002",
+	})
+	require.NoError(t, err)
+
+	_, err = adapter.BuildUnsignedTx(t.Context(), domain.UnsignedTxRequest{
+		From: sender.String(), To: recipient.String(), Amount: "0.1", TokenContract: nonceKey.String(),
+	})
+	require.ErrorIs(t, err, solana.ErrInvalidTokenMint)
+}
+
+func TestComputeUnitPrice(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, uint64(0), solana.ComputeUnitPrice(0))
+	assert.Equal(t, uint64(1), solana.ComputeUnitPrice(0.5))
+	assert.Equal(t, uint64(1000), solana.ComputeUnitPrice(1000))
+	assert.Equal(t, uint64(1001), solana.ComputeUnitPrice(1000.2))
+}
@@ -0,0 +1,32 @@
+package solana
+
+import (
+	"fmt"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+	"github.com/gagliardetto/solana-go"
+)
+
+// ValidateAddress checks that address is a base58 ed25519 public key on the
+// curve, which every wallet address is. Program derived addresses are off the
+// curve and rejected. Mainnet and testnet addresses cannot be told apart.
+func (a *Adapter) ValidateAddress(address string) (*domain.AddressValidation, error) {
+	return validateAddress(address, a.isTestnet)
+}
+
+func validateAddress(address string, isTestnet bool) (*domain.AddressValidation, error) {
+	pubkey, err := solana.PublicKeyFromBase58(address)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidSolanaAddress, err)
+	}
+	if !pubkey.IsOnCurve() {
+		return nil, fmt.Errorf("%w: public key is not on the ed25519 curve", ErrInvalidSolanaAddress)
+	}
+
+	return &domain.AddressValidation{
+		Address: address,
+		Kind:    domain.AddressKindAddress,
+		Format:  "ed25519",
+		Network: domain.NetworkName(isTestnet),
+	}, nil
+}
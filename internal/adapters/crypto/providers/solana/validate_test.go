@@ -0,0 +1,29 @@
+package solana_test
+
+import (
+	"testing"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/adapters/crypto/providers/solana"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+	solanago "github.com/gagliardetto/solana-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateAddress(t *testing.T) {
+	t.Parallel()
+
+	wallet := solanago.NewWallet().PublicKey()
+	validation, err := solana.ValidateAddress(wallet.String(), false)
+	require.NoError(t, err)
+	assert.Equal(t, domain.AddressKindAddress, validation.Kind)
+
+	pda, _, err := solanago.FindProgramAddress([][]byte{[]byte("seed")}, solanago.SystemProgramID)
+	require.NoError(t, err)
+	_, err = solana.ValidateAddress(pda.String(), false)
+	require.ErrorIs(t, err, domain.ErrInvalidAddress)
+	assert.Contains(t, err.Error(), "not on the ed25519 curve")
+
+	_, err = solana.ValidateAddress("0OIl", false)
+	require.ErrorIs(t, err, domain.ErrInvalidAddress)
+}
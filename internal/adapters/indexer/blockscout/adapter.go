@@ -0,0 +1,296 @@
+package blockscout
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/tracing"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const SourceName = "blockscout"
+
+var (
+	ErrUnexpectedStatus = errors.New("unexpected status")
+	ErrInvalidNumber    = errors.New("invalid number")
+	ErrMissingToken     = errors.New("token transfer without token details")
+)
+
+const (
+	requestTimeout = 15 * time.Second
+	// maxPages bounds how far back each listing is followed. Blockscout pages
+	// hold 50 items.
+	maxPages = 10
+)
+
+var tracer = tracing.Tracer("indexer/blockscout")
+
+// Adapter reads address history from the REST API of a Blockscout instance,
+// e.g. "https://eth.blockscout.com". The API key is optional.
+type Adapter struct {
+	baseURL string
+	apiKey  string
+	client  *http.Client
+}
+
+func NewAdapter(baseURL, apiKey string) *Adapter {
+	return &Adapter{
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		apiKey:  apiKey,
+		client:  &http.Client{Timeout: requestTimeout},
+	}
+}
+
+// GetTransfers lists the normal transactions, internal transactions and
+// ERC-20 transfers of address.
+func (a *Adapter) GetTransfers(ctx context.Context, address string) ([]domain.IndexedTransfer, error) {
+	var transfers []domain.IndexedTransfer
+	for _, kind := range []domain.TransferKind{domain.TransferNormal, domain.TransferInternal, domain.TransferToken} {
+		items, err := a.list(ctx, kind, address)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range items {
+			transfer, err := item.transfer(kind)
+			if err != nil {
+				return nil, fmt.Errorf("%s item %s: %w", kind, item.hash(), err)
+			}
+			transfers = append(transfers, transfer)
+		}
+	}
+	return transfers, nil
+}
+
+func listingOf(kind domain.TransferKind) (string, url.Values) {
+	switch kind {
+	case domain.TransferInternal:
+		return "internal-transactions", url.Values{}
+	case domain.TransferToken:
+		return "token-transfers", url.Values{"type": {"ERC-20"}}
+	default:
+		return "transactions", url.Values{}
+	}
+}
+
+type page struct {
+	Items          []item                     `json:"items"`
+	NextPageParams map[string]json.RawMessage `json:"next_page_params"`
+}
+
+type addressRef struct {
+	Hash string `json:"hash"`
+}
+
+type token struct {
+	AddressHash string  `json:"address_hash"`
+	Address     string  `json:"address"`
+	Symbol      string  `json:"symbol"`
+	Decimals    *string `json:"decimals"`
+}
+
+type fee struct {
+	Value string `json:"value"`
+}
+
+type total struct {
+	Value    string  `json:"value"`
+	Decimals *string `json:"decimals"`
+}
+
+// item holds the fields of the transaction, internal transaction and token
+// transfer listings. Older Blockscout releases name some of them differently,
+// so both spellings are decoded.
+type item struct {
+	Hash            string      `json:"hash"`
+	TransactionHash string      `json:"transaction_hash"`
+	TxHash          string      `json:"tx_hash"`
+	BlockNumber     *int64      `json:"block_number"`
+	Block           *int64      `json:"block"`
+	Timestamp       *time.Time  `json:"timestamp"`
+	From            *addressRef `json:"from"`
+	To              *addressRef `json:"to"`
+	Value           string      `json:"value"`
+	Fee             *fee        `json:"fee"`
+	Status          *string     `json:"status"`
+	Success         *bool       `json:"success"`
+	Token           *token      `json:"token"`
+	Total           *total      `json:"total"`
+}
+
+func (a *Adapter) list(ctx context.Context, kind domain.TransferKind, address string) ([]item, error) {
+	listing, query := listingOf(kind)
+	ctx, span := tracer.Start(ctx, "blockscout."+listing, trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			tracing.AttrEndpoint.String(tracing.Endpoint(a.baseURL)),
+			tracing.AttrRPCMethod.String(listing),
+		))
+	items, err := a.fetchAll(ctx, listing, address, query)
+	tracing.End(span, err)
+	return items, err
+}
+
+func (a *Adapter) fetchAll(ctx context.Context, listing, address string, query url.Values) ([]item, error) {
+	if a.apiKey != "" {
+		query.Set("apikey", a.apiKey)
+	}
+	rawURL := fmt.Sprintf("%s/api/v2/addresses/%s/%s", a.baseURL, url.PathEscape(address), listing)
+
+	var items []item
+	for range maxPages {
+		p, err := a.fetch(ctx, rawURL+"?"+query.Encode())
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, p.Items...)
+		if len(p.NextPageParams) == 0 {
+			break
+		}
+		for key, raw := range p.NextPageParams {
+			if value, ok := paramValue(raw); ok {
+				query.Set(key, value)
+			}
+		}
+	}
+	return items, nil
+}
+
+func (a *Adapter) fetch(ctx context.Context, rawURL string) (*page, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Addresses the instance has never seen answer 404.
+	if resp.StatusCode == http.StatusNotFound {
+		return &page{}, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("%w %d: %s", ErrUnexpectedStatus, resp.StatusCode, string(body))
+	}
+
+	var p page
+	if err := json.NewDecoder(resp.Body).Decode(&p); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+	return &p, nil
+}
+
+// paramValue renders a next_page_params value as a query parameter, keeping
+// large block numbers and indexes out of float formatting.
+func paramValue(raw json.RawMessage) (string, bool) {
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		return s, true
+	}
+	if string(raw) == "null" {
+		return "", false
+	}
+	return string(raw), true
+}
+
+func (i item) hash() string {
+	switch {
+	case i.Hash != "":
+		return i.Hash
+	case i.TransactionHash != "":
+		return i.TransactionHash
+	default:
+		return i.TxHash
+	}
+}
+
+func (i item) transfer(kind domain.TransferKind) (domain.IndexedTransfer, error) {
+	transfer := domain.IndexedTransfer{
+		Kind: kind,
+		Hash: i.hash(),
+	}
+	switch {
+	case i.BlockNumber != nil:
+		transfer.BlockNumber = *i.BlockNumber
+	case i.Block != nil:
+		transfer.BlockNumber = *i.Block
+	}
+	if i.Timestamp != nil {
+		transfer.Timestamp = i.Timestamp.UTC()
+	}
+	if i.From != nil {
+		transfer.From = i.From.Hash
+	}
+	if i.To != nil {
+		transfer.To = i.To.Hash
+	}
+
+	switch kind {
+	case domain.TransferNormal:
+		transfer.Failed = i.Status != nil && *i.Status == "error"
+		value, err := parseBig("value", i.Value)
+		if err != nil {
+			return domain.IndexedTransfer{}, err
+		}
+		transfer.Value = value
+		transfer.Fee = new(big.Int)
+		if i.Fee != nil {
+			if transfer.Fee, err = parseBig("fee", i.Fee.Value); err != nil {
+				return domain.IndexedTransfer{}, err
+			}
+		}
+	case domain.TransferInternal:
+		transfer.Failed = i.Success != nil && !*i.Success
+		value, err := parseBig("value", i.Value)
+		if err != nil {
+			return domain.IndexedTransfer{}, err
+		}
+		transfer.Value = value
+	case domain.TransferToken:
+		if i.Token == nil || i.Total == nil {
+			return domain.IndexedTransfer{}, ErrMissingToken
+		}
+		value, err := parseBig("total", i.Total.Value)
+		if err != nil {
+			return domain.IndexedTransfer{}, err
+		}
+		transfer.Value = value
+		transfer.TokenContract = i.Token.AddressHash
+		if transfer.TokenContract == "" {
+			transfer.TokenContract = i.Token.Address
+		}
+		transfer.TokenSymbol = i.Token.Symbol
+
+		decimals := i.Total.Decimals
+		if decimals == nil {
+			decimals = i.Token.Decimals
+		}
+		if decimals != nil {
+			if transfer.TokenDecimals, err = strconv.Atoi(*decimals); err != nil {
+				return domain.IndexedTransfer{}, fmt.Errorf("%w: decimals %q", ErrInvalidNumber, *decimals)
+			}
+		}
+	}
+	return transfer, nil
+}
+
+func parseBig(field, s string) (*big.Int, error) {
+	n, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s %q", ErrInvalidNumber, field, s)
+	}
+	return n, nil
+}
@@ -0,0 +1,236 @@
+package etherscan
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/tracing"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const SourceName = "etherscan"
+
+var (
+	ErrUnexpectedStatus = errors.New("unexpected status")
+	ErrAPI              = errors.New("etherscan API error")
+	ErrInvalidNumber    = errors.New("invalid number")
+)
+
+const (
+	requestTimeout = 15 * time.Second
+	// pageSize is the number of rows requested per listing. Only the newest
+	// page is read, which covers the history of all but the busiest wallets.
+	pageSize = 1000
+	// noTransactions is the message of the status "0" answer to an empty listing.
+	noTransactions = "No transactions found"
+)
+
+var tracer = tracing.Tracer("indexer/etherscan")
+
+// Adapter reads address history from an Etherscan-compatible account API.
+// baseURL is the full API endpoint, e.g. "https://api.etherscan.io/v2/api".
+// chainID selects the network on multichain (V2) endpoints and is left out of
+// requests when zero.
+type Adapter struct {
+	baseURL string
+	apiKey  string
+	chainID int64
+	client  *http.Client
+}
+
+func NewAdapter(baseURL, apiKey string, chainID int64) *Adapter {
+	return &Adapter{
+		baseURL: baseURL,
+		apiKey:  apiKey,
+		chainID: chainID,
+		client:  &http.Client{Timeout: requestTimeout},
+	}
+}
+
+// GetTransfers lists the normal transactions, internal transactions and
+// ERC-20 transfers of address.
+func (a *Adapter) GetTransfers(ctx context.Context, address string) ([]domain.IndexedTransfer, error) {
+	var transfers []domain.IndexedTransfer
+	for _, kind := range []domain.TransferKind{domain.TransferNormal, domain.TransferInternal, domain.TransferToken} {
+		rows, err := a.list(ctx, actionOf(kind), address)
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range rows {
+			transfer, err := row.transfer(kind)
+			if err != nil {
+				return nil, fmt.Errorf("%s row %s: %w", actionOf(kind), row.Hash, err)
+			}
+			transfers = append(transfers, transfer)
+		}
+	}
+	return transfers, nil
+}
+
+func actionOf(kind domain.TransferKind) string {
+	switch kind {
+	case domain.TransferInternal:
+		return "txlistinternal"
+	case domain.TransferToken:
+		return "tokentx"
+	default:
+		return "txlist"
+	}
+}
+
+type response struct {
+	Status  string          `json:"status"`
+	Message string          `json:"message"`
+	Result  json.RawMessage `json:"result"`
+}
+
+// row holds the fields shared by the txlist, txlistinternal and tokentx
+// results. Internal transactions carry their parent's hash.
+type row struct {
+	BlockNumber     string `json:"blockNumber"`
+	TimeStamp       string `json:"timeStamp"`
+	Hash            string `json:"hash"`
+	From            string `json:"from"`
+	To              string `json:"to"`
+	Value           string `json:"value"`
+	GasUsed         string `json:"gasUsed"`
+	GasPrice        string `json:"gasPrice"`
+	IsError         string `json:"isError"`
+	ContractAddress string `json:"contractAddress"`
+	TokenSymbol     string `json:"tokenSymbol"`
+	TokenDecimal    string `json:"tokenDecimal"`
+}
+
+func (a *Adapter) list(ctx context.Context, action, address string) ([]row, error) {
+	ctx, span := tracer.Start(ctx, "etherscan."+action, trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			tracing.AttrEndpoint.String(tracing.Endpoint(a.baseURL)),
+			tracing.AttrRPCMethod.String(action),
+		))
+	rows, err := a.fetch(ctx, action, address)
+	tracing.End(span, err)
+	return rows, err
+}
+
+func (a *Adapter) fetch(ctx context.Context, action, address string) ([]row, error) {
+	query := url.Values{
+		"module":  {"account"},
+		"action":  {action},
+		"address": {address},
+		"page":    {"1"},
+		"offset":  {strconv.Itoa(pageSize)},
+		"sort":    {"desc"},
+	}
+	if a.apiKey != "" {
+		query.Set("apikey", a.apiKey)
+	}
+	if a.chainID != 0 {
+		query.Set("chainid", strconv.FormatInt(a.chainID, 10))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, a.baseURL+"?"+query.Encode(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("%w %d: %s", ErrUnexpectedStatus, resp.StatusCode, string(body))
+	}
+
+	var decoded response
+	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	// Errors come back with status "0" and a string result, and so does an
+	// empty listing.
+	if decoded.Status != "1" {
+		if strings.HasPrefix(decoded.Message, noTransactions) {
+			return nil, nil
+		}
+		var detail string
+		_ = json.Unmarshal(decoded.Result, &detail)
+		return nil, fmt.Errorf("%w: %s: %s", ErrAPI, decoded.Message, detail)
+	}
+
+	var rows []row
+	if err := json.Unmarshal(decoded.Result, &rows); err != nil {
+		return nil, fmt.Errorf("failed to decode result: %w", err)
+	}
+	return rows, nil
+}
+
+func (r row) transfer(kind domain.TransferKind) (domain.IndexedTransfer, error) {
+	blockNumber, err := strconv.ParseInt(r.BlockNumber, 10, 64)
+	if err != nil {
+		return domain.IndexedTransfer{}, fmt.Errorf("%w: blockNumber %q", ErrInvalidNumber, r.BlockNumber)
+	}
+	timestamp, err := strconv.ParseInt(r.TimeStamp, 10, 64)
+	if err != nil {
+		return domain.IndexedTransfer{}, fmt.Errorf("%w: timeStamp %q", ErrInvalidNumber, r.TimeStamp)
+	}
+	value, err := parseBig("value", r.Value)
+	if err != nil {
+		return domain.IndexedTransfer{}, err
+	}
+
+	transfer := domain.IndexedTransfer{
+		Kind:        kind,
+		Hash:        r.Hash,
+		BlockNumber: blockNumber,
+		Timestamp:   time.Unix(timestamp, 0).UTC(),
+		From:        r.From,
+		To:          r.To,
+		Value:       value,
+		Failed:      r.IsError == "1",
+	}
+
+	switch kind {
+	case domain.TransferNormal:
+		gasUsed, err := parseBig("gasUsed", r.GasUsed)
+		if err != nil {
+			return domain.IndexedTransfer{}, err
+		}
+		gasPrice, err := parseBig("gasPrice", r.GasPrice)
+		if err != nil {
+			return domain.IndexedTransfer{}, err
+		}
+		transfer.Fee = gasUsed.Mul(gasUsed, gasPrice)
+	case domain.TransferToken:
+		decimals, err := strconv.Atoi(r.TokenDecimal)
+		if err != nil {
+			return domain.IndexedTransfer{}, fmt.Errorf("%w: tokenDecimal %q", ErrInvalidNumber, r.TokenDecimal)
+		}
+		transfer.TokenContract = r.ContractAddress
+		transfer.TokenSymbol = r.TokenSymbol
+		transfer.TokenDecimals = decimals
+	case domain.TransferInternal:
+	}
+	return transfer, nil
+}
+
+func parseBig(field, s string) (*big.Int, error) {
+	n, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s %q", ErrInvalidNumber, field, s)
+	}
+	return n, nil
+}
@@ -0,0 +1,93 @@
+package etherscan_test
+
+import (
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/adapters/indexer/etherscan"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/adapters/indexer/indexertest"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	wallet = "0x1111111111111111111111111111111111111111"
+	other  = "0x2222222222222222222222222222222222222222"
+	usdc   = "0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48"
+)
+
+func transfers() []domain.IndexedTransfer {
+	at := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	return []domain.IndexedTransfer{
+		{
+			Kind: domain.TransferNormal, Hash: "0xaa", BlockNumber: 100, Timestamp: at,
+			From: wallet, To: other, Value: big.NewInt(1e18), Fee: big.NewInt(21000e9),
+		},
+		{
+			Kind: domain.TransferNormal, Hash: "0xbb", BlockNumber: 101, Timestamp: at.Add(time.Minute),
+			From: wallet, To: usdc, Value: new(big.Int), Fee: big.NewInt(50000e9), Failed: true,
+		},
+		{
+			Kind: domain.TransferInternal, Hash: "0xcc", BlockNumber: 102, Timestamp: at.Add(2 * time.Minute),
+			From: other, To: wallet, Value: big.NewInt(5e17),
+		},
+		{
+			Kind: domain.TransferToken, Hash: "0xdd", BlockNumber: 103, Timestamp: at.Add(3 * time.Minute),
+			From: other, To: wallet, Value: big.NewInt(2500000),
+			TokenContract: usdc, TokenSymbol: "USDC", TokenDecimals: 6,
+		},
+		{
+			Kind: domain.TransferNormal, Hash: "0xee", BlockNumber: 104, Timestamp: at.Add(4 * time.Minute),
+			From: other, To: usdc, Value: new(big.Int), Fee: big.NewInt(1),
+		},
+	}
+}
+
+func TestAdapter_GetTransfers(t *testing.T) {
+	t.Parallel()
+
+	srv := indexertest.NewServer(transfers()...)
+	srv.APIKey = "secret"
+	t.Cleanup(srv.Close)
+
+	adapter := etherscan.NewAdapter(srv.URL+"/api", "secret", 1)
+	got, err := adapter.GetTransfers(t.Context(), wallet)
+	require.NoError(t, err)
+	assert.Equal(t, transfers()[:4], got)
+}
+
+func TestAdapter_GetTransfers_Empty(t *testing.T) {
+	t.Parallel()
+
+	srv := indexertest.NewServer(transfers()...)
+	t.Cleanup(srv.Close)
+
+	got, err := etherscan.NewAdapter(srv.URL+"/api", "", 0).
+		GetTransfers(t.Context(), "0x3333333333333333333333333333333333333333")
+	require.NoError(t, err)
+	assert.Empty(t, got)
+}
+
+func TestAdapter_GetTransfers_Errors(t *testing.T) {
+	t.Parallel()
+
+	srv := indexertest.NewServer(transfers()...)
+	srv.APIKey = "secret"
+	t.Cleanup(srv.Close)
+
+	_, err := etherscan.NewAdapter(srv.URL+"/api", "wrong", 0).GetTransfers(t.Context(), wallet)
+	require.ErrorIs(t, err, etherscan.ErrAPI)
+	assert.ErrorContains(t, err, "Invalid API Key")
+
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	t.Cleanup(failing.Close)
+
+	_, err = etherscan.NewAdapter(failing.URL, "", 0).GetTransfers(t.Context(), wallet)
+	require.ErrorIs(t, err, etherscan.ErrUnexpectedStatus)
+}
@@ -0,0 +1,190 @@
+// Package indexertest provides a local EVM indexer for tests. It answers both
+// the Etherscan account API and the Blockscout REST API from a fixed set of
+// transfers, so adapters can be exercised without network access.
+package indexertest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+)
+
+// DefaultPageSize is the Blockscout page size used unless PageSize is set.
+const DefaultPageSize = 50
+
+// Server serves transfers under /api (Etherscan) and /api/v2 (Blockscout).
+// Requests must carry APIKey as their apikey parameter when it is set.
+type Server struct {
+	*httptest.Server
+
+	APIKey   string
+	PageSize int
+
+	transfers []domain.IndexedTransfer
+}
+
+// NewServer starts a server listing transfers. Close it when done.
+func NewServer(transfers ...domain.IndexedTransfer) *Server {
+	s := &Server{PageSize: DefaultPageSize, transfers: transfers}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api", s.etherscan)
+	mux.HandleFunc("GET /api/v2/addresses/{address}/{listing}", s.blockscout)
+	s.Server = httptest.NewServer(mux)
+	return s
+}
+
+// touching returns the transfers of kind sent from or to address, in the
+// order they were given.
+func (s *Server) touching(kind domain.TransferKind, address string) []domain.IndexedTransfer {
+	var matches []domain.IndexedTransfer
+	for _, transfer := range s.transfers {
+		if transfer.Kind == kind &&
+			(strings.EqualFold(transfer.From, address) || strings.EqualFold(transfer.To, address)) {
+			matches = append(matches, transfer)
+		}
+	}
+	return matches
+}
+
+func (s *Server) etherscan(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	if s.APIKey != "" && query.Get("apikey") != s.APIKey {
+		writeJSON(w, map[string]any{"status": "0", "message": "NOTOK", "result": "Invalid API Key"})
+		return
+	}
+
+	var kind domain.TransferKind
+	switch query.Get("action") {
+	case "txlist":
+		kind = domain.TransferNormal
+	case "txlistinternal":
+		kind = domain.TransferInternal
+	case "tokentx":
+		kind = domain.TransferToken
+	default:
+		writeJSON(w, map[string]any{"status": "0", "message": "NOTOK", "result": "Error! Missing Or invalid Action name"})
+		return
+	}
+
+	rows := []map[string]string{}
+	for _, transfer := range s.touching(kind, query.Get("address")) {
+		rows = append(rows, etherscanRow(transfer))
+	}
+	if len(rows) == 0 {
+		writeJSON(w, map[string]any{"status": "0", "message": "No transactions found", "result": rows})
+		return
+	}
+	writeJSON(w, map[string]any{"status": "1", "message": "OK", "result": rows})
+}
+
+func etherscanRow(transfer domain.IndexedTransfer) map[string]string {
+	row := map[string]string{
+		"blockNumber": strconv.FormatInt(transfer.BlockNumber, 10),
+		"timeStamp":   strconv.FormatInt(transfer.Timestamp.Unix(), 10),
+		"hash":        transfer.Hash,
+		"from":        transfer.From,
+		"to":          transfer.To,
+		"value":       transfer.Value.String(),
+		"isError":     "0",
+	}
+	if transfer.Failed {
+		row["isError"] = "1"
+	}
+	if transfer.Fee != nil {
+		// Etherscan only reports gas used and price; a gas price equal to the
+		// fee keeps their product exact.
+		row["gasUsed"] = "1"
+		row["gasPrice"] = transfer.Fee.String()
+	}
+	if transfer.Kind == domain.TransferToken {
+		row["contractAddress"] = transfer.TokenContract
+		row["tokenSymbol"] = transfer.TokenSymbol
+		row["tokenDecimal"] = strconv.Itoa(transfer.TokenDecimals)
+	}
+	return row
+}
+
+func (s *Server) blockscout(w http.ResponseWriter, r *http.Request) {
+	if s.APIKey != "" && r.URL.Query().Get("apikey") != s.APIKey {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	var kind domain.TransferKind
+	switch r.PathValue("listing") {
+	case "transactions":
+		kind = domain.TransferNormal
+	case "internal-transactions":
+		kind = domain.TransferInternal
+	case "token-transfers":
+		kind = domain.TransferToken
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	matches := s.touching(kind, r.PathValue("address"))
+	start, _ := strconv.Atoi(r.URL.Query().Get("index"))
+	end := min(start+s.PageSize, len(matches))
+	start = min(start, end)
+
+	items := make([]map[string]any, 0, end-start)
+	for _, transfer := range matches[start:end] {
+		items = append(items, blockscoutItem(transfer))
+	}
+
+	var next map[string]any
+	if end < len(matches) {
+		next = map[string]any{"index": end, "items_count": s.PageSize}
+	}
+	writeJSON(w, map[string]any{"items": items, "next_page_params": next})
+}
+
+func blockscoutItem(transfer domain.IndexedTransfer) map[string]any {
+	item := map[string]any{
+		"from":         map[string]string{"hash": transfer.From},
+		"to":           map[string]string{"hash": transfer.To},
+		"block_number": nil,
+		"timestamp":    nil,
+	}
+	if transfer.BlockNumber != 0 {
+		item["block_number"] = transfer.BlockNumber
+		item["timestamp"] = transfer.Timestamp.UTC().Format(time.RFC3339Nano)
+	}
+
+	switch transfer.Kind {
+	case domain.TransferNormal:
+		item["hash"] = transfer.Hash
+		item["value"] = transfer.Value.String()
+		item["fee"] = map[string]string{"type": "actual", "value": transfer.Fee.String()}
+		item["status"] = "ok"
+		if transfer.Failed {
+			item["status"] = "error"
+		}
+	case domain.TransferInternal:
+		item["transaction_hash"] = transfer.Hash
+		item["value"] = transfer.Value.String()
+		item["success"] = !transfer.Failed
+	case domain.TransferToken:
+		decimals := strconv.Itoa(transfer.TokenDecimals)
+		item["transaction_hash"] = transfer.Hash
+		item["token"] = map[string]any{
+			"address_hash": transfer.TokenContract,
+			"symbol":       transfer.TokenSymbol,
+			"decimals":     decimals,
+			"type":         "ERC-20",
+		}
+		item["total"] = map[string]any{"value": transfer.Value.String(), "decimals": decimals}
+	}
+	return item
+}
+
+func writeJSON(w http.ResponseWriter, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(body)
+}
@@ -4,142 +4,226 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"net/http"
 	"strings"
 	"sync"
 	"time"
 
-	cmcrest "github.com/airgap-solution/cmc-rest/openapi/clientgen/go"
 	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
 	"github.com/airgap-solution/crypto-wallet-rest/internal/ports"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/tracing"
+	"go.opentelemetry.io/otel/trace"
 )
 
-var ErrProviderNotFoundForSymbol = errors.New("provider not found for symbol")
+var ErrProviderNotFoundForSymbol = &domain.Error{Code: domain.CodeUnsupportedSymbol, Message: "provider not found for symbol"}
 
 const (
-	RateCacheTTL    = 5 * time.Second
-	BalanceCacheTTL = 30 * time.Second
+	RateCacheTTL     = 5 * time.Second
+	BalanceCacheTTL  = 30 * time.Second
+	FeeCacheTTL      = 15 * time.Second
+	LastKnownRateTTL = 24 * time.Hour
 )
 
+var tracer = tracing.Tracer("provider")
+
+// CachedRateResult is a fetched rate. Change24h is the percentage reported by
+// the rate source, nil when it reports none.
 type CachedRateResult struct {
 	Rate      float64
-	Change24h float64
-}
-
-type CMCRestClient interface {
-	V1RateCurrencyFiatGet(ctx context.Context, from, to string) cmcrest.ApiV1RateCurrencyFiatGetRequest
-	V1RateCurrencyFiatGetExecute(
-		r cmcrest.ApiV1RateCurrencyFiatGetRequest,
-	) (*cmcrest.GetRateResponse, *http.Response, error)
+	Change24h *float64
+	FetchedAt time.Time
 }
 
 type Adapter struct {
-	cmcRest         CMCRestClient
-	cryptoProviders map[string]ports.CryptoProvider
-	rateCache       *Cache[*CachedRateResult]
-	balanceCache    *Cache[float64]
+	rates               ports.RateProvider
+	historicalRates     ports.HistoricalRateProvider
+	cryptoProviders     map[string]ports.CryptoProvider
+	rateCache           *Cache[*CachedRateResult]
+	lastKnownRates      *Cache[*CachedRateResult]
+	historicalRateCache *Cache[float64]
+	balanceCache        *Cache[domain.BalanceBreakdown]
+	feeCache            *Cache[*domain.FeeEstimates]
+	rateSamples         *rateSamples
 }
 
-func NewAdapter(cmcRest CMCRestClient, cryptoProviders map[string]ports.CryptoProvider) *Adapter {
+// NewAdapter creates a provider adapter. historicalRates may be nil, in which
+// case transaction and balance history is returned without fiat values.
+func NewAdapter(
+	rates ports.RateProvider, historicalRates ports.HistoricalRateProvider,
+	cryptoProviders map[string]ports.CryptoProvider,
+) *Adapter {
 	return &Adapter{
-		cmcRest:         cmcRest,
-		cryptoProviders: cryptoProviders,
-		rateCache:       NewCache[*CachedRateResult](),
-		balanceCache:    NewCache[float64](),
+		rates:               rates,
+		historicalRates:     historicalRates,
+		cryptoProviders:     cryptoProviders,
+		rateCache:           NewCache[*CachedRateResult](),
+		lastKnownRates:      NewCache[*CachedRateResult](),
+		historicalRateCache: NewCache[float64](),
+		balanceCache:        NewCache[domain.BalanceBreakdown](),
+		feeCache:            NewCache[*domain.FeeEstimates](),
+		rateSamples:         newRateSamples(),
 	}
 }
 
-func (a *Adapter) GetBalance(symbol, addr, fiatSymbol string) (*domain.BalanceResult, error) {
+func (a *Adapter) GetBalance(ctx context.Context, symbol, addr, fiatSymbol string) (*domain.BalanceResult, error) {
+	return a.balance(ctx, symbol, addr, fiatSymbol, domain.BalanceOptions{})
+}
+
+// balance values the balance of addr, split into confirmed and pending funds
+// by opts, in fiatSymbol.
+func (a *Adapter) balance(
+	ctx context.Context, symbol, addr, fiatSymbol string, opts domain.BalanceOptions,
+) (*domain.BalanceResult, error) {
+	ctx, span := tracer.Start(ctx, "provider.GetBalance", trace.WithAttributes(tracing.ChainAttributes(symbol)...))
+	result, err := a.getBalance(ctx, symbol, addr, fiatSymbol, opts)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (a *Adapter) getBalance(
+	ctx context.Context, symbol, addr, fiatSymbol string, opts domain.BalanceOptions,
+) (*domain.BalanceResult, error) {
 	if fiatSymbol == "" {
 		fiatSymbol = "USD"
 	}
 
+	breakdown, err := a.fetchBalance(ctx, symbol, addr, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.valueBalance(ctx, symbol, addr, fiatSymbol, breakdown), nil
+}
+
+func (a *Adapter) fetchBalance(
+	ctx context.Context, symbol, addr string, opts domain.BalanceOptions,
+) (domain.BalanceBreakdown, error) {
 	prov, ok := a.cryptoProviders[strings.ToUpper(symbol)]
 	if !ok {
-		return nil, fmt.Errorf("%w: %s", ErrProviderNotFoundForSymbol, symbol)
+		return domain.BalanceBreakdown{}, fmt.Errorf("%w: %s", ErrProviderNotFoundForSymbol, symbol)
 	}
 
-	cryptoBalance, err := a.getCachedOrFetchBalance(prov, symbol, addr)
-	if err != nil {
-		return nil, err
-	}
+	return a.getCachedOrFetchBalance(ctx, prov, symbol, addr, opts)
+}
 
-	rate, change24h, err := a.getCachedOrFetchRate(symbol, fiatSymbol)
+// valueBalance converts a crypto balance to fiatSymbol. A missing rate never
+// discards the crypto balance: the item is returned with a rate error and, if
+// available, fiat values from the last known rate.
+func (a *Adapter) valueBalance(
+	ctx context.Context, symbol, addr, fiatSymbol string, breakdown domain.BalanceBreakdown,
+) *domain.BalanceResult {
+	result := a.buildBalanceResult(symbol, addr, fiatSymbol, breakdown)
+
+	rate, err := a.getCachedOrFetchRate(ctx, symbol, fiatSymbol)
 	if err != nil {
-		return nil, err
+		rateErr := err.Error()
+		result.RateError = &rateErr
+		result.RateErrorCode = domain.CodeOf(err)
+		if rate == nil {
+			return result
+		}
+		result.RateStale = true
+		result.RateAge = time.Since(rate.FetchedAt)
 	}
 
-	return a.buildBalanceResult(symbol, addr, fiatSymbol, cryptoBalance, rate, change24h), nil
+	a.applyRate(ctx, result, symbol, fiatSymbol, rate)
+	return result
 }
 
-func (a *Adapter) getCachedOrFetchBalance(prov ports.CryptoProvider, symbol, addr string) (float64, error) {
-	balanceKey := fmt.Sprintf("balance:%s:%s", strings.ToUpper(symbol), addr)
+// getCachedOrFetchBalance returns the balance of addr split by opts. Providers
+// without a view of pending funds report their whole balance as confirmed.
+func (a *Adapter) getCachedOrFetchBalance(
+	ctx context.Context, prov ports.CryptoProvider, symbol, addr string, opts domain.BalanceOptions,
+) (domain.BalanceBreakdown, error) {
+	balanceKey := fmt.Sprintf("balance:%s:%s:%d:%s", strings.ToUpper(symbol), addr, opts.MinConfirmations, opts.Commitment)
 
-	if cachedBalance, found := a.balanceCache.Get(balanceKey); found {
+	if cachedBalance, found := lookupCache(ctx, a.balanceCache, "balance", balanceKey); found {
 		return cachedBalance, nil
 	}
 
-	balance, err := prov.GetBalance(addr)
-	if err != nil {
-		return 0, fmt.Errorf("failed to get balance from provider: %w", err)
+	var breakdown domain.BalanceBreakdown
+	if bp, ok := prov.(ports.BalanceBreakdownProvider); ok {
+		b, err := bp.GetBalanceBreakdown(ctx, addr, opts)
+		if err != nil {
+			return breakdown, fmt.Errorf("failed to get balance from provider: %w", domain.UpstreamError(err))
+		}
+		breakdown = *b
+	} else {
+		balance, err := prov.GetBalance(ctx, addr)
+		if err != nil {
+			return breakdown, fmt.Errorf("failed to get balance from provider: %w", domain.UpstreamError(err))
+		}
+		breakdown = domain.ConfirmedBalance(balance)
 	}
 
-	a.balanceCache.Set(balanceKey, balance, BalanceCacheTTL)
-	return balance, nil
+	a.balanceCache.Set(balanceKey, breakdown, BalanceCacheTTL)
+	return breakdown, nil
 }
 
-func (a *Adapter) getCachedOrFetchRate(symbol, fiatSymbol string) (float64, float64, error) {
-	rateSymbol := strings.TrimSuffix(symbol, "_TESTNET")
-	rateKey := fmt.Sprintf("rate:%s:%s", strings.ToUpper(rateSymbol), strings.ToUpper(fiatSymbol))
+// getCachedOrFetchRate returns the current rate. When the rate cannot be fetched
+// it returns the error together with the last known rate, which may be nil.
+func (a *Adapter) getCachedOrFetchRate(ctx context.Context, symbol, fiatSymbol string) (*CachedRateResult, error) {
+	key := rateKey(symbol, fiatSymbol)
 
-	if cachedRate, found := a.rateCache.Get(rateKey); found {
-		return cachedRate.Rate, cachedRate.Change24h, nil
+	if cachedRate, found := lookupCache(ctx, a.rateCache, "rate", key); found {
+		return cachedRate, nil
 	}
 
-	req := a.cmcRest.V1RateCurrencyFiatGet(context.Background(), rateSymbol, fiatSymbol)
-	resp, httpResp, err := a.cmcRest.V1RateCurrencyFiatGetExecute(req)
+	rate, err := a.rates.GetRate(ctx, symbol, fiatSymbol)
 	if err != nil {
-		return 0, 0, fmt.Errorf("failed to get rate from CMC: %w", err)
-	}
-	if httpResp != nil && httpResp.Body != nil {
-		defer httpResp.Body.Close()
-	}
-
-	rate := resp.GetRate()
-	var change24h float64
-	if resp.Change24h != nil {
-		change24h = *resp.Change24h
+		lastKnown, _ := lookupCache(ctx, a.lastKnownRates, "last_known_rate", key)
+		if !errors.Is(err, domain.ErrRateUnavailable) {
+			err = fmt.Errorf("%w: %w", domain.ErrRateUnavailable, err)
+		}
+		return lastKnown, err
 	}
 
 	rateResult := &CachedRateResult{
-		Rate:      rate,
-		Change24h: change24h,
+		Rate:      rate.Rate,
+		Change24h: rate.Change24h,
+		FetchedAt: time.Now(),
 	}
-	a.rateCache.Set(rateKey, rateResult, RateCacheTTL)
+	a.rateCache.Set(key, rateResult, RateCacheTTL)
+	a.lastKnownRates.Set(key, rateResult, LastKnownRateTTL)
+	a.rateSamples.record(key, rateResult.Rate, rateResult.FetchedAt)
 
-	return rate, change24h, nil
+	return rateResult, nil
 }
 
 func (a *Adapter) buildBalanceResult(
-	symbol, addr, fiatSymbol string, cryptoBalance, rate, change24h float64,
+	symbol, addr, fiatSymbol string, breakdown domain.BalanceBreakdown,
 ) *domain.BalanceResult {
 	return &domain.BalanceResult{
 		CryptoSymbol:  strings.ToUpper(symbol),
 		Address:       addr,
-		CryptoBalance: cryptoBalance,
+		CryptoBalance: breakdown.Total(),
+		Breakdown:     breakdown,
 		FiatSymbol:    strings.ToUpper(fiatSymbol),
-		FiatValue:     cryptoBalance * rate,
-		ExchangeRate:  rate,
 		Timestamp:     time.Now(),
-		Change24h:     cryptoBalance * change24h,
 	}
 }
 
-func (a *Adapter) GetBalances(requests []domain.BalanceRequest) ([]*domain.BalanceResult, error) {
-	return a.GetBatchBalances(requests)
+func (a *Adapter) applyRate(
+	ctx context.Context, result *domain.BalanceResult, symbol, fiatSymbol string, rate *CachedRateResult,
+) {
+	fiatValue := result.CryptoBalance * rate.Rate
+	exchangeRate := rate.Rate
+
+	result.FiatValue = &fiatValue
+	result.ExchangeRate = &exchangeRate
+	result.PriceChanges = a.priceChanges(ctx, symbol, fiatSymbol, result.CryptoBalance, rate)
+	if change, ok := domain.FindPriceChange(result.PriceChanges, domain.Window24h); ok {
+		change24h := change.FiatChange
+		result.Change24h = &change24h
+	}
+}
+
+func (a *Adapter) GetBalances(ctx context.Context, requests []domain.BalanceRequest) ([]*domain.BalanceResult, error) {
+	return a.GetBatchBalances(ctx, requests)
 }
 
-func (a *Adapter) GetBatchBalances(requests []domain.BalanceRequest) ([]*domain.BalanceResult, error) {
+func (a *Adapter) GetBatchBalances(
+	ctx context.Context, requests []domain.BalanceRequest,
+) ([]*domain.BalanceResult, error) {
 	results := make([]*domain.BalanceResult, len(requests))
 	var wg sync.WaitGroup
 	var mu sync.Mutex
@@ -149,22 +233,11 @@ func (a *Adapter) GetBatchBalances(requests []domain.BalanceRequest) ([]*domain.
 		go func(index int, request domain.BalanceRequest) {
 			defer wg.Done()
 
-			result, err := a.GetBalance(request.CryptoSymbol, request.Address, request.FiatSymbol)
+			result, err := a.batchBalance(ctx, request)
 
 			mu.Lock()
 			if err != nil {
-				errorMsg := err.Error()
-				results[index] = &domain.BalanceResult{
-					CryptoSymbol:  strings.ToUpper(request.CryptoSymbol),
-					Address:       request.Address,
-					CryptoBalance: 0,
-					FiatSymbol:    strings.ToUpper(request.FiatSymbol),
-					FiatValue:     0,
-					ExchangeRate:  0,
-					Timestamp:     time.Now(),
-					Change24h:     0,
-					Error:         &errorMsg,
-				}
+				results[index] = failedBalanceResult(request.CryptoSymbol, request.Address, request.FiatSymbol, err)
 			} else {
 				results[index] = result
 			}
@@ -175,3 +248,45 @@ func (a *Adapter) GetBatchBalances(requests []domain.BalanceRequest) ([]*domain.
 	wg.Wait()
 	return results, nil
 }
+
+// failedBalanceResult reports an item whose balance could not be fetched.
+func failedBalanceResult(symbol, addr, fiatSymbol string, err error) *domain.BalanceResult {
+	errorMsg := err.Error()
+	return &domain.BalanceResult{
+		CryptoSymbol:  strings.ToUpper(symbol),
+		Address:       addr,
+		CryptoBalance: 0,
+		FiatSymbol:    strings.ToUpper(fiatSymbol),
+		Timestamp:     time.Now(),
+		Error:         &errorMsg,
+		ErrorCode:     domain.CodeOf(err),
+	}
+}
+
+// batchBalance values one batch item. An item with several fiat symbols is
+// fetched once and converted to each of them.
+func (a *Adapter) batchBalance(ctx context.Context, request domain.BalanceRequest) (*domain.BalanceResult, error) {
+	if len(request.FiatSymbols) == 0 {
+		return a.balance(ctx, request.CryptoSymbol, request.Address, request.FiatSymbol, request.Options)
+	}
+
+	conversions := a.accountValues(
+		ctx, request.CryptoSymbol, request.Address, request.FiatSymbols, request.Options)
+	result := *conversions[0]
+	result.Conversions = conversions
+	return &result, nil
+}
+
+func rateKey(symbol, fiatSymbol string) string {
+	return fmt.Sprintf("rate:%s:%s", strings.ToUpper(symbol), strings.ToUpper(fiatSymbol))
+}
+
+// lookupCache wraps a cache read in a span recording whether it hit. The key is
+// left out of the span since it embeds the wallet address.
+func lookupCache[T any](ctx context.Context, cache *Cache[T], name, key string) (T, bool) {
+	_, span := tracer.Start(ctx, "cache.Get", trace.WithAttributes(tracing.AttrCacheName.String(name)))
+	value, found := cache.Get(key)
+	span.SetAttributes(tracing.AttrCacheHit.Bool(found))
+	span.End()
+	return value, found
+}
@@ -2,6 +2,7 @@ package provider_test
 
 import (
 	"errors"
+	"fmt"
 	"io"
 	"net/http"
 	"strings"
@@ -10,9 +11,12 @@ import (
 
 	cmcrest "github.com/airgap-solution/cmc-rest/openapi/clientgen/go"
 	"github.com/airgap-solution/crypto-wallet-rest/internal/adapters/provider"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/adapters/rates/cmc"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/adapters/rates/static"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/adapters/rates/testnet"
 	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
 	"github.com/airgap-solution/crypto-wallet-rest/internal/ports"
-	cmcmocks "github.com/airgap-solution/crypto-wallet-rest/mocks/internaladaptersprovider"
+	cmcmocks "github.com/airgap-solution/crypto-wallet-rest/mocks/internaladaptersratescmc"
 	portsmocks "github.com/airgap-solution/crypto-wallet-rest/mocks/internalports"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -41,7 +45,7 @@ func TestNewAdapter(t *testing.T) {
 		"BTC": portsmocks.NewMockCryptoProvider(ctrl),
 	}
 
-	adapter := provider.NewAdapter(mockCMC, cryptoProviders)
+	adapter := provider.NewAdapter(cmc.NewAdapter(mockCMC), nil, cryptoProviders)
 	assert.NotNil(t, adapter)
 }
 
@@ -58,14 +62,14 @@ func TestAdapter_GetBalance_Success(t *testing.T) {
 		"BTC": mockCryptoProvider,
 	}
 
-	adapter := provider.NewAdapter(mockCMC, cryptoProviders)
+	adapter := provider.NewAdapter(cmc.NewAdapter(mockCMC), nil, cryptoProviders)
 
 	symbol := testSymbol
 	address := testAddress
 	fiatSymbol := testFiatSymbol
 	cryptoBalance := 0.5
 	rate := 50000.0
-	change24h := 1000.0
+	change24h := 25.0
 
 	mockRequest := cmcrest.ApiV1RateCurrencyFiatGetRequest{}
 	response := &cmcrest.GetRateResponse{}
@@ -81,18 +85,18 @@ func TestAdapter_GetBalance_Success(t *testing.T) {
 		Return(response, &http.Response{Body: http.NoBody}, nil)
 
 	mockCryptoProvider.EXPECT().
-		GetBalance(address).
+		GetBalance(gomock.Any(), address).
 		Return(cryptoBalance, nil)
 
-	result, err := adapter.GetBalance(symbol, address, fiatSymbol)
+	result, err := adapter.GetBalance(t.Context(), symbol, address, fiatSymbol)
 	require.NoError(t, err)
 	assert.Equal(t, "BTC", result.CryptoSymbol)
 	assert.Equal(t, address, result.Address)
 	assert.InEpsilon(t, cryptoBalance, result.CryptoBalance, 0.001)
 	assert.Equal(t, "USD", result.FiatSymbol)
-	assert.InEpsilon(t, cryptoBalance*rate, result.FiatValue, 0.001)
-	assert.InEpsilon(t, rate, result.ExchangeRate, 0.001)
-	assert.InEpsilon(t, cryptoBalance*change24h, result.Change24h, 0.001)
+	assert.InEpsilon(t, cryptoBalance*rate, *result.FiatValue, 0.001)
+	assert.InEpsilon(t, rate, *result.ExchangeRate, 0.001)
+	assert.InEpsilon(t, cryptoBalance*(rate-rate/(1+change24h/100)), *result.Change24h, 0.001)
 	assert.WithinDuration(t, time.Now(), result.Timestamp, time.Second)
 	assert.Nil(t, result.Error)
 }
@@ -110,7 +114,7 @@ func TestAdapter_GetBalance_DefaultFiatSymbol(t *testing.T) {
 		"ETH": mockCryptoProvider,
 	}
 
-	adapter := provider.NewAdapter(mockCMC, cryptoProviders)
+	adapter := provider.NewAdapter(cmc.NewAdapter(mockCMC), nil, cryptoProviders)
 
 	symbol := "ETH"
 	address := "0x742d35Cc6634C0532925a3b8D3A7F13f"
@@ -132,10 +136,10 @@ func TestAdapter_GetBalance_DefaultFiatSymbol(t *testing.T) {
 		Return(response, &http.Response{Body: http.NoBody}, nil)
 
 	mockCryptoProvider.EXPECT().
-		GetBalance(address).
+		GetBalance(gomock.Any(), address).
 		Return(cryptoBalance, nil)
 
-	result, err := adapter.GetBalance(symbol, address, "")
+	result, err := adapter.GetBalance(t.Context(), symbol, address, "")
 	require.NoError(t, err)
 	assert.Equal(t, "USD", result.FiatSymbol)
 }
@@ -153,14 +157,16 @@ func TestAdapter_GetBalance_TestnetSymbol(t *testing.T) {
 		"BTC_TESTNET": mockCryptoProvider,
 	}
 
-	adapter := provider.NewAdapter(mockCMC, cryptoProviders)
+	rates, err := testnet.NewAdapter(cmc.NewAdapter(mockCMC), testnet.ModeMainnet, 0)
+	require.NoError(t, err)
+	adapter := provider.NewAdapter(rates, nil, cryptoProviders)
 
 	symbol := "BTC_TESTNET"
 	address := "tb1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4"
 	fiatSymbol := "USD"
 	cryptoBalance := 0.5
 	rate := 50000.0
-	change24h := 1000.0
+	change24h := 25.0
 
 	mockRequest := cmcrest.ApiV1RateCurrencyFiatGetRequest{}
 	response := &cmcrest.GetRateResponse{}
@@ -176,10 +182,10 @@ func TestAdapter_GetBalance_TestnetSymbol(t *testing.T) {
 		Return(response, &http.Response{Body: http.NoBody}, nil)
 
 	mockCryptoProvider.EXPECT().
-		GetBalance(address).
+		GetBalance(gomock.Any(), address).
 		Return(cryptoBalance, nil)
 
-	result, err := adapter.GetBalance(symbol, address, fiatSymbol)
+	result, err := adapter.GetBalance(t.Context(), symbol, address, fiatSymbol)
 	require.NoError(t, err)
 	assert.Equal(t, "BTC_TESTNET", result.CryptoSymbol)
 	assert.Equal(t, address, result.Address)
@@ -195,14 +201,15 @@ func TestAdapter_GetBalance_ProviderNotFound(t *testing.T) {
 
 	cryptoProviders := map[string]ports.CryptoProvider{}
 
-	adapter := provider.NewAdapter(mockCMC, cryptoProviders)
+	adapter := provider.NewAdapter(cmc.NewAdapter(mockCMC), nil, cryptoProviders)
 
-	result, err := adapter.GetBalance("INVALID", "test-address", "USD")
+	result, err := adapter.GetBalance(t.Context(), "INVALID", "test-address", "USD")
 	require.Error(t, err)
 	assert.Nil(t, result)
 	assert.Contains(t, err.Error(), "provider not found for symbol")
 	assert.Contains(t, err.Error(), "INVALID")
 	assert.ErrorIs(t, err, provider.ErrProviderNotFoundForSymbol)
+	assert.ErrorIs(t, err, domain.ErrUnsupportedSymbol)
 }
 
 func TestAdapter_GetBalance_CMCError(t *testing.T) {
@@ -218,14 +225,14 @@ func TestAdapter_GetBalance_CMCError(t *testing.T) {
 		"BTC": mockCryptoProvider,
 	}
 
-	adapter := provider.NewAdapter(mockCMC, cryptoProviders)
+	adapter := provider.NewAdapter(cmc.NewAdapter(mockCMC), nil, cryptoProviders)
 
 	symbol := testSymbol
 	address := testAddress
 	fiatSymbol := testFiatSymbol
 	cryptoBalance := 1.5
 	rate := 50000.0
-	change24h := 1000.0
+	change24h := 25.0
 
 	mockRequest := cmcrest.ApiV1RateCurrencyFiatGetRequest{}
 	response := &cmcrest.GetRateResponse{}
@@ -234,7 +241,7 @@ func TestAdapter_GetBalance_CMCError(t *testing.T) {
 	cmcError := errCMCAPI
 
 	mockCryptoProvider.EXPECT().
-		GetBalance(address).
+		GetBalance(gomock.Any(), address).
 		Return(cryptoBalance, nil)
 
 	mockCMC.EXPECT().
@@ -245,11 +252,76 @@ func TestAdapter_GetBalance_CMCError(t *testing.T) {
 		V1RateCurrencyFiatGetExecute(mockRequest).
 		Return(nil, &http.Response{Body: http.NoBody}, cmcError)
 
-	result, err := adapter.GetBalance(symbol, address, fiatSymbol)
-	require.Error(t, err)
-	assert.Nil(t, result)
-	assert.Contains(t, err.Error(), "failed to get rate from CMC")
-	assert.Contains(t, err.Error(), "CMC API error")
+	result, err := adapter.GetBalance(t.Context(), symbol, address, fiatSymbol)
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	assert.InEpsilon(t, cryptoBalance, result.CryptoBalance, 0.001)
+	assert.Nil(t, result.FiatValue)
+	assert.Nil(t, result.ExchangeRate)
+	assert.Nil(t, result.Change24h)
+	assert.Nil(t, result.Error)
+	require.NotNil(t, result.RateError)
+	assert.Contains(t, *result.RateError, "failed to get rate from CMC")
+	assert.Contains(t, *result.RateError, "CMC API error")
+	assert.Equal(t, domain.CodeRateUnavailable, result.RateErrorCode)
+	assert.False(t, result.RateStale)
+}
+
+func TestAdapter_GetBalance_CMCErrorFallsBackToLastKnownRate(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCMC := cmcmocks.NewMockCMCRestClient(ctrl)
+	mockCryptoProvider := portsmocks.NewMockCryptoProvider(ctrl)
+
+	cryptoProviders := map[string]ports.CryptoProvider{
+		"BTC": mockCryptoProvider,
+	}
+
+	adapter := provider.NewAdapter(cmc.NewAdapter(mockCMC), nil, cryptoProviders)
+
+	cryptoBalance := 2.0
+	rate := 40000.0
+
+	mockRequest := cmcrest.ApiV1RateCurrencyFiatGetRequest{}
+	response := &cmcrest.GetRateResponse{}
+	response.SetRate(rate)
+
+	mockCryptoProvider.EXPECT().
+		GetBalance(gomock.Any(), testAddress).
+		Return(cryptoBalance, nil)
+
+	mockCMC.EXPECT().
+		V1RateCurrencyFiatGet(gomock.Any(), testSymbol, testFiatSymbol).
+		Return(mockRequest).
+		Times(2)
+
+	gomock.InOrder(
+		mockCMC.EXPECT().
+			V1RateCurrencyFiatGetExecute(mockRequest).
+			Return(response, &http.Response{Body: http.NoBody}, nil),
+		mockCMC.EXPECT().
+			V1RateCurrencyFiatGetExecute(mockRequest).
+			Return(nil, nil, errCMCAPI),
+	)
+
+	first, err := adapter.GetBalance(t.Context(), testSymbol, testAddress, testFiatSymbol)
+	require.NoError(t, err)
+	assert.False(t, first.RateStale)
+
+	adapter.ExpireRate(testSymbol, testFiatSymbol)
+
+	second, err := adapter.GetBalance(t.Context(), testSymbol, testAddress, testFiatSymbol)
+	require.NoError(t, err)
+	require.NotNil(t, second.FiatValue)
+	assert.InEpsilon(t, cryptoBalance*rate, *second.FiatValue, 0.001)
+	assert.InEpsilon(t, rate, *second.ExchangeRate, 0.001)
+	assert.True(t, second.RateStale)
+	assert.GreaterOrEqual(t, second.RateAge, time.Duration(0))
+	require.NotNil(t, second.RateError)
+	assert.Equal(t, domain.CodeRateUnavailable, second.RateErrorCode)
 }
 
 func TestAdapter_GetBalance_CryptoProviderError(t *testing.T) {
@@ -265,7 +337,7 @@ func TestAdapter_GetBalance_CryptoProviderError(t *testing.T) {
 		"BTC": mockCryptoProvider,
 	}
 
-	adapter := provider.NewAdapter(mockCMC, cryptoProviders)
+	adapter := provider.NewAdapter(cmc.NewAdapter(mockCMC), nil, cryptoProviders)
 
 	symbol := testSymbol
 	address := testAddress
@@ -273,14 +345,42 @@ func TestAdapter_GetBalance_CryptoProviderError(t *testing.T) {
 	providerError := errProvider
 
 	mockCryptoProvider.EXPECT().
-		GetBalance(address).
+		GetBalance(gomock.Any(), address).
 		Return(0.0, providerError)
 
-	result, err := adapter.GetBalance(symbol, address, fiatSymbol)
+	result, err := adapter.GetBalance(t.Context(), symbol, address, fiatSymbol)
 	require.Error(t, err)
 	assert.Nil(t, result)
 	assert.Contains(t, err.Error(), "failed to get balance from provider")
 	assert.Contains(t, err.Error(), "provider connection error")
+	assert.ErrorIs(t, err, domain.ErrProviderUnavailable)
+}
+
+func TestAdapter_GetBalance_InvalidAddressKeepsCode(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCMC := cmcmocks.NewMockCMCRestClient(ctrl)
+	mockCryptoProvider := portsmocks.NewMockCryptoProvider(ctrl)
+
+	cryptoProviders := map[string]ports.CryptoProvider{
+		"BTC": mockCryptoProvider,
+	}
+
+	adapter := provider.NewAdapter(cmc.NewAdapter(mockCMC), nil, cryptoProviders)
+
+	mockCryptoProvider.EXPECT().
+		GetBalance(gomock.Any(), testAddress).
+		Return(0.0, fmt.Errorf("%w: bad xpub", domain.ErrInvalidAddress))
+
+	result, err := adapter.GetBalance(t.Context(), testSymbol, testAddress, testFiatSymbol)
+	require.Error(t, err)
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, domain.ErrInvalidAddress)
+	assert.NotErrorIs(t, err, domain.ErrProviderUnavailable)
+	assert.Equal(t, domain.CodeInvalidAddress, domain.CodeOf(err))
 }
 
 func TestAdapter_GetBalance_HTTPResponseBodyClosed(t *testing.T) {
@@ -296,14 +396,14 @@ func TestAdapter_GetBalance_HTTPResponseBodyClosed(t *testing.T) {
 		"BTC": mockCryptoProvider,
 	}
 
-	adapter := provider.NewAdapter(mockCMC, cryptoProviders)
+	adapter := provider.NewAdapter(cmc.NewAdapter(mockCMC), nil, cryptoProviders)
 
 	symbol := testSymbol
 	address := testAddress
 	fiatSymbol := testFiatSymbol
 	cryptoBalance := 0.5
 	rate := 50000.0
-	change24h := 1000.0
+	change24h := 25.0
 
 	mockRequest := cmcrest.ApiV1RateCurrencyFiatGetRequest{}
 	response := &cmcrest.GetRateResponse{}
@@ -323,10 +423,10 @@ func TestAdapter_GetBalance_HTTPResponseBodyClosed(t *testing.T) {
 		Return(response, httpResp, nil)
 
 	mockCryptoProvider.EXPECT().
-		GetBalance(address).
+		GetBalance(gomock.Any(), address).
 		Return(cryptoBalance, nil)
 
-	result, err := adapter.GetBalance(symbol, address, fiatSymbol)
+	result, err := adapter.GetBalance(t.Context(), symbol, address, fiatSymbol)
 	require.NoError(t, err)
 	assert.NotNil(t, result)
 	assert.Equal(t, symbol, result.CryptoSymbol)
@@ -341,9 +441,9 @@ func TestAdapter_GetBatchBalances_EmptyRequests(t *testing.T) {
 	mockCMC := cmcmocks.NewMockCMCRestClient(ctrl)
 	cryptoProviders := map[string]ports.CryptoProvider{}
 
-	adapter := provider.NewAdapter(mockCMC, cryptoProviders)
+	adapter := provider.NewAdapter(cmc.NewAdapter(mockCMC), nil, cryptoProviders)
 
-	results, err := adapter.GetBatchBalances([]domain.BalanceRequest{})
+	results, err := adapter.GetBatchBalances(t.Context(), []domain.BalanceRequest{})
 	require.NoError(t, err)
 	assert.Empty(t, results)
 }
@@ -361,14 +461,14 @@ func TestAdapter_GetBatchBalances_SingleRequest(t *testing.T) {
 		"BTC": mockCryptoProvider,
 	}
 
-	adapter := provider.NewAdapter(mockCMC, cryptoProviders)
+	adapter := provider.NewAdapter(cmc.NewAdapter(mockCMC), nil, cryptoProviders)
 
 	symbol := testSymbol
 	address := testAddress
 	fiatSymbol := testFiatSymbol
 	cryptoBalance := 0.5
 	rate := 50000.0
-	change24h := 1000.0
+	change24h := 25.0
 
 	mockRequest := cmcrest.ApiV1RateCurrencyFiatGetRequest{}
 	response := &cmcrest.GetRateResponse{}
@@ -384,7 +484,7 @@ func TestAdapter_GetBatchBalances_SingleRequest(t *testing.T) {
 		Return(response, &http.Response{Body: http.NoBody}, nil)
 
 	mockCryptoProvider.EXPECT().
-		GetBalance(address).
+		GetBalance(gomock.Any(), address).
 		Return(cryptoBalance, nil)
 
 	requests := []domain.BalanceRequest{
@@ -395,7 +495,7 @@ func TestAdapter_GetBatchBalances_SingleRequest(t *testing.T) {
 		},
 	}
 
-	results, err := adapter.GetBatchBalances(requests)
+	results, err := adapter.GetBatchBalances(t.Context(), requests)
 	require.NoError(t, err)
 	require.Len(t, results, 1)
 
@@ -404,9 +504,9 @@ func TestAdapter_GetBatchBalances_SingleRequest(t *testing.T) {
 	assert.Equal(t, address, result.Address)
 	assert.InEpsilon(t, cryptoBalance, result.CryptoBalance, 0.001)
 	assert.Equal(t, fiatSymbol, result.FiatSymbol)
-	assert.InEpsilon(t, cryptoBalance*rate, result.FiatValue, 0.001)
-	assert.InEpsilon(t, rate, result.ExchangeRate, 0.001)
-	assert.InEpsilon(t, cryptoBalance*change24h, result.Change24h, 0.001)
+	assert.InEpsilon(t, cryptoBalance*rate, *result.FiatValue, 0.001)
+	assert.InEpsilon(t, rate, *result.ExchangeRate, 0.001)
+	assert.InEpsilon(t, cryptoBalance*(rate-rate/(1+change24h/100)), *result.Change24h, 0.001)
 	assert.Nil(t, result.Error)
 }
 
@@ -420,7 +520,7 @@ func TestAdapter_GetBatchBalances_ErrorHandling(t *testing.T) {
 
 	cryptoProviders := map[string]ports.CryptoProvider{}
 
-	adapter := provider.NewAdapter(mockCMC, cryptoProviders)
+	adapter := provider.NewAdapter(cmc.NewAdapter(mockCMC), nil, cryptoProviders)
 
 	requests := []domain.BalanceRequest{
 		{
@@ -430,7 +530,7 @@ func TestAdapter_GetBatchBalances_ErrorHandling(t *testing.T) {
 		},
 	}
 
-	results, err := adapter.GetBalances(requests)
+	results, err := adapter.GetBalances(t.Context(), requests)
 	require.NoError(t, err)
 	require.Len(t, results, 1)
 
@@ -439,11 +539,12 @@ func TestAdapter_GetBatchBalances_ErrorHandling(t *testing.T) {
 	assert.Equal(t, "test-address", result.Address)
 	assert.Equal(t, "USD", result.FiatSymbol)
 	assert.InDelta(t, float64(0), result.CryptoBalance, 0.001)
-	assert.InDelta(t, float64(0), result.FiatValue, 0.001)
-	assert.InDelta(t, float64(0), result.ExchangeRate, 0.001)
-	assert.InDelta(t, float64(0), result.Change24h, 0.001)
+	assert.Nil(t, result.FiatValue)
+	assert.Nil(t, result.ExchangeRate)
+	assert.Nil(t, result.Change24h)
 	assert.NotNil(t, result.Error)
 	assert.Contains(t, *result.Error, "provider not found for symbol")
+	assert.Equal(t, domain.CodeUnsupportedSymbol, result.ErrorCode)
 	assert.WithinDuration(t, time.Now(), result.Timestamp, time.Second)
 }
 
@@ -456,7 +557,7 @@ func TestAdapter_GetBalances_CallsBatchMethod(t *testing.T) {
 	mockCMC := cmcmocks.NewMockCMCRestClient(ctrl)
 	cryptoProviders := map[string]ports.CryptoProvider{}
 
-	adapter := provider.NewAdapter(mockCMC, cryptoProviders)
+	adapter := provider.NewAdapter(cmc.NewAdapter(mockCMC), nil, cryptoProviders)
 
 	requests := []domain.BalanceRequest{
 		{
@@ -466,7 +567,7 @@ func TestAdapter_GetBalances_CallsBatchMethod(t *testing.T) {
 		},
 	}
 
-	results, err := adapter.GetBalances(requests)
+	results, err := adapter.GetBalances(t.Context(), requests)
 
 	require.NoError(t, err)
 	require.Len(t, results, 1)
@@ -485,7 +586,7 @@ func TestAdapter_ImplementsInterfaces(t *testing.T) {
 	mockCMC := cmcmocks.NewMockCMCRestClient(ctrl)
 	cryptoProviders := map[string]ports.CryptoProvider{}
 
-	adapter := provider.NewAdapter(mockCMC, cryptoProviders)
+	adapter := provider.NewAdapter(cmc.NewAdapter(mockCMC), nil, cryptoProviders)
 
 	var _ ports.Provider = adapter
 }
@@ -503,14 +604,14 @@ func TestAdapter_GetBalance_CaseInsensitiveSymbol(t *testing.T) {
 		"BTC": mockCryptoProvider,
 	}
 
-	adapter := provider.NewAdapter(mockCMC, cryptoProviders)
+	adapter := provider.NewAdapter(cmc.NewAdapter(mockCMC), nil, cryptoProviders)
 
 	symbol := "btc"
 	address := "1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa"
 	fiatSymbol := "USD"
 	cryptoBalance := 0.5
 	rate := 50000.0
-	change24h := 1000.0
+	change24h := 25.0
 
 	mockRequest := cmcrest.ApiV1RateCurrencyFiatGetRequest{}
 	response := &cmcrest.GetRateResponse{}
@@ -526,10 +627,10 @@ func TestAdapter_GetBalance_CaseInsensitiveSymbol(t *testing.T) {
 		Return(response, &http.Response{Body: http.NoBody}, nil)
 
 	mockCryptoProvider.EXPECT().
-		GetBalance(address).
+		GetBalance(gomock.Any(), address).
 		Return(cryptoBalance, nil)
 
-	result, err := adapter.GetBalance(symbol, address, fiatSymbol)
+	result, err := adapter.GetBalance(t.Context(), symbol, address, fiatSymbol)
 
 	require.NoError(t, err)
 	assert.Equal(t, "BTC", result.CryptoSymbol)
@@ -548,7 +649,7 @@ func TestAdapter_GetBalance_NilChange24h(t *testing.T) {
 		"BTC": mockCryptoProvider,
 	}
 
-	adapter := provider.NewAdapter(mockCMC, cryptoProviders)
+	adapter := provider.NewAdapter(cmc.NewAdapter(mockCMC), nil, cryptoProviders)
 
 	symbol := "btc"
 	address := testAddress
@@ -569,13 +670,14 @@ func TestAdapter_GetBalance_NilChange24h(t *testing.T) {
 		Return(response, &http.Response{Body: http.NoBody}, nil)
 
 	mockCryptoProvider.EXPECT().
-		GetBalance(address).
+		GetBalance(gomock.Any(), address).
 		Return(cryptoBalance, nil)
 
-	result, err := adapter.GetBalance(symbol, address, fiatSymbol)
+	result, err := adapter.GetBalance(t.Context(), symbol, address, fiatSymbol)
 
 	require.NoError(t, err)
-	assert.InDelta(t, float64(0), result.Change24h, 0.001)
+	assert.Nil(t, result.Change24h)
+	assert.Empty(t, result.PriceChanges)
 }
 
 func TestAdapter_GetBalance_CachingBehavior(t *testing.T) {
@@ -590,14 +692,14 @@ func TestAdapter_GetBalance_CachingBehavior(t *testing.T) {
 		"BTC": mockCryptoProvider,
 	}
 
-	adapter := provider.NewAdapter(mockCMC, cryptoProviders)
+	adapter := provider.NewAdapter(cmc.NewAdapter(mockCMC), nil, cryptoProviders)
 
 	symbol := testSymbol
 	address := testAddress
 	fiatSymbol := testFiatSymbol
 	cryptoBalance := 1.0
 	rate := 50000.0
-	change24h := 1000.0
+	change24h := 25.0
 
 	mockRequest := cmcrest.ApiV1RateCurrencyFiatGetRequest{}
 	response := &cmcrest.GetRateResponse{}
@@ -605,7 +707,7 @@ func TestAdapter_GetBalance_CachingBehavior(t *testing.T) {
 	response.SetChange24h(change24h)
 
 	mockCryptoProvider.EXPECT().
-		GetBalance(address).
+		GetBalance(gomock.Any(), address).
 		Return(cryptoBalance, nil).
 		Times(1)
 
@@ -621,19 +723,19 @@ func TestAdapter_GetBalance_CachingBehavior(t *testing.T) {
 
 	address2 := "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"
 	mockCryptoProvider.EXPECT().
-		GetBalance(address2).
+		GetBalance(gomock.Any(), address2).
 		Return(cryptoBalance*2, nil).
 		Times(1)
 
-	result1, err1 := adapter.GetBalance(symbol, address, fiatSymbol)
+	result1, err1 := adapter.GetBalance(t.Context(), symbol, address, fiatSymbol)
 	require.NoError(t, err1)
 	assert.InEpsilon(t, cryptoBalance, result1.CryptoBalance, 0.001)
-	assert.InEpsilon(t, rate, result1.ExchangeRate, 0.001)
+	assert.InEpsilon(t, rate, *result1.ExchangeRate, 0.001)
 
-	result2, err2 := adapter.GetBalance(symbol, address2, fiatSymbol)
+	result2, err2 := adapter.GetBalance(t.Context(), symbol, address2, fiatSymbol)
 	require.NoError(t, err2)
 	assert.InEpsilon(t, cryptoBalance*2, result2.CryptoBalance, 0.001)
-	assert.InEpsilon(t, rate, result2.ExchangeRate, 0.001)
+	assert.InEpsilon(t, rate, *result2.ExchangeRate, 0.001)
 }
 
 func TestAdapter_GetBalance_BalanceCacheHit(t *testing.T) {
@@ -648,14 +750,14 @@ func TestAdapter_GetBalance_BalanceCacheHit(t *testing.T) {
 		"BTC": mockCryptoProvider,
 	}
 
-	adapter := provider.NewAdapter(mockCMC, cryptoProviders)
+	adapter := provider.NewAdapter(cmc.NewAdapter(mockCMC), nil, cryptoProviders)
 
 	symbol := testSymbol
 	address := testAddress
 	fiatSymbol := testFiatSymbol
 	cryptoBalance := 1.0
 	rate := 50000.0
-	change24h := 1000.0
+	change24h := 25.0
 
 	mockRequest := cmcrest.ApiV1RateCurrencyFiatGetRequest{}
 	response := &cmcrest.GetRateResponse{}
@@ -663,7 +765,7 @@ func TestAdapter_GetBalance_BalanceCacheHit(t *testing.T) {
 	response.SetChange24h(change24h)
 
 	mockCryptoProvider.EXPECT().
-		GetBalance(address).
+		GetBalance(gomock.Any(), address).
 		Return(cryptoBalance, nil).
 		Times(1)
 
@@ -677,13 +779,88 @@ func TestAdapter_GetBalance_BalanceCacheHit(t *testing.T) {
 		Return(response, &http.Response{Body: http.NoBody}, nil).
 		Times(1)
 
-	result1, err1 := adapter.GetBalance(symbol, address, fiatSymbol)
+	result1, err1 := adapter.GetBalance(t.Context(), symbol, address, fiatSymbol)
 	require.NoError(t, err1)
 	assert.InEpsilon(t, cryptoBalance, result1.CryptoBalance, 0.001)
-	assert.InEpsilon(t, rate, result1.ExchangeRate, 0.001)
+	assert.InEpsilon(t, rate, *result1.ExchangeRate, 0.001)
 
-	result2, err2 := adapter.GetBalance(symbol, address, fiatSymbol)
+	result2, err2 := adapter.GetBalance(t.Context(), symbol, address, fiatSymbol)
 	require.NoError(t, err2)
 	assert.InEpsilon(t, cryptoBalance, result2.CryptoBalance, 0.001)
-	assert.InEpsilon(t, rate, result2.ExchangeRate, 0.001)
+	assert.InEpsilon(t, rate, *result2.ExchangeRate, 0.001)
+}
+
+var errSourceOffline = errors.New("source offline")
+
+func TestAdapter_GetBalance_RateSourceErrorIsRateUnavailable(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRates := portsmocks.NewMockRateProvider(ctrl)
+	mockCryptoProvider := portsmocks.NewMockCryptoProvider(ctrl)
+
+	adapter := provider.NewAdapter(mockRates, nil, map[string]ports.CryptoProvider{
+		"BTC": mockCryptoProvider,
+	})
+
+	address := "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4"
+	mockCryptoProvider.EXPECT().
+		GetBalance(gomock.Any(), address).
+		Return(1.5, nil)
+	mockRates.EXPECT().
+		GetRate(gomock.Any(), "BTC", "EUR").
+		Return(nil, errSourceOffline)
+
+	result, err := adapter.GetBalance(t.Context(), "BTC", address, "EUR")
+	require.NoError(t, err)
+	assert.InDelta(t, 1.5, result.CryptoBalance, 0)
+	assert.Nil(t, result.FiatValue)
+	require.NotNil(t, result.RateError)
+	assert.Equal(t, domain.CodeRateUnavailable, result.RateErrorCode)
+}
+
+// breakdownProvider is a crypto provider that can split confirmed from
+// pending funds.
+type breakdownProvider struct {
+	*portsmocks.MockCryptoProvider
+	*portsmocks.MockBalanceBreakdownProvider
+}
+
+func TestAdapter_GetBatchBalances_Breakdown(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	prov := &breakdownProvider{
+		MockCryptoProvider:           portsmocks.NewMockCryptoProvider(ctrl),
+		MockBalanceBreakdownProvider: portsmocks.NewMockBalanceBreakdownProvider(ctrl),
+	}
+	plain := portsmocks.NewMockCryptoProvider(ctrl)
+	adapter := provider.NewAdapter(
+		static.NewAdapter(map[string]map[string]float64{"BTC": {"USD": 100}, "KAS": {"USD": 1}}), nil,
+		map[string]ports.CryptoProvider{"BTC": prov, "KAS": plain})
+
+	opts := domain.BalanceOptions{MinConfirmations: 6}
+	prov.MockBalanceBreakdownProvider.EXPECT().GetBalanceBreakdown(gomock.Any(), testAddress, opts).
+		Return(&domain.BalanceBreakdown{Confirmed: 1, PendingIncoming: 0.5, PendingOutgoing: 0.25}, nil)
+	plain.EXPECT().GetBalance(gomock.Any(), "kaspa:addr").Return(3.0, nil)
+
+	results, err := adapter.GetBatchBalances(t.Context(), []domain.BalanceRequest{
+		{CryptoSymbol: "BTC", Address: testAddress, FiatSymbol: "USD", Options: opts},
+		{CryptoSymbol: "KAS", Address: "kaspa:addr", FiatSymbol: "USD", Options: opts},
+	})
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+
+	assert.InDelta(t, 1.25, results[0].CryptoBalance, 1e-9)
+	assert.Equal(t, domain.BalanceBreakdown{Confirmed: 1, PendingIncoming: 0.5, PendingOutgoing: 0.25},
+		results[0].Breakdown)
+	require.NotNil(t, results[0].FiatValue)
+	assert.InDelta(t, 125.0, *results[0].FiatValue, 1e-9)
+
+	assert.InDelta(t, 3.0, results[1].CryptoBalance, 1e-9)
+	assert.Equal(t, domain.ConfirmedBalance(3), results[1].Breakdown)
 }
@@ -140,12 +140,13 @@ func TestCache_DifferentTypes(t *testing.T) {
 	assert.True(t, found2)
 	assert.Equal(t, 42, value2)
 
-	rate := &provider.CachedRateResult{Rate: 100.5, Change24h: 2.5}
+	change24h := 2.5
+	rate := &provider.CachedRateResult{Rate: 100.5, Change24h: &change24h}
 	structCache.Set("rate", rate, 10*time.Second)
 	value3, found3 := structCache.Get("rate")
 	assert.True(t, found3)
 	assert.InEpsilon(t, 100.5, value3.Rate, 0.001)
-	assert.InEpsilon(t, 2.5, value3.Change24h, 0.001)
+	assert.InEpsilon(t, 2.5, *value3.Change24h, 0.001)
 }
 
 //nolint:paralleltest // This test is timing-sensitive and cannot run in parallel
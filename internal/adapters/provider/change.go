@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+)
+
+const (
+	// RateSampleInterval is the minimum spacing of recorded rate samples.
+	RateSampleInterval = 5 * time.Minute
+	// rateSampleRetention keeps enough samples to cover the longest window.
+	rateSampleRetention = 30*24*time.Hour + time.Hour
+)
+
+type rateSample struct {
+	at   time.Time
+	rate float64
+}
+
+// rateSamples records the rates this process has fetched so price changes can
+// be computed from observed data, including the 1h window that daily
+// historical rates cannot cover.
+type rateSamples struct {
+	mu      sync.Mutex
+	samples map[string][]rateSample
+}
+
+func newRateSamples() *rateSamples {
+	return &rateSamples{samples: make(map[string][]rateSample)}
+}
+
+func (r *rateSamples) record(key string, rate float64, at time.Time) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	samples := r.samples[key]
+	if n := len(samples); n > 0 && at.Sub(samples[n-1].at) < RateSampleInterval {
+		return
+	}
+	samples = append(samples, rateSample{at: at, rate: rate})
+
+	cutoff := at.Add(-rateSampleRetention)
+	first := sort.Search(len(samples), func(i int) bool { return !samples[i].at.Before(cutoff) })
+	r.samples[key] = samples[first:]
+}
+
+// at returns the sample closest to t if it is within tolerance of it.
+func (r *rateSamples) at(key string, t time.Time, tolerance time.Duration) (float64, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	samples := r.samples[key]
+	i := sort.Search(len(samples), func(i int) bool { return !samples[i].at.Before(t) })
+
+	best, found := time.Duration(0), false
+	var rate float64
+	for _, j := range []int{i - 1, i} {
+		if j < 0 || j >= len(samples) {
+			continue
+		}
+		distance := absDuration(samples[j].at.Sub(t))
+		if distance <= tolerance && (!found || distance < best) {
+			best, found, rate = distance, true, samples[j].rate
+		}
+	}
+	return rate, found
+}
+
+// priceChanges computes the change of every window that has a reference rate.
+func (a *Adapter) priceChanges(
+	ctx context.Context, symbol, fiatSymbol string, balance float64, rate *CachedRateResult,
+) []domain.PriceChange {
+	changes := make([]domain.PriceChange, 0, len(domain.ChangeWindows))
+	for _, window := range domain.ChangeWindows {
+		reference, ok := a.referenceRate(ctx, symbol, fiatSymbol, window, rate)
+		if !ok {
+			continue
+		}
+		changes = append(changes, domain.NewPriceChange(window, balance, rate.Rate, reference))
+	}
+	return changes
+}
+
+// referenceRate returns the rate at the start of window, measured from the
+// time rate was fetched. Recorded samples are preferred, then the 24h change
+// reported by the rate source, then the daily historical rate.
+func (a *Adapter) referenceRate(
+	ctx context.Context, symbol, fiatSymbol string, window domain.ChangeWindow, rate *CachedRateResult,
+) (float64, bool) {
+	start := rate.FetchedAt.Add(-window.Duration())
+	tolerance := max(window.Duration()/12, RateSampleInterval)
+	if reference, ok := a.rateSamples.at(rateKey(symbol, fiatSymbol), start, tolerance); ok {
+		return reference, true
+	}
+
+	if window == domain.Window24h && rate.Change24h != nil && *rate.Change24h > -100 {
+		return rate.Rate / (1 + *rate.Change24h/100), true
+	}
+
+	if window.Duration() < 24*time.Hour {
+		return 0, false
+	}
+	reference, err := a.getCachedOrFetchHistoricalRate(ctx, symbol, fiatSymbol, start)
+	if err != nil || reference == 0 {
+		return 0, false
+	}
+	return reference, true
+}
+
+func absDuration(d time.Duration) time.Duration {
+	if d < 0 {
+		return -d
+	}
+	return d
+}
@@ -0,0 +1,145 @@
+package provider_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/adapters/provider"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/adapters/rates/static"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/ports"
+	portsmocks "github.com/airgap-solution/crypto-wallet-rest/mocks/internalports"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func btcPrices() *static.Adapter {
+	return static.NewAdapter(map[string]map[string]float64{"BTC": {"USD": 50000}})
+}
+
+func TestAdapter_GetBalance_PriceChangesFromSamples(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCryptoProvider := portsmocks.NewMockCryptoProvider(ctrl)
+	adapter := provider.NewAdapter(btcPrices(), nil, map[string]ports.CryptoProvider{"BTC": mockCryptoProvider})
+
+	now := time.Now()
+	adapter.RecordRateSample("BTC", "USD", 40000, now.Add(-24*time.Hour))
+	adapter.RecordRateSample("btc", "usd", 49000, now.Add(-time.Hour-time.Minute))
+
+	mockCryptoProvider.EXPECT().GetBalance(gomock.Any(), testAddress).Return(0.5, nil)
+
+	result, err := adapter.GetBalance(t.Context(), "BTC", testAddress, "USD")
+
+	require.NoError(t, err)
+	require.Len(t, result.PriceChanges, 2, "7d and 30d have neither samples nor a historical source")
+
+	hour := result.PriceChanges[0]
+	assert.Equal(t, domain.Window1h, hour.Window)
+	assert.InDelta(t, 49000.0, hour.ReferenceRate, 0)
+	assert.InDelta(t, 500.0, hour.FiatChange, 1e-9)
+
+	day := result.PriceChanges[1]
+	assert.Equal(t, domain.Window24h, day.Window)
+	assert.InDelta(t, 25.0, day.Percent, 1e-9)
+	assert.InDelta(t, 5000.0, day.FiatChange, 1e-9)
+	require.NotNil(t, result.Change24h)
+	assert.InDelta(t, 5000.0, *result.Change24h, 1e-9)
+}
+
+func TestAdapter_GetBalance_PriceChangesFromSourcePercent(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRates := portsmocks.NewMockRateProvider(ctrl)
+	mockCryptoProvider := portsmocks.NewMockCryptoProvider(ctrl)
+	adapter := provider.NewAdapter(mockRates, nil, map[string]ports.CryptoProvider{"BTC": mockCryptoProvider})
+
+	change24h := -20.0
+	mockRates.EXPECT().GetRate(gomock.Any(), "BTC", "USD").
+		Return(&domain.Rate{Rate: 40000, Change24h: &change24h}, nil)
+	mockCryptoProvider.EXPECT().GetBalance(gomock.Any(), testAddress).Return(2.0, nil)
+
+	result, err := adapter.GetBalance(t.Context(), "BTC", testAddress, "USD")
+
+	require.NoError(t, err)
+	require.Len(t, result.PriceChanges, 1)
+	assert.Equal(t, domain.Window24h, result.PriceChanges[0].Window)
+	assert.InDelta(t, 50000.0, result.PriceChanges[0].ReferenceRate, 1e-9)
+	assert.InDelta(t, -20.0, result.PriceChanges[0].Percent, 1e-9)
+	assert.InDelta(t, -20000.0, *result.Change24h, 1e-9)
+}
+
+func TestAdapter_GetBalance_PriceChangesFromHistoricalRates(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockHistorical := portsmocks.NewMockHistoricalRateProvider(ctrl)
+	mockCryptoProvider := portsmocks.NewMockCryptoProvider(ctrl)
+	adapter := provider.NewAdapter(btcPrices(), mockHistorical,
+		map[string]ports.CryptoProvider{"BTC": mockCryptoProvider})
+
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	closes := map[time.Time]float64{
+		today.AddDate(0, 0, -1):  45000,
+		today.AddDate(0, 0, -7):  40000,
+		today.AddDate(0, 0, -30): 25000,
+	}
+	mockHistorical.EXPECT().GetHistoricalRate(gomock.Any(), "BTC", "USD", gomock.Any()).
+		DoAndReturn(func(_ context.Context, _, _ string, at time.Time) (*domain.Rate, error) {
+			return &domain.Rate{Rate: closes[at]}, nil
+		}).Times(3)
+	mockCryptoProvider.EXPECT().GetBalance(gomock.Any(), testAddress).Return(1.0, nil)
+
+	result, err := adapter.GetBalance(t.Context(), "BTC", testAddress, "USD")
+
+	require.NoError(t, err)
+	require.Len(t, result.PriceChanges, 3, "1h needs recorded samples")
+
+	expected := []struct {
+		window  domain.ChangeWindow
+		percent float64
+	}{
+		{domain.Window24h, 100.0 / 9},
+		{domain.Window7d, 25},
+		{domain.Window30d, 100},
+	}
+	for i, want := range expected {
+		assert.Equal(t, want.window, result.PriceChanges[i].Window)
+		assert.InDelta(t, want.percent, result.PriceChanges[i].Percent, 1e-9)
+	}
+}
+
+func TestAdapter_RecordRateSample_Spacing(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCryptoProvider := portsmocks.NewMockCryptoProvider(ctrl)
+	adapter := provider.NewAdapter(btcPrices(), nil, map[string]ports.CryptoProvider{"BTC": mockCryptoProvider})
+
+	// The second sample is too close to the first and is dropped, so the 1h
+	// reference is the first one.
+	now := time.Now()
+	adapter.RecordRateSample("BTC", "USD", 48000, now.Add(-time.Hour-2*time.Minute))
+	adapter.RecordRateSample("BTC", "USD", 49000, now.Add(-time.Hour))
+
+	mockCryptoProvider.EXPECT().GetBalance(gomock.Any(), testAddress).Return(1.0, nil)
+
+	result, err := adapter.GetBalance(t.Context(), "BTC", testAddress, "USD")
+
+	require.NoError(t, err)
+	change, ok := domain.FindPriceChange(result.PriceChanges, domain.Window1h)
+	require.True(t, ok)
+	assert.InDelta(t, 48000.0, change.ReferenceRate, 0)
+}
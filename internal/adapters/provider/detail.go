@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/ports"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/tracing"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var ErrTransactionDetailNotSupported = &domain.Error{
+	Code:    domain.CodeUnsupportedSymbol,
+	Message: "transaction lookup not supported for symbol",
+}
+
+// GetTransactionDetail looks up txid on the chain of symbol, marking the
+// parts of it that belong to wallet when wallet is set.
+func (a *Adapter) GetTransactionDetail(
+	ctx context.Context, symbol, txid, wallet string,
+) (*domain.TransactionDetail, error) {
+	ctx, span := tracer.Start(ctx, "provider.GetTransactionDetail",
+		trace.WithAttributes(tracing.ChainAttributes(symbol)...))
+	detail, err := a.getTransactionDetail(ctx, symbol, txid, wallet)
+	tracing.End(span, err)
+	return detail, err
+}
+
+func (a *Adapter) getTransactionDetail(
+	ctx context.Context, symbol, txid, wallet string,
+) (*domain.TransactionDetail, error) {
+	prov, ok := a.cryptoProviders[strings.ToUpper(symbol)]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrProviderNotFoundForSymbol, symbol)
+	}
+	lookup, ok := prov.(ports.TransactionDetailProvider)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrTransactionDetailNotSupported, symbol)
+	}
+
+	detail, err := lookup.GetTransactionDetail(ctx, txid, wallet)
+	if err != nil {
+		return nil, domain.UpstreamError(err)
+	}
+	return detail, nil
+}
@@ -0,0 +1,62 @@
+package provider_test
+
+import (
+	"testing"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/adapters/provider"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/adapters/rates/static"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/ports"
+	portsmocks "github.com/airgap-solution/crypto-wallet-rest/mocks/internalports"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// detailProvider is a crypto provider that can look up single transactions.
+type detailProvider struct {
+	*portsmocks.MockCryptoProvider
+	*portsmocks.MockTransactionDetailProvider
+}
+
+func TestAdapter_GetTransactionDetail(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	prov := &detailProvider{
+		MockCryptoProvider:            portsmocks.NewMockCryptoProvider(ctrl),
+		MockTransactionDetailProvider: portsmocks.NewMockTransactionDetailProvider(ctrl),
+	}
+	adapter := provider.NewAdapter(static.NewAdapter(nil), nil, map[string]ports.CryptoProvider{"BTC": prov})
+
+	detail := &domain.TransactionDetail{TransactionID: "tx1", Status: domain.TransactionConfirmed}
+	prov.MockTransactionDetailProvider.EXPECT().GetTransactionDetail(gomock.Any(), "tx1", testAddress).
+		Return(detail, nil)
+	prov.MockTransactionDetailProvider.EXPECT().GetTransactionDetail(gomock.Any(), "tx2", "").
+		Return(nil, domain.ErrTransactionNotFound)
+
+	got, err := adapter.GetTransactionDetail(t.Context(), "btc", "tx1", testAddress)
+	require.NoError(t, err)
+	assert.Equal(t, detail, got)
+
+	_, err = adapter.GetTransactionDetail(t.Context(), "BTC", "tx2", "")
+	require.ErrorIs(t, err, domain.ErrTransactionNotFound)
+}
+
+func TestAdapter_GetTransactionDetail_Unsupported(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	adapter := provider.NewAdapter(static.NewAdapter(nil), nil,
+		map[string]ports.CryptoProvider{"ETH": portsmocks.NewMockCryptoProvider(ctrl)})
+
+	_, err := adapter.GetTransactionDetail(t.Context(), "ETH", "tx1", "")
+	require.ErrorIs(t, err, provider.ErrTransactionDetailNotSupported)
+
+	_, err = adapter.GetTransactionDetail(t.Context(), "DOGE", "tx1", "")
+	require.ErrorIs(t, err, provider.ErrProviderNotFoundForSymbol)
+}
@@ -1,6 +1,8 @@
 package provider
 
-import "time"
+import (
+	"time"
+)
 
 func (c *Cache[T]) CleanupExpiredItems() {
 	c.cleanupExpiredItems()
@@ -9,3 +11,11 @@ func (c *Cache[T]) CleanupExpiredItems() {
 func NewCacheWithInterval[T any](cleanupInterval time.Duration) *Cache[T] {
 	return newCacheWithInterval[T](cleanupInterval)
 }
+
+func (a *Adapter) ExpireRate(symbol, fiatSymbol string) {
+	a.rateCache.Delete(rateKey(symbol, fiatSymbol))
+}
+
+func (a *Adapter) RecordRateSample(symbol, fiatSymbol string, rate float64, at time.Time) {
+	a.rateSamples.record(rateKey(symbol, fiatSymbol), rate, at)
+}
@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/ports"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/tracing"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var ErrFeesNotSupported = &domain.Error{
+	Code:    domain.CodeUnsupportedSymbol,
+	Message: "fee estimation not supported for symbol",
+}
+
+// EstimateFees returns the slow, normal and fast fee estimates of symbol with
+// the fee of a typical transaction valued in fiatSymbol. Chain estimates are
+// cached for FeeCacheTTL. Without a rate the fiat values stay nil, unless a
+// last known rate is available.
+func (a *Adapter) EstimateFees(ctx context.Context, symbol, fiatSymbol string) (*domain.FeeEstimates, error) {
+	ctx, span := tracer.Start(ctx, "provider.EstimateFees", trace.WithAttributes(tracing.ChainAttributes(symbol)...))
+	fees, err := a.estimateFees(ctx, symbol, fiatSymbol)
+	tracing.End(span, err)
+	return fees, err
+}
+
+func (a *Adapter) estimateFees(ctx context.Context, symbol, fiatSymbol string) (*domain.FeeEstimates, error) {
+	if fiatSymbol == "" {
+		fiatSymbol = "USD"
+	}
+
+	cached, err := a.getCachedOrFetchFees(ctx, symbol)
+	if err != nil {
+		return nil, err
+	}
+
+	fees := *cached
+	fees.CryptoSymbol = strings.ToUpper(symbol)
+	fees.FiatSymbol = strings.ToUpper(fiatSymbol)
+	fees.Estimates = slices.Clone(cached.Estimates)
+
+	rate, err := a.getCachedOrFetchRate(ctx, symbol, fiatSymbol)
+	if err != nil {
+		rateErr := err.Error()
+		fees.RateError = &rateErr
+		fees.RateErrorCode = domain.CodeOf(err)
+		if rate == nil {
+			return &fees, nil
+		}
+	}
+
+	exchangeRate := rate.Rate
+	fees.ExchangeRate = &exchangeRate
+	for i := range fees.Estimates {
+		fiatValue := fees.Estimates[i].Amount * rate.Rate
+		fees.Estimates[i].FiatValue = &fiatValue
+	}
+	return &fees, nil
+}
+
+func (a *Adapter) getCachedOrFetchFees(ctx context.Context, symbol string) (*domain.FeeEstimates, error) {
+	prov, ok := a.cryptoProviders[strings.ToUpper(symbol)]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrProviderNotFoundForSymbol, symbol)
+	}
+	estimator, ok := prov.(ports.FeeEstimator)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrFeesNotSupported, symbol)
+	}
+
+	key := "fees:" + strings.ToUpper(symbol)
+	if cached, found := lookupCache(ctx, a.feeCache, "fees", key); found {
+		return cached, nil
+	}
+
+	fees, err := estimator.EstimateFees(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to estimate fees: %w", domain.UpstreamError(err))
+	}
+
+	a.feeCache.Set(key, fees, FeeCacheTTL)
+	return fees, nil
+}
@@ -0,0 +1,77 @@
+package provider_test
+
+import (
+	"testing"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/adapters/provider"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/adapters/rates/static"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/ports"
+	portsmocks "github.com/airgap-solution/crypto-wallet-rest/mocks/internalports"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// feeProvider is a crypto provider that can estimate fees.
+type feeProvider struct {
+	*portsmocks.MockCryptoProvider
+	*portsmocks.MockFeeEstimator
+}
+
+func TestAdapter_EstimateFees(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	prov := &feeProvider{
+		MockCryptoProvider: portsmocks.NewMockCryptoProvider(ctrl),
+		MockFeeEstimator:   portsmocks.NewMockFeeEstimator(ctrl),
+	}
+	adapter := provider.NewAdapter(static.NewAdapter(map[string]map[string]float64{"BTC": {"EUR": 50000}}), nil,
+		map[string]ports.CryptoProvider{"BTC": prov})
+
+	prov.MockFeeEstimator.EXPECT().EstimateFees(gomock.Any()).Return(&domain.FeeEstimates{
+		CryptoSymbol: "BTC",
+		RateUnit:     "sat/vB",
+		SizeUnit:     "vbytes",
+		TypicalSize:  141,
+		Estimates: []domain.FeeEstimate{
+			{Level: domain.FeeLevelSlow, Rate: 1, Amount: 0.00000141},
+			{Level: domain.FeeLevelFast, Rate: 10, Amount: 0.0000141},
+		},
+	}, nil).Times(1)
+
+	fees, err := adapter.EstimateFees(t.Context(), "btc", "eur")
+	require.NoError(t, err)
+	assert.Equal(t, "EUR", fees.FiatSymbol)
+	require.NotNil(t, fees.ExchangeRate)
+	require.Len(t, fees.Estimates, 2)
+	require.NotNil(t, fees.Estimates[1].FiatValue)
+	assert.InDelta(t, 0.705, *fees.Estimates[1].FiatValue, 1e-9)
+
+	// The chain estimate is cached, a second fiat symbol only needs a rate.
+	fees, err = adapter.EstimateFees(t.Context(), "BTC", "USD")
+	require.NoError(t, err)
+	assert.Nil(t, fees.ExchangeRate)
+	assert.Nil(t, fees.Estimates[1].FiatValue)
+	require.NotNil(t, fees.RateError)
+	assert.Equal(t, domain.CodeRateUnavailable, fees.RateErrorCode)
+}
+
+func TestAdapter_EstimateFees_Unsupported(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	adapter := provider.NewAdapter(static.NewAdapter(nil), nil,
+		map[string]ports.CryptoProvider{"BTC": portsmocks.NewMockCryptoProvider(ctrl)})
+
+	_, err := adapter.EstimateFees(t.Context(), "BTC", "USD")
+	require.ErrorIs(t, err, provider.ErrFeesNotSupported)
+
+	_, err = adapter.EstimateFees(t.Context(), "DOGE", "USD")
+	require.ErrorIs(t, err, provider.ErrProviderNotFoundForSymbol)
+}
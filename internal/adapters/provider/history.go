@@ -0,0 +1,386 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/ports"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/tracing"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var ErrTransactionsNotSupported = &domain.Error{
+	Code:    domain.CodeUnsupportedSymbol,
+	Message: "transaction history not supported for symbol",
+}
+
+const (
+	// HistoricalRateCacheTTL is long since a closed day's price does not change.
+	HistoricalRateCacheTTL = 24 * time.Hour
+	// MaxHistoryDays bounds a balance history request to a year of daily points.
+	MaxHistoryDays = 366
+
+	day = 24 * time.Hour
+)
+
+// GetTransactions returns a page of the history of an address with each entry
+// valued at the rate of the day it was confirmed. Entries without a historical
+// rate keep nil fiat fields instead of failing the whole listing.
+func (a *Adapter) GetTransactions(
+	ctx context.Context, symbol, addr, fiatSymbol string, page domain.PageRequest,
+) (*domain.TransactionPage, error) {
+	ctx, span := tracer.Start(ctx, "provider.GetTransactions", trace.WithAttributes(tracing.ChainAttributes(symbol)...))
+	result, err := a.getTransactions(ctx, symbol, addr, fiatSymbol, page)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (a *Adapter) getTransactions(
+	ctx context.Context, symbol, addr, fiatSymbol string, page domain.PageRequest,
+) (*domain.TransactionPage, error) {
+	if fiatSymbol == "" {
+		fiatSymbol = "USD"
+	}
+
+	result, err := a.fetchTransactionPage(ctx, symbol, addr, page)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range result.Transactions {
+		tx := &result.Transactions[i]
+		tx.FiatSymbol = strings.ToUpper(fiatSymbol)
+		// Token amounts are not priced in the chain's native coin.
+		if tx.IsToken() {
+			continue
+		}
+		if rate, err := a.getCachedOrFetchHistoricalRate(ctx, symbol, fiatSymbol, tx.Timestamp); err == nil {
+			fiatValue := tx.Amount * rate
+			exchangeRate := rate
+			tx.FiatValue = &fiatValue
+			tx.ExchangeRate = &exchangeRate
+		}
+	}
+
+	return result, nil
+}
+
+// fetchTransactionPage asks providers that page history themselves for the
+// page and filters it, and cuts the page out of the filtered full listing of
+// the others. Cursors of the latter name the last entry of the previous page,
+// so they keep their place when new transactions arrive.
+func (a *Adapter) fetchTransactionPage(
+	ctx context.Context, symbol, addr string, page domain.PageRequest,
+) (*domain.TransactionPage, error) {
+	if prov, ok := a.cryptoProviders[strings.ToUpper(symbol)]; ok {
+		if pager, ok := prov.(ports.TransactionPager); ok {
+			if page.Order == domain.SortOldestFirst {
+				return nil, fmt.Errorf("%w: %s history can only be listed newest first",
+					domain.ErrBadRequest, strings.ToUpper(symbol))
+			}
+			result, err := pager.GetTransactionPage(ctx, addr, page)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get transactions from provider: %w", domain.UpstreamError(err))
+			}
+			result.Transactions = filterTransactions(result.Transactions, page.Filter)
+			return result, nil
+		}
+	}
+
+	txs, err := a.fetchTransactions(ctx, symbol, addr)
+	if err != nil {
+		return nil, err
+	}
+	txs = filterTransactions(txs, page.Filter)
+	sort.SliceStable(txs, func(i, j int) bool { return newerEntry(txs[i], txs[j]) })
+	if page.Order == domain.SortOldestFirst {
+		slices.Reverse(txs)
+	}
+
+	start := page.Offset
+	if page.Cursor != "" {
+		if start, err = cursorStart(txs, page.Cursor, page.Order); err != nil {
+			return nil, err
+		}
+	}
+
+	total := len(txs)
+	start = min(start, total)
+	end := min(start+page.Limit, total)
+	result := &domain.TransactionPage{Transactions: txs[start:end], TotalCount: &total}
+	if end < total && end > start {
+		result.NextCursor = entryCursor(txs[end-1])
+	}
+	return result, nil
+}
+
+func filterTransactions(txs []domain.Transaction, filter domain.TransactionFilter) []domain.Transaction {
+	filtered := make([]domain.Transaction, 0, len(txs))
+	for _, tx := range txs {
+		if filter.Matches(tx) {
+			filtered = append(filtered, tx)
+		}
+	}
+	return filtered
+}
+
+// newerEntry orders history newest first. Entries of the same time are
+// ordered by transaction ID and then token, native coin entries first, so the
+// order does not depend on the order the provider listed them in.
+func newerEntry(a, b domain.Transaction) bool {
+	if !a.Timestamp.Equal(b.Timestamp) {
+		return a.Timestamp.After(b.Timestamp)
+	}
+	if a.TransactionID != b.TransactionID {
+		return a.TransactionID < b.TransactionID
+	}
+	return a.TokenContract < b.TokenContract
+}
+
+// entryCursor returns the cursor of the page after tx: the time, transaction
+// ID and token of tx.
+func entryCursor(tx domain.Transaction) string {
+	return strings.Join([]string{
+		strconv.FormatInt(tx.Timestamp.UnixNano(), 10), tx.TransactionID, tx.TokenContract,
+	}, "|")
+}
+
+// cursorStart returns the index of the entry after the one cursor names.
+// When that entry left the listing, for example because a block was
+// reorganised away, the page starts at the first entry that sorts after its
+// time, transaction ID and token.
+func cursorStart(txs []domain.Transaction, cursor string, order domain.SortOrder) (int, error) {
+	parts := strings.Split(cursor, "|")
+	if len(parts) != 3 {
+		return 0, fmt.Errorf("%w: invalid cursor", domain.ErrBadRequest)
+	}
+	nanos, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: invalid cursor", domain.ErrBadRequest)
+	}
+
+	for i, tx := range txs {
+		if tx.TransactionID == parts[1] && tx.TokenContract == parts[2] {
+			return i + 1, nil
+		}
+	}
+
+	at := domain.Transaction{Timestamp: time.Unix(0, nanos), TransactionID: parts[1], TokenContract: parts[2]}
+	for i, tx := range txs {
+		if order == domain.SortOldestFirst && newerEntry(tx, at) ||
+			order != domain.SortOldestFirst && newerEntry(at, tx) {
+			return i, nil
+		}
+	}
+	return len(txs), nil
+}
+
+// GetBalanceHistory returns the end of day balance and fiat value of each
+// wallet for every UTC day between from and to. Balances are reconstructed by
+// walking the transaction history back from the current balance.
+func (a *Adapter) GetBalanceHistory(
+	ctx context.Context, requests []domain.BalanceRequest, fiatSymbol string, from, to time.Time,
+) (*domain.BalanceHistory, error) {
+	ctx, span := tracer.Start(ctx, "provider.GetBalanceHistory",
+		trace.WithAttributes(tracing.AttrBatchSize.Int(len(requests))))
+	history, err := a.getBalanceHistory(ctx, requests, fiatSymbol, from, to)
+	tracing.End(span, err)
+	return history, err
+}
+
+func (a *Adapter) getBalanceHistory(
+	ctx context.Context, requests []domain.BalanceRequest, fiatSymbol string, from, to time.Time,
+) (*domain.BalanceHistory, error) {
+	if fiatSymbol == "" {
+		fiatSymbol = "USD"
+	}
+
+	days, err := historyDays(from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	wallets := make([]domain.WalletHistory, len(requests))
+	var wg sync.WaitGroup
+	for i, req := range requests {
+		wg.Add(1)
+		go func(index int, request domain.BalanceRequest) {
+			defer wg.Done()
+			wallets[index] = a.walletHistory(ctx, request, fiatSymbol, days)
+		}(i, req)
+	}
+	wg.Wait()
+
+	return &domain.BalanceHistory{
+		FiatSymbol: strings.ToUpper(fiatSymbol),
+		From:       days[0],
+		To:         days[len(days)-1],
+		Totals:     historyTotals(wallets, days),
+		Wallets:    wallets,
+	}, nil
+}
+
+func (a *Adapter) walletHistory(
+	ctx context.Context, request domain.BalanceRequest, fiatSymbol string, days []time.Time,
+) domain.WalletHistory {
+	wallet := domain.WalletHistory{
+		CryptoSymbol: strings.ToUpper(request.CryptoSymbol),
+		Address:      request.Address,
+	}
+
+	points, err := a.historyPoints(ctx, request.CryptoSymbol, request.Address, fiatSymbol, days)
+	if err != nil {
+		errorMsg := err.Error()
+		wallet.Error = &errorMsg
+		wallet.ErrorCode = domain.CodeOf(err)
+		return wallet
+	}
+
+	wallet.Points = points
+	return wallet
+}
+
+func (a *Adapter) historyPoints(
+	ctx context.Context, symbol, addr, fiatSymbol string, days []time.Time,
+) ([]domain.HistoryPoint, error) {
+	prov, ok := a.cryptoProviders[strings.ToUpper(symbol)]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrProviderNotFoundForSymbol, symbol)
+	}
+
+	breakdown, err := a.getCachedOrFetchBalance(ctx, prov, symbol, addr, domain.BalanceOptions{})
+	if err != nil {
+		return nil, err
+	}
+	balance := breakdown.Total()
+
+	txs, err := a.fetchTransactions(ctx, symbol, addr)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(txs, func(i, j int) bool { return txs[i].Timestamp.After(txs[j].Timestamp) })
+
+	// Walk back from today: the balance at the end of a day is the current
+	// balance minus every change made after that day ended.
+	points := make([]domain.HistoryPoint, len(days))
+	next := 0
+	for i := len(days) - 1; i >= 0; i-- {
+		endOfDay := days[i].Add(day)
+		for next < len(txs) && !txs[next].Timestamp.Before(endOfDay) {
+			if !txs[next].IsToken() {
+				balance -= txs[next].NetAmount()
+			}
+			next++
+		}
+
+		point := domain.HistoryPoint{Date: days[i], CryptoBalance: balance}
+		if rate, err := a.getCachedOrFetchHistoricalRate(ctx, symbol, fiatSymbol, days[i]); err == nil {
+			fiatValue := balance * rate
+			exchangeRate := rate
+			point.FiatValue = &fiatValue
+			point.ExchangeRate = &exchangeRate
+		}
+		points[i] = point
+	}
+
+	return points, nil
+}
+
+func (a *Adapter) fetchTransactions(ctx context.Context, symbol, addr string) ([]domain.Transaction, error) {
+	prov, ok := a.cryptoProviders[strings.ToUpper(symbol)]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrProviderNotFoundForSymbol, symbol)
+	}
+
+	txProv, ok := prov.(ports.TransactionProvider)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrTransactionsNotSupported, symbol)
+	}
+
+	txs, err := txProv.GetTransactions(ctx, addr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transactions from provider: %w", domain.UpstreamError(err))
+	}
+	return txs, nil
+}
+
+// getCachedOrFetchHistoricalRate returns the daily rate for the UTC day
+// containing at. The current day is still open, so it uses the live rate.
+func (a *Adapter) getCachedOrFetchHistoricalRate(
+	ctx context.Context, symbol, fiatSymbol string, at time.Time,
+) (float64, error) {
+	date := at.UTC().Truncate(day)
+	if !date.Before(time.Now().UTC().Truncate(day)) {
+		rate, err := a.getCachedOrFetchRate(ctx, symbol, fiatSymbol)
+		if rate == nil {
+			return 0, err
+		}
+		return rate.Rate, nil
+	}
+
+	if a.historicalRates == nil {
+		return 0, fmt.Errorf("%w: no historical rate source configured", domain.ErrRateUnavailable)
+	}
+
+	symbol, fiatSymbol = strings.ToUpper(symbol), strings.ToUpper(fiatSymbol)
+	rateKey := fmt.Sprintf("historical_rate:%s:%s:%s", symbol, fiatSymbol, date.Format(time.DateOnly))
+
+	if cachedRate, found := lookupCache(ctx, a.historicalRateCache, "historical_rate", rateKey); found {
+		return cachedRate, nil
+	}
+
+	rate, err := a.historicalRates.GetHistoricalRate(ctx, symbol, fiatSymbol, date)
+	if err != nil {
+		if !errors.Is(err, domain.ErrRateUnavailable) {
+			err = fmt.Errorf("%w: %w", domain.ErrRateUnavailable, err)
+		}
+		return 0, err
+	}
+
+	a.historicalRateCache.Set(rateKey, rate.Rate, HistoricalRateCacheTTL)
+	return rate.Rate, nil
+}
+
+// historyDays returns the UTC midnights from from to to, both included.
+func historyDays(from, to time.Time) ([]time.Time, error) {
+	from = from.UTC().Truncate(day)
+	to = to.UTC().Truncate(day)
+	if to.Before(from) {
+		return nil, fmt.Errorf("%w: history end date is before start date", domain.ErrBadRequest)
+	}
+
+	count := int(to.Sub(from)/day) + 1
+	if count > MaxHistoryDays {
+		return nil, fmt.Errorf("%w: history range exceeds %d days", domain.ErrBadRequest, MaxHistoryDays)
+	}
+
+	days := make([]time.Time, count)
+	for i := range days {
+		days[i] = from.Add(time.Duration(i) * day)
+	}
+	return days, nil
+}
+
+func historyTotals(wallets []domain.WalletHistory, days []time.Time) []domain.HistoryTotal {
+	totals := make([]domain.HistoryTotal, len(days))
+	for i, date := range days {
+		total := domain.HistoryTotal{Date: date, Complete: true}
+		for _, wallet := range wallets {
+			if wallet.Error != nil || wallet.Points[i].FiatValue == nil {
+				total.Complete = false
+				continue
+			}
+			total.FiatValue += *wallet.Points[i].FiatValue
+		}
+		totals[i] = total
+	}
+	return totals
+}
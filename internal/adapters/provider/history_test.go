@@ -0,0 +1,356 @@
+package provider_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/adapters/provider"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/adapters/rates/static"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/ports"
+	portsmocks "github.com/airgap-solution/crypto-wallet-rest/mocks/internalports"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// historyProvider is a crypto provider that can also list transactions.
+type historyProvider struct {
+	*portsmocks.MockCryptoProvider
+	*portsmocks.MockTransactionProvider
+}
+
+func newHistoryProvider(ctrl *gomock.Controller) *historyProvider {
+	return &historyProvider{
+		MockCryptoProvider:      portsmocks.NewMockCryptoProvider(ctrl),
+		MockTransactionProvider: portsmocks.NewMockTransactionProvider(ctrl),
+	}
+}
+
+func utcDate(year int, month time.Month, day, hour int) time.Time {
+	return time.Date(year, month, day, hour, 0, 0, 0, time.UTC)
+}
+
+func TestAdapter_GetTransactions_FiatValuation(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	prov := newHistoryProvider(ctrl)
+	historical := portsmocks.NewMockHistoricalRateProvider(ctrl)
+	adapter := provider.NewAdapter(static.NewAdapter(nil), historical,
+		map[string]ports.CryptoProvider{"BTC": prov})
+
+	prov.MockTransactionProvider.EXPECT().GetTransactions(gomock.Any(), testAddress).Return([]domain.Transaction{
+		{TransactionID: "tx2", Timestamp: utcDate(2024, 1, 2, 15), Amount: 0.5},
+		{TransactionID: "tx1", Timestamp: utcDate(2024, 1, 1, 9), Amount: 1},
+		{TransactionID: "tx1", Timestamp: utcDate(2024, 1, 1, 9), Amount: 25, TokenContract: "0xa0b8", TokenSymbol: "USDC"},
+	}, nil)
+	historical.EXPECT().GetHistoricalRate(gomock.Any(), "BTC", "EUR", utcDate(2024, 1, 2, 0)).
+		Return(&domain.Rate{Rate: 40000}, nil)
+	historical.EXPECT().GetHistoricalRate(gomock.Any(), "BTC", "EUR", utcDate(2024, 1, 1, 0)).
+		Return(nil, errors.New("no price"))
+
+	page, err := adapter.GetTransactions(t.Context(), "BTC", testAddress, "eur", domain.PageRequest{Limit: 50})
+
+	require.NoError(t, err)
+	require.NotNil(t, page.TotalCount)
+	assert.Equal(t, 3, *page.TotalCount)
+	assert.Empty(t, page.NextCursor)
+	txs := page.Transactions
+	require.Len(t, txs, 3)
+	assert.Equal(t, "EUR", txs[0].FiatSymbol)
+	require.NotNil(t, txs[0].FiatValue)
+	assert.InDelta(t, 20000.0, *txs[0].FiatValue, 0)
+	assert.InDelta(t, 40000.0, *txs[0].ExchangeRate, 0)
+	assert.Equal(t, "EUR", txs[1].FiatSymbol)
+	assert.Nil(t, txs[1].FiatValue)
+	assert.Nil(t, txs[1].ExchangeRate)
+	assert.Equal(t, "USDC", txs[2].TokenSymbol)
+	assert.Nil(t, txs[2].FiatValue)
+}
+
+func TestAdapter_GetTransactions_NotSupported(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	adapter := provider.NewAdapter(static.NewAdapter(nil), nil,
+		map[string]ports.CryptoProvider{"SOL": portsmocks.NewMockCryptoProvider(ctrl)})
+
+	_, err := adapter.GetTransactions(t.Context(), "SOL", testAddress, testFiatSymbol, domain.PageRequest{Limit: 50})
+	require.ErrorIs(t, err, provider.ErrTransactionsNotSupported)
+	assert.Equal(t, domain.CodeUnsupportedSymbol, domain.CodeOf(err))
+
+	_, err = adapter.GetTransactions(t.Context(), "DOGE", testAddress, testFiatSymbol, domain.PageRequest{Limit: 50})
+	require.ErrorIs(t, err, provider.ErrProviderNotFoundForSymbol)
+}
+
+func TestAdapter_GetTransactions_Cursor(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	prov := newHistoryProvider(ctrl)
+	adapter := provider.NewAdapter(static.NewAdapter(nil), nil, map[string]ports.CryptoProvider{"BTC": prov})
+
+	day1 := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	history := []domain.Transaction{
+		{TransactionID: "tx3", Timestamp: day1.AddDate(0, 0, 2)},
+		{TransactionID: "tx2", Timestamp: day1.AddDate(0, 0, 1)},
+		{TransactionID: "tx1", Timestamp: day1},
+	}
+	prov.MockTransactionProvider.EXPECT().GetTransactions(gomock.Any(), testAddress).Return(history[1:], nil)
+	prov.MockTransactionProvider.EXPECT().GetTransactions(gomock.Any(), testAddress).Return(history, nil).Times(2)
+
+	page, err := adapter.GetTransactions(t.Context(), "BTC", testAddress, "", domain.PageRequest{Limit: 1})
+	require.NoError(t, err)
+	require.Len(t, page.Transactions, 1)
+	assert.Equal(t, "tx2", page.Transactions[0].TransactionID)
+	require.NotEmpty(t, page.NextCursor)
+
+	// tx3 arrived in the meantime: the cursor keeps its place where an
+	// offset would list tx2 again.
+	next, err := adapter.GetTransactions(t.Context(), "BTC", testAddress, "",
+		domain.PageRequest{Cursor: page.NextCursor, Limit: 5})
+	require.NoError(t, err)
+	require.Len(t, next.Transactions, 1)
+	assert.Equal(t, "tx1", next.Transactions[0].TransactionID)
+	assert.Empty(t, next.NextCursor)
+	require.NotNil(t, next.TotalCount)
+	assert.Equal(t, 3, *next.TotalCount)
+
+	_, err = adapter.GetTransactions(t.Context(), "BTC", testAddress, "", domain.PageRequest{Cursor: "tx1", Limit: 5})
+	require.ErrorIs(t, err, domain.ErrBadRequest)
+}
+
+func TestAdapter_GetTransactions_CursorEntryRemoved(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	prov := newHistoryProvider(ctrl)
+	adapter := provider.NewAdapter(static.NewAdapter(nil), nil, map[string]ports.CryptoProvider{"BTC": prov})
+
+	day1 := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	history := []domain.Transaction{
+		{TransactionID: "tx4", Timestamp: day1.AddDate(0, 0, 2)},
+		{TransactionID: "tx1", Timestamp: day1.AddDate(0, 0, 1)},
+		{TransactionID: "tx2", Timestamp: day1.AddDate(0, 0, 1)},
+		{TransactionID: "tx3", Timestamp: day1.AddDate(0, 0, 1)},
+		{TransactionID: "tx0", Timestamp: day1},
+	}
+	// tx2 is reorganised away after the first page of each order.
+	withoutTx2 := append(append([]domain.Transaction{}, history[:2]...), history[3:]...)
+	gomock.InOrder(
+		prov.MockTransactionProvider.EXPECT().GetTransactions(gomock.Any(), testAddress).Return(history, nil),
+		prov.MockTransactionProvider.EXPECT().GetTransactions(gomock.Any(), testAddress).Return(withoutTx2, nil),
+		prov.MockTransactionProvider.EXPECT().GetTransactions(gomock.Any(), testAddress).Return(history, nil),
+		prov.MockTransactionProvider.EXPECT().GetTransactions(gomock.Any(), testAddress).Return(withoutTx2, nil),
+	)
+
+	page, err := adapter.GetTransactions(t.Context(), "BTC", testAddress, "", domain.PageRequest{Limit: 3})
+	require.NoError(t, err)
+	require.Len(t, page.Transactions, 3)
+	assert.Equal(t, "tx2", page.Transactions[2].TransactionID)
+
+	// tx3 shares the time of tx2 and is not skipped, nor is tx1 oldest first.
+	next, err := adapter.GetTransactions(t.Context(), "BTC", testAddress, "",
+		domain.PageRequest{Cursor: page.NextCursor, Limit: 5})
+	require.NoError(t, err)
+	require.Len(t, next.Transactions, 2)
+	assert.Equal(t, "tx3", next.Transactions[0].TransactionID)
+	assert.Equal(t, "tx0", next.Transactions[1].TransactionID)
+
+	page, err = adapter.GetTransactions(t.Context(), "BTC", testAddress, "",
+		domain.PageRequest{Limit: 3, Order: domain.SortOldestFirst})
+	require.NoError(t, err)
+	require.Len(t, page.Transactions, 3)
+	assert.Equal(t, "tx2", page.Transactions[2].TransactionID)
+
+	next, err = adapter.GetTransactions(t.Context(), "BTC", testAddress, "",
+		domain.PageRequest{Cursor: page.NextCursor, Limit: 5, Order: domain.SortOldestFirst})
+	require.NoError(t, err)
+	require.Len(t, next.Transactions, 2)
+	assert.Equal(t, "tx1", next.Transactions[0].TransactionID)
+	assert.Equal(t, "tx4", next.Transactions[1].TransactionID)
+}
+
+func TestAdapter_GetTransactions_Filter(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	prov := newHistoryProvider(ctrl)
+	adapter := provider.NewAdapter(static.NewAdapter(nil), nil, map[string]ports.CryptoProvider{"BTC": prov})
+
+	day1 := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	prov.MockTransactionProvider.EXPECT().GetTransactions(gomock.Any(), testAddress).Return([]domain.Transaction{
+		{TransactionID: "pending", Timestamp: day1.AddDate(0, 0, 3), Amount: 5, Direction: domain.DirectionIncoming},
+		{TransactionID: "out", Timestamp: day1.AddDate(0, 0, 2), Amount: 2, Direction: domain.DirectionOutgoing,
+			Confirmations: 1},
+		{TransactionID: "small", Timestamp: day1.AddDate(0, 0, 1), Amount: 0.1, Direction: domain.DirectionIncoming,
+			Confirmations: 2},
+		{TransactionID: "in", Timestamp: day1, Amount: 1, Direction: domain.DirectionIncoming, Confirmations: 3},
+	}, nil).AnyTimes()
+
+	ids := func(page *domain.TransactionPage) []string {
+		var ids []string
+		for _, tx := range page.Transactions {
+			ids = append(ids, tx.TransactionID)
+		}
+		return ids
+	}
+
+	page, err := adapter.GetTransactions(t.Context(), "BTC", testAddress, "", domain.PageRequest{
+		Limit:  1,
+		Filter: domain.TransactionFilter{Direction: domain.DirectionIncoming, MinAmount: 0.5},
+		Order:  domain.SortOldestFirst,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"in"}, ids(page))
+	require.NotNil(t, page.TotalCount)
+	assert.Equal(t, 2, *page.TotalCount)
+
+	page, err = adapter.GetTransactions(t.Context(), "BTC", testAddress, "", domain.PageRequest{
+		Cursor: page.NextCursor,
+		Limit:  1,
+		Filter: domain.TransactionFilter{Direction: domain.DirectionIncoming, MinAmount: 0.5},
+		Order:  domain.SortOldestFirst,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"pending"}, ids(page))
+	assert.Empty(t, page.NextCursor)
+
+	page, err = adapter.GetTransactions(t.Context(), "BTC", testAddress, "", domain.PageRequest{
+		Limit: 10,
+		Filter: domain.TransactionFilter{
+			Status: domain.TransactionConfirmed,
+			Since:  day1.AddDate(0, 0, 1),
+			Until:  day1.AddDate(0, 0, 2),
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"out", "small"}, ids(page))
+
+	page, err = adapter.GetTransactions(t.Context(), "BTC", testAddress, "", domain.PageRequest{
+		Limit:  10,
+		Filter: domain.TransactionFilter{Status: domain.TransactionPending},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"pending"}, ids(page))
+}
+
+// pagingProvider is a crypto provider that pages its history itself.
+type pagingProvider struct {
+	*portsmocks.MockCryptoProvider
+	*portsmocks.MockTransactionPager
+}
+
+func TestAdapter_GetTransactions_Pager(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	prov := &pagingProvider{
+		MockCryptoProvider:   portsmocks.NewMockCryptoProvider(ctrl),
+		MockTransactionPager: portsmocks.NewMockTransactionPager(ctrl),
+	}
+	adapter := provider.NewAdapter(static.NewAdapter(map[string]map[string]float64{"SOL": {"USD": 150}}), nil,
+		map[string]ports.CryptoProvider{"SOL": prov})
+
+	request := domain.PageRequest{Cursor: "sig9", Limit: 2, Filter: domain.TransactionFilter{MinAmount: 1}}
+	prov.MockTransactionPager.EXPECT().GetTransactionPage(gomock.Any(), testAddress, request).
+		Return(&domain.TransactionPage{
+			Transactions: []domain.Transaction{
+				{TransactionID: "sig8", Timestamp: time.Now(), Amount: 2},
+				{TransactionID: "sig7", Timestamp: time.Now(), Amount: 0.5},
+			},
+			NextCursor: "sig7",
+		}, nil)
+
+	page, err := adapter.GetTransactions(t.Context(), "sol", testAddress, "usd", request)
+	require.NoError(t, err)
+	assert.Nil(t, page.TotalCount)
+	assert.Equal(t, "sig7", page.NextCursor)
+	require.Len(t, page.Transactions, 1)
+	assert.Equal(t, "sig8", page.Transactions[0].TransactionID)
+	require.NotNil(t, page.Transactions[0].FiatValue)
+	assert.InDelta(t, 300.0, *page.Transactions[0].FiatValue, 1e-9)
+
+	_, err = adapter.GetTransactions(t.Context(), "sol", testAddress, "usd",
+		domain.PageRequest{Limit: 2, Order: domain.SortOldestFirst})
+	require.ErrorIs(t, err, domain.ErrBadRequest)
+}
+
+func TestAdapter_GetBalanceHistory(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	prov := newHistoryProvider(ctrl)
+	historical := portsmocks.NewMockHistoricalRateProvider(ctrl)
+	adapter := provider.NewAdapter(static.NewAdapter(nil), historical,
+		map[string]ports.CryptoProvider{"BTC": prov})
+
+	prov.MockCryptoProvider.EXPECT().GetBalance(gomock.Any(), testAddress).Return(1.25, nil)
+	prov.MockTransactionProvider.EXPECT().GetTransactions(gomock.Any(), testAddress).Return([]domain.Transaction{
+		{TransactionID: "tx1", Timestamp: utcDate(2024, 1, 2, 10), Amount: 1, Direction: domain.DirectionIncoming},
+		{TransactionID: "tx3", Timestamp: utcDate(2024, 2, 1, 0), Amount: 0.5, Direction: domain.DirectionIncoming},
+		{TransactionID: "tx2", Timestamp: utcDate(2024, 1, 3, 23), Amount: 0.25, Direction: domain.DirectionOutgoing},
+	}, nil)
+	historical.EXPECT().GetHistoricalRate(gomock.Any(), "BTC", "USD", gomock.Any()).
+		Return(&domain.Rate{Rate: 40000}, nil).Times(3)
+
+	history, err := adapter.GetBalanceHistory(t.Context(), []domain.BalanceRequest{
+		{CryptoSymbol: "btc", Address: testAddress},
+		{CryptoSymbol: "DOGE", Address: testAddress},
+	}, "", utcDate(2024, 1, 1, 12), utcDate(2024, 1, 3, 0))
+
+	require.NoError(t, err)
+	assert.Equal(t, "USD", history.FiatSymbol)
+	assert.Equal(t, utcDate(2024, 1, 1, 0), history.From)
+	assert.Equal(t, utcDate(2024, 1, 3, 0), history.To)
+
+	require.Len(t, history.Wallets, 2)
+	btc := history.Wallets[0]
+	assert.Equal(t, "BTC", btc.CryptoSymbol)
+	assert.Nil(t, btc.Error)
+	require.Len(t, btc.Points, 3)
+	assert.InDelta(t, 0.0, btc.Points[0].CryptoBalance, 1e-9)
+	assert.InDelta(t, 1.0, btc.Points[1].CryptoBalance, 1e-9)
+	assert.InDelta(t, 0.75, btc.Points[2].CryptoBalance, 1e-9)
+	require.NotNil(t, btc.Points[2].FiatValue)
+	assert.InDelta(t, 30000.0, *btc.Points[2].FiatValue, 1e-6)
+
+	doge := history.Wallets[1]
+	require.NotNil(t, doge.Error)
+	assert.Empty(t, doge.Points)
+
+	require.Len(t, history.Totals, 3)
+	assert.InDelta(t, 30000.0, history.Totals[2].FiatValue, 1e-6)
+	assert.False(t, history.Totals[2].Complete)
+}
+
+func TestAdapter_GetBalanceHistory_InvalidRange(t *testing.T) {
+	t.Parallel()
+
+	adapter := provider.NewAdapter(static.NewAdapter(nil), nil, map[string]ports.CryptoProvider{})
+	requests := []domain.BalanceRequest{{CryptoSymbol: "BTC", Address: testAddress}}
+
+	_, err := adapter.GetBalanceHistory(t.Context(), requests, testFiatSymbol,
+		utcDate(2024, 1, 2, 0), utcDate(2024, 1, 1, 0))
+	require.ErrorIs(t, err, domain.ErrBadRequest)
+
+	_, err = adapter.GetBalanceHistory(t.Context(), requests, testFiatSymbol,
+		utcDate(2023, 1, 1, 0), utcDate(2024, 1, 2, 0))
+	require.ErrorIs(t, err, domain.ErrBadRequest)
+}
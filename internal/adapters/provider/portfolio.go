@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/tracing"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// GetPortfolio values every account of wallets in each of fiatSymbols. Each
+// balance is fetched once and converted per fiat symbol through the rate cache.
+func (a *Adapter) GetPortfolio(
+	ctx context.Context, wallets []domain.PortfolioWallet, fiatSymbols []string,
+) (*domain.Portfolio, error) {
+	accounts := 0
+	for _, wallet := range wallets {
+		accounts += len(wallet.Accounts)
+	}
+
+	ctx, span := tracer.Start(ctx, "provider.GetPortfolio",
+		trace.WithAttributes(tracing.AttrBatchSize.Int(accounts)))
+	portfolio, err := a.getPortfolio(ctx, wallets, fiatSymbols)
+	tracing.End(span, err)
+	return portfolio, err
+}
+
+func (a *Adapter) getPortfolio(
+	ctx context.Context, wallets []domain.PortfolioWallet, fiatSymbols []string,
+) (*domain.Portfolio, error) {
+	if len(wallets) == 0 {
+		return nil, fmt.Errorf("%w: at least one wallet is required", domain.ErrBadRequest)
+	}
+	fiatSymbols = normalizeFiatSymbols(fiatSymbols)
+
+	results := make([][][]*domain.BalanceResult, len(wallets))
+	var wg sync.WaitGroup
+	for w, wallet := range wallets {
+		results[w] = make([][]*domain.BalanceResult, len(wallet.Accounts))
+		for i, account := range wallet.Accounts {
+			wg.Go(func() {
+				results[w][i] = a.accountValues(ctx, account.CryptoSymbol, account.Address, fiatSymbols, domain.BalanceOptions{})
+			})
+		}
+	}
+	wg.Wait()
+
+	return domain.NewPortfolio(wallets, fiatSymbols, results), nil
+}
+
+// accountValues values one balance in every fiat symbol. A failed balance
+// fetch yields an error result per fiat symbol.
+func (a *Adapter) accountValues(
+	ctx context.Context, symbol, addr string, fiatSymbols []string, opts domain.BalanceOptions,
+) []*domain.BalanceResult {
+	results := make([]*domain.BalanceResult, len(fiatSymbols))
+	breakdown, err := a.fetchBalance(ctx, symbol, addr, opts)
+	for i, fiatSymbol := range fiatSymbols {
+		if err != nil {
+			results[i] = failedBalanceResult(symbol, addr, fiatSymbol, err)
+			continue
+		}
+		results[i] = a.valueBalance(ctx, symbol, addr, fiatSymbol, breakdown)
+	}
+	return results
+}
+
+// normalizeFiatSymbols upper-cases and dedupes fiatSymbols, defaulting to USD.
+func normalizeFiatSymbols(fiatSymbols []string) []string {
+	normalized := make([]string, 0, len(fiatSymbols))
+	for _, fiatSymbol := range fiatSymbols {
+		fiatSymbol = strings.ToUpper(strings.TrimSpace(fiatSymbol))
+		if fiatSymbol != "" && !slices.Contains(normalized, fiatSymbol) {
+			normalized = append(normalized, fiatSymbol)
+		}
+	}
+	if len(normalized) == 0 {
+		return []string{"USD"}
+	}
+	return normalized
+}
@@ -0,0 +1,134 @@
+package provider_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/adapters/provider"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/adapters/rates/static"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/ports"
+	portsmocks "github.com/airgap-solution/crypto-wallet-rest/mocks/internalports"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAdapter_GetPortfolio(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	btc := portsmocks.NewMockCryptoProvider(ctrl)
+	eth := portsmocks.NewMockCryptoProvider(ctrl)
+	adapter := provider.NewAdapter(static.NewAdapter(map[string]map[string]float64{
+		"BTC": {"USD": 60000, "EUR": 50000},
+		"ETH": {"USD": 3000, "EUR": 2500},
+	}), nil, map[string]ports.CryptoProvider{"BTC": btc, "ETH": eth})
+
+	now := time.Now()
+	adapter.RecordRateSample("BTC", "USD", 50000, now.Add(-24*time.Hour))
+	adapter.RecordRateSample("ETH", "USD", 2000, now.Add(-24*time.Hour))
+
+	// Each balance is fetched once, however many fiat symbols are requested.
+	btc.EXPECT().GetBalance(gomock.Any(), "cold-btc").Return(1.0, nil)
+	btc.EXPECT().GetBalance(gomock.Any(), "hot-btc").Return(0.5, nil)
+	eth.EXPECT().GetBalance(gomock.Any(), "cold-eth").Return(10.0, nil)
+
+	portfolio, err := adapter.GetPortfolio(t.Context(), []domain.PortfolioWallet{
+		{Name: "cold", Accounts: []domain.PortfolioAccount{
+			{CryptoSymbol: "btc", Address: "cold-btc"},
+			{CryptoSymbol: "ETH", Address: "cold-eth"},
+		}},
+		{Name: "hot", Accounts: []domain.PortfolioAccount{
+			{CryptoSymbol: "BTC", Address: "hot-btc"},
+			{CryptoSymbol: "DOGE", Address: "hot-doge"},
+		}},
+	}, []string{"usd", "EUR", "USD"})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"USD", "EUR"}, portfolio.FiatSymbols)
+
+	require.Len(t, portfolio.Totals, 2)
+	usd := portfolio.Totals[0]
+	assert.InDelta(t, 120000.0, usd.FiatValue, 1e-9)
+	assert.False(t, usd.Complete, "the DOGE account could not be valued")
+	assert.Nil(t, usd.ExchangeRate, "the total mixes assets")
+	assert.Nil(t, usd.AllocationPercent)
+	require.NotNil(t, usd.Change24h)
+	assert.InDelta(t, 25000.0, *usd.Change24h, 1e-9)
+	assert.InDelta(t, 25000.0/95000.0*100, *usd.Change24hPercent, 1e-9)
+	eur := portfolio.Totals[1]
+	assert.InDelta(t, 100000.0, eur.FiatValue, 1e-9)
+	assert.Nil(t, eur.Change24h, "no EUR history is known")
+
+	require.Len(t, portfolio.Wallets, 2)
+	cold := portfolio.Wallets[0]
+	assert.Equal(t, "cold", cold.Name)
+	assert.True(t, cold.Values[0].Complete)
+	assert.InDelta(t, 90000.0, cold.Values[0].FiatValue, 1e-9)
+	require.NotNil(t, cold.Values[0].AllocationPercent)
+	assert.InDelta(t, 75.0, *cold.Values[0].AllocationPercent, 1e-9)
+	require.NotNil(t, cold.Accounts[0].Values[1].ExchangeRate)
+	assert.InDelta(t, 50000.0, *cold.Accounts[0].Values[1].ExchangeRate, 0)
+
+	hot := portfolio.Wallets[1]
+	assert.False(t, hot.Values[0].Complete)
+	assert.Nil(t, hot.Values[0].ExchangeRate)
+	doge := hot.Accounts[1]
+	require.NotNil(t, doge.Error)
+	assert.Equal(t, domain.CodeUnsupportedSymbol, doge.ErrorCode)
+	require.Len(t, doge.Values, 2)
+	assert.False(t, doge.Values[1].Complete)
+
+	require.Len(t, portfolio.Assets, 2)
+	assets := portfolio.Assets[0]
+	assert.Equal(t, "BTC", assets.CryptoSymbol)
+	assert.InDelta(t, 1.5, assets.CryptoBalance, 1e-9)
+	require.NotNil(t, assets.Values[0].ExchangeRate)
+	assert.InDelta(t, 60000.0, *assets.Values[0].ExchangeRate, 0)
+	assert.InDelta(t, 75.0, *assets.Values[0].AllocationPercent, 1e-9)
+	assert.Equal(t, "ETH", portfolio.Assets[1].CryptoSymbol)
+	assert.InDelta(t, 25.0, *portfolio.Assets[1].Values[1].AllocationPercent, 1e-9)
+}
+
+func TestAdapter_GetPortfolio_NoWallets(t *testing.T) {
+	t.Parallel()
+
+	adapter := provider.NewAdapter(static.NewAdapter(nil), nil, nil)
+
+	_, err := adapter.GetPortfolio(t.Context(), nil, nil)
+	require.ErrorIs(t, err, domain.ErrBadRequest)
+}
+
+func TestAdapter_GetBatchBalances_FiatConversions(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	btc := portsmocks.NewMockCryptoProvider(ctrl)
+	adapter := provider.NewAdapter(static.NewAdapter(map[string]map[string]float64{
+		"BTC": {"USD": 60000, "CAD": 80000},
+	}), nil, map[string]ports.CryptoProvider{"BTC": btc})
+
+	btc.EXPECT().GetBalance(gomock.Any(), testAddress).Return(0.5, nil)
+
+	results, err := adapter.GetBatchBalances(t.Context(), []domain.BalanceRequest{{
+		CryptoSymbol: "BTC", Address: testAddress, FiatSymbol: "CAD", FiatSymbols: []string{"CAD", "USD", "EUR"},
+	}})
+
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	result := results[0]
+	assert.Equal(t, "CAD", result.FiatSymbol)
+	assert.InDelta(t, 40000.0, *result.FiatValue, 1e-9)
+
+	require.Len(t, result.Conversions, 3)
+	assert.Equal(t, "USD", result.Conversions[1].FiatSymbol)
+	assert.InDelta(t, 30000.0, *result.Conversions[1].FiatValue, 1e-9)
+	assert.Nil(t, result.Conversions[2].FiatValue)
+	assert.Equal(t, domain.CodeRateUnavailable, result.Conversions[2].RateErrorCode)
+	assert.InDelta(t, 0.5, result.Conversions[2].CryptoBalance, 0)
+}
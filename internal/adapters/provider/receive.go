@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/ports"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/tracing"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var ErrReceiveAddressesNotSupported = &domain.Error{
+	Code:    domain.CodeUnsupportedSymbol,
+	Message: "receive address derivation not supported for symbol",
+}
+
+// GetReceiveAddresses returns count consecutive unused external addresses of
+// xpub on the chain of symbol.
+func (a *Adapter) GetReceiveAddresses(
+	ctx context.Context, symbol, xpub string, count int,
+) ([]domain.ReceiveAddress, error) {
+	ctx, span := tracer.Start(ctx, "provider.GetReceiveAddresses", trace.WithAttributes(tracing.ChainAttributes(symbol)...))
+	addresses, err := a.getReceiveAddresses(ctx, symbol, xpub, count)
+	tracing.End(span, err)
+	return addresses, err
+}
+
+func (a *Adapter) getReceiveAddresses(
+	ctx context.Context, symbol, xpub string, count int,
+) ([]domain.ReceiveAddress, error) {
+	prov, ok := a.cryptoProviders[strings.ToUpper(symbol)]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrProviderNotFoundForSymbol, symbol)
+	}
+	deriver, ok := prov.(ports.ReceiveAddressProvider)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrReceiveAddressesNotSupported, symbol)
+	}
+	return deriver.GetReceiveAddresses(ctx, xpub, count)
+}
@@ -0,0 +1,58 @@
+package provider_test
+
+import (
+	"testing"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/adapters/provider"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/adapters/rates/static"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/ports"
+	portsmocks "github.com/airgap-solution/crypto-wallet-rest/mocks/internalports"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// receivingProvider is a crypto provider that can derive receive addresses.
+type receivingProvider struct {
+	*portsmocks.MockCryptoProvider
+	*portsmocks.MockReceiveAddressProvider
+}
+
+func TestAdapter_GetReceiveAddresses(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	prov := &receivingProvider{
+		MockCryptoProvider:         portsmocks.NewMockCryptoProvider(ctrl),
+		MockReceiveAddressProvider: portsmocks.NewMockReceiveAddressProvider(ctrl),
+	}
+	adapter := provider.NewAdapter(static.NewAdapter(nil), nil, map[string]ports.CryptoProvider{"BTC": prov})
+
+	want := []domain.ReceiveAddress{{Index: 4, Address: "bc1qnext", DerivationPath: "m/84'/0'/0'/0/4"}}
+	prov.MockReceiveAddressProvider.EXPECT().GetReceiveAddresses(gomock.Any(), testAddress, 1).Return(want, nil)
+
+	addresses, err := adapter.GetReceiveAddresses(t.Context(), "btc", testAddress, 1)
+
+	require.NoError(t, err)
+	assert.Equal(t, want, addresses)
+}
+
+func TestAdapter_GetReceiveAddresses_Unsupported(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	adapter := provider.NewAdapter(static.NewAdapter(nil), nil,
+		map[string]ports.CryptoProvider{"SOL": portsmocks.NewMockCryptoProvider(ctrl)})
+
+	_, err := adapter.GetReceiveAddresses(t.Context(), "SOL", testAddress, 1)
+	require.ErrorIs(t, err, provider.ErrReceiveAddressesNotSupported)
+	assert.Equal(t, domain.CodeUnsupportedSymbol, domain.CodeOf(err))
+
+	_, err = adapter.GetReceiveAddresses(t.Context(), "DOGE", testAddress, 1)
+	require.ErrorIs(t, err, provider.ErrProviderNotFoundForSymbol)
+}
@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/ports"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/tracing"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var (
+	ErrUnsignedTxNotSupported = &domain.Error{
+		Code:    domain.CodeUnsupportedSymbol,
+		Message: "unsigned transaction building not supported for symbol",
+	}
+	ErrBatchTxNotSupported = &domain.Error{
+		Code:    domain.CodeUnsupportedSymbol,
+		Message: "several outputs, OP_RETURN, coin control, RBF and lock time options not supported for symbol",
+	}
+	ErrFeeBumpNotSupported = &domain.Error{
+		Code:    domain.CodeUnsupportedSymbol,
+		Message: "fee bumping not supported for symbol",
+	}
+)
+
+// BuildUnsignedTx builds an unsigned transaction on the chain of symbol for
+// an air-gapped signer. Requests using batch options need a provider that
+// takes them.
+func (a *Adapter) BuildUnsignedTx(
+	ctx context.Context, symbol string, request domain.UnsignedTxRequest,
+) (*domain.UnsignedTx, error) {
+	ctx, span := tracer.Start(ctx, "provider.BuildUnsignedTx", trace.WithAttributes(tracing.ChainAttributes(symbol)...))
+	tx, err := a.buildUnsignedTx(ctx, symbol, request)
+	tracing.End(span, err)
+	return tx, err
+}
+
+func (a *Adapter) buildUnsignedTx(
+	ctx context.Context, symbol string, request domain.UnsignedTxRequest,
+) (*domain.UnsignedTx, error) {
+	prov, ok := a.cryptoProviders[strings.ToUpper(symbol)]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrProviderNotFoundForSymbol, symbol)
+	}
+	builder, ok := prov.(ports.UnsignedTxBuilder)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnsignedTxNotSupported, symbol)
+	}
+
+	build := builder.BuildUnsignedTx
+	if request.IsBatch() {
+		batcher, ok := prov.(ports.BatchTxBuilder)
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrBatchTxNotSupported, symbol)
+		}
+		build = batcher.BuildBatchTx
+	}
+
+	tx, err := build(ctx, request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build unsigned transaction: %w", domain.UpstreamError(err))
+	}
+	tx.CryptoSymbol = strings.ToUpper(symbol)
+	return tx, nil
+}
+
+// BumpFee builds an unsigned transaction speeding up an unconfirmed
+// transaction of a wallet on the chain of symbol.
+func (a *Adapter) BumpFee(ctx context.Context, symbol string, request domain.FeeBumpRequest) (*domain.UnsignedTx, error) {
+	ctx, span := tracer.Start(ctx, "provider.BumpFee", trace.WithAttributes(tracing.ChainAttributes(symbol)...))
+	tx, err := a.bumpFee(ctx, symbol, request)
+	tracing.End(span, err)
+	return tx, err
+}
+
+func (a *Adapter) bumpFee(ctx context.Context, symbol string, request domain.FeeBumpRequest) (*domain.UnsignedTx, error) {
+	prov, ok := a.cryptoProviders[strings.ToUpper(symbol)]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrProviderNotFoundForSymbol, symbol)
+	}
+	bumper, ok := prov.(ports.FeeBumper)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrFeeBumpNotSupported, symbol)
+	}
+
+	tx, err := bumper.BumpFee(ctx, request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bump fee: %w", domain.UpstreamError(err))
+	}
+	tx.CryptoSymbol = strings.ToUpper(symbol)
+	return tx, nil
+}
@@ -0,0 +1,133 @@
+package provider_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/adapters/provider"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/adapters/rates/static"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/ports"
+	portsmocks "github.com/airgap-solution/crypto-wallet-rest/mocks/internalports"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// txBuilderProvider is a crypto provider that can build unsigned transactions.
+type txBuilderProvider struct {
+	*portsmocks.MockCryptoProvider
+	*portsmocks.MockUnsignedTxBuilder
+}
+
+func TestAdapter_BuildUnsignedTx(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	prov := &txBuilderProvider{
+		MockCryptoProvider:    portsmocks.NewMockCryptoProvider(ctrl),
+		MockUnsignedTxBuilder: portsmocks.NewMockUnsignedTxBuilder(ctrl),
+	}
+	adapter := provider.NewAdapter(static.NewAdapter(nil), nil, map[string]ports.CryptoProvider{"ETH": prov})
+
+	request := domain.UnsignedTxRequest{From: "0xfrom", To: "0xto", Amount: "1"}
+	prov.MockUnsignedTxBuilder.EXPECT().BuildUnsignedTx(gomock.Any(), request).
+		Return(&domain.UnsignedTx{Amount: "1", Payload: []byte{0x02}}, nil)
+
+	tx, err := adapter.BuildUnsignedTx(t.Context(), "eth", request)
+	require.NoError(t, err)
+	assert.Equal(t, "ETH", tx.CryptoSymbol)
+
+	prov.MockUnsignedTxBuilder.EXPECT().BuildUnsignedTx(gomock.Any(), request).
+		Return(nil, errors.New("connection refused"))
+
+	_, err = adapter.BuildUnsignedTx(t.Context(), "ETH", request)
+	require.ErrorIs(t, err, domain.ErrProviderUnavailable)
+}
+
+func TestAdapter_BuildUnsignedTx_Unsupported(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	adapter := provider.NewAdapter(static.NewAdapter(nil), nil,
+		map[string]ports.CryptoProvider{"BTC": portsmocks.NewMockCryptoProvider(ctrl)})
+
+	_, err := adapter.BuildUnsignedTx(t.Context(), "BTC", domain.UnsignedTxRequest{})
+	require.ErrorIs(t, err, provider.ErrUnsignedTxNotSupported)
+
+	_, err = adapter.BuildUnsignedTx(t.Context(), "DOGE", domain.UnsignedTxRequest{})
+	require.ErrorIs(t, err, provider.ErrProviderNotFoundForSymbol)
+}
+
+// batchTxProvider is a crypto provider that also takes batch options.
+type batchTxProvider struct {
+	*portsmocks.MockCryptoProvider
+	*portsmocks.MockUnsignedTxBuilder
+	*portsmocks.MockBatchTxBuilder
+}
+
+func TestAdapter_BuildUnsignedTx_Batch(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	batcher := &batchTxProvider{
+		MockCryptoProvider:    portsmocks.NewMockCryptoProvider(ctrl),
+		MockUnsignedTxBuilder: portsmocks.NewMockUnsignedTxBuilder(ctrl),
+		MockBatchTxBuilder:    portsmocks.NewMockBatchTxBuilder(ctrl),
+	}
+	single := &txBuilderProvider{
+		MockCryptoProvider:    portsmocks.NewMockCryptoProvider(ctrl),
+		MockUnsignedTxBuilder: portsmocks.NewMockUnsignedTxBuilder(ctrl),
+	}
+	adapter := provider.NewAdapter(static.NewAdapter(nil), nil,
+		map[string]ports.CryptoProvider{"BTC": batcher, "ETH": single})
+
+	request := domain.UnsignedTxRequest{
+		From:       "xpub",
+		Recipients: []domain.Recipient{{Address: "bc1qa", Amount: "0.1"}, {Address: "bc1qb", Amount: "0.2"}},
+	}
+	batcher.MockBatchTxBuilder.EXPECT().BuildBatchTx(gomock.Any(), request).
+		Return(&domain.UnsignedTx{Amount: "0.3"}, nil)
+
+	tx, err := adapter.BuildUnsignedTx(t.Context(), "btc", request)
+	require.NoError(t, err)
+	assert.Equal(t, "BTC", tx.CryptoSymbol)
+
+	_, err = adapter.BuildUnsignedTx(t.Context(), "ETH", domain.UnsignedTxRequest{From: "0xfrom", DisableRBF: true})
+	require.ErrorIs(t, err, provider.ErrBatchTxNotSupported)
+}
+
+type feeBumpProvider struct {
+	*portsmocks.MockCryptoProvider
+	*portsmocks.MockFeeBumper
+}
+
+func TestAdapter_BumpFee(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	bumper := &feeBumpProvider{
+		MockCryptoProvider: portsmocks.NewMockCryptoProvider(ctrl),
+		MockFeeBumper:      portsmocks.NewMockFeeBumper(ctrl),
+	}
+	adapter := provider.NewAdapter(static.NewAdapter(nil), nil,
+		map[string]ports.CryptoProvider{"BTC": bumper, "ETH": portsmocks.NewMockCryptoProvider(ctrl)})
+
+	request := domain.FeeBumpRequest{From: "xpub", TransactionID: "aa", Method: domain.FeeBumpCPFP, FeeRate: 20}
+	bumper.MockFeeBumper.EXPECT().BumpFee(gomock.Any(), request).Return(&domain.UnsignedTx{Fee: "0.0001"}, nil)
+
+	tx, err := adapter.BumpFee(t.Context(), "btc", request)
+	require.NoError(t, err)
+	assert.Equal(t, "BTC", tx.CryptoSymbol)
+
+	_, err = adapter.BumpFee(t.Context(), "ETH", request)
+	require.ErrorIs(t, err, provider.ErrFeeBumpNotSupported)
+}
@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/ports"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/tracing"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var ErrUTXOsNotSupported = &domain.Error{
+	Code:    domain.CodeUnsupportedSymbol,
+	Message: "UTXO listing not supported for symbol",
+}
+
+// GetUTXOs lists the unspent outputs of an address, xpub or descriptor on the
+// chain of symbol that pass filter.
+func (a *Adapter) GetUTXOs(
+	ctx context.Context, symbol, addr string, filter domain.UTXOFilter,
+) ([]domain.UTXO, error) {
+	ctx, span := tracer.Start(ctx, "provider.GetUTXOs", trace.WithAttributes(tracing.ChainAttributes(symbol)...))
+	utxos, err := a.getUTXOs(ctx, symbol, addr, filter)
+	tracing.End(span, err)
+	return utxos, err
+}
+
+func (a *Adapter) getUTXOs(
+	ctx context.Context, symbol, addr string, filter domain.UTXOFilter,
+) ([]domain.UTXO, error) {
+	prov, ok := a.cryptoProviders[strings.ToUpper(symbol)]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrProviderNotFoundForSymbol, symbol)
+	}
+	lister, ok := prov.(ports.UTXOProvider)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUTXOsNotSupported, symbol)
+	}
+
+	utxos, err := lister.GetUTXOs(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	filtered := make([]domain.UTXO, 0, len(utxos))
+	for _, utxo := range utxos {
+		if filter.Match(utxo) {
+			filtered = append(filtered, utxo)
+		}
+	}
+	return filtered, nil
+}
@@ -0,0 +1,67 @@
+package provider_test
+
+import (
+	"testing"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/adapters/provider"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/adapters/rates/static"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/ports"
+	portsmocks "github.com/airgap-solution/crypto-wallet-rest/mocks/internalports"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// utxoProvider is a crypto provider that can list unspent outputs.
+type utxoProvider struct {
+	*portsmocks.MockCryptoProvider
+	*portsmocks.MockUTXOProvider
+}
+
+func TestAdapter_GetUTXOs(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	prov := &utxoProvider{
+		MockCryptoProvider: portsmocks.NewMockCryptoProvider(ctrl),
+		MockUTXOProvider:   portsmocks.NewMockUTXOProvider(ctrl),
+	}
+	adapter := provider.NewAdapter(static.NewAdapter(nil), nil, map[string]ports.CryptoProvider{"BTC": prov})
+
+	utxos := []domain.UTXO{
+		{TransactionID: "confirmed", Value: 100000, Confirmations: 6},
+		{TransactionID: "mempool", Value: 100000, Confirmations: 0},
+		{TransactionID: "dust", Value: 300, Confirmations: 10},
+	}
+	prov.MockUTXOProvider.EXPECT().GetUTXOs(gomock.Any(), testAddress).Return(utxos, nil).Times(2)
+
+	all, err := adapter.GetUTXOs(t.Context(), "btc", testAddress, domain.UTXOFilter{})
+	require.NoError(t, err)
+	assert.Equal(t, utxos, all)
+
+	filtered, err := adapter.GetUTXOs(t.Context(), "BTC", testAddress,
+		domain.UTXOFilter{MinConfirmations: 1, DustThreshold: 546})
+	require.NoError(t, err)
+	require.Len(t, filtered, 1)
+	assert.Equal(t, "confirmed", filtered[0].TransactionID)
+}
+
+func TestAdapter_GetUTXOs_Unsupported(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	adapter := provider.NewAdapter(static.NewAdapter(nil), nil,
+		map[string]ports.CryptoProvider{"ETH": portsmocks.NewMockCryptoProvider(ctrl)})
+
+	_, err := adapter.GetUTXOs(t.Context(), "ETH", testAddress, domain.UTXOFilter{})
+	require.ErrorIs(t, err, provider.ErrUTXOsNotSupported)
+	assert.Equal(t, domain.CodeUnsupportedSymbol, domain.CodeOf(err))
+
+	_, err = adapter.GetUTXOs(t.Context(), "DOGE", testAddress, domain.UTXOFilter{})
+	require.ErrorIs(t, err, provider.ErrProviderNotFoundForSymbol)
+}
@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/ports"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/tracing"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ValidateAddress checks an address or extended public key against the chain
+// of symbol without any upstream call. Providers that cannot validate offline
+// accept every non-empty address.
+func (a *Adapter) ValidateAddress(ctx context.Context, symbol, addr string) (*domain.AddressValidation, error) {
+	_, span := tracer.Start(ctx, "provider.ValidateAddress", trace.WithAttributes(tracing.ChainAttributes(symbol)...))
+	validation, err := a.validateAddress(symbol, addr)
+	tracing.End(span, err)
+	return validation, err
+}
+
+func (a *Adapter) validateAddress(symbol, addr string) (*domain.AddressValidation, error) {
+	prov, ok := a.cryptoProviders[strings.ToUpper(symbol)]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrProviderNotFoundForSymbol, symbol)
+	}
+	if strings.TrimSpace(addr) == "" {
+		return nil, fmt.Errorf("%w: address is empty", domain.ErrInvalidAddress)
+	}
+
+	validator, ok := prov.(ports.AddressValidator)
+	if !ok {
+		return &domain.AddressValidation{
+			CryptoSymbol: strings.ToUpper(symbol),
+			Address:      addr,
+			Kind:         domain.AddressKindAddress,
+		}, nil
+	}
+
+	validation, err := validator.ValidateAddress(addr)
+	if err != nil {
+		return nil, err
+	}
+	validation.CryptoSymbol = strings.ToUpper(symbol)
+	validation.Address = addr
+	return validation, nil
+}
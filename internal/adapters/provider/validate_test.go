@@ -0,0 +1,84 @@
+package provider_test
+
+import (
+	"testing"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/adapters/provider"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/adapters/rates/static"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/ports"
+	portsmocks "github.com/airgap-solution/crypto-wallet-rest/mocks/internalports"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// validatingProvider is a crypto provider that can also validate addresses.
+type validatingProvider struct {
+	*portsmocks.MockCryptoProvider
+	*portsmocks.MockAddressValidator
+}
+
+func TestAdapter_ValidateAddress(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	prov := &validatingProvider{
+		MockCryptoProvider:   portsmocks.NewMockCryptoProvider(ctrl),
+		MockAddressValidator: portsmocks.NewMockAddressValidator(ctrl),
+	}
+	adapter := provider.NewAdapter(static.NewAdapter(nil), nil, map[string]ports.CryptoProvider{"BTC": prov})
+
+	prov.MockAddressValidator.EXPECT().ValidateAddress(testAddress).Return(&domain.AddressValidation{
+		Kind: domain.AddressKindExtendedKey, Format: "zpub", Network: domain.NetworkMainnet,
+	}, nil)
+
+	validation, err := adapter.ValidateAddress(t.Context(), "btc", testAddress)
+
+	require.NoError(t, err)
+	assert.Equal(t, "BTC", validation.CryptoSymbol)
+	assert.Equal(t, testAddress, validation.Address)
+	assert.Equal(t, "zpub", validation.Format)
+}
+
+func TestAdapter_ValidateAddress_Invalid(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	prov := &validatingProvider{
+		MockCryptoProvider:   portsmocks.NewMockCryptoProvider(ctrl),
+		MockAddressValidator: portsmocks.NewMockAddressValidator(ctrl),
+	}
+	adapter := provider.NewAdapter(static.NewAdapter(nil), nil, map[string]ports.CryptoProvider{"BTC": prov})
+
+	prov.MockAddressValidator.EXPECT().ValidateAddress("tb1q").Return(nil, domain.NetworkMismatchError(false))
+
+	_, err := adapter.ValidateAddress(t.Context(), "BTC", "tb1q")
+
+	require.ErrorIs(t, err, domain.ErrInvalidAddress)
+	assert.Equal(t, domain.CodeInvalidAddress, domain.CodeOf(err))
+}
+
+func TestAdapter_ValidateAddress_WithoutValidator(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	adapter := provider.NewAdapter(static.NewAdapter(nil), nil,
+		map[string]ports.CryptoProvider{"BTC": portsmocks.NewMockCryptoProvider(ctrl)})
+
+	validation, err := adapter.ValidateAddress(t.Context(), "BTC", testAddress)
+	require.NoError(t, err)
+	assert.Equal(t, domain.AddressKindAddress, validation.Kind)
+
+	_, err = adapter.ValidateAddress(t.Context(), "BTC", " ")
+	require.ErrorIs(t, err, domain.ErrInvalidAddress)
+
+	_, err = adapter.ValidateAddress(t.Context(), "DOGE", testAddress)
+	require.ErrorIs(t, err, provider.ErrProviderNotFoundForSymbol)
+}
@@ -0,0 +1,61 @@
+package cmc
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	cmcrest "github.com/airgap-solution/cmc-rest/openapi/clientgen/go"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/tracing"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const SourceName = "cmc-rest"
+
+var tracer = tracing.Tracer("rates/cmc")
+
+type CMCRestClient interface {
+	V1RateCurrencyFiatGet(ctx context.Context, from, to string) cmcrest.ApiV1RateCurrencyFiatGetRequest
+	V1RateCurrencyFiatGetExecute(
+		r cmcrest.ApiV1RateCurrencyFiatGetRequest,
+	) (*cmcrest.GetRateResponse, *http.Response, error)
+}
+
+type Adapter struct {
+	client CMCRestClient
+}
+
+func NewAdapter(client CMCRestClient) *Adapter {
+	return &Adapter{client: client}
+}
+
+func (a *Adapter) GetRate(ctx context.Context, cryptoSymbol, fiatSymbol string) (*domain.Rate, error) {
+	ctx, span := tracer.Start(ctx, "cmcrest.V1RateCurrencyFiatGet", trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			tracing.AttrChainSymbol.String(strings.ToUpper(cryptoSymbol)),
+			tracing.AttrRPCMethod.String("V1RateCurrencyFiatGet"),
+		))
+	req := a.client.V1RateCurrencyFiatGet(ctx, cryptoSymbol, fiatSymbol)
+	resp, httpResp, err := a.client.V1RateCurrencyFiatGetExecute(req)
+	tracing.End(span, err)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to get rate from CMC: %w", domain.ErrRateUnavailable, err)
+	}
+	if httpResp != nil && httpResp.Body != nil {
+		defer httpResp.Body.Close()
+	}
+
+	rate := &domain.Rate{
+		Rate:      resp.GetRate(),
+		Change24h: resp.Change24h,
+		Source:    SourceName,
+		UpdatedAt: time.Now(),
+	}
+	if resp.UpdatedAt != nil {
+		rate.UpdatedAt = *resp.UpdatedAt
+	}
+	return rate, nil
+}
@@ -0,0 +1,100 @@
+package cmc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	cmcrest "github.com/airgap-solution/cmc-rest/openapi/clientgen/go"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/tracing"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var ErrUnexpectedStatus = errors.New("unexpected status")
+
+const (
+	historicalRequestTimeout = 10 * time.Second
+	dateLayout               = "2006-01-02"
+)
+
+// HistoricalAdapter reads daily rates from cmc-rest's
+// GET /v1/rate/{currency}/{fiat}/history?date=YYYY-MM-DD endpoint, which
+// answers with the same body as the current rate endpoint. The generated
+// client does not cover it yet, so the request is made directly.
+type HistoricalAdapter struct {
+	baseURL string
+	client  *http.Client
+}
+
+func NewHistoricalAdapter(baseURL string) *HistoricalAdapter {
+	return &HistoricalAdapter{
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		client:  &http.Client{Timeout: historicalRequestTimeout},
+	}
+}
+
+func (a *HistoricalAdapter) GetHistoricalRate(
+	ctx context.Context, cryptoSymbol, fiatSymbol string, at time.Time,
+) (*domain.Rate, error) {
+	ctx, span := tracer.Start(ctx, "cmcrest.V1RateCurrencyFiatHistoryGet", trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			tracing.AttrChainSymbol.String(strings.ToUpper(cryptoSymbol)),
+			tracing.AttrRPCMethod.String("V1RateCurrencyFiatHistoryGet"),
+		))
+	resp, err := a.fetch(ctx, cryptoSymbol, fiatSymbol, at)
+	tracing.End(span, err)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to get historical rate from CMC: %w", domain.ErrRateUnavailable, err)
+	}
+	if resp.Rate == nil {
+		return nil, fmt.Errorf("%w: no historical rate for %s/%s on %s", domain.ErrRateUnavailable,
+			strings.ToUpper(cryptoSymbol), strings.ToUpper(fiatSymbol), at.UTC().Format(dateLayout))
+	}
+
+	rate := &domain.Rate{
+		Rate:      *resp.Rate,
+		Source:    SourceName,
+		UpdatedAt: at,
+	}
+	if resp.UpdatedAt != nil {
+		rate.UpdatedAt = *resp.UpdatedAt
+	}
+	return rate, nil
+}
+
+func (a *HistoricalAdapter) fetch(
+	ctx context.Context, cryptoSymbol, fiatSymbol string, at time.Time,
+) (*cmcrest.GetRateResponse, error) {
+	rawURL := fmt.Sprintf("%s/v1/rate/%s/%s/history?date=%s", a.baseURL,
+		url.PathEscape(cryptoSymbol), url.PathEscape(fiatSymbol), at.UTC().Format(dateLayout))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("%w %d: %s", ErrUnexpectedStatus, resp.StatusCode, string(body))
+	}
+
+	var result cmcrest.GetRateResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+	return &result, nil
+}
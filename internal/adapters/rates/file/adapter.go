@@ -0,0 +1,109 @@
+package file
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+)
+
+const (
+	SourceName = "file"
+	dateLayout = "2006-01-02"
+	// MaxGap is how far back a missing day may fall back to an earlier price.
+	MaxGap = 7 * 24 * time.Hour
+)
+
+var ErrInvalidPriceFile = errors.New("invalid price file")
+
+type dailyPrice struct {
+	date  time.Time
+	price float64
+}
+
+// Adapter serves historical rates from a local CSV file with the header
+// date,crypto_symbol,fiat_symbol,price and one daily close per row, e.g.
+// 2024-01-31,BTC,USD,42580.12.
+type Adapter struct {
+	prices map[string][]dailyPrice
+}
+
+func NewAdapter(path string) (*Adapter, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not open price file: %w", err)
+	}
+	defer f.Close()
+
+	return NewAdapterFromReader(f)
+}
+
+func NewAdapterFromReader(r io.Reader) (*Adapter, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = 4
+	reader.TrimLeadingSpace = true
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidPriceFile, err)
+	}
+
+	prices := make(map[string][]dailyPrice)
+	for i, record := range records {
+		if i == 0 && strings.EqualFold(record[0], "date") {
+			continue
+		}
+
+		date, err := time.Parse(dateLayout, record[0])
+		if err != nil {
+			return nil, fmt.Errorf("%w: line %d: %w", ErrInvalidPriceFile, i+1, err)
+		}
+		price, err := strconv.ParseFloat(record[3], 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w: line %d: %w", ErrInvalidPriceFile, i+1, err)
+		}
+
+		key := pairKey(record[1], record[2])
+		prices[key] = append(prices[key], dailyPrice{date: date, price: price})
+	}
+
+	for _, series := range prices {
+		sort.Slice(series, func(i, j int) bool { return series[i].date.Before(series[j].date) })
+	}
+
+	return &Adapter{prices: prices}, nil
+}
+
+func (a *Adapter) GetHistoricalRate(
+	_ context.Context, cryptoSymbol, fiatSymbol string, at time.Time,
+) (*domain.Rate, error) {
+	series := a.prices[pairKey(cryptoSymbol, fiatSymbol)]
+	day := at.UTC().Truncate(24 * time.Hour)
+
+	// Index of the first entry after day; the one before it is the latest
+	// price on or before day.
+	idx := sort.Search(len(series), func(i int) bool { return series[i].date.After(day) })
+	if idx == 0 || day.Sub(series[idx-1].date) > MaxGap {
+		return nil, fmt.Errorf("%w: no price for %s/%s on %s in price file", domain.ErrRateUnavailable,
+			strings.ToUpper(cryptoSymbol), strings.ToUpper(fiatSymbol), day.Format(dateLayout))
+	}
+
+	entry := series[idx-1]
+	return &domain.Rate{
+		Rate:      entry.price,
+		Source:    SourceName,
+		UpdatedAt: entry.date,
+	}, nil
+}
+
+func pairKey(cryptoSymbol, fiatSymbol string) string {
+	return strings.ToUpper(strings.TrimSpace(cryptoSymbol)) + ":" + strings.ToUpper(strings.TrimSpace(fiatSymbol))
+}
@@ -0,0 +1,120 @@
+package file_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/adapters/rates/file"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const prices = `date,crypto_symbol,fiat_symbol,price
+2024-01-02,BTC,USD,45000
+2024-01-01,BTC,USD,42000
+2024-01-01,BTC,EUR,38000
+`
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestAdapter_GetHistoricalRate(t *testing.T) {
+	t.Parallel()
+
+	adapter, err := file.NewAdapterFromReader(strings.NewReader(prices))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		crypto   string
+		fiat     string
+		at       time.Time
+		expected float64
+	}{
+		{name: "exact day", crypto: "BTC", fiat: "USD", at: date(2024, 1, 1), expected: 42000},
+		{name: "unsorted rows", crypto: "BTC", fiat: "USD", at: date(2024, 1, 2), expected: 45000},
+		{name: "time of day ignored", crypto: "btc", fiat: "usd", at: date(2024, 1, 2).Add(23 * time.Hour), expected: 45000},
+		{name: "falls back to earlier day", crypto: "BTC", fiat: "EUR", at: date(2024, 1, 8), expected: 38000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rate, err := adapter.GetHistoricalRate(t.Context(), tt.crypto, tt.fiat, tt.at)
+			require.NoError(t, err)
+			assert.InDelta(t, tt.expected, rate.Rate, 0)
+			assert.Equal(t, file.SourceName, rate.Source)
+		})
+	}
+}
+
+func TestAdapter_GetHistoricalRate_Unavailable(t *testing.T) {
+	t.Parallel()
+
+	adapter, err := file.NewAdapterFromReader(strings.NewReader(prices))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		crypto string
+		at     time.Time
+	}{
+		{name: "before first price", crypto: "BTC", at: date(2023, 12, 31)},
+		{name: "gap too large", crypto: "BTC", at: date(2024, 1, 2).Add(file.MaxGap + 24*time.Hour)},
+		{name: "unknown pair", crypto: "LTC", at: date(2024, 1, 1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := adapter.GetHistoricalRate(t.Context(), tt.crypto, "USD", tt.at)
+			require.ErrorIs(t, err, domain.ErrRateUnavailable)
+		})
+	}
+}
+
+func TestNewAdapterFromReader_Invalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{name: "bad date", content: "01/01/2024,BTC,USD,42000\n"},
+		{name: "bad price", content: "2024-01-01,BTC,USD,cheap\n"},
+		{name: "wrong column count", content: "2024-01-01,BTC,42000\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := file.NewAdapterFromReader(strings.NewReader(tt.content))
+			require.ErrorIs(t, err, file.ErrInvalidPriceFile)
+		})
+	}
+}
+
+func TestNewAdapter(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "prices.csv")
+	require.NoError(t, os.WriteFile(path, []byte(prices), 0o600))
+
+	adapter, err := file.NewAdapter(path)
+	require.NoError(t, err)
+
+	rate, err := adapter.GetHistoricalRate(t.Context(), "BTC", "USD", date(2024, 1, 1))
+	require.NoError(t, err)
+	assert.InDelta(t, 42000.0, rate.Rate, 0)
+
+	_, err = file.NewAdapter(filepath.Join(t.TempDir(), "missing.csv"))
+	require.Error(t, err)
+}
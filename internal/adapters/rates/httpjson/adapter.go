@@ -0,0 +1,169 @@
+package httpjson
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/tracing"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var (
+	ErrUnexpectedStatus = errors.New("unexpected status")
+	ErrFieldNotFound    = errors.New("field not found")
+	ErrFieldNotNumeric  = errors.New("field is not numeric")
+)
+
+const requestTimeout = 10 * time.Second
+
+var tracer = tracing.Tracer("rates/httpjson")
+
+// Adapter reads a rate from any HTTP endpoint returning JSON. The URL template
+// may contain {crypto} and {fiat} placeholders, and fields are located with
+// dot-separated paths such as "data.BTC.quote.USD.price".
+type Adapter struct {
+	name          string
+	urlTemplate   string
+	ratePath      string
+	change24hPath string
+	client        *http.Client
+}
+
+func NewAdapter(name, urlTemplate, ratePath, change24hPath string) *Adapter {
+	return &Adapter{
+		name:          name,
+		urlTemplate:   urlTemplate,
+		ratePath:      ratePath,
+		change24hPath: change24hPath,
+		client:        &http.Client{Timeout: requestTimeout},
+	}
+}
+
+func (a *Adapter) GetRate(ctx context.Context, cryptoSymbol, fiatSymbol string) (*domain.Rate, error) {
+	rate, err := a.getRate(ctx, cryptoSymbol, fiatSymbol)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to get rate from %s: %w", domain.ErrRateUnavailable, a.name, err)
+	}
+	return rate, nil
+}
+
+func (a *Adapter) getRate(ctx context.Context, cryptoSymbol, fiatSymbol string) (*domain.Rate, error) {
+	body, err := a.fetch(ctx, a.buildURL(cryptoSymbol, fiatSymbol))
+	if err != nil {
+		return nil, err
+	}
+
+	var doc any
+	if err := json.Unmarshal(body, &doc); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	price, err := lookupNumber(doc, a.ratePath)
+	if err != nil {
+		return nil, fmt.Errorf("rate %q: %w", a.ratePath, err)
+	}
+
+	rate := &domain.Rate{
+		Rate:      price,
+		Source:    a.name,
+		UpdatedAt: time.Now(),
+	}
+	if a.change24hPath != "" {
+		change, err := lookupNumber(doc, a.change24hPath)
+		if err != nil {
+			return nil, fmt.Errorf("change24h %q: %w", a.change24hPath, err)
+		}
+		rate.Change24h = &change
+	}
+	return rate, nil
+}
+
+func (a *Adapter) buildURL(cryptoSymbol, fiatSymbol string) string {
+	return strings.NewReplacer(
+		"{crypto}", url.PathEscape(strings.ToUpper(cryptoSymbol)),
+		"{crypto_lower}", url.PathEscape(strings.ToLower(cryptoSymbol)),
+		"{fiat}", url.PathEscape(strings.ToUpper(fiatSymbol)),
+		"{fiat_lower}", url.PathEscape(strings.ToLower(fiatSymbol)),
+	).Replace(a.urlTemplate)
+}
+
+func (a *Adapter) fetch(ctx context.Context, rawURL string) ([]byte, error) {
+	ctx, span := tracer.Start(ctx, "httpjson.GET", trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(tracing.AttrEndpoint.String(tracing.Endpoint(rawURL))),
+	)
+	body, err := a.get(ctx, rawURL)
+	tracing.End(span, err)
+	return body, err
+}
+
+func (a *Adapter) get(ctx context.Context, rawURL string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("%w %d: %s", ErrUnexpectedStatus, resp.StatusCode, string(body))
+	}
+
+	buf, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("could not read response body: %w", err)
+	}
+	return buf, nil
+}
+
+// lookupNumber walks a dot-separated path through decoded JSON. Numeric
+// segments index into arrays, and string values are parsed as floats since
+// many price APIs quote prices as strings.
+func lookupNumber(doc any, path string) (float64, error) {
+	current := doc
+	for segment := range strings.SplitSeq(path, ".") {
+		switch node := current.(type) {
+		case map[string]any:
+			next, ok := node[segment]
+			if !ok {
+				return 0, fmt.Errorf("%w: %s", ErrFieldNotFound, segment)
+			}
+			current = next
+		case []any:
+			index, err := strconv.Atoi(segment)
+			if err != nil || index < 0 || index >= len(node) {
+				return 0, fmt.Errorf("%w: %s", ErrFieldNotFound, segment)
+			}
+			current = node[index]
+		default:
+			return 0, fmt.Errorf("%w: %s", ErrFieldNotFound, segment)
+		}
+	}
+
+	switch value := current.(type) {
+	case float64:
+		return value, nil
+	case string:
+		parsed, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return 0, fmt.Errorf("%w: %q", ErrFieldNotNumeric, value)
+		}
+		return parsed, nil
+	default:
+		return 0, ErrFieldNotNumeric
+	}
+}
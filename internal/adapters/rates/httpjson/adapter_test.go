@@ -0,0 +1,61 @@
+package httpjson_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/adapters/rates/httpjson"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdapter_GetRate(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/price/btc/USD", r.URL.Path)
+		_, _ = w.Write([]byte(`{"data":{"quotes":[{"price":"60000.5","change":{"24h":-2.5}}]}}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	adapter := httpjson.NewAdapter("example", srv.URL+"/price/{crypto_lower}/{fiat}",
+		"data.quotes.0.price", "data.quotes.0.change.24h")
+
+	rate, err := adapter.GetRate(t.Context(), "BTC", "usd")
+	require.NoError(t, err)
+	assert.InDelta(t, 60000.5, rate.Rate, 0)
+	require.NotNil(t, rate.Change24h)
+	assert.InDelta(t, -2.5, *rate.Change24h, 0)
+	assert.Equal(t, "example", rate.Source)
+}
+
+func TestAdapter_GetRate_MissingField(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"data":{}}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	adapter := httpjson.NewAdapter("example", srv.URL, "data.price", "")
+
+	_, err := adapter.GetRate(t.Context(), "BTC", "USD")
+	require.ErrorIs(t, err, domain.ErrRateUnavailable)
+	assert.ErrorIs(t, err, httpjson.ErrFieldNotFound)
+}
+
+func TestAdapter_GetRate_UnexpectedStatus(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	t.Cleanup(srv.Close)
+
+	adapter := httpjson.NewAdapter("example", srv.URL, "price", "")
+
+	_, err := adapter.GetRate(t.Context(), "BTC", "USD")
+	require.ErrorIs(t, err, httpjson.ErrUnexpectedStatus)
+}
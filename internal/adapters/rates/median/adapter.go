@@ -0,0 +1,133 @@
+package median
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/ports"
+)
+
+// Adapter queries every source concurrently and returns the median rate.
+// Quotes deviating from the median by more than maxDeviation (a fraction,
+// 0.05 = 5%) are rejected before the final median is taken.
+type Adapter struct {
+	sources      []ports.RateProvider
+	maxDeviation float64
+}
+
+func NewAdapter(maxDeviation float64, sources ...ports.RateProvider) *Adapter {
+	return &Adapter{
+		sources:      sources,
+		maxDeviation: maxDeviation,
+	}
+}
+
+func (a *Adapter) GetRate(ctx context.Context, cryptoSymbol, fiatSymbol string) (*domain.Rate, error) {
+	rates, errs := a.fetchAll(ctx, cryptoSymbol, fiatSymbol)
+	if len(rates) == 0 {
+		return nil, fmt.Errorf("%w: all rate sources failed: %w", domain.ErrRateUnavailable, errors.Join(errs...))
+	}
+
+	accepted := a.rejectOutliers(rates)
+	if len(accepted) == 0 {
+		return nil, fmt.Errorf("%w: rate sources disagree beyond %.2f%%", domain.ErrRateUnavailable, a.maxDeviation*100)
+	}
+	return aggregate(accepted), nil
+}
+
+func (a *Adapter) fetchAll(ctx context.Context, cryptoSymbol, fiatSymbol string) ([]*domain.Rate, []error) {
+	results := make([]*domain.Rate, len(a.sources))
+	errs := make([]error, len(a.sources))
+
+	var wg sync.WaitGroup
+	for i, source := range a.sources {
+		wg.Go(func() {
+			results[i], errs[i] = source.GetRate(ctx, cryptoSymbol, fiatSymbol)
+		})
+	}
+	wg.Wait()
+
+	rates := make([]*domain.Rate, 0, len(results))
+	for i, rate := range results {
+		if errs[i] == nil && rate != nil {
+			rates = append(rates, rate)
+		}
+	}
+	return rates, errs
+}
+
+func (a *Adapter) rejectOutliers(rates []*domain.Rate) []*domain.Rate {
+	if a.maxDeviation <= 0 || len(rates) < 3 {
+		return rates
+	}
+
+	mid := median(rates, func(r *domain.Rate) float64 { return r.Rate })
+	if mid == 0 {
+		return rates
+	}
+
+	accepted := make([]*domain.Rate, 0, len(rates))
+	for _, rate := range rates {
+		if math.Abs(rate.Rate-mid)/mid <= a.maxDeviation {
+			accepted = append(accepted, rate)
+		}
+	}
+	return accepted
+}
+
+// aggregate combines the accepted quotes into a single rate whose Source lists
+// every contributing source.
+func aggregate(rates []*domain.Rate) *domain.Rate {
+	result := &domain.Rate{
+		Rate:      median(rates, func(r *domain.Rate) float64 { return r.Rate }),
+		Change24h: medianChange24h(rates),
+	}
+
+	sources := make([]string, 0, len(rates))
+	for _, rate := range rates {
+		sources = append(sources, rate.Source)
+		if rate.UpdatedAt.After(result.UpdatedAt) {
+			result.UpdatedAt = rate.UpdatedAt
+		}
+	}
+	slices.Sort(sources)
+	result.Source = strings.Join(sources, ",")
+	return result
+}
+
+// medianChange24h returns the median 24h change of the sources that report
+// one, or nil when none do.
+func medianChange24h(rates []*domain.Rate) *float64 {
+	reporting := make([]*domain.Rate, 0, len(rates))
+	for _, rate := range rates {
+		if rate.Change24h != nil {
+			reporting = append(reporting, rate)
+		}
+	}
+	if len(reporting) == 0 {
+		return nil
+	}
+
+	change := median(reporting, func(r *domain.Rate) float64 { return *r.Change24h })
+	return &change
+}
+
+func median(rates []*domain.Rate, value func(*domain.Rate) float64) float64 {
+	values := make([]float64, len(rates))
+	for i, rate := range rates {
+		values[i] = value(rate)
+	}
+	slices.Sort(values)
+
+	n := len(values)
+	if n%2 == 1 {
+		return values[n/2]
+	}
+	return (values[n/2-1] + values[n/2]) / 2
+}
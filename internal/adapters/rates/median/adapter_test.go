@@ -0,0 +1,100 @@
+package median_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/adapters/rates/median"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/adapters/rates/static"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+	portsmocks "github.com/airgap-solution/crypto-wallet-rest/mocks/internalports"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var errSourceDown = errors.New("source down")
+
+func source(price float64) *static.Adapter {
+	return static.NewAdapter(map[string]map[string]float64{"BTC": {"USD": price}})
+}
+
+func TestAdapter_GetRate_Median(t *testing.T) {
+	t.Parallel()
+
+	adapter := median.NewAdapter(0.05, source(100), source(101), source(102))
+
+	rate, err := adapter.GetRate(t.Context(), "BTC", "USD")
+	require.NoError(t, err)
+	assert.InDelta(t, 101.0, rate.Rate, 0)
+}
+
+func TestAdapter_GetRate_RejectsOutliers(t *testing.T) {
+	t.Parallel()
+
+	adapter := median.NewAdapter(0.05, source(100), source(101), source(102), source(500))
+
+	rate, err := adapter.GetRate(t.Context(), "BTC", "USD")
+	require.NoError(t, err)
+	assert.InDelta(t, 101.0, rate.Rate, 0)
+	assert.Equal(t, "static,static,static", rate.Source)
+}
+
+func TestAdapter_GetRate_IgnoresFailingSources(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	failing := portsmocks.NewMockRateProvider(ctrl)
+	failing.EXPECT().GetRate(gomock.Any(), "BTC", "USD").Return(nil, errSourceDown)
+
+	adapter := median.NewAdapter(0.05, failing, source(100), source(104))
+
+	rate, err := adapter.GetRate(t.Context(), "BTC", "USD")
+	require.NoError(t, err)
+	assert.InDelta(t, 102.0, rate.Rate, 0)
+}
+
+func TestAdapter_GetRate_AllSourcesFail(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	failing := portsmocks.NewMockRateProvider(ctrl)
+	failing.EXPECT().GetRate(gomock.Any(), "BTC", "EUR").Return(nil, errSourceDown)
+
+	adapter := median.NewAdapter(0.05, failing, source(100))
+
+	_, err := adapter.GetRate(t.Context(), "BTC", "EUR")
+	require.Error(t, err)
+	assert.ErrorIs(t, err, domain.ErrRateUnavailable)
+}
+
+func TestAdapter_GetRate_SourcesDisagree(t *testing.T) {
+	t.Parallel()
+
+	adapter := median.NewAdapter(0.05, source(100), source(100), source(300), source(300))
+
+	_, err := adapter.GetRate(t.Context(), "BTC", "USD")
+	require.Error(t, err)
+	assert.ErrorIs(t, err, domain.ErrRateUnavailable)
+}
+
+func TestAdapter_GetRate_Change24hFromReportingSources(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	reporting := portsmocks.NewMockRateProvider(ctrl)
+	change := -2.5
+	reporting.EXPECT().GetRate(gomock.Any(), "BTC", "USD").
+		Return(&domain.Rate{Rate: 101, Change24h: &change, Source: "reporting"}, nil)
+
+	adapter := median.NewAdapter(0.05, reporting, source(100), source(102))
+
+	rate, err := adapter.GetRate(t.Context(), "BTC", "USD")
+	require.NoError(t, err)
+	require.NotNil(t, rate.Change24h)
+	assert.InDelta(t, -2.5, *rate.Change24h, 0)
+
+	rate, err = median.NewAdapter(0.05, source(100), source(102)).GetRate(t.Context(), "BTC", "USD")
+	require.NoError(t, err)
+	assert.Nil(t, rate.Change24h)
+}
@@ -0,0 +1,43 @@
+package static
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+)
+
+const SourceName = "static"
+
+// Adapter serves rates from a fixed price table, for offline and test
+// deployments. Prices are keyed by crypto symbol, then fiat symbol.
+type Adapter struct {
+	prices map[string]map[string]float64
+}
+
+func NewAdapter(prices map[string]map[string]float64) *Adapter {
+	normalized := make(map[string]map[string]float64, len(prices))
+	for crypto, fiats := range prices {
+		byFiat := make(map[string]float64, len(fiats))
+		for fiat, price := range fiats {
+			byFiat[strings.ToUpper(fiat)] = price
+		}
+		normalized[strings.ToUpper(crypto)] = byFiat
+	}
+	return &Adapter{prices: normalized}
+}
+
+func (a *Adapter) GetRate(_ context.Context, cryptoSymbol, fiatSymbol string) (*domain.Rate, error) {
+	price, ok := a.prices[strings.ToUpper(cryptoSymbol)][strings.ToUpper(fiatSymbol)]
+	if !ok {
+		return nil, fmt.Errorf("%w: no static price for %s/%s", domain.ErrRateUnavailable,
+			strings.ToUpper(cryptoSymbol), strings.ToUpper(fiatSymbol))
+	}
+	return &domain.Rate{
+		Rate:      price,
+		Source:    SourceName,
+		UpdatedAt: time.Now(),
+	}, nil
+}
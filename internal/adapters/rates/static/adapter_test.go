@@ -0,0 +1,30 @@
+package static_test
+
+import (
+	"testing"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/adapters/rates/static"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdapter_GetRate(t *testing.T) {
+	t.Parallel()
+
+	adapter := static.NewAdapter(map[string]map[string]float64{"btc": {"usd": 60000}})
+
+	rate, err := adapter.GetRate(t.Context(), "BTC", "USD")
+	require.NoError(t, err)
+	assert.InDelta(t, 60000.0, rate.Rate, 0)
+	assert.Equal(t, static.SourceName, rate.Source)
+}
+
+func TestAdapter_GetRate_Missing(t *testing.T) {
+	t.Parallel()
+
+	adapter := static.NewAdapter(map[string]map[string]float64{"BTC": {"USD": 60000}})
+
+	_, err := adapter.GetRate(t.Context(), "BTC", "EUR")
+	require.ErrorIs(t, err, domain.ErrRateUnavailable)
+}
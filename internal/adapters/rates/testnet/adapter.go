@@ -0,0 +1,113 @@
+package testnet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/ports"
+)
+
+const (
+	Suffix     = "_TESTNET"
+	SourceName = "testnet"
+)
+
+// Mode selects how testnet symbols are priced.
+type Mode string
+
+const (
+	// ModeZero prices every testnet coin at zero.
+	ModeZero Mode = "zero"
+	// ModeFixed prices every testnet coin at a configured fixed rate.
+	ModeFixed Mode = "fixed"
+	// ModeMainnet prices testnet coins at the rate of their mainnet counterpart.
+	ModeMainnet Mode = "mainnet"
+)
+
+var ErrUnknownMode = errors.New("unknown testnet rate mode")
+
+// Adapter decorates a rate provider so that *_TESTNET symbols are priced
+// according to the configured mode. Other symbols are passed through.
+type Adapter struct {
+	next      ports.RateProvider
+	mode      Mode
+	fixedRate float64
+}
+
+func NewAdapter(next ports.RateProvider, mode Mode, fixedRate float64) (*Adapter, error) {
+	if err := validateMode(mode); err != nil {
+		return nil, err
+	}
+
+	return &Adapter{
+		next:      next,
+		mode:      mode,
+		fixedRate: fixedRate,
+	}, nil
+}
+
+func (a *Adapter) GetRate(ctx context.Context, cryptoSymbol, fiatSymbol string) (*domain.Rate, error) {
+	upper := strings.ToUpper(cryptoSymbol)
+	if !strings.HasSuffix(upper, Suffix) {
+		return a.next.GetRate(ctx, cryptoSymbol, fiatSymbol)
+	}
+
+	if a.mode == ModeMainnet {
+		return a.next.GetRate(ctx, strings.TrimSuffix(upper, Suffix), fiatSymbol)
+	}
+	return testnetRate(a.mode, a.fixedRate, time.Now()), nil
+}
+
+// HistoricalAdapter applies the same testnet pricing to historical rates.
+type HistoricalAdapter struct {
+	next      ports.HistoricalRateProvider
+	mode      Mode
+	fixedRate float64
+}
+
+func NewHistoricalAdapter(next ports.HistoricalRateProvider, mode Mode, fixedRate float64) (*HistoricalAdapter, error) {
+	if err := validateMode(mode); err != nil {
+		return nil, err
+	}
+
+	return &HistoricalAdapter{
+		next:      next,
+		mode:      mode,
+		fixedRate: fixedRate,
+	}, nil
+}
+
+func (a *HistoricalAdapter) GetHistoricalRate(
+	ctx context.Context, cryptoSymbol, fiatSymbol string, at time.Time,
+) (*domain.Rate, error) {
+	upper := strings.ToUpper(cryptoSymbol)
+	if !strings.HasSuffix(upper, Suffix) {
+		return a.next.GetHistoricalRate(ctx, cryptoSymbol, fiatSymbol, at)
+	}
+
+	if a.mode == ModeMainnet {
+		return a.next.GetHistoricalRate(ctx, strings.TrimSuffix(upper, Suffix), fiatSymbol, at)
+	}
+	return testnetRate(a.mode, a.fixedRate, at), nil
+}
+
+func validateMode(mode Mode) error {
+	switch mode {
+	case ModeZero, ModeFixed, ModeMainnet:
+		return nil
+	default:
+		return fmt.Errorf("%w: %q", ErrUnknownMode, mode)
+	}
+}
+
+func testnetRate(mode Mode, fixedRate float64, at time.Time) *domain.Rate {
+	rate := &domain.Rate{Source: SourceName, UpdatedAt: at}
+	if mode == ModeFixed {
+		rate.Rate = fixedRate
+	}
+	return rate
+}
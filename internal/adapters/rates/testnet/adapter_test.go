@@ -0,0 +1,114 @@
+package testnet_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/adapters/rates/file"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/adapters/rates/static"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/adapters/rates/testnet"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mainnetPrices() *static.Adapter {
+	return static.NewAdapter(map[string]map[string]float64{"BTC": {"USD": 60000}})
+}
+
+func TestAdapter_GetRate_ZeroMode(t *testing.T) {
+	t.Parallel()
+
+	adapter, err := testnet.NewAdapter(mainnetPrices(), testnet.ModeZero, 0)
+	require.NoError(t, err)
+
+	rate, err := adapter.GetRate(t.Context(), "BTC_TESTNET", "USD")
+	require.NoError(t, err)
+	assert.InDelta(t, 0.0, rate.Rate, 0)
+	assert.Equal(t, testnet.SourceName, rate.Source)
+}
+
+func TestAdapter_GetRate_FixedMode(t *testing.T) {
+	t.Parallel()
+
+	adapter, err := testnet.NewAdapter(mainnetPrices(), testnet.ModeFixed, 1.5)
+	require.NoError(t, err)
+
+	rate, err := adapter.GetRate(t.Context(), "btc_testnet", "USD")
+	require.NoError(t, err)
+	assert.InDelta(t, 1.5, rate.Rate, 0)
+}
+
+func TestAdapter_GetRate_MainnetMode(t *testing.T) {
+	t.Parallel()
+
+	adapter, err := testnet.NewAdapter(mainnetPrices(), testnet.ModeMainnet, 0)
+	require.NoError(t, err)
+
+	rate, err := adapter.GetRate(t.Context(), "BTC_TESTNET", "USD")
+	require.NoError(t, err)
+	assert.InDelta(t, 60000.0, rate.Rate, 0)
+}
+
+func TestAdapter_GetRate_MainnetSymbolPassesThrough(t *testing.T) {
+	t.Parallel()
+
+	adapter, err := testnet.NewAdapter(mainnetPrices(), testnet.ModeZero, 0)
+	require.NoError(t, err)
+
+	rate, err := adapter.GetRate(t.Context(), "BTC", "USD")
+	require.NoError(t, err)
+	assert.InDelta(t, 60000.0, rate.Rate, 0)
+}
+
+func TestNewAdapter_UnknownMode(t *testing.T) {
+	t.Parallel()
+
+	_, err := testnet.NewAdapter(mainnetPrices(), "free", 0)
+	require.ErrorIs(t, err, testnet.ErrUnknownMode)
+}
+
+func historicalPrices(t *testing.T) *file.Adapter {
+	t.Helper()
+
+	adapter, err := file.NewAdapterFromReader(strings.NewReader("date,crypto_symbol,fiat_symbol,price\n2024-01-01,BTC,USD,42000\n"))
+	require.NoError(t, err)
+	return adapter
+}
+
+func TestHistoricalAdapter_GetHistoricalRate(t *testing.T) {
+	t.Parallel()
+
+	at := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		mode     testnet.Mode
+		symbol   string
+		expected float64
+	}{
+		{name: "zero", mode: testnet.ModeZero, symbol: "BTC_TESTNET", expected: 0},
+		{name: "fixed", mode: testnet.ModeFixed, symbol: "BTC_TESTNET", expected: 1.5},
+		{name: "mainnet", mode: testnet.ModeMainnet, symbol: "btc_testnet", expected: 42000},
+		{name: "mainnet symbol", mode: testnet.ModeZero, symbol: "BTC", expected: 42000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			adapter, err := testnet.NewHistoricalAdapter(historicalPrices(t), tt.mode, 1.5)
+			require.NoError(t, err)
+
+			rate, err := adapter.GetHistoricalRate(t.Context(), tt.symbol, "USD", at)
+			require.NoError(t, err)
+			assert.InDelta(t, tt.expected, rate.Rate, 0)
+		})
+	}
+}
+
+func TestNewHistoricalAdapter_UnknownMode(t *testing.T) {
+	t.Parallel()
+
+	_, err := testnet.NewHistoricalAdapter(historicalPrices(t), "free", 0)
+	require.ErrorIs(t, err, testnet.ErrUnknownMode)
+}
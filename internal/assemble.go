@@ -5,14 +5,18 @@ import (
 	"time"
 
 	"github.com/airgap-solution/crypto-wallet-rest/internal/config"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/service"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/tracing"
 	cryptowalletrest "github.com/airgap-solution/crypto-wallet-rest/openapi/servergen/go"
 )
 
 var readTimeout = time.Second * 10
 
 func Assemble(cfg config.Config, servicer cryptowalletrest.DefaultAPIServicer) *http.Server {
-	ctrl := cryptowalletrest.NewDefaultAPIController(servicer)
+	ctrl := cryptowalletrest.NewDefaultAPIController(servicer,
+		cryptowalletrest.WithDefaultAPIErrorHandler(service.ErrorHandler))
 	router := cryptowalletrest.NewRouter(ctrl)
+	router.Use(tracing.Middleware)
 	srv := &http.Server{Addr: cfg.ListenAddr, Handler: corsMiddleware(router), ReadTimeout: readTimeout}
 	return srv
 }
@@ -4,7 +4,7 @@ type CryptoConfig struct {
 	Kaspa    RPCConfig `toml:"kaspa"`
 	Bitcoin  RPCConfig `toml:"bitcoin"`
 	Litecoin RPCConfig `toml:"litecoin"`
-	Ethereum RPCConfig `toml:"ethereum"`
+	Ethereum EVMConfig `toml:"ethereum"`
 	Solana   RPCConfig `toml:"solana"`
 }
 
@@ -13,10 +13,84 @@ type RPCConfig struct {
 	TestnetRPC string `toml:"testnet_rpc"`
 }
 
+// EVMConfig configures an EVM network. Transaction history is read from the
+// indexers since JSON-RPC cannot list an address's transactions.
+type EVMConfig struct {
+	MainnetRPC     string        `toml:"mainnet_rpc"`
+	TestnetRPC     string        `toml:"testnet_rpc"`
+	MainnetIndexer IndexerConfig `toml:"mainnet_indexer"`
+	TestnetIndexer IndexerConfig `toml:"testnet_indexer"`
+}
+
+type IndexerConfig struct {
+	// Kind is "etherscan" or "blockscout". When empty, transaction history
+	// is unavailable on the network.
+	Kind string `toml:"kind"`
+	// URL is the full API endpoint for "etherscan", e.g.
+	// "https://api.etherscan.io/v2/api", and the instance root for
+	// "blockscout", e.g. "https://eth.blockscout.com".
+	URL    string `toml:"url"`
+	APIKey string `toml:"api_key"`
+	// ChainID selects the network on multichain Etherscan endpoints.
+	ChainID int64 `toml:"chain_id"`
+}
+
+type TracingConfig struct {
+	Enabled     bool    `toml:"enabled"`
+	Endpoint    string  `toml:"endpoint"`
+	Insecure    bool    `toml:"insecure"`
+	ServiceName string  `toml:"service_name"`
+	SampleRatio float64 `toml:"sample_ratio"`
+}
+
+type RatesConfig struct {
+	// Sources lists the rate sources to query: "cmc-rest", "static", or the
+	// name of an http_json source. More than one source enables median
+	// aggregation with outlier rejection.
+	Sources      []string `toml:"sources"`
+	MaxDeviation float64  `toml:"max_deviation"`
+	// FiatSymbols restricts the fiat symbols clients may request. When empty
+	// every ISO 4217 code is accepted.
+	FiatSymbols []string              `toml:"fiat_symbols"`
+	Static      StaticRatesConfig     `toml:"static"`
+	HTTPJSON    []HTTPJSONRateConfig  `toml:"http_json"`
+	Testnet     TestnetRatesConfig    `toml:"testnet"`
+	Historical  HistoricalRatesConfig `toml:"historical"`
+}
+
+type StaticRatesConfig struct {
+	Prices map[string]map[string]float64 `toml:"prices"`
+}
+
+// HTTPJSONRateConfig describes a JSON price API. Change24hPath must point at
+// the 24 hour change in percent; leave it empty when the source has none.
+type HTTPJSONRateConfig struct {
+	Name          string `toml:"name"`
+	URL           string `toml:"url"`
+	RatePath      string `toml:"rate_path"`
+	Change24hPath string `toml:"change24h_path"`
+}
+
+type HistoricalRatesConfig struct {
+	// Source is "cmc-rest" or "file". When empty, transaction and balance
+	// history is returned without fiat values.
+	Source string `toml:"source"`
+	// PriceFile is the CSV file read by the "file" source.
+	PriceFile string `toml:"price_file"`
+}
+
+type TestnetRatesConfig struct {
+	// Mode is "zero", "fixed" or "mainnet".
+	Mode      string  `toml:"mode"`
+	FixedRate float64 `toml:"fixed_rate"`
+}
+
 type Config struct {
-	ListenAddr  string       `toml:"listen_addr"`
-	CMCRestAddr string       `toml:"cmc_rest_addr"`
-	Crypto      CryptoConfig `toml:"crypto"`
+	ListenAddr  string        `toml:"listen_addr"`
+	CMCRestAddr string        `toml:"cmc_rest_addr"`
+	Crypto      CryptoConfig  `toml:"crypto"`
+	Rates       RatesConfig   `toml:"rates"`
+	Tracing     TracingConfig `toml:"tracing"`
 }
 
 func DefaultConfig() Config {
@@ -32,15 +106,40 @@ func DefaultConfig() Config {
 				MainnetRPC: "electrum-ltc.bysh.me:50001",
 				TestnetRPC: "electrum-ltc.bysh.me:51001",
 			},
-			Ethereum: RPCConfig{
+			Ethereum: EVMConfig{
 				MainnetRPC: "https://eth.llamarpc.com",
 				TestnetRPC: "https://eth-sepolia.public.blastapi.io",
+				MainnetIndexer: IndexerConfig{
+					Kind: "blockscout",
+					URL:  "https://eth.blockscout.com",
+				},
+				TestnetIndexer: IndexerConfig{
+					Kind: "blockscout",
+					URL:  "https://eth-sepolia.blockscout.com",
+				},
 			},
 			Solana: RPCConfig{
 				MainnetRPC: "https://api.mainnet-beta.solana.com",
 				TestnetRPC: "https://api.testnet.solana.com",
 			},
 		},
+		Rates: RatesConfig{
+			Sources:      []string{"cmc-rest"},
+			MaxDeviation: 0.05,
+			Testnet: TestnetRatesConfig{
+				Mode: "zero",
+			},
+			Historical: HistoricalRatesConfig{
+				Source: "cmc-rest",
+			},
+		},
+		Tracing: TracingConfig{
+			Enabled:     false,
+			Endpoint:    "localhost:4318",
+			Insecure:    true,
+			ServiceName: "crypto-wallet-rest",
+			SampleRatio: 1,
+		},
 	}
 
 	return cfg
@@ -14,3 +14,32 @@ func TestDefaultConfig(t *testing.T) {
 	assert.Equal(t, ":8399", cfg.ListenAddr)
 	assert.Equal(t, "192.168.2.71:8765", cfg.CMCRestAddr)
 }
+
+func TestDefaultConfig_TracingDisabled(t *testing.T) {
+	t.Parallel()
+	cfg := config.DefaultConfig()
+
+	assert.False(t, cfg.Tracing.Enabled)
+	assert.Equal(t, "localhost:4318", cfg.Tracing.Endpoint)
+	assert.Equal(t, "crypto-wallet-rest", cfg.Tracing.ServiceName)
+	assert.InDelta(t, 1.0, cfg.Tracing.SampleRatio, 0)
+}
+
+func TestDefaultConfig_Rates(t *testing.T) {
+	t.Parallel()
+	cfg := config.DefaultConfig()
+
+	assert.Equal(t, []string{"cmc-rest"}, cfg.Rates.Sources)
+	assert.InDelta(t, 0.05, cfg.Rates.MaxDeviation, 0)
+	assert.Equal(t, "zero", cfg.Rates.Testnet.Mode)
+	assert.Equal(t, "cmc-rest", cfg.Rates.Historical.Source)
+}
+
+func TestDefaultConfig_EthereumIndexer(t *testing.T) {
+	t.Parallel()
+	cfg := config.DefaultConfig()
+
+	assert.Equal(t, "blockscout", cfg.Crypto.Ethereum.MainnetIndexer.Kind)
+	assert.Equal(t, "https://eth.blockscout.com", cfg.Crypto.Ethereum.MainnetIndexer.URL)
+	assert.Equal(t, "blockscout", cfg.Crypto.Ethereum.TestnetIndexer.Kind)
+}
@@ -0,0 +1,50 @@
+package domain
+
+import "fmt"
+
+// AddressKind tells a plain address apart from an extended public key.
+type AddressKind string
+
+const (
+	AddressKindAddress     AddressKind = "address"
+	AddressKindExtendedKey AddressKind = "extended_key"
+)
+
+const (
+	NetworkMainnet = "mainnet"
+	NetworkTestnet = "testnet"
+)
+
+// AddressValidation describes an address or extended public key that passed
+// its chain's offline checks. Format names the encoding or script type, such
+// as "p2wpkh", "zpub" or "eip55".
+type AddressValidation struct {
+	CryptoSymbol string      `json:"cryptoSymbol"`
+	Address      string      `json:"address"`
+	Kind         AddressKind `json:"kind"`
+	Format       string      `json:"format"`
+	Network      string      `json:"network"`
+}
+
+// NetworkName maps a testnet flag to its network name.
+func NetworkName(isTestnet bool) string {
+	if isTestnet {
+		return NetworkTestnet
+	}
+	return NetworkMainnet
+}
+
+// NetworkMismatchError reports an address of the other network, e.g. a
+// testnet address sent to a mainnet symbol.
+func NetworkMismatchError(isTestnet bool) error {
+	return fmt.Errorf("%w: %s address used on %s", ErrInvalidAddress, NetworkName(!isTestnet), NetworkName(isTestnet))
+}
+
+// ReceiveAddress is an address on the external chain of an extended public
+// key. DerivationPath is the full BIP44 style path for account level keys and
+// relative to the key otherwise.
+type ReceiveAddress struct {
+	Index          uint32 `json:"index"`
+	Address        string `json:"address"`
+	DerivationPath string `json:"derivationPath"`
+}
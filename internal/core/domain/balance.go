@@ -1,23 +1,173 @@
 package domain
 
-import "time"
+import (
+	"fmt"
+	"strings"
+	"time"
+)
 
 // BalanceResult represents the complete balance information including fiat conversion.
+// Fiat fields are nil when no exchange rate could be obtained; the crypto balance is
+// still reported in that case and RateError explains why. Change24h is the fiat
+// change of the 24h entry in PriceChanges, nil when that window is missing.
+// Conversions holds one result per fiat symbol when the request had FiatSymbols.
+// CryptoBalance is the Total of Breakdown.
 type BalanceResult struct {
-	CryptoSymbol  string    `json:"cryptoSymbol"`
-	Address       string    `json:"address"`
-	CryptoBalance float64   `json:"cryptoBalance"`
-	FiatSymbol    string    `json:"fiatSymbol"`
-	FiatValue     float64   `json:"fiatValue"`
-	ExchangeRate  float64   `json:"exchangeRate"`
-	Timestamp     time.Time `json:"timestamp"`
-	Change24h     float64   `json:"change24h"`
-	Error         *string   `json:"error,omitempty"`
-}
-
-// BalanceRequest represents a single balance request in a batch.
+	CryptoSymbol  string           `json:"cryptoSymbol"`
+	Address       string           `json:"address"`
+	CryptoBalance float64          `json:"cryptoBalance"`
+	Breakdown     BalanceBreakdown `json:"breakdown"`
+	FiatSymbol    string           `json:"fiatSymbol"`
+	FiatValue     *float64         `json:"fiatValue"`
+	ExchangeRate  *float64         `json:"exchangeRate"`
+	Timestamp     time.Time        `json:"timestamp"`
+	Change24h     *float64         `json:"change24h"`
+	PriceChanges  []PriceChange    `json:"priceChanges,omitempty"`
+	Error         *string          `json:"error,omitempty"`
+	ErrorCode     ErrorCode        `json:"errorCode,omitempty"`
+	RateError     *string          `json:"rateError,omitempty"`
+	RateErrorCode ErrorCode        `json:"rateErrorCode,omitempty"`
+	RateStale     bool             `json:"rateStale,omitempty"`
+	RateAge       time.Duration    `json:"rateAge,omitempty"`
+	Conversions   []*BalanceResult `json:"conversions,omitempty"`
+}
+
+// Valuations returns the result once per fiat symbol it was valued in.
+func (r *BalanceResult) Valuations() []*BalanceResult {
+	if len(r.Conversions) > 0 {
+		return r.Conversions
+	}
+	return []*BalanceResult{r}
+}
+
+// BalanceRequest represents a single balance request in a batch. When
+// FiatSymbols is set the balance is valued in each of them, and FiatSymbol
+// must be its first entry.
 type BalanceRequest struct {
-	CryptoSymbol string `json:"cryptoSymbol"`
-	Address      string `json:"address"`
-	FiatSymbol   string `json:"fiatSymbol"`
+	CryptoSymbol string         `json:"cryptoSymbol"`
+	Address      string         `json:"address"`
+	FiatSymbol   string         `json:"fiatSymbol"`
+	FiatSymbols  []string       `json:"fiatSymbols,omitempty"`
+	Options      BalanceOptions `json:"options"`
+}
+
+// BalanceBreakdown splits a balance by settlement, in whole coins. Confirmed
+// counts funds that meet the confirmation threshold or commitment level of
+// the request; the pending amounts are moving into or out of the wallet but
+// do not meet it yet. MWEB is what Litecoin wallets hold in the MWEB
+// extension block; it is not spendable on the canonical chain and not part
+// of Total.
+type BalanceBreakdown struct {
+	Confirmed       float64 `json:"confirmed"`
+	PendingIncoming float64 `json:"pendingIncoming"`
+	PendingOutgoing float64 `json:"pendingOutgoing"`
+	MWEB            float64 `json:"mweb,omitempty"`
+}
+
+// ConfirmedBalance is the breakdown of a chain without a view of pending funds.
+func ConfirmedBalance(balance float64) BalanceBreakdown {
+	return BalanceBreakdown{Confirmed: balance}
+}
+
+// NetPending books a signed pending change as incoming or outgoing.
+func (b *BalanceBreakdown) NetPending(change float64) {
+	if change > 0 {
+		b.PendingIncoming += change
+	} else {
+		b.PendingOutgoing -= change
+	}
+}
+
+// Total is the balance once every pending movement settles.
+func (b BalanceBreakdown) Total() float64 {
+	return b.Confirmed + b.PendingIncoming - b.PendingOutgoing
+}
+
+// Commitment is a Solana commitment level.
+type Commitment string
+
+const (
+	CommitmentProcessed Commitment = "processed"
+	CommitmentConfirmed Commitment = "confirmed"
+	CommitmentFinalized Commitment = "finalized"
+)
+
+// BalanceOptions chooses what counts as confirmed. MinConfirmations applies
+// to block based chains and Commitment to Solana; zero values keep the chain
+// defaults of one confirmation and finalized.
+type BalanceOptions struct {
+	MinConfirmations int64      `json:"minConfirmations,omitempty"`
+	Commitment       Commitment `json:"commitment,omitempty"`
+}
+
+// ParseCommitment accepts a commitment level, empty for the default.
+func ParseCommitment(s string) (Commitment, error) {
+	switch c := Commitment(strings.ToLower(strings.TrimSpace(s))); c {
+	case "", CommitmentProcessed, CommitmentConfirmed, CommitmentFinalized:
+		return c, nil
+	default:
+		return "", fmt.Errorf("%w: unknown commitment %q, use processed, confirmed or finalized", ErrBadRequest, s)
+	}
+}
+
+// ChangeWindow is a period over which a price change is reported.
+type ChangeWindow string
+
+const (
+	Window1h  ChangeWindow = "1h"
+	Window24h ChangeWindow = "24h"
+	Window7d  ChangeWindow = "7d"
+	Window30d ChangeWindow = "30d"
+)
+
+// ChangeWindows lists every window, shortest first.
+var ChangeWindows = []ChangeWindow{Window1h, Window24h, Window7d, Window30d}
+
+func (w ChangeWindow) Duration() time.Duration {
+	switch w {
+	case Window1h:
+		return time.Hour
+	case Window24h:
+		return 24 * time.Hour
+	case Window7d:
+		return 7 * 24 * time.Hour
+	case Window30d:
+		return 30 * 24 * time.Hour
+	default:
+		return 0
+	}
+}
+
+// PriceChange is the move of the exchange rate over a window and what it did
+// to the fiat value of the current balance. ReferenceRate is the rate at the
+// start of the window and is zero for portfolio totals.
+type PriceChange struct {
+	Window        ChangeWindow `json:"window"`
+	Percent       float64      `json:"percent"`
+	FiatChange    float64      `json:"fiatChange"`
+	ReferenceRate float64      `json:"referenceRate,omitempty"`
+}
+
+// NewPriceChange compares rate with the reference rate at the start of window
+// for a balance held throughout it.
+func NewPriceChange(window ChangeWindow, balance, rate, referenceRate float64) PriceChange {
+	change := PriceChange{
+		Window:        window,
+		FiatChange:    balance * (rate - referenceRate),
+		ReferenceRate: referenceRate,
+	}
+	if referenceRate != 0 {
+		change.Percent = (rate - referenceRate) / referenceRate * 100
+	}
+	return change
+}
+
+// FindPriceChange returns the entry for window, if present.
+func FindPriceChange(changes []PriceChange, window ChangeWindow) (PriceChange, bool) {
+	for _, change := range changes {
+		if change.Window == window {
+			return change, true
+		}
+	}
+	return PriceChange{}, false
 }
@@ -0,0 +1,83 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+)
+
+// ErrorCode is the stable, machine-readable identifier returned to clients.
+type ErrorCode string
+
+const (
+	CodeBadRequest          ErrorCode = "BAD_REQUEST"
+	CodeInvalidAddress      ErrorCode = "INVALID_ADDRESS"
+	CodeUnsupportedSymbol   ErrorCode = "UNSUPPORTED_SYMBOL"
+	CodeUnsupportedFiat     ErrorCode = "UNSUPPORTED_FIAT"
+	CodeInsufficientFunds   ErrorCode = "INSUFFICIENT_FUNDS"
+	CodeTransactionNotFound ErrorCode = "TRANSACTION_NOT_FOUND"
+	CodeRateUnavailable     ErrorCode = "RATE_UNAVAILABLE"
+	CodeProviderUnavailable ErrorCode = "PROVIDER_UNAVAILABLE"
+	CodeUpstreamTimeout     ErrorCode = "UPSTREAM_TIMEOUT"
+	CodeInternal            ErrorCode = "INTERNAL_ERROR"
+)
+
+// Error is a domain error carrying a stable code. Adapters wrap the sentinels
+// below so callers can match with errors.Is and classify with CodeOf.
+type Error struct {
+	Code    ErrorCode
+	Message string
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// Is matches any domain error with the same code, so adapter-specific errors
+// such as an invalid Ethereum address still satisfy errors.Is(err, ErrInvalidAddress).
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	return ok && t.Code == e.Code
+}
+
+var (
+	ErrBadRequest          = &Error{Code: CodeBadRequest, Message: "bad request"}
+	ErrInvalidAddress      = &Error{Code: CodeInvalidAddress, Message: "invalid address"}
+	ErrUnsupportedSymbol   = &Error{Code: CodeUnsupportedSymbol, Message: "unsupported symbol"}
+	ErrUnsupportedFiat     = &Error{Code: CodeUnsupportedFiat, Message: "unsupported fiat symbol"}
+	ErrInsufficientFunds   = &Error{Code: CodeInsufficientFunds, Message: "insufficient funds"}
+	ErrTransactionNotFound = &Error{Code: CodeTransactionNotFound, Message: "transaction not found"}
+	ErrRateUnavailable     = &Error{Code: CodeRateUnavailable, Message: "exchange rate unavailable"}
+	ErrProviderUnavailable = &Error{Code: CodeProviderUnavailable, Message: "provider unavailable"}
+	ErrUpstreamTimeout     = &Error{Code: CodeUpstreamTimeout, Message: "upstream timeout"}
+)
+
+// CodeOf returns the code of the first domain error in err's chain, or
+// CodeInternal when err is not a domain error.
+func CodeOf(err error) ErrorCode {
+	var domainErr *Error
+	if errors.As(err, &domainErr) {
+		return domainErr.Code
+	}
+	return CodeInternal
+}
+
+// UpstreamError classifies a failure talking to a node, explorer or rate
+// service. Errors that already carry a domain code are returned unchanged.
+func UpstreamError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var domainErr *Error
+	if errors.As(err, &domainErr) {
+		return err
+	}
+
+	var netErr net.Error
+	if errors.Is(err, context.DeadlineExceeded) || (errors.As(err, &netErr) && netErr.Timeout()) {
+		return fmt.Errorf("%w: %w", ErrUpstreamTimeout, err)
+	}
+	return fmt.Errorf("%w: %w", ErrProviderUnavailable, err)
+}
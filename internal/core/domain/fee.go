@@ -0,0 +1,40 @@
+package domain
+
+// FeeLevel is a priority tier of a fee estimate.
+type FeeLevel string
+
+const (
+	FeeLevelSlow   FeeLevel = "slow"
+	FeeLevelNormal FeeLevel = "normal"
+	FeeLevelFast   FeeLevel = "fast"
+)
+
+// FeeEstimate is the fee of one priority tier. Rate is in the RateUnit of its
+// FeeEstimates and Amount is the fee of a typical transaction in whole coins.
+// BaseFee and PriorityFee split Rate on EIP-1559 chains, in the same unit.
+// EstimatedSeconds is zero when the chain gives no confirmation target.
+type FeeEstimate struct {
+	Level            FeeLevel `json:"level"`
+	Rate             float64  `json:"rate"`
+	Amount           float64  `json:"amount"`
+	FiatValue        *float64 `json:"fiatValue"`
+	BaseFee          *float64 `json:"baseFee,omitempty"`
+	PriorityFee      *float64 `json:"priorityFee,omitempty"`
+	EstimatedSeconds int64    `json:"estimatedSeconds,omitempty"`
+}
+
+// FeeEstimates holds the slow, normal and fast estimates of a chain. Amounts
+// are priced for a transaction of TypicalSize, in SizeUnit: virtual bytes,
+// gas, compute units or grams of mass. Fiat fields are nil when no exchange
+// rate could be obtained and RateError explains why.
+type FeeEstimates struct {
+	CryptoSymbol  string        `json:"cryptoSymbol"`
+	RateUnit      string        `json:"rateUnit"`
+	SizeUnit      string        `json:"sizeUnit"`
+	TypicalSize   int64         `json:"typicalSize"`
+	Estimates     []FeeEstimate `json:"estimates"`
+	FiatSymbol    string        `json:"fiatSymbol"`
+	ExchangeRate  *float64      `json:"exchangeRate"`
+	RateError     *string       `json:"rateError,omitempty"`
+	RateErrorCode ErrorCode     `json:"rateErrorCode,omitempty"`
+}
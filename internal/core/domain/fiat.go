@@ -0,0 +1,76 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+// iso4217 holds the active ISO 4217 alphabetic currency codes.
+var iso4217 = setOf(
+	"AED", "AFN", "ALL", "AMD", "ANG", "AOA", "ARS", "AUD", "AWG", "AZN",
+	"BAM", "BBD", "BDT", "BGN", "BHD", "BIF", "BMD", "BND", "BOB", "BOV",
+	"BRL", "BSD", "BTN", "BWP", "BYN", "BZD", "CAD", "CDF", "CHE", "CHF",
+	"CHW", "CLF", "CLP", "CNY", "COP", "COU", "CRC", "CUP", "CVE", "CZK",
+	"DJF", "DKK", "DOP", "DZD", "EGP", "ERN", "ETB", "EUR", "FJD", "FKP",
+	"GBP", "GEL", "GHS", "GIP", "GMD", "GNF", "GTQ", "GYD", "HKD", "HNL",
+	"HTG", "HUF", "IDR", "ILS", "INR", "IQD", "IRR", "ISK", "JMD", "JOD",
+	"JPY", "KES", "KGS", "KHR", "KMF", "KPW", "KRW", "KWD", "KYD", "KZT",
+	"LAK", "LBP", "LKR", "LRD", "LSL", "LYD", "MAD", "MDL", "MGA", "MKD",
+	"MMK", "MNT", "MOP", "MRU", "MUR", "MVR", "MWK", "MXN", "MXV", "MYR",
+	"MZN", "NAD", "NGN", "NIO", "NOK", "NPR", "NZD", "OMR", "PAB", "PEN",
+	"PGK", "PHP", "PKR", "PLN", "PYG", "QAR", "RON", "RSD", "RUB", "RWF",
+	"SAR", "SBD", "SCR", "SDG", "SEK", "SGD", "SHP", "SLE", "SOS", "SRD",
+	"SSP", "STN", "SVC", "SYP", "SZL", "THB", "TJS", "TMT", "TND", "TOP",
+	"TRY", "TTD", "TWD", "TZS", "UAH", "UGX", "USD", "USN", "UYI", "UYU",
+	"UYW", "UZS", "VED", "VES", "VND", "VUV", "WST", "XAF", "XAG", "XAU",
+	"XCD", "XCG", "XDR", "XOF", "XPD", "XPF", "XPT", "XSU", "XUA", "YER",
+	"ZAR", "ZMW", "ZWG",
+)
+
+// FiatSymbols validates fiat symbols against ISO 4217 and an optional
+// allowlist. A nil *FiatSymbols accepts every ISO 4217 code.
+type FiatSymbols struct {
+	allowed map[string]struct{}
+}
+
+// NewFiatSymbols restricts fiat symbols to allowed, or accepts every ISO 4217
+// code when allowed is empty. Every allowed symbol must itself be ISO 4217.
+func NewFiatSymbols(allowed []string) (*FiatSymbols, error) {
+	fiatSymbols := &FiatSymbols{}
+	if len(allowed) == 0 {
+		return fiatSymbols, nil
+	}
+
+	fiatSymbols.allowed = make(map[string]struct{}, len(allowed))
+	for _, symbol := range allowed {
+		normalized := strings.ToUpper(strings.TrimSpace(symbol))
+		if _, ok := iso4217[normalized]; !ok {
+			return nil, fmt.Errorf("allowed fiat symbol %q is not an ISO 4217 code", symbol)
+		}
+		fiatSymbols.allowed[normalized] = struct{}{}
+	}
+	return fiatSymbols, nil
+}
+
+// Normalize returns the upper-case form of symbol, or an ErrUnsupportedFiat
+// error when it is not ISO 4217 or not allowed.
+func (f *FiatSymbols) Normalize(symbol string) (string, error) {
+	normalized := strings.ToUpper(strings.TrimSpace(symbol))
+	if _, ok := iso4217[normalized]; !ok {
+		return "", fmt.Errorf("%w: %q is not an ISO 4217 currency code", ErrUnsupportedFiat, symbol)
+	}
+	if f != nil && f.allowed != nil {
+		if _, ok := f.allowed[normalized]; !ok {
+			return "", fmt.Errorf("%w: %s is not enabled on this server", ErrUnsupportedFiat, normalized)
+		}
+	}
+	return normalized, nil
+}
+
+func setOf(values ...string) map[string]struct{} {
+	set := make(map[string]struct{}, len(values))
+	for _, value := range values {
+		set[value] = struct{}{}
+	}
+	return set
+}
@@ -0,0 +1,37 @@
+package domain
+
+import "time"
+
+// BalanceHistory is the daily fiat value of a set of wallets over a date range.
+type BalanceHistory struct {
+	FiatSymbol string          `json:"fiatSymbol"`
+	From       time.Time       `json:"from"`
+	To         time.Time       `json:"to"`
+	Totals     []HistoryTotal  `json:"totals"`
+	Wallets    []WalletHistory `json:"wallets"`
+}
+
+// HistoryTotal is the portfolio value at the end of a day. Complete is false
+// when a wallet failed or had no rate for that day, in which case FiatValue
+// only covers the wallets that could be valued.
+type HistoryTotal struct {
+	Date      time.Time `json:"date"`
+	FiatValue float64   `json:"fiatValue"`
+	Complete  bool      `json:"complete"`
+}
+
+// WalletHistory is the end of day balance of a single wallet.
+type WalletHistory struct {
+	CryptoSymbol string         `json:"cryptoSymbol"`
+	Address      string         `json:"address"`
+	Points       []HistoryPoint `json:"points"`
+	Error        *string        `json:"error,omitempty"`
+	ErrorCode    ErrorCode      `json:"errorCode,omitempty"`
+}
+
+type HistoryPoint struct {
+	Date          time.Time `json:"date"`
+	CryptoBalance float64   `json:"cryptoBalance"`
+	ExchangeRate  *float64  `json:"exchangeRate"`
+	FiatValue     *float64  `json:"fiatValue"`
+}
@@ -0,0 +1,39 @@
+package domain
+
+import (
+	"math/big"
+	"time"
+)
+
+// TransferKind tells which indexer listing a transfer came from.
+type TransferKind string
+
+const (
+	// TransferNormal is a transaction sent by an externally owned account.
+	TransferNormal TransferKind = "normal"
+	// TransferInternal is a value transfer made by a contract call.
+	TransferInternal TransferKind = "internal"
+	// TransferToken is an ERC-20 Transfer event.
+	TransferToken TransferKind = "token"
+)
+
+// IndexedTransfer is one row of an EVM indexer's address history. Values are
+// in the smallest unit: wei for native transfers and the token's base unit for
+// token transfers. Fee is only set on normal transactions, since the sender
+// of the carrying transaction pays it once however many transfers it makes.
+// BlockNumber is zero while the transaction is pending.
+type IndexedTransfer struct {
+	Kind        TransferKind
+	Hash        string
+	BlockNumber int64
+	Timestamp   time.Time
+	From        string
+	To          string
+	Value       *big.Int
+	Fee         *big.Int
+	// Failed transfers were reverted: they moved no value but still cost the fee.
+	Failed        bool
+	TokenContract string
+	TokenSymbol   string
+	TokenDecimals int
+}
@@ -0,0 +1,268 @@
+package domain
+
+import "strings"
+
+// PortfolioTotal is the combined value of all balances in one fiat symbol.
+// Complete is false when a balance failed or had no exchange rate, in which
+// case FiatValue only covers the balances that could be valued. A window is
+// only reported when every valued balance has it, so the totals never mix
+// periods.
+type PortfolioTotal struct {
+	FiatSymbol   string        `json:"fiatSymbol"`
+	FiatValue    float64       `json:"fiatValue"`
+	Complete     bool          `json:"complete"`
+	PriceChanges []PriceChange `json:"priceChanges"`
+}
+
+// PortfolioTotals sums results per fiat symbol, in the order the symbols
+// first appear.
+func PortfolioTotals(results []*BalanceResult) []PortfolioTotal {
+	var totals []PortfolioTotal
+	index := make(map[string]int)
+	valued := make(map[string][]*BalanceResult)
+
+	for _, result := range results {
+		i, ok := index[result.FiatSymbol]
+		if !ok {
+			i = len(totals)
+			index[result.FiatSymbol] = i
+			totals = append(totals, PortfolioTotal{FiatSymbol: result.FiatSymbol, Complete: true})
+		}
+
+		if result.Error != nil || result.FiatValue == nil {
+			totals[i].Complete = false
+			continue
+		}
+		totals[i].FiatValue += *result.FiatValue
+		valued[result.FiatSymbol] = append(valued[result.FiatSymbol], result)
+	}
+
+	for i := range totals {
+		totals[i].PriceChanges = portfolioChanges(totals[i].FiatValue, valued[totals[i].FiatSymbol])
+	}
+	return totals
+}
+
+func portfolioChanges(fiatValue float64, results []*BalanceResult) []PriceChange {
+	changes := make([]PriceChange, 0, len(ChangeWindows))
+	if len(results) == 0 {
+		return changes
+	}
+
+	for _, window := range ChangeWindows {
+		var fiatChange float64
+		complete := true
+		for _, result := range results {
+			change, ok := FindPriceChange(result.PriceChanges, window)
+			if !ok {
+				complete = false
+				break
+			}
+			fiatChange += change.FiatChange
+		}
+		if !complete {
+			continue
+		}
+
+		// The portfolio was worth fiatValue - fiatChange at the start of the window.
+		change := PriceChange{Window: window, FiatChange: fiatChange}
+		if reference := fiatValue - fiatChange; reference != 0 {
+			change.Percent = fiatChange / reference * 100
+		}
+		changes = append(changes, change)
+	}
+	return changes
+}
+
+// PortfolioWallet is a named set of accounts valued together.
+type PortfolioWallet struct {
+	Name     string             `json:"name"`
+	Accounts []PortfolioAccount `json:"accounts"`
+}
+
+// PortfolioAccount is an address or xpub of a single crypto symbol.
+type PortfolioAccount struct {
+	CryptoSymbol string `json:"cryptoSymbol"`
+	Address      string `json:"address"`
+}
+
+// Portfolio is a set of wallets valued in one or more fiat symbols. Every
+// Values slice holds one entry per FiatSymbols entry, in the same order.
+type Portfolio struct {
+	FiatSymbols []string          `json:"fiatSymbols"`
+	Totals      []PortfolioValue  `json:"totals"`
+	Wallets     []WalletPortfolio `json:"wallets"`
+	Assets      []AssetPortfolio  `json:"assets"`
+}
+
+type WalletPortfolio struct {
+	Name     string             `json:"name"`
+	Values   []PortfolioValue   `json:"values"`
+	Accounts []AccountPortfolio `json:"accounts"`
+}
+
+type AccountPortfolio struct {
+	CryptoSymbol  string           `json:"cryptoSymbol"`
+	Address       string           `json:"address"`
+	CryptoBalance float64          `json:"cryptoBalance"`
+	Values        []PortfolioValue `json:"values"`
+	Error         *string          `json:"error,omitempty"`
+	ErrorCode     ErrorCode        `json:"errorCode,omitempty"`
+}
+
+// AssetPortfolio is the combined balance of one crypto symbol across wallets.
+type AssetPortfolio struct {
+	CryptoSymbol  string           `json:"cryptoSymbol"`
+	CryptoBalance float64          `json:"cryptoBalance"`
+	Values        []PortfolioValue `json:"values"`
+}
+
+// PortfolioValue is the value of a group of balances in one fiat symbol.
+// ExchangeRate is only set when every balance is in the same crypto symbol,
+// and AllocationPercent is the share of the grand total, nil for the grand
+// total itself.
+type PortfolioValue struct {
+	FiatSymbol        string   `json:"fiatSymbol"`
+	FiatValue         float64  `json:"fiatValue"`
+	ExchangeRate      *float64 `json:"exchangeRate"`
+	Change24h         *float64 `json:"change24h"`
+	Change24hPercent  *float64 `json:"change24hPercent"`
+	AllocationPercent *float64 `json:"allocationPercent,omitempty"`
+	Complete          bool     `json:"complete"`
+}
+
+// NewPortfolio assembles a portfolio from the valued balances of every
+// account, indexed by wallet, then account, then fiat symbol.
+func NewPortfolio(wallets []PortfolioWallet, fiatSymbols []string, results [][][]*BalanceResult) *Portfolio {
+	portfolio := &Portfolio{
+		FiatSymbols: fiatSymbols,
+		Wallets:     make([]WalletPortfolio, len(wallets)),
+	}
+
+	var all []*BalanceResult
+	var assetOrder []string
+	assetResults := make(map[string][]*BalanceResult)
+	assetBalances := make(map[string]float64)
+
+	for w, wallet := range wallets {
+		walletPortfolio := WalletPortfolio{
+			Name:     wallet.Name,
+			Accounts: make([]AccountPortfolio, len(wallet.Accounts)),
+		}
+
+		var walletResults []*BalanceResult
+		for i, accountResults := range results[w] {
+			account := AccountPortfolio{
+				CryptoSymbol: strings.ToUpper(wallet.Accounts[i].CryptoSymbol),
+				Address:      wallet.Accounts[i].Address,
+				Values:       PortfolioValues(accountResults, fiatSymbols),
+			}
+			if len(accountResults) > 0 && accountResults[0].Error != nil {
+				account.Error = accountResults[0].Error
+				account.ErrorCode = accountResults[0].ErrorCode
+			} else if len(accountResults) > 0 {
+				account.CryptoBalance = accountResults[0].CryptoBalance
+				if _, ok := assetBalances[account.CryptoSymbol]; !ok {
+					assetOrder = append(assetOrder, account.CryptoSymbol)
+				}
+				assetBalances[account.CryptoSymbol] += account.CryptoBalance
+			}
+
+			assetResults[account.CryptoSymbol] = append(assetResults[account.CryptoSymbol], accountResults...)
+			walletResults = append(walletResults, accountResults...)
+			walletPortfolio.Accounts[i] = account
+		}
+
+		walletPortfolio.Values = PortfolioValues(walletResults, fiatSymbols)
+		portfolio.Wallets[w] = walletPortfolio
+		all = append(all, walletResults...)
+	}
+
+	portfolio.Assets = make([]AssetPortfolio, len(assetOrder))
+	for i, symbol := range assetOrder {
+		portfolio.Assets[i] = AssetPortfolio{
+			CryptoSymbol:  symbol,
+			CryptoBalance: assetBalances[symbol],
+			Values:        PortfolioValues(assetResults[symbol], fiatSymbols),
+		}
+	}
+
+	portfolio.Totals = PortfolioValues(all, fiatSymbols)
+	portfolio.allocate()
+	return portfolio
+}
+
+// PortfolioValues sums results into one value per fiat symbol, in the order
+// of fiatSymbols.
+func PortfolioValues(results []*BalanceResult, fiatSymbols []string) []PortfolioValue {
+	totals := make(map[string]PortfolioTotal)
+	for _, total := range PortfolioTotals(results) {
+		totals[total.FiatSymbol] = total
+	}
+
+	values := make([]PortfolioValue, len(fiatSymbols))
+	for i, fiatSymbol := range fiatSymbols {
+		value := PortfolioValue{FiatSymbol: fiatSymbol, Complete: true}
+		if total, ok := totals[fiatSymbol]; ok {
+			value.FiatValue = total.FiatValue
+			value.Complete = total.Complete
+			if change, ok := FindPriceChange(total.PriceChanges, Window24h); ok {
+				fiatChange, percent := change.FiatChange, change.Percent
+				value.Change24h = &fiatChange
+				value.Change24hPercent = &percent
+			}
+		}
+		value.ExchangeRate = commonExchangeRate(results, fiatSymbol)
+		values[i] = value
+	}
+	return values
+}
+
+// allocate sets the share of the grand total on every wallet, account and asset.
+func (p *Portfolio) allocate() {
+	for w := range p.Wallets {
+		p.allocateValues(p.Wallets[w].Values)
+		for i := range p.Wallets[w].Accounts {
+			p.allocateValues(p.Wallets[w].Accounts[i].Values)
+		}
+	}
+	for i := range p.Assets {
+		p.allocateValues(p.Assets[i].Values)
+	}
+}
+
+func (p *Portfolio) allocateValues(values []PortfolioValue) {
+	for i := range values {
+		total := p.Totals[i].FiatValue
+		if total == 0 {
+			continue
+		}
+		allocation := values[i].FiatValue / total * 100
+		values[i].AllocationPercent = &allocation
+	}
+}
+
+// commonExchangeRate returns the rate of the results in fiatSymbol when they
+// share a crypto symbol, since a rate is meaningless for a mix of assets.
+// Failed balances do not hide the rate of the others.
+func commonExchangeRate(results []*BalanceResult, fiatSymbol string) *float64 {
+	var rate *float64
+	var cryptoSymbol string
+	for _, result := range results {
+		if result.FiatSymbol != fiatSymbol {
+			continue
+		}
+		if cryptoSymbol != "" && result.CryptoSymbol != cryptoSymbol {
+			return nil
+		}
+		cryptoSymbol = result.CryptoSymbol
+		if result.Error != nil {
+			continue
+		}
+		if result.ExchangeRate == nil {
+			return nil
+		}
+		rate = result.ExchangeRate
+	}
+	return rate
+}
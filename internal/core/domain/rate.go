@@ -0,0 +1,13 @@
+package domain
+
+import "time"
+
+// Rate is a crypto to fiat exchange rate reported by a price source.
+// Change24h is the percentage change of the rate over the last 24 hours, nil
+// when the source does not report one.
+type Rate struct {
+	Rate      float64   `json:"rate"`
+	Change24h *float64  `json:"change24h"`
+	Source    string    `json:"source"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
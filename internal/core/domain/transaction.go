@@ -0,0 +1,194 @@
+package domain
+
+import "time"
+
+// Direction tells whether a transaction moved funds into or out of the wallet.
+type Direction string
+
+const (
+	DirectionIncoming Direction = "incoming"
+	DirectionOutgoing Direction = "outgoing"
+)
+
+// Transaction is a wallet history entry. Amount is the absolute net change to
+// the wallet in whole coins, so outgoing amounts include the fee paid. Token
+// transfers set TokenContract and count Amount in whole tokens; the fee of the
+// transaction carrying them is reported by a separate native coin entry.
+type Transaction struct {
+	TransactionID string    `json:"transactionId"`
+	BlockHeight   *int64    `json:"blockHeight"`
+	Timestamp     time.Time `json:"timestamp"`
+	Amount        float64   `json:"amount"`
+	Direction     Direction `json:"direction"`
+	Confirmations int64     `json:"confirmations"`
+	FeeAmount     *float64  `json:"feeAmount"`
+	FromAddresses []string  `json:"fromAddresses"`
+	ToAddresses   []string  `json:"toAddresses"`
+	FiatSymbol    string    `json:"fiatSymbol"`
+	FiatValue     *float64  `json:"fiatValue"`
+	ExchangeRate  *float64  `json:"exchangeRate"`
+	TokenContract string    `json:"tokenContract,omitempty"`
+	TokenSymbol   string    `json:"tokenSymbol,omitempty"`
+}
+
+// NetAmount returns the signed change to the wallet balance.
+func (t Transaction) NetAmount() float64 {
+	if t.Direction == DirectionOutgoing {
+		return -t.Amount
+	}
+	return t.Amount
+}
+
+// IsToken reports whether the entry moved a token rather than the native coin.
+func (t Transaction) IsToken() bool {
+	return t.TokenContract != ""
+}
+
+// TransactionStatus tells whether a transaction has been confirmed yet.
+type TransactionStatus string
+
+const (
+	TransactionConfirmed TransactionStatus = "confirmed"
+	TransactionPending   TransactionStatus = "pending"
+	// TransactionFailed marks a transaction included in a block whose
+	// execution failed, so it only cost the fee. History entries are never
+	// listed under it.
+	TransactionFailed TransactionStatus = "failed"
+)
+
+// SortOrder is the order history is listed in.
+type SortOrder string
+
+const (
+	SortNewestFirst SortOrder = "desc"
+	SortOldestFirst SortOrder = "asc"
+)
+
+// TransactionFilter selects history entries. Zero fields match every entry.
+// MinAmount is compared with Amount, in whole tokens for token entries.
+type TransactionFilter struct {
+	Direction Direction
+	Since     time.Time
+	Until     time.Time
+	MinAmount float64
+	Status    TransactionStatus
+}
+
+// Matches reports whether tx passes every criterion of f. Since and Until
+// are inclusive.
+func (f TransactionFilter) Matches(tx Transaction) bool {
+	switch {
+	case f.Direction != "" && tx.Direction != f.Direction:
+		return false
+	case !f.Since.IsZero() && tx.Timestamp.Before(f.Since):
+		return false
+	case !f.Until.IsZero() && tx.Timestamp.After(f.Until):
+		return false
+	case tx.Amount < f.MinAmount:
+		return false
+	case f.Status == TransactionConfirmed && tx.Confirmations == 0:
+		return false
+	case f.Status == TransactionPending && tx.Confirmations > 0:
+		return false
+	}
+	return true
+}
+
+// PageRequest selects a page of history. Cursor is the NextCursor of the
+// previous page and takes precedence over Offset. Filter applies before
+// paging where the full history is known, and to each page where the chain
+// pages history itself. Order defaults to SortNewestFirst.
+type PageRequest struct {
+	Cursor string
+	Offset int
+	Limit  int
+	Filter TransactionFilter
+	Order  SortOrder
+}
+
+// TransactionPage is one page of history, newest first. NextCursor is empty on
+// the last page. TotalCount is nil when the chain pages history itself and
+// the total is not known without walking all of it.
+type TransactionPage struct {
+	Transactions []Transaction
+	NextCursor   string
+	TotalCount   *int
+}
+
+// TransactionDetail is one transaction decoded in full. Which parts are set
+// depends on the chain: Inputs and Outputs on UTXO chains, Transfers on
+// account chains, Logs on EVM chains and Instructions on Solana. Owned marks
+// the parts belonging to the wallet the detail was requested for. Timestamp
+// is nil until the transaction is in a block.
+type TransactionDetail struct {
+	TransactionID string
+	Status        TransactionStatus
+	BlockHeight   *int64
+	BlockHash     string
+	Timestamp     *time.Time
+	Confirmations int64
+	FeeAmount     *float64
+	Inputs        []TransactionInput
+	Outputs       []TransactionOutput
+	Transfers     []TransactionTransfer
+	Logs          []TransactionLog
+	Instructions  []TransactionInstruction
+}
+
+// TransactionInput is an output spent by a transaction. Value is in the
+// smallest unit of the chain and Amount in whole coins. Coinbase inputs spend
+// nothing and leave every field but Index zero.
+type TransactionInput struct {
+	Index               uint32
+	PreviousTransaction string
+	PreviousIndex       uint32
+	Address             string
+	Value               int64
+	Amount              float64
+	Owned               bool
+}
+
+// TransactionOutput is an output created by a transaction. Address is empty
+// for scripts without one, such as OP_RETURN outputs.
+type TransactionOutput struct {
+	Index   uint32
+	Address string
+	Value   int64
+	Amount  float64
+	Owned   bool
+}
+
+// TransactionTransfer is value moved between two accounts, in whole coins,
+// or in whole tokens when TokenContract is set.
+type TransactionTransfer struct {
+	From          string
+	To            string
+	Amount        float64
+	TokenContract string
+	TokenSymbol   string
+	FromOwned     bool
+	ToOwned       bool
+}
+
+// TransactionLog is an event emitted by an EVM contract, with topics and data
+// hex encoded.
+type TransactionLog struct {
+	Index   uint
+	Address string
+	Topics  []string
+	Data    string
+}
+
+// TransactionInstruction is one Solana instruction. Inner instructions carry
+// the index of the instruction that invoked them in Parent. Program and Type
+// name the program and instruction where they are known; Data is base58
+// encoded as the node reports it.
+type TransactionInstruction struct {
+	Index     int
+	Parent    *int
+	ProgramID string
+	Program   string
+	Type      string
+	Accounts  []string
+	Data      string
+}
@@ -0,0 +1,189 @@
+package domain
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// AmountMax is the Amount that sends everything From holds, less fees.
+const AmountMax = "max"
+
+// UnsignedTxRequest asks for an unsigned transaction sending Amount, in whole
+// coins or in whole tokens of TokenContract, from From to To, or with
+// AmountMax everything From holds after fees. A positive
+// FeeRate overrides the estimated fee rate, in the RateUnit of the fee
+// estimates of the chain. NonceAccount makes a Solana transaction use the
+// durable nonce of that account so it does not expire before it is signed.
+//
+// The remaining fields are batch options of UTXO chains. Recipients pays
+// several outputs instead of To and Amount, OpReturn adds a data output,
+// RequiredInputs are spent and ExcludedInputs left alone whatever the coin
+// selection would pick. Transactions signal BIP-125 replaceability unless
+// DisableRBF is set, and LockTime sets their lock time.
+type UnsignedTxRequest struct {
+	From          string
+	To            string
+	Amount        string
+	FeeRate       float64
+	TokenContract string
+	NonceAccount  string
+
+	Recipients     []Recipient
+	OpReturn       []byte
+	RequiredInputs []Outpoint
+	ExcludedInputs []Outpoint
+	DisableRBF     bool
+	LockTime       uint32
+}
+
+// Recipient is an output of an unsigned transaction paying Amount, in whole
+// coins or AmountMax, to Address.
+type Recipient struct {
+	Address string `json:"address"`
+	Amount  string `json:"amount"`
+}
+
+// Outpoint names the output at index Vout of a transaction.
+type Outpoint struct {
+	TransactionID string `json:"transactionId"`
+	Vout          uint32 `json:"vout"`
+}
+
+func (o Outpoint) String() string {
+	return fmt.Sprintf("%s:%d", o.TransactionID, o.Vout)
+}
+
+// SendsMax reports whether the request sweeps From instead of sending a set
+// amount.
+func (r UnsignedTxRequest) SendsMax() bool {
+	return IsAmountMax(r.Amount)
+}
+
+// IsAmountMax reports whether amount is AmountMax.
+func IsAmountMax(amount string) bool {
+	return strings.EqualFold(strings.TrimSpace(amount), AmountMax)
+}
+
+// IsBatch reports whether the request uses any batch option, which only the
+// builders of UTXO chains take.
+func (r UnsignedTxRequest) IsBatch() bool {
+	return len(r.Recipients) > 1 || len(r.OpReturn) > 0 || len(r.RequiredInputs) > 0 ||
+		len(r.ExcludedInputs) > 0 || r.DisableRBF || r.LockTime != 0
+}
+
+// Payees returns Recipients, or the single recipient To and Amount.
+func (r UnsignedTxRequest) Payees() []Recipient {
+	if len(r.Recipients) > 0 {
+		return r.Recipients
+	}
+	return []Recipient{{Address: r.To, Amount: r.Amount}}
+}
+
+// FeeBumpMethod is how a stuck transaction is sped up.
+type FeeBumpMethod string
+
+const (
+	// FeeBumpRBF replaces the transaction with one spending the same inputs
+	// at a higher fee, as BIP-125 allows.
+	FeeBumpRBF FeeBumpMethod = "rbf"
+	// FeeBumpCPFP spends an output of the transaction back to the wallet
+	// with a child paying the fee of both.
+	FeeBumpCPFP FeeBumpMethod = "cpfp"
+)
+
+// FeeBumpRequest asks for an unsigned transaction speeding up TransactionID,
+// an unconfirmed transaction of the wallet From, with Method. A positive
+// FeeRate is the rate to reach instead of the estimated one, for the child
+// and its parent together with FeeBumpCPFP.
+type FeeBumpRequest struct {
+	From          string
+	TransactionID string
+	Method        FeeBumpMethod
+	FeeRate       float64
+}
+
+// PayloadEncoding is the text encoding an unsigned transaction is returned in.
+type PayloadEncoding string
+
+const (
+	EncodingHex    PayloadEncoding = "hex"
+	EncodingBase64 PayloadEncoding = "base64"
+)
+
+// TxDetail is one line of the human-readable breakdown a signing device shows
+// before it signs.
+type TxDetail struct {
+	Label string `json:"label"`
+	Value string `json:"value"`
+}
+
+// UnsignedTxInput is an output spent by an unsigned transaction of a UTXO
+// chain, with the key path and the digest the signer signs it with.
+type UnsignedTxInput struct {
+	TransactionID  string `json:"transactionId"`
+	Vout           uint32 `json:"vout"`
+	Value          int64  `json:"value"`
+	Address        string `json:"address"`
+	DerivationPath string `json:"derivationPath,omitempty"`
+	SigningHash    []byte `json:"signingHash"`
+}
+
+// UnsignedTx is a transaction ready for an air-gapped signer. Payload is its
+// chain encoding, returned in Encoding, and SigningHash the digest to sign,
+// nil where the signer signs Payload itself or signs per input. Inputs lists
+// the spent outputs of UTXO chains. Amount is what the transaction sends and
+// Fee the highest fee it can pay, both exact decimals in whole coins.
+// Recipients lists every output paid when there are several.
+type UnsignedTx struct {
+	CryptoSymbol string            `json:"cryptoSymbol"`
+	From         string            `json:"from"`
+	To           string            `json:"to"`
+	Amount       string            `json:"amount"`
+	Fee          string            `json:"fee"`
+	Payload      []byte            `json:"payload"`
+	Encoding     PayloadEncoding   `json:"encoding"`
+	SigningHash  []byte            `json:"signingHash,omitempty"`
+	Inputs       []UnsignedTxInput `json:"inputs,omitempty"`
+	Recipients   []Recipient       `json:"recipients,omitempty"`
+	Details      []TxDetail        `json:"details"`
+}
+
+// ParseUnits converts a positive decimal amount of whole units to base units
+// with the given number of decimals, without going through a float. Amounts
+// with more fractional digits than decimals are rejected.
+func ParseUnits(amount string, decimals int) (*big.Int, error) {
+	whole, frac, _ := strings.Cut(amount, ".")
+	if whole+frac == "" || !isDigits(whole) || !isDigits(frac) || len(frac) > decimals {
+		return nil, fmt.Errorf("%w: invalid amount %q", ErrBadRequest, amount)
+	}
+
+	units, _ := new(big.Int).SetString(whole+frac+strings.Repeat("0", decimals-len(frac)), 10)
+	if units.Sign() == 0 {
+		return nil, fmt.Errorf("%w: amount must be positive", ErrBadRequest)
+	}
+	return units, nil
+}
+
+// FormatUnits renders base units as whole units with the given number of
+// decimals, without exponent or trailing zeros.
+func FormatUnits(units *big.Int, decimals int) string {
+	digits := units.String()
+	if len(digits) <= decimals {
+		digits = strings.Repeat("0", decimals-len(digits)+1) + digits
+	}
+	whole, frac := digits[:len(digits)-decimals], strings.TrimRight(digits[len(digits)-decimals:], "0")
+	if frac == "" {
+		return whole
+	}
+	return whole + "." + frac
+}
+
+func isDigits(s string) bool {
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
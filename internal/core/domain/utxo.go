@@ -0,0 +1,29 @@
+package domain
+
+// UTXO is an unspent output owned by a wallet. Value is in the smallest unit
+// of the chain (satoshi, litoshi, sompi) and Amount the same value in whole
+// coins. BlockHeight is nil for outputs still in the mempool; on Kaspa it is
+// the DAA score of the accepting block.
+type UTXO struct {
+	TransactionID  string  `json:"transactionId"`
+	Vout           uint32  `json:"vout"`
+	Value          int64   `json:"value"`
+	Amount         float64 `json:"amount"`
+	Confirmations  int64   `json:"confirmations"`
+	BlockHeight    *int64  `json:"blockHeight"`
+	ScriptPubKey   string  `json:"scriptPubKey"`
+	Address        string  `json:"address"`
+	DerivationPath string  `json:"derivationPath,omitempty"`
+}
+
+// UTXOFilter drops outputs with fewer confirmations than MinConfirmations or
+// a value below DustThreshold, in the smallest unit of the chain.
+type UTXOFilter struct {
+	MinConfirmations int64
+	DustThreshold    int64
+}
+
+// Match reports whether u passes the filter.
+func (f UTXOFilter) Match(u UTXO) bool {
+	return u.Confirmations >= f.MinConfirmations && u.Value >= f.DustThreshold
+}
@@ -1,17 +1,63 @@
 package service
 
 import (
+	"errors"
 	"net/http"
+	"time"
 
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
 	cryptowalletrest "github.com/airgap-solution/crypto-wallet-rest/openapi/servergen/go"
 )
 
-type Error struct {
-	Message string `json:"message"`
+var statusByCode = map[domain.ErrorCode]int{
+	domain.CodeBadRequest:          http.StatusBadRequest,
+	domain.CodeInvalidAddress:      http.StatusBadRequest,
+	domain.CodeUnsupportedSymbol:   http.StatusNotFound,
+	domain.CodeUnsupportedFiat:     http.StatusBadRequest,
+	domain.CodeInsufficientFunds:   http.StatusUnprocessableEntity,
+	domain.CodeTransactionNotFound: http.StatusNotFound,
+	domain.CodeRateUnavailable:     http.StatusBadGateway,
+	domain.CodeProviderUnavailable: http.StatusServiceUnavailable,
+	domain.CodeUpstreamTimeout:     http.StatusGatewayTimeout,
+}
+
+// StatusOf returns the HTTP status for an error code.
+func StatusOf(code domain.ErrorCode) int {
+	if status, ok := statusByCode[code]; ok {
+		return status
+	}
+	return http.StatusInternalServerError
 }
 
 func handleError(err error) (cryptowalletrest.ImplResponse, error) {
-	code := http.StatusNotImplemented
+	code := domain.CodeOf(err)
+
+	return cryptowalletrest.Response(StatusOf(code), newErrorResponse(code, err.Error())), nil
+}
+
+func newErrorResponse(code domain.ErrorCode, message string) cryptowalletrest.ErrorResponse {
+	return cryptowalletrest.ErrorResponse{
+		Error:     string(code),
+		Message:   message,
+		Timestamp: time.Now(),
+	}
+}
+
+// ErrorHandler replaces the generated DefaultErrorHandler so request parsing
+// failures use the same ErrorResponse body as errors raised by the service.
+func ErrorHandler(w http.ResponseWriter, _ *http.Request, err error, result *cryptowalletrest.ImplResponse) {
+	var parsingErr *cryptowalletrest.ParsingError
+	var requiredErr *cryptowalletrest.RequiredError
+	if errors.As(err, &parsingErr) || errors.As(err, &requiredErr) {
+		status := http.StatusBadRequest
+		_ = cryptowalletrest.EncodeJSONResponse(newErrorResponse(domain.CodeBadRequest, err.Error()), &status, w)
+		return
+	}
 
-	return cryptowalletrest.Response(code, Error{Message: err.Error()}), nil
+	code := domain.CodeOf(err)
+	status := StatusOf(code)
+	if result != nil && result.Code != 0 {
+		status = result.Code
+	}
+	_ = cryptowalletrest.EncodeJSONResponse(newErrorResponse(code, err.Error()), &status, w)
 }
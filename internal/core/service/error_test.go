@@ -1,10 +1,16 @@
 package service_test
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"testing"
 
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
 	"github.com/airgap-solution/crypto-wallet-rest/internal/core/service"
+	cryptowalletrest "github.com/airgap-solution/crypto-wallet-rest/openapi/servergen/go"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -14,9 +20,73 @@ func TestHandleError(t *testing.T) {
 	resp, err := service.HandleError(assert.AnError)
 
 	require.NoError(t, err)
-	require.Equal(t, http.StatusNotImplemented, resp.Code)
+	require.Equal(t, http.StatusInternalServerError, resp.Code)
 
-	body, ok := resp.Body.(service.Error)
+	body, ok := resp.Body.(cryptowalletrest.ErrorResponse)
 	require.True(t, ok)
+	require.Equal(t, string(domain.CodeInternal), body.Error)
 	require.Equal(t, assert.AnError.Error(), body.Message)
+	require.False(t, body.Timestamp.IsZero())
+}
+
+func TestHandleError_DomainErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		err    error
+		status int
+		code   domain.ErrorCode
+	}{
+		{domain.ErrBadRequest, http.StatusBadRequest, domain.CodeBadRequest},
+		{fmt.Errorf("decode: %w", domain.ErrInvalidAddress), http.StatusBadRequest, domain.CodeInvalidAddress},
+		{domain.ErrUnsupportedSymbol, http.StatusNotFound, domain.CodeUnsupportedSymbol},
+		{domain.ErrUnsupportedFiat, http.StatusBadRequest, domain.CodeUnsupportedFiat},
+		{domain.ErrInsufficientFunds, http.StatusUnprocessableEntity, domain.CodeInsufficientFunds},
+		{domain.ErrTransactionNotFound, http.StatusNotFound, domain.CodeTransactionNotFound},
+		{domain.ErrRateUnavailable, http.StatusBadGateway, domain.CodeRateUnavailable},
+		{domain.UpstreamError(assert.AnError), http.StatusServiceUnavailable, domain.CodeProviderUnavailable},
+		{domain.UpstreamError(context.DeadlineExceeded), http.StatusGatewayTimeout, domain.CodeUpstreamTimeout},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.code), func(t *testing.T) {
+			t.Parallel()
+			resp, err := service.HandleError(tt.err)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.status, resp.Code)
+
+			body, ok := resp.Body.(cryptowalletrest.ErrorResponse)
+			require.True(t, ok)
+			assert.Equal(t, string(tt.code), body.Error)
+			assert.Equal(t, tt.err.Error(), body.Message)
+		})
+	}
+}
+
+func TestErrorHandler_ParsingError(t *testing.T) {
+	t.Parallel()
+	rec := httptest.NewRecorder()
+
+	service.ErrorHandler(rec, nil, &cryptowalletrest.ParsingError{Param: "limit", Err: assert.AnError}, nil)
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	var body cryptowalletrest.ErrorResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	assert.Equal(t, string(domain.CodeBadRequest), body.Error)
+	assert.Contains(t, body.Message, "limit")
+}
+
+func TestErrorHandler_RequiredError(t *testing.T) {
+	t.Parallel()
+	rec := httptest.NewRecorder()
+
+	service.ErrorHandler(rec, nil, &cryptowalletrest.RequiredError{Field: "requests"}, nil)
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	var body cryptowalletrest.ErrorResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	assert.Equal(t, string(domain.CodeBadRequest), body.Error)
 }
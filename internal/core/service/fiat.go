@@ -0,0 +1,78 @@
+package service
+
+import (
+	"slices"
+	"time"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+	cryptowalletrest "github.com/airgap-solution/crypto-wallet-rest/openapi/servergen/go"
+)
+
+const defaultFiatSymbol = "USD"
+
+// fiatSymbol validates symbol, falling back to fallback when it is empty.
+func (s Service) fiatSymbol(symbol, fallback string) (string, error) {
+	if symbol == "" {
+		symbol = fallback
+	}
+	return s.fiatSymbols.Normalize(symbol)
+}
+
+// balanceRequest resolves the fiat symbols of one /balances item. The item's
+// own fiat_symbol comes first, then its fiat_symbols; defaultFiat only applies
+// when the item names none.
+func (s Service) balanceRequest(
+	item cryptowalletrest.BalancesPostRequestRequestsInner, defaultFiat string,
+) (domain.BalanceRequest, error) {
+	requested := item.FiatSymbols
+	if item.FiatSymbol != "" || len(requested) == 0 {
+		requested = append([]string{item.FiatSymbol}, requested...)
+	}
+
+	fiatSymbols := make([]string, 0, len(requested))
+	for _, symbol := range requested {
+		fiatSymbol, err := s.fiatSymbol(symbol, defaultFiat)
+		if err != nil {
+			return domain.BalanceRequest{}, err
+		}
+		if !slices.Contains(fiatSymbols, fiatSymbol) {
+			fiatSymbols = append(fiatSymbols, fiatSymbol)
+		}
+	}
+
+	request := domain.BalanceRequest{
+		CryptoSymbol: item.CryptoSymbol,
+		Address:      item.Address,
+		FiatSymbol:   fiatSymbols[0],
+	}
+	if len(item.FiatSymbols) > 0 {
+		request.FiatSymbols = fiatSymbols
+	}
+	return request, nil
+}
+
+func fiatConversions(conversions []*domain.BalanceResult) map[string]cryptowalletrest.FiatConversion {
+	if len(conversions) == 0 {
+		return nil
+	}
+
+	mapped := make(map[string]cryptowalletrest.FiatConversion, len(conversions))
+	for _, conversion := range conversions {
+		fiatConversion := cryptowalletrest.FiatConversion{
+			FiatValue:    conversion.FiatValue,
+			ExchangeRate: conversion.ExchangeRate,
+			Change24h:    conversion.Change24h,
+			PriceChanges: priceChanges(conversion.PriceChanges),
+		}
+		if conversion.RateError != nil {
+			fiatConversion.RateError = *conversion.RateError
+			fiatConversion.RateErrorCode = string(conversion.RateErrorCode)
+		}
+		if conversion.RateStale {
+			fiatConversion.RateStale = true
+			fiatConversion.RateAgeSeconds = int64(conversion.RateAge / time.Second)
+		}
+		mapped[conversion.FiatSymbol] = fiatConversion
+	}
+	return mapped
+}
@@ -2,81 +2,976 @@ package service
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
 	"github.com/airgap-solution/crypto-wallet-rest/internal/ports"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/tracing"
 	cryptowalletrest "github.com/airgap-solution/crypto-wallet-rest/openapi/servergen/go"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 )
 
+var tracer = tracing.Tracer("service")
+
 type Service struct {
-	adapter ports.Provider
+	adapter     ports.Provider
+	fiatSymbols *domain.FiatSymbols
+}
+
+type Option func(*Service)
+
+// WithFiatSymbols restricts the accepted fiat symbols. Without it every ISO
+// 4217 code is accepted.
+func WithFiatSymbols(fiatSymbols *domain.FiatSymbols) Option {
+	return func(s *Service) {
+		s.fiatSymbols = fiatSymbols
+	}
 }
 
-func New(adapter ports.Provider) Service {
-	return Service{adapter: adapter}
+func New(adapter ports.Provider, opts ...Option) Service {
+	s := Service{adapter: adapter}
+	for _, opt := range opts {
+		opt(&s)
+	}
+	return s
 }
 
 func (s Service) BalancesPost(
-	_ context.Context, request cryptowalletrest.BalancesPostRequest,
+	ctx context.Context, request cryptowalletrest.BalancesPostRequest,
 ) (cryptowalletrest.ImplResponse, error) {
+	ctx, span := tracer.Start(ctx, "Service.BalancesPost",
+		trace.WithAttributes(tracing.AttrBatchSize.Int(len(request.Requests))))
+	defer span.End()
+
+	defaultFiat, err := s.fiatSymbol(request.FiatSymbol, defaultFiatSymbol)
+	if err != nil {
+		return handleError(err)
+	}
+	commitment, err := domain.ParseCommitment(request.Commitment)
+	if err != nil {
+		return handleError(err)
+	}
+	options := domain.BalanceOptions{MinConfirmations: request.MinConfirmations, Commitment: commitment}
+
 	// Convert OpenAPI request to internal format
 	balanceRequests := make([]domain.BalanceRequest, len(request.Requests))
 	for i, req := range request.Requests {
-		fiatSymbol := req.FiatSymbol
-		if fiatSymbol == "" {
-			fiatSymbol = "USD"
-		}
-		balanceRequests[i] = domain.BalanceRequest{
-			CryptoSymbol: req.CryptoSymbol,
-			Address:      req.Address,
-			FiatSymbol:   fiatSymbol,
+		balanceRequests[i], err = s.balanceRequest(req, defaultFiat)
+		if err != nil {
+			return handleError(err)
 		}
+		balanceRequests[i].Options = options
 	}
 
-	// Get all balances using batch method
-	results, err := s.adapter.GetBatchBalances(balanceRequests)
+	// Reject invalid addresses and network mismatches per item up front
+	validRequests, results := s.validateRequests(ctx, balanceRequests)
 
+	// Get all balances using batch method
+	fetched, err := s.adapter.GetBatchBalances(ctx, validRequests)
 	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
 		return handleError(err)
 	}
+	results = mergeResults(results, fetched)
 
 	// Convert results to OpenAPI format
 	balances := make([]cryptowalletrest.BalancesPost200ResponseResultsInner, len(results))
+	var valuations []*domain.BalanceResult
 	for i, result := range results {
+		valuations = append(valuations, result.Valuations()...)
 		balance := cryptowalletrest.BalancesPost200ResponseResultsInner{
-			CryptoSymbol:  result.CryptoSymbol,
-			Address:       result.Address,
-			CryptoBalance: result.CryptoBalance,
-			FiatSymbol:    result.FiatSymbol,
-			FiatValue:     result.FiatValue,
-			ExchangeRate:  result.ExchangeRate,
-			Change24h:     result.Change24h,
-			Timestamp:     result.Timestamp,
+			CryptoSymbol:     result.CryptoSymbol,
+			Address:          result.Address,
+			CryptoBalance:    result.CryptoBalance,
+			ConfirmedBalance: result.Breakdown.Confirmed,
+			PendingIncoming:  result.Breakdown.PendingIncoming,
+			PendingOutgoing:  result.Breakdown.PendingOutgoing,
+			MwebBalance:      result.Breakdown.MWEB,
+			FiatSymbol:       result.FiatSymbol,
+			FiatValue:        result.FiatValue,
+			ExchangeRate:     result.ExchangeRate,
+			Change24h:        result.Change24h,
+			PriceChanges:     priceChanges(result.PriceChanges),
+			Timestamp:        result.Timestamp,
+			Conversions:      fiatConversions(result.Conversions),
 		}
 		if result.Error != nil {
 			balance.Error = *result.Error
+			balance.ErrorCode = string(result.ErrorCode)
+		}
+		if result.RateError != nil {
+			balance.RateError = *result.RateError
+			balance.RateErrorCode = string(result.RateErrorCode)
+		}
+		if result.RateStale {
+			balance.RateStale = true
+			balance.RateAgeSeconds = int64(result.RateAge / time.Second)
 		}
 		balances[i] = balance
 	}
 
+	portfolioTotals := domain.PortfolioTotals(valuations)
+	totals := make([]cryptowalletrest.BalancesPost200ResponseTotalsInner, len(portfolioTotals))
+	for i, total := range portfolioTotals {
+		totals[i] = cryptowalletrest.BalancesPost200ResponseTotalsInner{
+			FiatSymbol:   total.FiatSymbol,
+			FiatValue:    total.FiatValue,
+			Complete:     total.Complete,
+			PriceChanges: priceChanges(total.PriceChanges),
+		}
+	}
+
 	return cryptowalletrest.Response(http.StatusOK, cryptowalletrest.BalancesPost200Response{
 		Results:   balances,
+		Totals:    totals,
 		Timestamp: time.Now(),
 	}), nil
 }
 
+func priceChanges(changes []domain.PriceChange) []cryptowalletrest.PriceChange {
+	result := make([]cryptowalletrest.PriceChange, len(changes))
+	for i, change := range changes {
+		result[i] = cryptowalletrest.PriceChange{
+			Window:        string(change.Window),
+			Percent:       change.Percent,
+			FiatChange:    change.FiatChange,
+			ReferenceRate: change.ReferenceRate,
+		}
+	}
+	return result
+}
+
+func (s Service) BalancesHistoryPost(
+	ctx context.Context, request cryptowalletrest.BalancesHistoryPostRequest,
+) (cryptowalletrest.ImplResponse, error) {
+	ctx, span := tracer.Start(ctx, "Service.BalancesHistoryPost",
+		trace.WithAttributes(tracing.AttrBatchSize.Int(len(request.Requests))))
+	defer span.End()
+
+	from, err := time.Parse(time.DateOnly, request.From)
+	if err != nil {
+		return handleError(fmt.Errorf("%w: invalid from date: %w", domain.ErrBadRequest, err))
+	}
+	to, err := time.Parse(time.DateOnly, request.To)
+	if err != nil {
+		return handleError(fmt.Errorf("%w: invalid to date: %w", domain.ErrBadRequest, err))
+	}
+
+	fiatSymbol, err := s.fiatSymbol(request.FiatSymbol, defaultFiatSymbol)
+	if err != nil {
+		return handleError(err)
+	}
+
+	balanceRequests := make([]domain.BalanceRequest, len(request.Requests))
+	for i, req := range request.Requests {
+		balanceRequests[i] = domain.BalanceRequest{
+			CryptoSymbol: req.CryptoSymbol,
+			Address:      req.Address,
+			FiatSymbol:   fiatSymbol,
+		}
+	}
+
+	history, err := s.adapter.GetBalanceHistory(ctx, balanceRequests, fiatSymbol, from, to)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return handleError(err)
+	}
+
+	totals := make([]cryptowalletrest.BalancesHistoryPost200ResponseTotalsInner, len(history.Totals))
+	for i, total := range history.Totals {
+		totals[i] = cryptowalletrest.BalancesHistoryPost200ResponseTotalsInner{
+			Date:      total.Date.Format(time.DateOnly),
+			FiatValue: total.FiatValue,
+			Complete:  total.Complete,
+		}
+	}
+
+	wallets := make([]cryptowalletrest.BalancesHistoryPost200ResponseWalletsInner, len(history.Wallets))
+	for i, wallet := range history.Wallets {
+		points := make([]cryptowalletrest.BalanceHistoryPoint, len(wallet.Points))
+		for j, point := range wallet.Points {
+			points[j] = cryptowalletrest.BalanceHistoryPoint{
+				Date:          point.Date.Format(time.DateOnly),
+				CryptoBalance: point.CryptoBalance,
+				ExchangeRate:  point.ExchangeRate,
+				FiatValue:     point.FiatValue,
+			}
+		}
+		walletHistory := cryptowalletrest.BalancesHistoryPost200ResponseWalletsInner{
+			CryptoSymbol: wallet.CryptoSymbol,
+			Address:      wallet.Address,
+			Points:       points,
+		}
+		if wallet.Error != nil {
+			walletHistory.Error = *wallet.Error
+			walletHistory.ErrorCode = string(wallet.ErrorCode)
+		}
+		wallets[i] = walletHistory
+	}
+
+	return cryptowalletrest.Response(http.StatusOK, cryptowalletrest.BalancesHistoryPost200Response{
+		FiatSymbol: history.FiatSymbol,
+		From:       history.From.Format(time.DateOnly),
+		To:         history.To.Format(time.DateOnly),
+		Totals:     totals,
+		Wallets:    wallets,
+	}), nil
+}
+
+func (s Service) PortfolioPost(
+	ctx context.Context, request cryptowalletrest.PortfolioPostRequest,
+) (cryptowalletrest.ImplResponse, error) {
+	ctx, span := tracer.Start(ctx, "Service.PortfolioPost",
+		trace.WithAttributes(tracing.AttrBatchSize.Int(len(request.Wallets))))
+	defer span.End()
+
+	fiatSymbols := make([]string, len(request.FiatSymbols))
+	for i, symbol := range request.FiatSymbols {
+		fiatSymbol, err := s.fiatSymbol(symbol, defaultFiatSymbol)
+		if err != nil {
+			return handleError(err)
+		}
+		fiatSymbols[i] = fiatSymbol
+	}
+
+	wallets := make([]domain.PortfolioWallet, len(request.Wallets))
+	for i, wallet := range request.Wallets {
+		accounts := make([]domain.PortfolioAccount, len(wallet.Accounts))
+		for j, account := range wallet.Accounts {
+			accounts[j] = domain.PortfolioAccount{
+				CryptoSymbol: account.CryptoSymbol,
+				Address:      account.Address,
+			}
+		}
+		wallets[i] = domain.PortfolioWallet{Name: wallet.Name, Accounts: accounts}
+	}
+
+	portfolio, err := s.adapter.GetPortfolio(ctx, wallets, fiatSymbols)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return handleError(err)
+	}
+
+	walletsResp := make([]cryptowalletrest.PortfolioPost200ResponseWalletsInner, len(portfolio.Wallets))
+	for i, wallet := range portfolio.Wallets {
+		accounts := make([]cryptowalletrest.PortfolioPost200ResponseWalletsInnerAccountsInner, len(wallet.Accounts))
+		for j, account := range wallet.Accounts {
+			accounts[j] = cryptowalletrest.PortfolioPost200ResponseWalletsInnerAccountsInner{
+				CryptoSymbol:  account.CryptoSymbol,
+				Address:       account.Address,
+				CryptoBalance: account.CryptoBalance,
+				Values:        portfolioValues(account.Values),
+			}
+			if account.Error != nil {
+				accounts[j].Error = *account.Error
+				accounts[j].ErrorCode = string(account.ErrorCode)
+			}
+		}
+		walletsResp[i] = cryptowalletrest.PortfolioPost200ResponseWalletsInner{
+			Name:     wallet.Name,
+			Values:   portfolioValues(wallet.Values),
+			Accounts: accounts,
+		}
+	}
+
+	assets := make([]cryptowalletrest.PortfolioPost200ResponseAssetsInner, len(portfolio.Assets))
+	for i, asset := range portfolio.Assets {
+		assets[i] = cryptowalletrest.PortfolioPost200ResponseAssetsInner{
+			CryptoSymbol:  asset.CryptoSymbol,
+			CryptoBalance: asset.CryptoBalance,
+			Values:        portfolioValues(asset.Values),
+		}
+	}
+
+	return cryptowalletrest.Response(http.StatusOK, cryptowalletrest.PortfolioPost200Response{
+		FiatSymbols: portfolio.FiatSymbols,
+		Totals:      portfolioValues(portfolio.Totals),
+		Wallets:     walletsResp,
+		Assets:      assets,
+		Timestamp:   time.Now(),
+	}), nil
+}
+
+func portfolioValues(values []domain.PortfolioValue) []cryptowalletrest.PortfolioValue {
+	mapped := make([]cryptowalletrest.PortfolioValue, len(values))
+	for i, value := range values {
+		mapped[i] = cryptowalletrest.PortfolioValue{
+			FiatSymbol:       value.FiatSymbol,
+			FiatValue:        value.FiatValue,
+			ExchangeRate:     value.ExchangeRate,
+			Change24h:        value.Change24h,
+			Change24hPercent: value.Change24hPercent,
+			Complete:         value.Complete,
+		}
+		if value.AllocationPercent != nil {
+			mapped[i].AllocationPercent = *value.AllocationPercent
+		}
+	}
+	return mapped
+}
+
+// ValidateAddressGet reports whether an address or xpub belongs to the chain
+// of cryptoSymbol. An invalid address is a normal answer with Valid false;
+// only an unsupported symbol is an error.
+func (s Service) ValidateAddressGet(
+	ctx context.Context, cryptoSymbol, address string,
+) (cryptowalletrest.ImplResponse, error) {
+	ctx, span := tracer.Start(ctx, "Service.ValidateAddressGet",
+		trace.WithAttributes(tracing.ChainAttributes(cryptoSymbol)...))
+	defer span.End()
+
+	validation, err := s.adapter.ValidateAddress(ctx, cryptoSymbol, address)
+	if err != nil && !errors.Is(err, domain.ErrInvalidAddress) {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return handleError(err)
+	}
+
+	response := cryptowalletrest.ValidateAddressGet200Response{
+		CryptoSymbol: strings.ToUpper(cryptoSymbol),
+		Address:      address,
+		Valid:        err == nil,
+	}
+	if err != nil {
+		response.Error = err.Error()
+		response.ErrorCode = string(domain.CodeOf(err))
+	} else {
+		response.Kind = string(validation.Kind)
+		response.Format = validation.Format
+		response.Network = validation.Network
+	}
+
+	return cryptowalletrest.Response(http.StatusOK, response), nil
+}
+
+// ReceiveAddressGet returns the next count unused receive addresses of xpub.
+// The key is validated offline first so a plain address is rejected before
+// any chain lookup.
+func (s Service) ReceiveAddressGet(
+	ctx context.Context, cryptoSymbol, xpub string, count int32,
+) (cryptowalletrest.ImplResponse, error) {
+	ctx, span := tracer.Start(ctx, "Service.ReceiveAddressGet",
+		trace.WithAttributes(tracing.ChainAttributes(cryptoSymbol)...))
+	defer span.End()
+
+	validation, err := s.adapter.ValidateAddress(ctx, cryptoSymbol, xpub)
+	if err == nil && validation.Kind != domain.AddressKindExtendedKey {
+		err = fmt.Errorf("%w: not an extended public key", domain.ErrInvalidAddress)
+	}
+	if err != nil {
+		return handleError(err)
+	}
+
+	addresses, err := s.adapter.GetReceiveAddresses(ctx, cryptoSymbol, xpub, int(count))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return handleError(err)
+	}
+
+	mapped := make([]cryptowalletrest.ReceiveAddress, len(addresses))
+	for i, addr := range addresses {
+		mapped[i] = cryptowalletrest.ReceiveAddress{
+			Index:          int32(addr.Index), //nolint:gosec // bounded by the gap limit walk
+			Address:        addr.Address,
+			DerivationPath: addr.DerivationPath,
+		}
+	}
+
+	return cryptowalletrest.Response(http.StatusOK, cryptowalletrest.ReceiveAddressGet200Response{
+		CryptoSymbol: strings.ToUpper(cryptoSymbol),
+		Xpub:         xpub,
+		Addresses:    mapped,
+	}), nil
+}
+
+// UtxosGet lists the unspent outputs of an address, xpub or descriptor with
+// outputs below minConfirmations or dustThreshold left out.
+func (s Service) UtxosGet(
+	ctx context.Context, cryptoSymbol, address string, minConfirmations int32, dustThreshold int64,
+) (cryptowalletrest.ImplResponse, error) {
+	ctx, span := tracer.Start(ctx, "Service.UtxosGet",
+		trace.WithAttributes(tracing.ChainAttributes(cryptoSymbol)...))
+	defer span.End()
+
+	utxos, err := s.adapter.GetUTXOs(ctx, cryptoSymbol, address, domain.UTXOFilter{
+		MinConfirmations: int64(minConfirmations),
+		DustThreshold:    dustThreshold,
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return handleError(err)
+	}
+
+	var totalValue int64
+	var totalAmount float64
+	outputs := make([]cryptowalletrest.UnspentOutput, len(utxos))
+	for i, utxo := range utxos {
+		outputs[i] = cryptowalletrest.UnspentOutput{
+			Txid:           utxo.TransactionID,
+			Vout:           int32(utxo.Vout), //nolint:gosec // output indexes are far below 2^31
+			Value:          utxo.Value,
+			Amount:         formatAmount(utxo.Amount),
+			Confirmations:  utxo.Confirmations,
+			ScriptPubkey:   utxo.ScriptPubKey,
+			Address:        utxo.Address,
+			DerivationPath: utxo.DerivationPath,
+		}
+		if utxo.BlockHeight != nil {
+			outputs[i].BlockHeight = *utxo.BlockHeight
+		}
+		totalValue += utxo.Value
+		totalAmount += utxo.Amount
+	}
+
+	return cryptowalletrest.Response(http.StatusOK, cryptowalletrest.UtxosGet200Response{
+		CryptoSymbol: strings.ToUpper(cryptoSymbol),
+		Address:      address,
+		Utxos:        outputs,
+		TotalValue:   totalValue,
+		TotalAmount:  formatAmount(totalAmount),
+	}), nil
+}
+
 func (s Service) TransactionsGet(
-	_ context.Context, _ string, _ string, _ int32, _ int32,
+	ctx context.Context, cryptoSymbol, address, fiatSymbol string, limit, offset int32,
+	cursor, direction, since, until, minAmount, status, sort string,
 ) (cryptowalletrest.ImplResponse, error) {
-	return cryptowalletrest.Response(http.StatusNotImplemented, nil), nil
+	ctx, span := tracer.Start(ctx, "Service.TransactionsGet",
+		trace.WithAttributes(tracing.ChainAttributes(cryptoSymbol)...))
+	defer span.End()
+
+	fiatSymbol, err := s.fiatSymbol(fiatSymbol, defaultFiatSymbol)
+	if err != nil {
+		return handleError(err)
+	}
+
+	filter, err := transactionFilter(direction, since, until, minAmount, status)
+	if err != nil {
+		return handleError(err)
+	}
+	order := domain.SortOrder(strings.ToLower(sort))
+	switch order {
+	case "", domain.SortNewestFirst, domain.SortOldestFirst:
+	default:
+		return handleError(fmt.Errorf("%w: sort must be %s or %s", domain.ErrBadRequest,
+			domain.SortNewestFirst, domain.SortOldestFirst))
+	}
+
+	page := domain.PageRequest{Offset: int(offset), Limit: int(limit), Filter: filter, Order: order}
+	if cursor != "" {
+		decoded, err := base64.RawURLEncoding.DecodeString(cursor)
+		if err != nil || len(decoded) == 0 {
+			return handleError(fmt.Errorf("%w: invalid cursor", domain.ErrBadRequest))
+		}
+		page.Cursor = string(decoded)
+	}
+
+	result, err := s.adapter.GetTransactions(ctx, cryptoSymbol, address, fiatSymbol, page)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return handleError(err)
+	}
+
+	transactions := make([]cryptowalletrest.Transaction, 0, len(result.Transactions))
+	for _, tx := range result.Transactions {
+		transaction := cryptowalletrest.Transaction{
+			TransactionId: tx.TransactionID,
+			Timestamp:     tx.Timestamp,
+			Amount:        formatAmount(tx.Amount),
+			Direction:     string(tx.Direction),
+			Confirmations: int32(tx.Confirmations), //nolint:gosec // confirmations are far below 2^31
+			FromAddresses: tx.FromAddresses,
+			ToAddresses:   tx.ToAddresses,
+			FiatSymbol:    tx.FiatSymbol,
+			FiatValue:     tx.FiatValue,
+			ExchangeRate:  tx.ExchangeRate,
+			TokenContract: tx.TokenContract,
+			TokenSymbol:   tx.TokenSymbol,
+		}
+		if tx.BlockHeight != nil {
+			transaction.BlockHeight = int32(*tx.BlockHeight) //nolint:gosec // block heights are far below 2^31
+		}
+		if tx.FeeAmount != nil {
+			transaction.FeeAmount = formatAmount(*tx.FeeAmount)
+		}
+		transactions = append(transactions, transaction)
+	}
+
+	response := cryptowalletrest.TransactionsGet200Response{
+		CryptoSymbol: strings.ToUpper(cryptoSymbol),
+		Address:      address,
+		Transactions: transactions,
+		HasMore:      result.NextCursor != "",
+	}
+	if result.TotalCount != nil {
+		total := int32(*result.TotalCount) //nolint:gosec // histories are far below 2^31 entries
+		response.TotalCount = &total
+	}
+	if result.NextCursor != "" {
+		response.NextCursor = base64.RawURLEncoding.EncodeToString([]byte(result.NextCursor))
+	}
+	return cryptowalletrest.Response(http.StatusOK, response), nil
+}
+
+// transactionFilter validates the filter parameters of TransactionsGet.
+// Empty parameters do not filter.
+func transactionFilter(direction, since, until, minAmount, status string) (domain.TransactionFilter, error) {
+	filter := domain.TransactionFilter{
+		Direction: domain.Direction(strings.ToLower(direction)),
+		Status:    domain.TransactionStatus(strings.ToLower(status)),
+	}
+	switch filter.Direction {
+	case "", domain.DirectionIncoming, domain.DirectionOutgoing:
+	default:
+		return filter, fmt.Errorf("%w: direction must be %s or %s", domain.ErrBadRequest,
+			domain.DirectionIncoming, domain.DirectionOutgoing)
+	}
+	switch filter.Status {
+	case "", domain.TransactionConfirmed, domain.TransactionPending:
+	default:
+		return filter, fmt.Errorf("%w: status must be %s or %s", domain.ErrBadRequest,
+			domain.TransactionConfirmed, domain.TransactionPending)
+	}
+
+	var err error
+	if since != "" {
+		if filter.Since, err = time.Parse(time.RFC3339, since); err != nil {
+			return filter, fmt.Errorf("%w: invalid since time: %w", domain.ErrBadRequest, err)
+		}
+	}
+	if until != "" {
+		if filter.Until, err = time.Parse(time.RFC3339, until); err != nil {
+			return filter, fmt.Errorf("%w: invalid until time: %w", domain.ErrBadRequest, err)
+		}
+	}
+	if !filter.Since.IsZero() && !filter.Until.IsZero() && filter.Until.Before(filter.Since) {
+		return filter, fmt.Errorf("%w: until must not be before since", domain.ErrBadRequest)
+	}
+	if minAmount != "" {
+		filter.MinAmount, err = strconv.ParseFloat(minAmount, 64)
+		if err != nil || math.IsNaN(filter.MinAmount) || math.IsInf(filter.MinAmount, 0) || filter.MinAmount < 0 {
+			return filter, fmt.Errorf("%w: min_amount must be a non-negative number", domain.ErrBadRequest)
+		}
+	}
+	return filter, nil
+}
+
+// TransactionsCryptoSymbolTxidGet looks up one transaction and returns it
+// decoded, with the parts belonging to wallet marked when wallet is set.
+func (s Service) TransactionsCryptoSymbolTxidGet(
+	ctx context.Context, cryptoSymbol, txid, wallet string,
+) (cryptowalletrest.ImplResponse, error) {
+	ctx, span := tracer.Start(ctx, "Service.TransactionsCryptoSymbolTxidGet",
+		trace.WithAttributes(tracing.ChainAttributes(cryptoSymbol)...))
+	defer span.End()
+
+	detail, err := s.adapter.GetTransactionDetail(ctx, cryptoSymbol, txid, wallet)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return handleError(err)
+	}
+
+	response := cryptowalletrest.TransactionsCryptoSymbolTxidGet200Response{
+		CryptoSymbol:  strings.ToUpper(cryptoSymbol),
+		Txid:          detail.TransactionID,
+		Status:        string(detail.Status),
+		Confirmations: int32(detail.Confirmations), //nolint:gosec // confirmations are far below 2^31
+		BlockHash:     detail.BlockHash,
+		Timestamp:     detail.Timestamp,
+		Inputs:        transactionInputs(detail.Inputs),
+		Outputs:       transactionOutputs(detail.Outputs),
+		Transfers:     transactionTransfers(detail.Transfers),
+		Logs:          transactionLogs(detail.Logs),
+		Instructions:  transactionInstructions(detail.Instructions),
+	}
+	if detail.BlockHeight != nil {
+		response.BlockHeight = int32(*detail.BlockHeight) //nolint:gosec // block heights are far below 2^31
+	}
+	if detail.FeeAmount != nil {
+		response.FeeAmount = formatAmount(*detail.FeeAmount)
+	}
+	return cryptowalletrest.Response(http.StatusOK, response), nil
+}
+
+func transactionInputs(inputs []domain.TransactionInput) []cryptowalletrest.TransactionInput {
+	mapped := make([]cryptowalletrest.TransactionInput, len(inputs))
+	for i, input := range inputs {
+		mapped[i] = cryptowalletrest.TransactionInput{
+			Index:        int32(input.Index),         //nolint:gosec // input indexes are far below 2^31
+			PreviousVout: int32(input.PreviousIndex), //nolint:gosec // output indexes are far below 2^31
+			PreviousTxid: input.PreviousTransaction,
+			Address:      input.Address,
+			Value:        input.Value,
+			Amount:       formatAmount(input.Amount),
+			Owned:        input.Owned,
+		}
+	}
+	return mapped
+}
+
+func transactionOutputs(outputs []domain.TransactionOutput) []cryptowalletrest.TransactionOutput {
+	mapped := make([]cryptowalletrest.TransactionOutput, len(outputs))
+	for i, output := range outputs {
+		mapped[i] = cryptowalletrest.TransactionOutput{
+			Vout:    int32(output.Index), //nolint:gosec // output indexes are far below 2^31
+			Address: output.Address,
+			Value:   output.Value,
+			Amount:  formatAmount(output.Amount),
+			Owned:   output.Owned,
+		}
+	}
+	return mapped
+}
+
+func transactionTransfers(transfers []domain.TransactionTransfer) []cryptowalletrest.TransactionTransfer {
+	mapped := make([]cryptowalletrest.TransactionTransfer, len(transfers))
+	for i, transfer := range transfers {
+		mapped[i] = cryptowalletrest.TransactionTransfer{
+			FromAddress:   transfer.From,
+			ToAddress:     transfer.To,
+			Amount:        formatAmount(transfer.Amount),
+			TokenContract: transfer.TokenContract,
+			TokenSymbol:   transfer.TokenSymbol,
+			FromOwned:     transfer.FromOwned,
+			ToOwned:       transfer.ToOwned,
+		}
+	}
+	return mapped
+}
+
+func transactionLogs(logs []domain.TransactionLog) []cryptowalletrest.TransactionLog {
+	mapped := make([]cryptowalletrest.TransactionLog, len(logs))
+	for i, log := range logs {
+		mapped[i] = cryptowalletrest.TransactionLog{
+			LogIndex: int32(log.Index), //nolint:gosec // log indexes are far below 2^31
+			Address:  log.Address,
+			Topics:   log.Topics,
+			Data:     log.Data,
+		}
+	}
+	return mapped
+}
+
+func transactionInstructions(instructions []domain.TransactionInstruction) []cryptowalletrest.TransactionInstruction {
+	mapped := make([]cryptowalletrest.TransactionInstruction, len(instructions))
+	for i, instruction := range instructions {
+		mapped[i] = cryptowalletrest.TransactionInstruction{
+			Index:     int32(instruction.Index), //nolint:gosec // instruction indexes are far below 2^31
+			ProgramId: instruction.ProgramID,
+			Program:   instruction.Program,
+			Type:      instruction.Type,
+			Accounts:  instruction.Accounts,
+			Data:      instruction.Data,
+		}
+		if instruction.Parent != nil {
+			parent := int32(*instruction.Parent) //nolint:gosec // instruction indexes are far below 2^31
+			mapped[i].ParentIndex = &parent
+		}
+	}
+	return mapped
+}
+
+func (s Service) FeesGet(
+	ctx context.Context, cryptoSymbol, fiatSymbol string,
+) (cryptowalletrest.ImplResponse, error) {
+	ctx, span := tracer.Start(ctx, "Service.FeesGet",
+		trace.WithAttributes(tracing.ChainAttributes(cryptoSymbol)...))
+	defer span.End()
+
+	fiatSymbol, err := s.fiatSymbol(fiatSymbol, defaultFiatSymbol)
+	if err != nil {
+		return handleError(err)
+	}
+
+	fees, err := s.adapter.EstimateFees(ctx, cryptoSymbol, fiatSymbol)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return handleError(err)
+	}
+
+	estimates := make([]cryptowalletrest.FeeEstimate, len(fees.Estimates))
+	for i, estimate := range fees.Estimates {
+		estimates[i] = cryptowalletrest.FeeEstimate{
+			Level:            string(estimate.Level),
+			FeeRate:          estimate.Rate,
+			FeeAmount:        formatAmount(estimate.Amount),
+			FiatValue:        estimate.FiatValue,
+			EstimatedSeconds: estimate.EstimatedSeconds,
+		}
+		if estimate.BaseFee != nil {
+			estimates[i].BaseFee = *estimate.BaseFee
+		}
+		if estimate.PriorityFee != nil {
+			estimates[i].PriorityFee = *estimate.PriorityFee
+		}
+	}
+
+	response := cryptowalletrest.FeesGet200Response{
+		CryptoSymbol: fees.CryptoSymbol,
+		FiatSymbol:   fees.FiatSymbol,
+		RateUnit:     fees.RateUnit,
+		SizeUnit:     fees.SizeUnit,
+		TypicalSize:  fees.TypicalSize,
+		ExchangeRate: fees.ExchangeRate,
+		Estimates:    estimates,
+	}
+	if fees.RateError != nil {
+		response.RateError = *fees.RateError
+		response.RateErrorCode = string(fees.RateErrorCode)
+	}
+	return cryptowalletrest.Response(http.StatusOK, response), nil
 }
 
+// UnsignedTxGet builds an unsigned transaction sending amount, or with
+// domain.AmountMax everything after fees, from fromAddress to toAddress, or a
+// token transfer when tokenContract is set, for an air-gapped signer. A zero
+// feeRate leaves the fee to the chain defaults and nonceAccount selects a
+// Solana durable nonce.
 func (s Service) UnsignedTxGet(
-	_ context.Context, _ string, _ string, _ string, _ string, _ float64,
+	ctx context.Context, cryptoSymbol, fromAddress, toAddress, amount string, feeRate float64,
+	tokenContract, nonceAccount string,
 ) (cryptowalletrest.ImplResponse, error) {
-	return cryptowalletrest.Response(http.StatusNotImplemented, nil), nil
+	ctx, span := tracer.Start(ctx, "Service.UnsignedTxGet",
+		trace.WithAttributes(tracing.ChainAttributes(cryptoSymbol)...))
+	defer span.End()
+
+	if feeRate < 0 {
+		return handleError(fmt.Errorf("%w: fee_rate must not be negative", domain.ErrBadRequest))
+	}
+
+	tx, err := s.adapter.BuildUnsignedTx(ctx, cryptoSymbol, domain.UnsignedTxRequest{
+		From:          fromAddress,
+		To:            toAddress,
+		Amount:        amount,
+		FeeRate:       feeRate,
+		TokenContract: tokenContract,
+		NonceAccount:  nonceAccount,
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return handleError(err)
+	}
+
+	response := cryptowalletrest.UnsignedTxGet200Response{
+		CryptoSymbol:       tx.CryptoSymbol,
+		FromAddress:        tx.From,
+		ToAddress:          tx.To,
+		Amount:             tx.Amount,
+		FeeAmount:          tx.Fee,
+		UnsignedTx:         encodePayload(tx.Payload, tx.Encoding),
+		UnsignedTxEncoding: string(tx.Encoding),
+		TxSizeBytes:        int32(len(tx.Payload)), //nolint:gosec // transactions are far below 2^31 bytes
+		Inputs:             unsignedTxInputs(tx.Inputs),
+		Details:            unsignedTxDetails(tx.Details),
+	}
+	if tx.SigningHash != nil {
+		response.SigningHash = hex.EncodeToString(tx.SigningHash)
+	}
+	return cryptowalletrest.Response(http.StatusOK, response), nil
+}
+
+// UnsignedTxPost builds an unsigned transaction paying every output of the
+// request, with the batch options UTXO chains take: an OP_RETURN output,
+// required and excluded inputs, opting out of RBF and a lock time.
+func (s Service) UnsignedTxPost(
+	ctx context.Context, request cryptowalletrest.UnsignedTxPostRequest,
+) (cryptowalletrest.ImplResponse, error) {
+	ctx, span := tracer.Start(ctx, "Service.UnsignedTxPost",
+		trace.WithAttributes(tracing.ChainAttributes(request.CryptoSymbol)...))
+	defer span.End()
+
+	txRequest, err := unsignedTxRequest(request)
+	if err != nil {
+		return handleError(err)
+	}
+	tx, err := s.adapter.BuildUnsignedTx(ctx, request.CryptoSymbol, txRequest)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return handleError(err)
+	}
+
+	response := cryptowalletrest.UnsignedTxPost200Response{
+		CryptoSymbol:       tx.CryptoSymbol,
+		FromAddress:        tx.From,
+		Outputs:            unsignedTxOutputs(tx),
+		Amount:             tx.Amount,
+		FeeAmount:          tx.Fee,
+		UnsignedTx:         encodePayload(tx.Payload, tx.Encoding),
+		UnsignedTxEncoding: string(tx.Encoding),
+		TxSizeBytes:        int32(len(tx.Payload)), //nolint:gosec // transactions are far below 2^31 bytes
+		Inputs:             unsignedTxInputs(tx.Inputs),
+		Details:            unsignedTxDetails(tx.Details),
+	}
+	if tx.SigningHash != nil {
+		response.SigningHash = hex.EncodeToString(tx.SigningHash)
+	}
+	return cryptowalletrest.Response(http.StatusOK, response), nil
+}
+
+// unsignedTxRequest validates the body of UnsignedTxPost. A single output is
+// sent as To and Amount so every chain can build it.
+func unsignedTxRequest(request cryptowalletrest.UnsignedTxPostRequest) (domain.UnsignedTxRequest, error) {
+	if len(request.Outputs) == 0 {
+		return domain.UnsignedTxRequest{}, fmt.Errorf("%w: outputs must not be empty", domain.ErrBadRequest)
+	}
+	if request.FeeRate < 0 {
+		return domain.UnsignedTxRequest{}, fmt.Errorf("%w: fee_rate must not be negative", domain.ErrBadRequest)
+	}
+	if request.LockTime < 0 || request.LockTime > math.MaxUint32 {
+		return domain.UnsignedTxRequest{}, fmt.Errorf("%w: lock_time must be between 0 and %d",
+			domain.ErrBadRequest, uint32(math.MaxUint32))
+	}
+	var opReturn []byte
+	if request.OpReturn != "" {
+		var err error
+		if opReturn, err = hex.DecodeString(request.OpReturn); err != nil {
+			return domain.UnsignedTxRequest{}, fmt.Errorf("%w: op_return must be hex encoded", domain.ErrBadRequest)
+		}
+	}
+	required, err := outpoints(request.RequiredInputs)
+	if err != nil {
+		return domain.UnsignedTxRequest{}, err
+	}
+	excluded, err := outpoints(request.ExcludedInputs)
+	if err != nil {
+		return domain.UnsignedTxRequest{}, err
+	}
+
+	txRequest := domain.UnsignedTxRequest{
+		From:           request.FromAddress,
+		FeeRate:        request.FeeRate,
+		TokenContract:  request.TokenContract,
+		NonceAccount:   request.NonceAccount,
+		OpReturn:       opReturn,
+		RequiredInputs: required,
+		ExcludedInputs: excluded,
+		DisableRBF:     request.DisableRbf,
+		LockTime:       uint32(request.LockTime), //nolint:gosec // checked against the uint32 range above
+	}
+	if len(request.Outputs) == 1 {
+		txRequest.To, txRequest.Amount = request.Outputs[0].Address, request.Outputs[0].Amount
+		return txRequest, nil
+	}
+	for _, output := range request.Outputs {
+		txRequest.Recipients = append(txRequest.Recipients,
+			domain.Recipient{Address: output.Address, Amount: output.Amount})
+	}
+	return txRequest, nil
+}
+
+func outpoints(inputs []cryptowalletrest.Outpoint) ([]domain.Outpoint, error) {
+	var result []domain.Outpoint
+	for _, input := range inputs {
+		if input.Vout < 0 {
+			return nil, fmt.Errorf("%w: vout must not be negative", domain.ErrBadRequest)
+		}
+		result = append(result, domain.Outpoint{
+			TransactionID: input.Txid,
+			Vout:          uint32(input.Vout), //nolint:gosec // checked non-negative above
+		})
+	}
+	return result, nil
+}
+
+// unsignedTxOutputs lists the outputs tx pays, its single recipient when
+// there are not several.
+func unsignedTxOutputs(tx *domain.UnsignedTx) []cryptowalletrest.UnsignedTxOutput {
+	recipients := tx.Recipients
+	if len(recipients) == 0 && tx.To != "" {
+		recipients = []domain.Recipient{{Address: tx.To, Amount: tx.Amount}}
+	}
+	outputs := make([]cryptowalletrest.UnsignedTxOutput, len(recipients))
+	for i, recipient := range recipients {
+		outputs[i] = cryptowalletrest.UnsignedTxOutput{Address: recipient.Address, Amount: recipient.Amount}
+	}
+	return outputs
+}
+
+func unsignedTxInputs(txInputs []domain.UnsignedTxInput) []cryptowalletrest.UnsignedTxInput {
+	var inputs []cryptowalletrest.UnsignedTxInput
+	for _, input := range txInputs {
+		inputs = append(inputs, cryptowalletrest.UnsignedTxInput{
+			Txid:           input.TransactionID,
+			Vout:           int32(input.Vout), //nolint:gosec // output indexes are far below 2^31
+			Value:          input.Value,
+			Address:        input.Address,
+			DerivationPath: input.DerivationPath,
+			SigningHash:    hex.EncodeToString(input.SigningHash),
+		})
+	}
+	return inputs
+}
+
+func unsignedTxDetails(txDetails []domain.TxDetail) []cryptowalletrest.UnsignedTxDetail {
+	details := make([]cryptowalletrest.UnsignedTxDetail, len(txDetails))
+	for i, detail := range txDetails {
+		details[i] = cryptowalletrest.UnsignedTxDetail{Label: detail.Label, Value: detail.Value}
+	}
+	return details
+}
+
+// UnsignedTxBumpPost builds a transaction speeding up an unconfirmed
+// transaction of a wallet, replacing it or spending it with a child.
+func (s Service) UnsignedTxBumpPost(
+	ctx context.Context, request cryptowalletrest.UnsignedTxBumpPostRequest,
+) (cryptowalletrest.ImplResponse, error) {
+	ctx, span := tracer.Start(ctx, "Service.UnsignedTxBumpPost",
+		trace.WithAttributes(tracing.ChainAttributes(request.CryptoSymbol)...))
+	defer span.End()
+
+	method := domain.FeeBumpMethod(strings.ToLower(request.Method))
+	if method != domain.FeeBumpRBF && method != domain.FeeBumpCPFP {
+		return handleError(fmt.Errorf("%w: method must be %s or %s", domain.ErrBadRequest,
+			domain.FeeBumpRBF, domain.FeeBumpCPFP))
+	}
+	if request.FeeRate < 0 {
+		return handleError(fmt.Errorf("%w: fee_rate must not be negative", domain.ErrBadRequest))
+	}
+
+	tx, err := s.adapter.BumpFee(ctx, request.CryptoSymbol, domain.FeeBumpRequest{
+		From:          request.FromAddress,
+		TransactionID: request.Txid,
+		Method:        method,
+		FeeRate:       request.FeeRate,
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return handleError(err)
+	}
+
+	return cryptowalletrest.Response(http.StatusOK, cryptowalletrest.UnsignedTxBumpPost200Response{
+		CryptoSymbol:       tx.CryptoSymbol,
+		FromAddress:        tx.From,
+		Txid:               request.Txid,
+		Outputs:            unsignedTxOutputs(tx),
+		Amount:             tx.Amount,
+		FeeAmount:          tx.Fee,
+		UnsignedTx:         encodePayload(tx.Payload, tx.Encoding),
+		UnsignedTxEncoding: string(tx.Encoding),
+		TxSizeBytes:        int32(len(tx.Payload)), //nolint:gosec // transactions are far below 2^31 bytes
+		Inputs:             unsignedTxInputs(tx.Inputs),
+		Details:            unsignedTxDetails(tx.Details),
+	}), nil
 }
 
 func (s Service) BroadcastPost(
@@ -84,3 +979,16 @@ func (s Service) BroadcastPost(
 ) (cryptowalletrest.ImplResponse, error) {
 	return cryptowalletrest.Response(http.StatusNotImplemented, nil), nil
 }
+
+// encodePayload renders an unsigned transaction in encoding, hex by default.
+func encodePayload(payload []byte, encoding domain.PayloadEncoding) string {
+	if encoding == domain.EncodingBase64 {
+		return base64.StdEncoding.EncodeToString(payload)
+	}
+	return hex.EncodeToString(payload)
+}
+
+// formatAmount renders a coin amount without exponent or trailing zeros.
+func formatAmount(amount float64) string {
+	return strconv.FormatFloat(amount, 'f', -1, 64)
+}
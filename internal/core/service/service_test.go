@@ -2,6 +2,8 @@ package service_test
 
 import (
 	"errors"
+	"fmt"
+	"net/http"
 	"testing"
 	"time"
 
@@ -18,6 +20,20 @@ var (
 	errProviderGeneric = errors.New("provider error")
 )
 
+func float64Ptr(v float64) *float64 {
+	return &v
+}
+
+func int32Ptr(v int32) *int32 {
+	return &v
+}
+
+// expectValidAddresses lets every address pass the up-front validation step.
+func expectValidAddresses(mockProvider *internalportsmocks.MockProvider) {
+	mockProvider.EXPECT().ValidateAddress(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&domain.AddressValidation{}, nil).AnyTimes()
+}
+
 func TestNew(t *testing.T) {
 	t.Parallel()
 	ctrl := gomock.NewController(t)
@@ -34,15 +50,16 @@ func TestService_BalancesPost_BasicFunctionality(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockProvider := internalportsmocks.NewMockProvider(ctrl)
+	expectValidAddresses(mockProvider)
 
 	btcResult := &domain.BalanceResult{
 		CryptoSymbol:  "BTC",
 		Address:       "1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa",
 		CryptoBalance: 0.001,
 		FiatSymbol:    "USD",
-		FiatValue:     50.0,
-		ExchangeRate:  50000.0,
-		Change24h:     1.0,
+		FiatValue:     float64Ptr(50.0),
+		ExchangeRate:  float64Ptr(50000.0),
+		Change24h:     float64Ptr(1.0),
 		Timestamp:     time.Now(),
 		Error:         nil,
 	}
@@ -52,9 +69,9 @@ func TestService_BalancesPost_BasicFunctionality(t *testing.T) {
 		Address:       "0x742d35Cc6634C0532925a3b8D3A7F13f",
 		CryptoBalance: 1.5,
 		FiatSymbol:    "EUR",
-		FiatValue:     3000.0,
-		ExchangeRate:  2000.0,
-		Change24h:     -30.0,
+		FiatValue:     float64Ptr(3000.0),
+		ExchangeRate:  float64Ptr(2000.0),
+		Change24h:     float64Ptr(-30.0),
 		Timestamp:     time.Now(),
 		Error:         nil,
 	}
@@ -72,7 +89,7 @@ func TestService_BalancesPost_BasicFunctionality(t *testing.T) {
 		},
 	}
 
-	mockProvider.EXPECT().GetBatchBalances(expectedRequests).Return([]*domain.BalanceResult{btcResult, ethResult}, nil)
+	mockProvider.EXPECT().GetBatchBalances(gomock.Any(), expectedRequests).Return([]*domain.BalanceResult{btcResult, ethResult}, nil)
 
 	svc := service.New(mockProvider)
 
@@ -105,7 +122,7 @@ func TestService_BalancesPost_BasicFunctionality(t *testing.T) {
 	assert.Equal(t, "1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa", btcBalance.Address)
 	assert.InEpsilon(t, 0.001, btcBalance.CryptoBalance, 0.001)
 	assert.Equal(t, "USD", btcBalance.FiatSymbol)
-	assert.InEpsilon(t, 50.0, btcBalance.FiatValue, 0.001)
+	assert.InEpsilon(t, 50.0, *btcBalance.FiatValue, 0.001)
 	assert.Empty(t, btcBalance.Error)
 
 	ethBalance := responseBody.Results[1]
@@ -113,25 +130,103 @@ func TestService_BalancesPost_BasicFunctionality(t *testing.T) {
 	assert.Equal(t, "0x742d35Cc6634C0532925a3b8D3A7F13f", ethBalance.Address)
 	assert.InEpsilon(t, 1.5, ethBalance.CryptoBalance, 0.001)
 	assert.Equal(t, "EUR", ethBalance.FiatSymbol)
-	assert.InEpsilon(t, 3000.0, ethBalance.FiatValue, 0.001)
+	assert.InEpsilon(t, 3000.0, *ethBalance.FiatValue, 0.001)
 	assert.Empty(t, ethBalance.Error)
 }
 
+func TestService_BalancesPost_PriceChangesAndTotals(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockProvider := internalportsmocks.NewMockProvider(ctrl)
+	expectValidAddresses(mockProvider)
+	svc := service.New(mockProvider)
+
+	errorMsg := "provider unavailable"
+	results := []*domain.BalanceResult{
+		{
+			CryptoSymbol:  "BTC",
+			CryptoBalance: 0.01,
+			FiatSymbol:    "USD",
+			FiatValue:     float64Ptr(500),
+			ExchangeRate:  float64Ptr(50000),
+			Change24h:     float64Ptr(100),
+			PriceChanges: []domain.PriceChange{
+				domain.NewPriceChange(domain.Window1h, 0.01, 50000, 49000),
+				domain.NewPriceChange(domain.Window24h, 0.01, 50000, 40000),
+			},
+		},
+		{
+			CryptoSymbol:  "LTC",
+			CryptoBalance: 10,
+			FiatSymbol:    "USD",
+			FiatValue:     float64Ptr(500),
+			ExchangeRate:  float64Ptr(50),
+			Change24h:     float64Ptr(-100),
+			PriceChanges: []domain.PriceChange{
+				domain.NewPriceChange(domain.Window24h, 10, 50, 60),
+			},
+		},
+		{CryptoSymbol: "ETH", FiatSymbol: "EUR", Error: &errorMsg},
+	}
+	mockProvider.EXPECT().GetBatchBalances(gomock.Any(), gomock.Any()).Return(results, nil)
+
+	response, err := svc.BalancesPost(t.Context(), cryptowalletrest.BalancesPostRequest{
+		Requests: []cryptowalletrest.BalancesPostRequestRequestsInner{
+			{CryptoSymbol: "BTC", Address: "xpub"},
+			{CryptoSymbol: "LTC", Address: "ltub"},
+			{CryptoSymbol: "ETH", Address: "0x", FiatSymbol: "EUR"},
+		},
+	})
+
+	require.NoError(t, err)
+	body, ok := response.Body.(cryptowalletrest.BalancesPost200Response)
+	require.True(t, ok)
+
+	btcChanges := body.Results[0].PriceChanges
+	require.Len(t, btcChanges, 2)
+	assert.Equal(t, "1h", btcChanges[0].Window)
+	assert.InDelta(t, 1000.0/49000*100, btcChanges[0].Percent, 1e-9)
+	assert.InDelta(t, 10.0, btcChanges[0].FiatChange, 1e-9)
+	assert.InDelta(t, 49000.0, btcChanges[0].ReferenceRate, 0)
+	assert.Equal(t, "24h", btcChanges[1].Window)
+	assert.InDelta(t, 25.0, btcChanges[1].Percent, 1e-9)
+
+	require.Len(t, body.Totals, 2)
+	usd := body.Totals[0]
+	assert.Equal(t, "USD", usd.FiatSymbol)
+	assert.InDelta(t, 1000.0, usd.FiatValue, 0)
+	assert.True(t, usd.Complete)
+	require.Len(t, usd.PriceChanges, 1, "1h is left out since LTC has no 1h reference")
+	assert.Equal(t, "24h", usd.PriceChanges[0].Window)
+	assert.InDelta(t, 0.0, usd.PriceChanges[0].FiatChange, 1e-9)
+	assert.InDelta(t, 0.0, usd.PriceChanges[0].Percent, 1e-9)
+	assert.Zero(t, usd.PriceChanges[0].ReferenceRate)
+
+	eur := body.Totals[1]
+	assert.Equal(t, "EUR", eur.FiatSymbol)
+	assert.False(t, eur.Complete)
+	assert.NotNil(t, eur.PriceChanges)
+	assert.Empty(t, eur.PriceChanges)
+}
+
 func TestService_BalancesPost_ErrorHandling(t *testing.T) {
 	t.Parallel()
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	mockProvider := internalportsmocks.NewMockProvider(ctrl)
+	expectValidAddresses(mockProvider)
 
 	btcResult := &domain.BalanceResult{
 		CryptoSymbol:  "BTC",
 		Address:       "1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa",
 		CryptoBalance: 0.001,
 		FiatSymbol:    "USD",
-		FiatValue:     50.0,
-		ExchangeRate:  50000.0,
-		Change24h:     1.0,
+		FiatValue:     float64Ptr(50.0),
+		ExchangeRate:  float64Ptr(50000.0),
+		Change24h:     float64Ptr(1.0),
 		Timestamp:     time.Now(),
 		Error:         nil,
 	}
@@ -142,11 +237,9 @@ func TestService_BalancesPost_ErrorHandling(t *testing.T) {
 		Address:       "0x742d35Cc6634C0532925a3b8D3A7F13f",
 		CryptoBalance: 0,
 		FiatSymbol:    "USD",
-		FiatValue:     0,
-		ExchangeRate:  0,
-		Change24h:     0,
 		Timestamp:     time.Now(),
 		Error:         &errorMsg,
+		ErrorCode:     domain.CodeUnsupportedSymbol,
 	}
 
 	expectedRequests := []domain.BalanceRequest{
@@ -162,7 +255,7 @@ func TestService_BalancesPost_ErrorHandling(t *testing.T) {
 		},
 	}
 
-	mockProvider.EXPECT().GetBatchBalances(expectedRequests).Return([]*domain.BalanceResult{btcResult, ethResult}, nil)
+	mockProvider.EXPECT().GetBatchBalances(gomock.Any(), expectedRequests).Return([]*domain.BalanceResult{btcResult, ethResult}, nil)
 
 	svc := service.New(mockProvider)
 
@@ -197,6 +290,53 @@ func TestService_BalancesPost_ErrorHandling(t *testing.T) {
 	ethBalance := responseBody.Results[1]
 	assert.Equal(t, "ETH", ethBalance.CryptoSymbol)
 	assert.Equal(t, "provider not found for symbol", ethBalance.Error)
+	assert.Equal(t, "UNSUPPORTED_SYMBOL", ethBalance.ErrorCode)
+}
+
+func TestService_BalancesPost_InvalidAddress(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockProvider := internalportsmocks.NewMockProvider(ctrl)
+
+	mockProvider.EXPECT().ValidateAddress(gomock.Any(), "BTC", "tb1qtestnet").Return(nil, domain.NetworkMismatchError(false))
+	mockProvider.EXPECT().ValidateAddress(gomock.Any(), "ETH", "0xvalid").Return(&domain.AddressValidation{}, nil)
+	mockProvider.EXPECT().GetBatchBalances(gomock.Any(), []domain.BalanceRequest{
+		{CryptoSymbol: "ETH", Address: "0xvalid", FiatSymbol: "USD"},
+	}).Return([]*domain.BalanceResult{{
+		CryptoSymbol:  "ETH",
+		Address:       "0xvalid",
+		CryptoBalance: 1,
+		FiatSymbol:    "USD",
+		FiatValue:     float64Ptr(3000),
+	}}, nil)
+
+	svc := service.New(mockProvider)
+
+	response, err := svc.BalancesPost(t.Context(), cryptowalletrest.BalancesPostRequest{
+		Requests: []cryptowalletrest.BalancesPostRequestRequestsInner{
+			{CryptoSymbol: "BTC", Address: "tb1qtestnet"},
+			{CryptoSymbol: "ETH", Address: "0xvalid"},
+		},
+	})
+
+	require.NoError(t, err)
+	body, ok := response.Body.(cryptowalletrest.BalancesPost200Response)
+	require.True(t, ok)
+	require.Len(t, body.Results, 2)
+
+	assert.Equal(t, "BTC", body.Results[0].CryptoSymbol)
+	assert.Equal(t, "INVALID_ADDRESS", body.Results[0].ErrorCode)
+	assert.Contains(t, body.Results[0].Error, "testnet address used on mainnet")
+	assert.Equal(t, "USD", body.Results[0].FiatSymbol)
+
+	assert.Equal(t, "ETH", body.Results[1].CryptoSymbol)
+	assert.Empty(t, body.Results[1].Error)
+
+	require.Len(t, body.Totals, 1)
+	assert.InDelta(t, 3000, body.Totals[0].FiatValue, 0.001)
+	assert.False(t, body.Totals[0].Complete)
 }
 
 func TestService_BalancesPost_DefaultFiatSymbol(t *testing.T) {
@@ -205,15 +345,16 @@ func TestService_BalancesPost_DefaultFiatSymbol(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockProvider := internalportsmocks.NewMockProvider(ctrl)
+	expectValidAddresses(mockProvider)
 
 	btcResult := &domain.BalanceResult{
 		CryptoSymbol:  "BTC",
 		Address:       "1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa",
 		CryptoBalance: 0.001,
 		FiatSymbol:    "USD",
-		FiatValue:     50.0,
-		ExchangeRate:  50000.0,
-		Change24h:     1.0,
+		FiatValue:     float64Ptr(50.0),
+		ExchangeRate:  float64Ptr(50000.0),
+		Change24h:     float64Ptr(1.0),
 		Timestamp:     time.Now(),
 		Error:         nil,
 	}
@@ -227,7 +368,7 @@ func TestService_BalancesPost_DefaultFiatSymbol(t *testing.T) {
 		},
 	}
 
-	mockProvider.EXPECT().GetBatchBalances(expectedRequests).Return([]*domain.BalanceResult{btcResult}, nil)
+	mockProvider.EXPECT().GetBatchBalances(gomock.Any(), expectedRequests).Return([]*domain.BalanceResult{btcResult}, nil)
 
 	svc := service.New(mockProvider)
 
@@ -255,14 +396,201 @@ func TestService_BalancesPost_DefaultFiatSymbol(t *testing.T) {
 	assert.Equal(t, "USD", btcBalance.FiatSymbol)
 }
 
+func TestService_BalancesPost_RequestFiatSymbolDefault(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockProvider := internalportsmocks.NewMockProvider(ctrl)
+	expectValidAddresses(mockProvider)
+	svc := service.New(mockProvider)
+
+	expectedRequests := []domain.BalanceRequest{
+		{CryptoSymbol: "BTC", Address: "xpub", FiatSymbol: "EUR"},
+		{CryptoSymbol: "ETH", Address: "0x", FiatSymbol: "CAD"},
+	}
+	mockProvider.EXPECT().GetBatchBalances(gomock.Any(), expectedRequests).Return([]*domain.BalanceResult{
+		{CryptoSymbol: "BTC", FiatSymbol: "EUR"},
+		{CryptoSymbol: "ETH", FiatSymbol: "CAD"},
+	}, nil)
+
+	response, err := svc.BalancesPost(t.Context(), cryptowalletrest.BalancesPostRequest{
+		Requests: []cryptowalletrest.BalancesPostRequestRequestsInner{
+			{CryptoSymbol: "BTC", Address: "xpub"},
+			{CryptoSymbol: "ETH", Address: "0x", FiatSymbol: "cad"},
+		},
+		FiatSymbol: "eur",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.Code)
+}
+
+func TestService_BalancesPost_FiatConversions(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockProvider := internalportsmocks.NewMockProvider(ctrl)
+	expectValidAddresses(mockProvider)
+	svc := service.New(mockProvider)
+
+	rateErr := "exchange rate unavailable: no CAD price"
+	usd := &domain.BalanceResult{
+		CryptoSymbol: "BTC", Address: "xpub", CryptoBalance: 0.01, FiatSymbol: "USD",
+		FiatValue: float64Ptr(600), ExchangeRate: float64Ptr(60000),
+	}
+	cad := &domain.BalanceResult{
+		CryptoSymbol: "BTC", Address: "xpub", CryptoBalance: 0.01, FiatSymbol: "CAD",
+		RateError: &rateErr, RateErrorCode: domain.CodeRateUnavailable,
+	}
+	result := *usd
+	result.Conversions = []*domain.BalanceResult{usd, cad}
+
+	expectedRequests := []domain.BalanceRequest{
+		{CryptoSymbol: "BTC", Address: "xpub", FiatSymbol: "USD", FiatSymbols: []string{"USD", "CAD"}},
+	}
+	mockProvider.EXPECT().GetBatchBalances(gomock.Any(), expectedRequests).Return([]*domain.BalanceResult{&result}, nil)
+
+	response, err := svc.BalancesPost(t.Context(), cryptowalletrest.BalancesPostRequest{
+		Requests: []cryptowalletrest.BalancesPostRequestRequestsInner{
+			{CryptoSymbol: "BTC", Address: "xpub", FiatSymbols: []string{"usd", "CAD", "USD"}},
+		},
+		FiatSymbol: "EUR",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.Code)
+
+	body, ok := response.Body.(cryptowalletrest.BalancesPost200Response)
+	require.True(t, ok)
+	require.Len(t, body.Results, 1)
+	assert.Equal(t, "USD", body.Results[0].FiatSymbol)
+	require.Len(t, body.Results[0].Conversions, 2)
+	assert.Equal(t, float64Ptr(600), body.Results[0].Conversions["USD"].FiatValue)
+	assert.Nil(t, body.Results[0].Conversions["CAD"].FiatValue)
+	assert.Equal(t, "RATE_UNAVAILABLE", body.Results[0].Conversions["CAD"].RateErrorCode)
+
+	require.Len(t, body.Totals, 2)
+	assert.Equal(t, "USD", body.Totals[0].FiatSymbol)
+	assert.True(t, body.Totals[0].Complete)
+	assert.Equal(t, "CAD", body.Totals[1].FiatSymbol)
+	assert.False(t, body.Totals[1].Complete)
+}
+
+func TestService_BalancesPost_UnsupportedFiat(t *testing.T) {
+	t.Parallel()
+
+	allowed, err := domain.NewFiatSymbols([]string{"usd", "EUR"})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		request cryptowalletrest.BalancesPostRequest
+	}{
+		{"not ISO 4217", cryptowalletrest.BalancesPostRequest{
+			Requests: []cryptowalletrest.BalancesPostRequestRequestsInner{
+				{CryptoSymbol: "BTC", Address: "xpub", FiatSymbol: "DOLLARS"},
+			},
+		}},
+		{"request default not allowed", cryptowalletrest.BalancesPostRequest{
+			Requests:   []cryptowalletrest.BalancesPostRequestRequestsInner{{CryptoSymbol: "BTC", Address: "xpub"}},
+			FiatSymbol: "CAD",
+		}},
+		{"conversion not allowed", cryptowalletrest.BalancesPostRequest{
+			Requests: []cryptowalletrest.BalancesPostRequestRequestsInner{
+				{CryptoSymbol: "BTC", Address: "xpub", FiatSymbols: []string{"USD", "JPY"}},
+			},
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			svc := service.New(internalportsmocks.NewMockProvider(ctrl), service.WithFiatSymbols(allowed))
+
+			response, err := svc.BalancesPost(t.Context(), tt.request)
+
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusBadRequest, response.Code)
+			errorResponse, ok := response.Body.(cryptowalletrest.ErrorResponse)
+			require.True(t, ok)
+			assert.Equal(t, "UNSUPPORTED_FIAT", errorResponse.Error)
+		})
+	}
+}
+
+func TestService_BalancesPost_Breakdown(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockProvider := internalportsmocks.NewMockProvider(ctrl)
+	expectValidAddresses(mockProvider)
+
+	options := domain.BalanceOptions{MinConfirmations: 6, Commitment: domain.CommitmentConfirmed}
+	mockProvider.EXPECT().GetBatchBalances(gomock.Any(), []domain.BalanceRequest{
+		{CryptoSymbol: "BTC", Address: "xpub", FiatSymbol: "USD", Options: options},
+	}).Return([]*domain.BalanceResult{{
+		CryptoSymbol:  "BTC",
+		Address:       "xpub",
+		CryptoBalance: 1.25,
+		Breakdown:     domain.BalanceBreakdown{Confirmed: 1, PendingIncoming: 0.5, PendingOutgoing: 0.25},
+		FiatSymbol:    "USD",
+		Timestamp:     time.Now(),
+	}}, nil)
+
+	svc := service.New(mockProvider)
+
+	response, err := svc.BalancesPost(t.Context(), cryptowalletrest.BalancesPostRequest{
+		Requests:         []cryptowalletrest.BalancesPostRequestRequestsInner{{CryptoSymbol: "BTC", Address: "xpub"}},
+		MinConfirmations: 6,
+		Commitment:       "Confirmed",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.Code)
+	responseBody, ok := response.Body.(cryptowalletrest.BalancesPost200Response)
+	require.True(t, ok)
+	require.Len(t, responseBody.Results, 1)
+	result := responseBody.Results[0]
+	assert.InDelta(t, 1.25, result.CryptoBalance, 1e-9)
+	assert.InDelta(t, 1.0, result.ConfirmedBalance, 1e-9)
+	assert.InDelta(t, 0.5, result.PendingIncoming, 1e-9)
+	assert.InDelta(t, 0.25, result.PendingOutgoing, 1e-9)
+}
+
+func TestService_BalancesPost_UnknownCommitment(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	svc := service.New(internalportsmocks.NewMockProvider(ctrl))
+
+	response, err := svc.BalancesPost(t.Context(), cryptowalletrest.BalancesPostRequest{
+		Requests:   []cryptowalletrest.BalancesPostRequestRequestsInner{{CryptoSymbol: "SOL", Address: "addr"}},
+		Commitment: "rooted",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, response.Code)
+	errorResponse, ok := response.Body.(cryptowalletrest.ErrorResponse)
+	require.True(t, ok)
+	assert.Equal(t, "BAD_REQUEST", errorResponse.Error)
+}
+
 func TestService_BalancesPost_EmptyRequests(t *testing.T) {
 	t.Parallel()
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	mockProvider := internalportsmocks.NewMockProvider(ctrl)
+	expectValidAddresses(mockProvider)
 
-	mockProvider.EXPECT().GetBatchBalances([]domain.BalanceRequest{}).Return([]*domain.BalanceResult{}, nil)
+	mockProvider.EXPECT().GetBatchBalances(gomock.Any(), []domain.BalanceRequest{}).Return([]*domain.BalanceResult{}, nil)
 
 	svc := service.New(mockProvider)
 
@@ -286,6 +614,7 @@ func TestService_BalancesPost_BatchOperationError(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockProvider := internalportsmocks.NewMockProvider(ctrl)
+	expectValidAddresses(mockProvider)
 
 	expectedRequests := []domain.BalanceRequest{
 		{
@@ -295,7 +624,7 @@ func TestService_BalancesPost_BatchOperationError(t *testing.T) {
 		},
 	}
 
-	mockProvider.EXPECT().GetBatchBalances(expectedRequests).Return(nil, errProviderGeneric)
+	mockProvider.EXPECT().GetBatchBalances(gomock.Any(), expectedRequests).Return(nil, errProviderGeneric)
 
 	svc := service.New(mockProvider)
 
@@ -312,11 +641,13 @@ func TestService_BalancesPost_BatchOperationError(t *testing.T) {
 	response, err := svc.BalancesPost(t.Context(), request)
 
 	require.NoError(t, err)
-	assert.Equal(t, 501, response.Code)
+	assert.Equal(t, http.StatusInternalServerError, response.Code)
 
-	errorResponse, ok := response.Body.(service.Error)
+	errorResponse, ok := response.Body.(cryptowalletrest.ErrorResponse)
 	require.True(t, ok)
+	assert.Equal(t, "INTERNAL_ERROR", errorResponse.Error)
 	assert.Equal(t, "provider error", errorResponse.Message)
+	assert.False(t, errorResponse.Timestamp.IsZero())
 }
 
 func TestTransactionsGet(t *testing.T) {
@@ -327,13 +658,53 @@ func TestTransactionsGet(t *testing.T) {
 	mockProvider := internalportsmocks.NewMockProvider(ctrl)
 	svc := service.New(mockProvider)
 
-	response, err := svc.TransactionsGet(t.Context(), "BTC", "address", 10, 0)
+	height := int64(800000)
+	total := 3
+	page := &domain.TransactionPage{
+		Transactions: []domain.Transaction{{
+			TransactionID: "tx2",
+			BlockHeight:   &height,
+			Timestamp:     time.Date(2024, 1, 31, 12, 0, 0, 0, time.UTC),
+			Amount:        0.5,
+			Direction:     domain.DirectionOutgoing,
+			Confirmations: 6,
+			FeeAmount:     float64Ptr(0.00001500),
+			FiatSymbol:    "EUR",
+			FiatValue:     float64Ptr(20000),
+			ExchangeRate:  float64Ptr(40000),
+		}},
+		NextCursor: "2",
+		TotalCount: &total,
+	}
+	mockProvider.EXPECT().GetTransactions(gomock.Any(), "btc", "xpub", "EUR",
+		domain.PageRequest{Offset: 1, Limit: 1, Order: domain.SortNewestFirst}).
+		Return(page, nil)
+
+	response, err := svc.TransactionsGet(t.Context(), "btc", "xpub", "EUR", 1, 1, "", "", "", "", "", "", "desc")
 
 	require.NoError(t, err)
-	assert.Equal(t, 501, response.Code)
+	assert.Equal(t, http.StatusOK, response.Code)
+
+	body, ok := response.Body.(cryptowalletrest.TransactionsGet200Response)
+	require.True(t, ok)
+	assert.Equal(t, "BTC", body.CryptoSymbol)
+	assert.Equal(t, int32Ptr(3), body.TotalCount)
+	assert.True(t, body.HasMore)
+	assert.NotEmpty(t, body.NextCursor)
+	require.Len(t, body.Transactions, 1)
+
+	tx := body.Transactions[0]
+	assert.Equal(t, "tx2", tx.TransactionId)
+	assert.Equal(t, int32(800000), tx.BlockHeight)
+	assert.Equal(t, "0.5", tx.Amount)
+	assert.Equal(t, "outgoing", tx.Direction)
+	assert.Equal(t, "0.000015", tx.FeeAmount)
+	assert.Equal(t, "EUR", tx.FiatSymbol)
+	assert.Equal(t, float64Ptr(20000), tx.FiatValue)
+	assert.Equal(t, float64Ptr(40000), tx.ExchangeRate)
 }
 
-func TestUnsignedTxGet(t *testing.T) {
+func TestTransactionsGet_Token(t *testing.T) {
 	t.Parallel()
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -341,13 +712,29 @@ func TestUnsignedTxGet(t *testing.T) {
 	mockProvider := internalportsmocks.NewMockProvider(ctrl)
 	svc := service.New(mockProvider)
 
-	response, err := svc.UnsignedTxGet(t.Context(), "BTC", "from", "to", "USD", 1.0)
+	mockProvider.EXPECT().GetTransactions(gomock.Any(), "ETH", "0xwallet", "USD", gomock.Any()).
+		Return(&domain.TransactionPage{Transactions: []domain.Transaction{{
+			TransactionID: "0xabc",
+			Amount:        25,
+			Direction:     domain.DirectionIncoming,
+			FiatSymbol:    "USD",
+			TokenContract: "0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48",
+			TokenSymbol:   "USDC",
+		}}}, nil)
+
+	response, err := svc.TransactionsGet(t.Context(), "ETH", "0xwallet", "", 50, 0, "", "", "", "", "", "", "desc")
 
 	require.NoError(t, err)
-	assert.Equal(t, 501, response.Code)
+	body, ok := response.Body.(cryptowalletrest.TransactionsGet200Response)
+	require.True(t, ok)
+	require.Len(t, body.Transactions, 1)
+	assert.Equal(t, "25", body.Transactions[0].Amount)
+	assert.Equal(t, "0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48", body.Transactions[0].TokenContract)
+	assert.Equal(t, "USDC", body.Transactions[0].TokenSymbol)
+	assert.Nil(t, body.Transactions[0].FiatValue)
 }
 
-func TestBroadcastPost(t *testing.T) {
+func TestTransactionsGet_Cursor(t *testing.T) {
 	t.Parallel()
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -355,8 +742,1025 @@ func TestBroadcastPost(t *testing.T) {
 	mockProvider := internalportsmocks.NewMockProvider(ctrl)
 	svc := service.New(mockProvider)
 
-	response, err := svc.BroadcastPost(t.Context(), cryptowalletrest.BroadcastPostRequest{})
+	mockProvider.EXPECT().GetTransactions(gomock.Any(), "SOL", "address", "USD",
+		domain.PageRequest{Limit: 1, Order: domain.SortNewestFirst}).
+		Return(&domain.TransactionPage{Transactions: []domain.Transaction{{TransactionID: "sig2"}}, NextCursor: "sig2"}, nil)
+	mockProvider.EXPECT().GetTransactions(gomock.Any(), "SOL", "address", "USD",
+		domain.PageRequest{Cursor: "sig2", Limit: 1, Order: domain.SortNewestFirst}).
+		Return(&domain.TransactionPage{Transactions: []domain.Transaction{{TransactionID: "sig1"}}}, nil)
 
+	response, err := svc.TransactionsGet(t.Context(), "SOL", "address", "", 1, 0, "", "", "", "", "", "", "desc")
 	require.NoError(t, err)
-	assert.Equal(t, 501, response.Code)
+	body, ok := response.Body.(cryptowalletrest.TransactionsGet200Response)
+	require.True(t, ok)
+	assert.Nil(t, body.TotalCount)
+	assert.True(t, body.HasMore)
+	require.NotEmpty(t, body.NextCursor)
+
+	response, err = svc.TransactionsGet(t.Context(), "SOL", "address", "", 1, 0,
+		body.NextCursor, "", "", "", "", "", "desc")
+	require.NoError(t, err)
+	body, ok = response.Body.(cryptowalletrest.TransactionsGet200Response)
+	require.True(t, ok)
+	require.Len(t, body.Transactions, 1)
+	assert.Equal(t, "sig1", body.Transactions[0].TransactionId)
+	assert.False(t, body.HasMore)
+	assert.Empty(t, body.NextCursor)
+}
+
+func TestTransactionsGet_InvalidCursor(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockProvider := internalportsmocks.NewMockProvider(ctrl)
+	svc := service.New(mockProvider)
+
+	response, err := svc.TransactionsGet(t.Context(), "SOL", "address", "", 50, 0,
+		"not a cursor!", "", "", "", "", "", "desc")
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, response.Code)
+	errorResponse, ok := response.Body.(cryptowalletrest.ErrorResponse)
+	require.True(t, ok)
+	assert.Equal(t, "BAD_REQUEST", errorResponse.Error)
+}
+
+func TestTransactionsGet_Filter(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockProvider := internalportsmocks.NewMockProvider(ctrl)
+	svc := service.New(mockProvider)
+
+	mockProvider.EXPECT().GetTransactions(gomock.Any(), "BTC", "xpub", "USD", domain.PageRequest{
+		Limit: 50,
+		Filter: domain.TransactionFilter{
+			Direction: domain.DirectionOutgoing,
+			Since:     time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			Until:     time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+			MinAmount: 0.5,
+			Status:    domain.TransactionConfirmed,
+		},
+		Order: domain.SortOldestFirst,
+	}).Return(&domain.TransactionPage{}, nil)
+
+	response, err := svc.TransactionsGet(t.Context(), "BTC", "xpub", "", 50, 0, "",
+		"outgoing", "2024-01-01T00:00:00Z", "2024-02-01T00:00:00Z", "0.5", "confirmed", "asc")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.Code)
+
+	for _, params := range [][6]string{
+		{"sideways", "", "", "", "", "desc"},
+		{"", "yesterday", "", "", "", "desc"},
+		{"", "2024-02-01T00:00:00Z", "2024-01-01T00:00:00Z", "", "", "desc"},
+		{"", "", "", "-1", "", "desc"},
+		{"", "", "", "NaN", "", "desc"},
+		{"", "", "", "", "mined", "desc"},
+		{"", "", "", "", "", "random"},
+	} {
+		response, err := svc.TransactionsGet(t.Context(), "BTC", "xpub", "", 50, 0, "",
+			params[0], params[1], params[2], params[3], params[4], params[5])
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, response.Code, params)
+	}
+}
+
+func TestTransactionsGet_Unsupported(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockProvider := internalportsmocks.NewMockProvider(ctrl)
+	svc := service.New(mockProvider)
+
+	mockProvider.EXPECT().GetTransactions(gomock.Any(), "SOL", "address", "USD", gomock.Any()).
+		Return(nil, fmt.Errorf("%w: SOL", domain.ErrUnsupportedSymbol))
+
+	response, err := svc.TransactionsGet(t.Context(), "SOL", "address", "USD", 50, 0, "", "", "", "", "", "", "desc")
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, response.Code)
+	errorResponse, ok := response.Body.(cryptowalletrest.ErrorResponse)
+	require.True(t, ok)
+	assert.Equal(t, "UNSUPPORTED_SYMBOL", errorResponse.Error)
+}
+
+func TestTransactionsCryptoSymbolTxidGet(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockProvider := internalportsmocks.NewMockProvider(ctrl)
+	svc := service.New(mockProvider)
+
+	blockHeight := int64(800000)
+	blockTime := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	fee := 0.00001
+	parent := 0
+	mockProvider.EXPECT().GetTransactionDetail(gomock.Any(), "btc", "tx1", "xpub").
+		Return(&domain.TransactionDetail{
+			TransactionID: "tx1",
+			Status:        domain.TransactionConfirmed,
+			BlockHeight:   &blockHeight,
+			BlockHash:     "hash",
+			Timestamp:     &blockTime,
+			Confirmations: 3,
+			FeeAmount:     &fee,
+			Inputs: []domain.TransactionInput{{
+				PreviousTransaction: "prev", PreviousIndex: 1, Address: "bc1in", Value: 101000, Amount: 0.00101,
+				Owned: true,
+			}},
+			Outputs: []domain.TransactionOutput{
+				{Index: 0, Address: "bc1out", Value: 60000, Amount: 0.0006},
+				{Index: 1, Address: "bc1change", Value: 40000, Amount: 0.0004, Owned: true},
+			},
+			Instructions: []domain.TransactionInstruction{{Index: 0, Parent: &parent, ProgramID: "program"}},
+		}, nil)
+
+	response, err := svc.TransactionsCryptoSymbolTxidGet(t.Context(), "btc", "tx1", "xpub")
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.Code)
+	body, ok := response.Body.(cryptowalletrest.TransactionsCryptoSymbolTxidGet200Response)
+	require.True(t, ok)
+	assert.Equal(t, "BTC", body.CryptoSymbol)
+	assert.Equal(t, "tx1", body.Txid)
+	assert.Equal(t, "confirmed", body.Status)
+	assert.Equal(t, int32(800000), body.BlockHeight)
+	assert.Equal(t, "hash", body.BlockHash)
+	assert.Equal(t, &blockTime, body.Timestamp)
+	assert.Equal(t, int32(3), body.Confirmations)
+	assert.Equal(t, "0.00001", body.FeeAmount)
+	assert.Equal(t, []cryptowalletrest.TransactionInput{{
+		PreviousTxid: "prev", PreviousVout: 1, Address: "bc1in", Value: 101000, Amount: "0.00101", Owned: true,
+	}}, body.Inputs)
+	require.Len(t, body.Outputs, 2)
+	assert.Equal(t, "0.0004", body.Outputs[1].Amount)
+	assert.Equal(t, int32(1), body.Outputs[1].Vout)
+	assert.True(t, body.Outputs[1].Owned)
+	assert.False(t, body.Outputs[0].Owned)
+	assert.Empty(t, body.Transfers)
+	require.Len(t, body.Instructions, 1)
+	assert.Equal(t, int32Ptr(0), body.Instructions[0].ParentIndex)
+}
+
+func TestTransactionsCryptoSymbolTxidGet_NotFound(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockProvider := internalportsmocks.NewMockProvider(ctrl)
+	svc := service.New(mockProvider)
+
+	mockProvider.EXPECT().GetTransactionDetail(gomock.Any(), "ETH", "0xmissing", "").
+		Return(nil, fmt.Errorf("%w: 0xmissing", domain.ErrTransactionNotFound))
+
+	response, err := svc.TransactionsCryptoSymbolTxidGet(t.Context(), "ETH", "0xmissing", "")
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, response.Code)
+	errorResponse, ok := response.Body.(cryptowalletrest.ErrorResponse)
+	require.True(t, ok)
+	assert.Equal(t, "TRANSACTION_NOT_FOUND", errorResponse.Error)
+}
+
+func TestService_ValidateAddressGet(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockProvider := internalportsmocks.NewMockProvider(ctrl)
+	svc := service.New(mockProvider)
+
+	mockProvider.EXPECT().ValidateAddress(gomock.Any(), "btc", "zpub").Return(&domain.AddressValidation{
+		CryptoSymbol: "BTC",
+		Address:      "zpub",
+		Kind:         domain.AddressKindExtendedKey,
+		Format:       "zpub",
+		Network:      domain.NetworkMainnet,
+	}, nil)
+
+	response, err := svc.ValidateAddressGet(t.Context(), "btc", "zpub")
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.Equal(t, cryptowalletrest.ValidateAddressGet200Response{
+		CryptoSymbol: "BTC",
+		Address:      "zpub",
+		Valid:        true,
+		Kind:         "extended_key",
+		Format:       "zpub",
+		Network:      "mainnet",
+	}, response.Body)
+}
+
+func TestService_ValidateAddressGet_Invalid(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockProvider := internalportsmocks.NewMockProvider(ctrl)
+	svc := service.New(mockProvider)
+
+	mockProvider.EXPECT().ValidateAddress(gomock.Any(), "BTC", "tb1q").Return(nil, domain.NetworkMismatchError(false))
+	mockProvider.EXPECT().ValidateAddress(gomock.Any(), "DOGE", "D123").
+		Return(nil, fmt.Errorf("%w: DOGE", domain.ErrUnsupportedSymbol))
+
+	response, err := svc.ValidateAddressGet(t.Context(), "BTC", "tb1q")
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.Code)
+	body, ok := response.Body.(cryptowalletrest.ValidateAddressGet200Response)
+	require.True(t, ok)
+	assert.False(t, body.Valid)
+	assert.Equal(t, "INVALID_ADDRESS", body.ErrorCode)
+	assert.Contains(t, body.Error, "testnet address used on mainnet")
+
+	response, err = svc.ValidateAddressGet(t.Context(), "DOGE", "D123")
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, response.Code)
+}
+
+func TestService_ReceiveAddressGet(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockProvider := internalportsmocks.NewMockProvider(ctrl)
+	svc := service.New(mockProvider)
+
+	mockProvider.EXPECT().ValidateAddress(gomock.Any(), "btc", "zpub").
+		Return(&domain.AddressValidation{Kind: domain.AddressKindExtendedKey, Format: "zpub"}, nil)
+	mockProvider.EXPECT().GetReceiveAddresses(gomock.Any(), "btc", "zpub", 2).Return([]domain.ReceiveAddress{
+		{Index: 3, Address: "bc1qthree", DerivationPath: "m/84'/0'/0'/0/3"},
+		{Index: 4, Address: "bc1qfour", DerivationPath: "m/84'/0'/0'/0/4"},
+	}, nil)
+
+	response, err := svc.ReceiveAddressGet(t.Context(), "btc", "zpub", 2)
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.Equal(t, cryptowalletrest.ReceiveAddressGet200Response{
+		CryptoSymbol: "BTC",
+		Xpub:         "zpub",
+		Addresses: []cryptowalletrest.ReceiveAddress{
+			{Index: 3, Address: "bc1qthree", DerivationPath: "m/84'/0'/0'/0/3"},
+			{Index: 4, Address: "bc1qfour", DerivationPath: "m/84'/0'/0'/0/4"},
+		},
+	}, response.Body)
+}
+
+func TestService_ReceiveAddressGet_Errors(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockProvider := internalportsmocks.NewMockProvider(ctrl)
+	svc := service.New(mockProvider)
+
+	mockProvider.EXPECT().ValidateAddress(gomock.Any(), "BTC", "bc1qplain").
+		Return(&domain.AddressValidation{Kind: domain.AddressKindAddress}, nil)
+	mockProvider.EXPECT().ValidateAddress(gomock.Any(), "SOL", "xpub").
+		Return(&domain.AddressValidation{Kind: domain.AddressKindExtendedKey}, nil)
+	mockProvider.EXPECT().GetReceiveAddresses(gomock.Any(), "SOL", "xpub", 1).
+		Return(nil, fmt.Errorf("%w: SOL", domain.ErrUnsupportedSymbol))
+
+	response, err := svc.ReceiveAddressGet(t.Context(), "BTC", "bc1qplain", 1)
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, response.Code)
+
+	response, err = svc.ReceiveAddressGet(t.Context(), "SOL", "xpub", 1)
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, response.Code)
+}
+
+func TestService_UtxosGet(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockProvider := internalportsmocks.NewMockProvider(ctrl)
+	svc := service.New(mockProvider)
+
+	height := int64(800000)
+	mockProvider.EXPECT().GetUTXOs(gomock.Any(), "btc", "zpub", domain.UTXOFilter{MinConfirmations: 1, DustThreshold: 546}).
+		Return([]domain.UTXO{
+			{
+				TransactionID: "aa", Vout: 1, Value: 150000, Amount: 0.0015, Confirmations: 6, BlockHeight: &height,
+				ScriptPubKey: "0014ab", Address: "bc1qone", DerivationPath: "m/84'/0'/0'/0/0",
+			},
+			{
+				TransactionID: "bb", Vout: 0, Value: 50000, Amount: 0.0005, Confirmations: 1,
+				ScriptPubKey: "0014cd", Address: "bc1qtwo", DerivationPath: "m/84'/0'/0'/1/0",
+			},
+		}, nil)
+
+	response, err := svc.UtxosGet(t.Context(), "btc", "zpub", 1, 546)
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.Equal(t, cryptowalletrest.UtxosGet200Response{
+		CryptoSymbol: "BTC",
+		Address:      "zpub",
+		Utxos: []cryptowalletrest.UnspentOutput{
+			{
+				Txid: "aa", Vout: 1, Value: 150000, Amount: "0.0015", Confirmations: 6, BlockHeight: 800000,
+				ScriptPubkey: "0014ab", Address: "bc1qone", DerivationPath: "m/84'/0'/0'/0/0",
+			},
+			{
+				Txid: "bb", Vout: 0, Value: 50000, Amount: "0.0005", Confirmations: 1,
+				ScriptPubkey: "0014cd", Address: "bc1qtwo", DerivationPath: "m/84'/0'/0'/1/0",
+			},
+		},
+		TotalValue:  200000,
+		TotalAmount: "0.002",
+	}, response.Body)
+}
+
+func TestService_UtxosGet_Error(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockProvider := internalportsmocks.NewMockProvider(ctrl)
+	svc := service.New(mockProvider)
+
+	mockProvider.EXPECT().GetUTXOs(gomock.Any(), "BTC", "wpkh(bad)", domain.UTXOFilter{}).
+		Return(nil, fmt.Errorf("%w: unsupported output descriptor", domain.ErrInvalidAddress))
+
+	response, err := svc.UtxosGet(t.Context(), "BTC", "wpkh(bad)", 0, 0)
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, response.Code)
+}
+
+func TestService_FeesGet(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockProvider := internalportsmocks.NewMockProvider(ctrl)
+	svc := service.New(mockProvider)
+
+	baseFee, priorityFee := 10.0, 1.5
+	mockProvider.EXPECT().EstimateFees(gomock.Any(), "eth", "EUR").Return(&domain.FeeEstimates{
+		CryptoSymbol: "ETH",
+		RateUnit:     "gwei",
+		SizeUnit:     "gas",
+		TypicalSize:  21000,
+		FiatSymbol:   "EUR",
+		ExchangeRate: float64Ptr(2000),
+		Estimates: []domain.FeeEstimate{{
+			Level:       domain.FeeLevelNormal,
+			Rate:        11.5,
+			Amount:      0.0002415,
+			FiatValue:   float64Ptr(0.483),
+			BaseFee:     &baseFee,
+			PriorityFee: &priorityFee,
+		}},
+	}, nil)
+
+	response, err := svc.FeesGet(t.Context(), "eth", "eur")
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.Equal(t, cryptowalletrest.FeesGet200Response{
+		CryptoSymbol: "ETH",
+		FiatSymbol:   "EUR",
+		RateUnit:     "gwei",
+		SizeUnit:     "gas",
+		TypicalSize:  21000,
+		ExchangeRate: float64Ptr(2000),
+		Estimates: []cryptowalletrest.FeeEstimate{{
+			Level:       "normal",
+			FeeRate:     11.5,
+			FeeAmount:   "0.0002415",
+			FiatValue:   float64Ptr(0.483),
+			BaseFee:     10,
+			PriorityFee: 1.5,
+		}},
+	}, response.Body)
+
+	response, err = svc.FeesGet(t.Context(), "eth", "DOLLARS")
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, response.Code)
+}
+
+func TestService_BalancesHistoryPost(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockProvider := internalportsmocks.NewMockProvider(ctrl)
+	svc := service.New(mockProvider)
+
+	day1 := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	day2 := day1.AddDate(0, 0, 1)
+	walletErr := "provider unavailable: connection refused"
+	history := &domain.BalanceHistory{
+		FiatSymbol: "USD",
+		From:       day1,
+		To:         day2,
+		Totals: []domain.HistoryTotal{
+			{Date: day1, FiatValue: 100, Complete: false},
+			{Date: day2, FiatValue: 110, Complete: false},
+		},
+		Wallets: []domain.WalletHistory{
+			{
+				CryptoSymbol: "BTC",
+				Address:      "xpub",
+				Points: []domain.HistoryPoint{
+					{Date: day1, CryptoBalance: 0.01, ExchangeRate: float64Ptr(10000), FiatValue: float64Ptr(100)},
+					{Date: day2, CryptoBalance: 0.01, ExchangeRate: float64Ptr(11000), FiatValue: float64Ptr(110)},
+				},
+			},
+			{CryptoSymbol: "LTC", Address: "ltub", Error: &walletErr, ErrorCode: domain.CodeProviderUnavailable},
+		},
+	}
+
+	expectedRequests := []domain.BalanceRequest{
+		{CryptoSymbol: "BTC", Address: "xpub", FiatSymbol: "USD"},
+		{CryptoSymbol: "LTC", Address: "ltub", FiatSymbol: "USD"},
+	}
+	mockProvider.EXPECT().GetBalanceHistory(gomock.Any(), expectedRequests, "USD", day1, day2).Return(history, nil)
+
+	response, err := svc.BalancesHistoryPost(t.Context(), cryptowalletrest.BalancesHistoryPostRequest{
+		Requests: []cryptowalletrest.BalancesHistoryPostRequestRequestsInner{
+			{CryptoSymbol: "BTC", Address: "xpub"},
+			{CryptoSymbol: "LTC", Address: "ltub"},
+		},
+		From: "2024-01-01",
+		To:   "2024-01-02",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.Code)
+
+	body, ok := response.Body.(cryptowalletrest.BalancesHistoryPost200Response)
+	require.True(t, ok)
+	assert.Equal(t, "2024-01-01", body.From)
+	assert.Equal(t, "2024-01-02", body.To)
+	require.Len(t, body.Totals, 2)
+	assert.Equal(t, "2024-01-02", body.Totals[1].Date)
+	assert.InDelta(t, 110, body.Totals[1].FiatValue, 0)
+	assert.False(t, body.Totals[1].Complete)
+
+	require.Len(t, body.Wallets, 2)
+	require.Len(t, body.Wallets[0].Points, 2)
+	assert.Equal(t, "2024-01-01", body.Wallets[0].Points[0].Date)
+	assert.Equal(t, float64Ptr(100), body.Wallets[0].Points[0].FiatValue)
+	assert.NotNil(t, body.Wallets[1].Points)
+	assert.Empty(t, body.Wallets[1].Points)
+	assert.Equal(t, walletErr, body.Wallets[1].Error)
+	assert.Equal(t, "PROVIDER_UNAVAILABLE", body.Wallets[1].ErrorCode)
+}
+
+func TestService_BalancesHistoryPost_InvalidDate(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockProvider := internalportsmocks.NewMockProvider(ctrl)
+	svc := service.New(mockProvider)
+
+	response, err := svc.BalancesHistoryPost(t.Context(), cryptowalletrest.BalancesHistoryPostRequest{
+		Requests: []cryptowalletrest.BalancesHistoryPostRequestRequestsInner{{CryptoSymbol: "BTC", Address: "xpub"}},
+		From:     "01/01/2024",
+		To:       "2024-01-02",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, response.Code)
+	errorResponse, ok := response.Body.(cryptowalletrest.ErrorResponse)
+	require.True(t, ok)
+	assert.Equal(t, "BAD_REQUEST", errorResponse.Error)
+}
+
+func TestService_PortfolioPost(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockProvider := internalportsmocks.NewMockProvider(ctrl)
+	svc := service.New(mockProvider)
+
+	accountErr := "provider unavailable: connection refused"
+	btcValue := domain.PortfolioValue{
+		FiatSymbol: "USD", FiatValue: 600, ExchangeRate: float64Ptr(60000),
+		Change24h: float64Ptr(100), Change24hPercent: float64Ptr(20), AllocationPercent: float64Ptr(100), Complete: true,
+	}
+	portfolio := &domain.Portfolio{
+		FiatSymbols: []string{"USD"},
+		Totals:      []domain.PortfolioValue{{FiatSymbol: "USD", FiatValue: 600, Complete: false}},
+		Wallets: []domain.WalletPortfolio{{
+			Name:   "savings",
+			Values: []domain.PortfolioValue{{FiatSymbol: "USD", FiatValue: 600, AllocationPercent: float64Ptr(100)}},
+			Accounts: []domain.AccountPortfolio{
+				{CryptoSymbol: "BTC", Address: "xpub", CryptoBalance: 0.01, Values: []domain.PortfolioValue{btcValue}},
+				{
+					CryptoSymbol: "LTC", Address: "ltub", Error: &accountErr, ErrorCode: domain.CodeProviderUnavailable,
+					Values: []domain.PortfolioValue{{FiatSymbol: "USD"}},
+				},
+			},
+		}},
+		Assets: []domain.AssetPortfolio{
+			{CryptoSymbol: "BTC", CryptoBalance: 0.01, Values: []domain.PortfolioValue{btcValue}},
+		},
+	}
+
+	expectedWallets := []domain.PortfolioWallet{{
+		Name: "savings",
+		Accounts: []domain.PortfolioAccount{
+			{CryptoSymbol: "BTC", Address: "xpub"},
+			{CryptoSymbol: "LTC", Address: "ltub"},
+		},
+	}}
+	mockProvider.EXPECT().GetPortfolio(gomock.Any(), expectedWallets, []string{"USD"}).Return(portfolio, nil)
+
+	response, err := svc.PortfolioPost(t.Context(), cryptowalletrest.PortfolioPostRequest{
+		Wallets: []cryptowalletrest.PortfolioPostRequestWalletsInner{{
+			Name: "savings",
+			Accounts: []cryptowalletrest.PortfolioPostRequestWalletsInnerAccountsInner{
+				{CryptoSymbol: "BTC", Address: "xpub"},
+				{CryptoSymbol: "LTC", Address: "ltub"},
+			},
+		}},
+		FiatSymbols: []string{"usd"},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.Code)
+
+	body, ok := response.Body.(cryptowalletrest.PortfolioPost200Response)
+	require.True(t, ok)
+	assert.Equal(t, []string{"USD"}, body.FiatSymbols)
+	require.Len(t, body.Totals, 1)
+	assert.False(t, body.Totals[0].Complete)
+	assert.Zero(t, body.Totals[0].AllocationPercent)
+
+	require.Len(t, body.Wallets, 1)
+	assert.Equal(t, "savings", body.Wallets[0].Name)
+	assert.InDelta(t, 100, body.Wallets[0].Values[0].AllocationPercent, 0)
+	btc := body.Wallets[0].Accounts[0]
+	assert.Equal(t, float64Ptr(60000), btc.Values[0].ExchangeRate)
+	assert.Equal(t, float64Ptr(100), btc.Values[0].Change24h)
+	assert.Equal(t, float64Ptr(20), btc.Values[0].Change24hPercent)
+	assert.Empty(t, btc.Error)
+	assert.Equal(t, accountErr, body.Wallets[0].Accounts[1].Error)
+	assert.Equal(t, "PROVIDER_UNAVAILABLE", body.Wallets[0].Accounts[1].ErrorCode)
+
+	require.Len(t, body.Assets, 1)
+	assert.InDelta(t, 0.01, body.Assets[0].CryptoBalance, 0)
+	assert.False(t, body.Timestamp.IsZero())
+}
+
+func TestService_PortfolioPost_BadRequest(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockProvider := internalportsmocks.NewMockProvider(ctrl)
+	svc := service.New(mockProvider)
+
+	mockProvider.EXPECT().GetPortfolio(gomock.Any(), []domain.PortfolioWallet{}, []string{}).
+		Return(nil, fmt.Errorf("%w: at least one wallet is required", domain.ErrBadRequest))
+
+	response, err := svc.PortfolioPost(t.Context(), cryptowalletrest.PortfolioPostRequest{})
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, response.Code)
+	errorResponse, ok := response.Body.(cryptowalletrest.ErrorResponse)
+	require.True(t, ok)
+	assert.Equal(t, "BAD_REQUEST", errorResponse.Error)
+}
+
+func TestUnsignedTxGet(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockProvider := internalportsmocks.NewMockProvider(ctrl)
+	svc := service.New(mockProvider)
+
+	from := "0x1111111111111111111111111111111111111111"
+	to := "0x2222222222222222222222222222222222222222"
+	usdc := "0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48"
+	mockProvider.EXPECT().BuildUnsignedTx(gomock.Any(), "eth", domain.UnsignedTxRequest{
+		From: from, To: to, Amount: "12.5", FeeRate: 30, TokenContract: usdc,
+	}).Return(&domain.UnsignedTx{
+		CryptoSymbol: "ETH",
+		From:         from,
+		To:           to,
+		Amount:       "12.5",
+		Fee:          "0.00225",
+		Payload:      []byte{0x02, 0xf8},
+		Encoding:     domain.EncodingHex,
+		SigningHash:  []byte{0xab, 0xcd},
+		Details:      []domain.TxDetail{{Label: "Amount", Value: "12.5 USDC"}},
+	}, nil)
+
+	response, err := svc.UnsignedTxGet(t.Context(), "eth", from, to, "12.5", 30, usdc, "")
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.Equal(t, cryptowalletrest.UnsignedTxGet200Response{
+		CryptoSymbol:       "ETH",
+		FromAddress:        from,
+		ToAddress:          to,
+		Amount:             "12.5",
+		FeeAmount:          "0.00225",
+		UnsignedTx:         "02f8",
+		UnsignedTxEncoding: "hex",
+		TxSizeBytes:        2,
+		SigningHash:        "abcd",
+		Details:            []cryptowalletrest.UnsignedTxDetail{{Label: "Amount", Value: "12.5 USDC"}},
+	}, response.Body)
+}
+
+func TestUnsignedTxGet_Base64(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockProvider := internalportsmocks.NewMockProvider(ctrl)
+	svc := service.New(mockProvider)
+
+	from := "9WzDXwBbmkg8ZTbNMqUxvQRAyrZzDsGYdLVL9zYtAWWM"
+	to := "4Nd1mBQtrMJVYVfKf2PJy9NZUZdTAsp7D4xWLs4gDB4T"
+	nonceAccount := "7Np41oeYqPefeNQEHSv1UDhYrehxin3NStELsSKCT4K2"
+	mockProvider.EXPECT().BuildUnsignedTx(gomock.Any(), "SOL", domain.UnsignedTxRequest{
+		From: from, To: to, Amount: "0.5", NonceAccount: nonceAccount,
+	}).Return(&domain.UnsignedTx{
+		CryptoSymbol: "SOL",
+		From:         from,
+		To:           to,
+		Amount:       "0.5",
+		Fee:          "0.00001",
+		Payload:      []byte{0x01, 0x00, 0x02},
+		Encoding:     domain.EncodingBase64,
+	}, nil)
+
+	response, err := svc.UnsignedTxGet(t.Context(), "SOL", from, to, "0.5", 0, "", nonceAccount)
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.Code)
+	body, ok := response.Body.(cryptowalletrest.UnsignedTxGet200Response)
+	require.True(t, ok)
+	assert.Equal(t, "AQAC", body.UnsignedTx)
+	assert.Equal(t, "base64", body.UnsignedTxEncoding)
+	assert.Empty(t, body.SigningHash)
+}
+
+func TestUnsignedTxGet_Inputs(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockProvider := internalportsmocks.NewMockProvider(ctrl)
+	svc := service.New(mockProvider)
+
+	kpub := "kpub2HtoTgsmZ1yHn2Ub3xqFgiBNdUB4ZoQ5AXmzL4bWGJqo7qBmAYUgFrCkEWtJHPFQSu5bxzd6r6YrFhhtnKFhRR5h9K5vFxQ2eLMqGmvt36Q"
+	to := "kaspa:qr0lr4ml9fn3chekrqmjdkergxl93l4wrk3dankcgvjq776s9wn9jkdskewva"
+	mockProvider.EXPECT().BuildUnsignedTx(gomock.Any(), "KAS", domain.UnsignedTxRequest{
+		From: kpub, To: to, Amount: "1.5",
+	}).Return(&domain.UnsignedTx{
+		CryptoSymbol: "KAS",
+		From:         kpub,
+		To:           to,
+		Amount:       "1.5",
+		Fee:          "0.00002036",
+		Payload:      []byte{0x0a, 0x01},
+		Encoding:     domain.EncodingHex,
+		Inputs: []domain.UnsignedTxInput{{
+			TransactionID:  "aa",
+			Vout:           1,
+			Value:          200000000,
+			Address:        "kaspa:qz...",
+			DerivationPath: "m/44'/111111'/0'/0/3",
+			SigningHash:    []byte{0xbe, 0xef},
+		}},
+	}, nil)
+
+	response, err := svc.UnsignedTxGet(t.Context(), "KAS", kpub, to, "1.5", 0, "", "")
+
+	require.NoError(t, err)
+	body, ok := response.Body.(cryptowalletrest.UnsignedTxGet200Response)
+	require.True(t, ok)
+	assert.Equal(t, "0a01", body.UnsignedTx)
+	assert.Equal(t, []cryptowalletrest.UnsignedTxInput{{
+		Txid:           "aa",
+		Vout:           1,
+		Value:          200000000,
+		Address:        "kaspa:qz...",
+		DerivationPath: "m/44'/111111'/0'/0/3",
+		SigningHash:    "beef",
+	}}, body.Inputs)
+}
+
+func TestUnsignedTxGet_Errors(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockProvider := internalportsmocks.NewMockProvider(ctrl)
+	svc := service.New(mockProvider)
+
+	response, err := svc.UnsignedTxGet(t.Context(), "ETH", "from", "to", "1", -1, "", "")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, response.Code)
+
+	mockProvider.EXPECT().BuildUnsignedTx(gomock.Any(), "ETH", gomock.Any()).
+		Return(nil, fmt.Errorf("%w: 0.1 ETH available", domain.ErrInsufficientFunds))
+	response, err = svc.UnsignedTxGet(t.Context(), "ETH", "from", "to", "1", 0, "", "")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusUnprocessableEntity, response.Code)
+	errorResponse, ok := response.Body.(cryptowalletrest.ErrorResponse)
+	require.True(t, ok)
+	assert.Equal(t, "INSUFFICIENT_FUNDS", errorResponse.Error)
+}
+
+func TestUnsignedTxPost(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockProvider := internalportsmocks.NewMockProvider(ctrl)
+	svc := service.New(mockProvider)
+
+	zpub := "zpub6rFR7y4Q2AijBEqTUquhVz398htDFrtymD9xYYfG1m4wAcvPhXNfE3EfH1r1ADqtfSdVCToUG868RvUUkgDKf31mGDtKsAYz2oz2AGutZYs"
+	recipients := []domain.Recipient{
+		{Address: "bc1qcr8te4kr609gcawutmrza0j4xv80jy8z306fyu", Amount: "0.002"},
+		{Address: "bc1qnjg0jd8228aq7egyzacy8cys3knf9xvrerkf9g", Amount: "0.003"},
+	}
+	mockProvider.EXPECT().BuildUnsignedTx(gomock.Any(), "BTC", domain.UnsignedTxRequest{
+		From:           zpub,
+		FeeRate:        4,
+		Recipients:     recipients,
+		OpReturn:       []byte("payout"),
+		RequiredInputs: []domain.Outpoint{{TransactionID: "aa", Vout: 0}},
+		ExcludedInputs: []domain.Outpoint{{TransactionID: "bb", Vout: 2}},
+		DisableRBF:     true,
+		LockTime:       900000,
+	}).Return(&domain.UnsignedTx{
+		CryptoSymbol: "BTC",
+		From:         zpub,
+		Amount:       "0.005",
+		Fee:          "0.00001128",
+		Payload:      []byte{0x70, 0x73},
+		Encoding:     domain.EncodingBase64,
+		Recipients:   recipients,
+		Details:      []domain.TxDetail{{Label: "Replace-by-fee", Value: "disabled"}},
+	}, nil)
+
+	response, err := svc.UnsignedTxPost(t.Context(), cryptowalletrest.UnsignedTxPostRequest{
+		CryptoSymbol: "BTC",
+		FromAddress:  zpub,
+		Outputs: []cryptowalletrest.UnsignedTxOutput{
+			{Address: recipients[0].Address, Amount: "0.002"},
+			{Address: recipients[1].Address, Amount: "0.003"},
+		},
+		FeeRate:        4,
+		OpReturn:       "7061796f7574",
+		RequiredInputs: []cryptowalletrest.Outpoint{{Txid: "aa"}},
+		ExcludedInputs: []cryptowalletrest.Outpoint{{Txid: "bb", Vout: 2}},
+		DisableRbf:     true,
+		LockTime:       900000,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.Equal(t, cryptowalletrest.UnsignedTxPost200Response{
+		CryptoSymbol: "BTC",
+		FromAddress:  zpub,
+		Outputs: []cryptowalletrest.UnsignedTxOutput{
+			{Address: recipients[0].Address, Amount: "0.002"},
+			{Address: recipients[1].Address, Amount: "0.003"},
+		},
+		Amount:             "0.005",
+		FeeAmount:          "0.00001128",
+		UnsignedTx:         "cHM=",
+		UnsignedTxEncoding: "base64",
+		TxSizeBytes:        2,
+		Details:            []cryptowalletrest.UnsignedTxDetail{{Label: "Replace-by-fee", Value: "disabled"}},
+	}, response.Body)
+}
+
+func TestUnsignedTxPost_SingleOutput(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockProvider := internalportsmocks.NewMockProvider(ctrl)
+	svc := service.New(mockProvider)
+
+	from := "0x1111111111111111111111111111111111111111"
+	to := "0x2222222222222222222222222222222222222222"
+	mockProvider.EXPECT().BuildUnsignedTx(gomock.Any(), "ETH", domain.UnsignedTxRequest{
+		From: from, To: to, Amount: "max",
+	}).Return(&domain.UnsignedTx{
+		CryptoSymbol: "ETH",
+		From:         from,
+		To:           to,
+		Amount:       "0.99979",
+		Fee:          "0.00021",
+		Payload:      []byte{0x02},
+		Encoding:     domain.EncodingHex,
+	}, nil)
+
+	response, err := svc.UnsignedTxPost(t.Context(), cryptowalletrest.UnsignedTxPostRequest{
+		CryptoSymbol: "ETH",
+		FromAddress:  from,
+		Outputs:      []cryptowalletrest.UnsignedTxOutput{{Address: to, Amount: "max"}},
+	})
+
+	require.NoError(t, err)
+	body, ok := response.Body.(cryptowalletrest.UnsignedTxPost200Response)
+	require.True(t, ok)
+	assert.Equal(t, []cryptowalletrest.UnsignedTxOutput{{Address: to, Amount: "0.99979"}}, body.Outputs)
+}
+
+func TestUnsignedTxPost_Errors(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockProvider := internalportsmocks.NewMockProvider(ctrl)
+	svc := service.New(mockProvider)
+
+	outputs := []cryptowalletrest.UnsignedTxOutput{{Address: "to", Amount: "1"}}
+	for _, request := range []cryptowalletrest.UnsignedTxPostRequest{
+		{CryptoSymbol: "BTC", FromAddress: "from", Outputs: []cryptowalletrest.UnsignedTxOutput{}},
+		{CryptoSymbol: "BTC", FromAddress: "from", Outputs: outputs, FeeRate: -1},
+		{CryptoSymbol: "BTC", FromAddress: "from", Outputs: outputs, OpReturn: "zz"},
+		{CryptoSymbol: "BTC", FromAddress: "from", Outputs: outputs, LockTime: 1 << 32},
+		{CryptoSymbol: "BTC", FromAddress: "from", Outputs: outputs,
+			ExcludedInputs: []cryptowalletrest.Outpoint{{Txid: "aa", Vout: -1}}},
+	} {
+		response, err := svc.UnsignedTxPost(t.Context(), request)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, response.Code)
+	}
+}
+
+func TestUnsignedTxBumpPost(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockProvider := internalportsmocks.NewMockProvider(ctrl)
+	svc := service.New(mockProvider)
+
+	txid := "f4184fc596403b9d638783cf57adfe4c75c605f6356fbc91338530e9831e9e16"
+	mockProvider.EXPECT().BumpFee(gomock.Any(), "BTC", domain.FeeBumpRequest{
+		From: "xpub", TransactionID: txid, Method: domain.FeeBumpCPFP, FeeRate: 12,
+	}).Return(&domain.UnsignedTx{
+		CryptoSymbol: "BTC",
+		From:         "xpub",
+		To:           "bc1qchange",
+		Amount:       "0.00097",
+		Fee:          "0.00003",
+		Payload:      []byte{0x70, 0x73},
+		Encoding:     domain.EncodingBase64,
+		Details:      []domain.TxDetail{{Label: "Speeds up", Value: txid}},
+	}, nil)
+
+	response, err := svc.UnsignedTxBumpPost(t.Context(), cryptowalletrest.UnsignedTxBumpPostRequest{
+		CryptoSymbol: "BTC",
+		FromAddress:  "xpub",
+		Txid:         txid,
+		Method:       "CPFP",
+		FeeRate:      12,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.Equal(t, cryptowalletrest.UnsignedTxBumpPost200Response{
+		CryptoSymbol:       "BTC",
+		FromAddress:        "xpub",
+		Txid:               txid,
+		Outputs:            []cryptowalletrest.UnsignedTxOutput{{Address: "bc1qchange", Amount: "0.00097"}},
+		Amount:             "0.00097",
+		FeeAmount:          "0.00003",
+		UnsignedTx:         "cHM=",
+		UnsignedTxEncoding: "base64",
+		TxSizeBytes:        2,
+		Details:            []cryptowalletrest.UnsignedTxDetail{{Label: "Speeds up", Value: txid}},
+	}, response.Body)
+}
+
+func TestUnsignedTxBumpPost_Errors(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockProvider := internalportsmocks.NewMockProvider(ctrl)
+	svc := service.New(mockProvider)
+
+	for _, request := range []cryptowalletrest.UnsignedTxBumpPostRequest{
+		{CryptoSymbol: "BTC", FromAddress: "xpub", Txid: "aa", Method: "double"},
+		{CryptoSymbol: "BTC", FromAddress: "xpub", Txid: "aa", Method: "rbf", FeeRate: -1},
+	} {
+		response, err := svc.UnsignedTxBumpPost(t.Context(), request)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, response.Code)
+	}
+
+	mockProvider.EXPECT().BumpFee(gomock.Any(), "BTC", gomock.Any()).
+		Return(nil, fmt.Errorf("%w: change too small", domain.ErrInsufficientFunds))
+	response, err := svc.UnsignedTxBumpPost(t.Context(), cryptowalletrest.UnsignedTxBumpPostRequest{
+		CryptoSymbol: "BTC", FromAddress: "xpub", Txid: "aa", Method: "rbf",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusUnprocessableEntity, response.Code)
+}
+
+func TestBroadcastPost(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockProvider := internalportsmocks.NewMockProvider(ctrl)
+	svc := service.New(mockProvider)
+
+	response, err := svc.BroadcastPost(t.Context(), cryptowalletrest.BroadcastPostRequest{})
+
+	require.NoError(t, err)
+	assert.Equal(t, 501, response.Code)
+}
+
+func TestService_BalancesPost_RateUnavailable(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockProvider := internalportsmocks.NewMockProvider(ctrl)
+	expectValidAddresses(mockProvider)
+
+	rateErr := "exchange rate unavailable: failed to get rate from CMC"
+	btcResult := &domain.BalanceResult{
+		CryptoSymbol:  "BTC",
+		Address:       "1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa",
+		CryptoBalance: 0.5,
+		FiatSymbol:    "USD",
+		FiatValue:     float64Ptr(20000.0),
+		ExchangeRate:  float64Ptr(40000.0),
+		Change24h:     float64Ptr(0),
+		Timestamp:     time.Now(),
+		RateError:     &rateErr,
+		RateErrorCode: domain.CodeRateUnavailable,
+		RateStale:     true,
+		RateAge:       90 * time.Second,
+	}
+	ethResult := &domain.BalanceResult{
+		CryptoSymbol:  "ETH",
+		Address:       "0x742d35Cc6634C0532925a3b8D3A7F13f",
+		CryptoBalance: 1.5,
+		FiatSymbol:    "USD",
+		Timestamp:     time.Now(),
+		RateError:     &rateErr,
+		RateErrorCode: domain.CodeRateUnavailable,
+	}
+
+	mockProvider.EXPECT().GetBatchBalances(gomock.Any(), gomock.Any()).
+		Return([]*domain.BalanceResult{btcResult, ethResult}, nil)
+
+	svc := service.New(mockProvider)
+
+	request := cryptowalletrest.BalancesPostRequest{
+		Requests: []cryptowalletrest.BalancesPostRequestRequestsInner{
+			{CryptoSymbol: "BTC", Address: "1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa"},
+			{CryptoSymbol: "ETH", Address: "0x742d35Cc6634C0532925a3b8D3A7F13f"},
+		},
+	}
+
+	response, err := svc.BalancesPost(t.Context(), request)
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.Code)
+
+	responseBody, ok := response.Body.(cryptowalletrest.BalancesPost200Response)
+	require.True(t, ok)
+	require.Len(t, responseBody.Results, 2)
+
+	stale := responseBody.Results[0]
+	require.NotNil(t, stale.FiatValue)
+	assert.InEpsilon(t, 20000.0, *stale.FiatValue, 0.001)
+	assert.True(t, stale.RateStale)
+	assert.Equal(t, int64(90), stale.RateAgeSeconds)
+	assert.Equal(t, "RATE_UNAVAILABLE", stale.RateErrorCode)
+	assert.Empty(t, stale.Error)
+
+	missing := responseBody.Results[1]
+	assert.InEpsilon(t, 1.5, missing.CryptoBalance, 0.001)
+	assert.Nil(t, missing.FiatValue)
+	assert.Nil(t, missing.ExchangeRate)
+	assert.Nil(t, missing.Change24h)
+	assert.Equal(t, rateErr, missing.RateError)
+	assert.False(t, missing.RateStale)
 }
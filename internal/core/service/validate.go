@@ -0,0 +1,52 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+)
+
+// validateRequests checks every item's address before any upstream call. It
+// returns the valid requests together with a result slice in request order in
+// which each invalid item already holds its error.
+func (s Service) validateRequests(
+	ctx context.Context, requests []domain.BalanceRequest,
+) ([]domain.BalanceRequest, []*domain.BalanceResult) {
+	valid := make([]domain.BalanceRequest, 0, len(requests))
+	results := make([]*domain.BalanceResult, len(requests))
+	for i, request := range requests {
+		if _, err := s.adapter.ValidateAddress(ctx, request.CryptoSymbol, request.Address); err != nil {
+			results[i] = invalidBalanceResult(request, err)
+			continue
+		}
+		valid = append(valid, request)
+	}
+	return valid, results
+}
+
+// mergeResults fills the gaps left by validateRequests with the results of the
+// valid requests, which are in the same relative order.
+func mergeResults(results, fetched []*domain.BalanceResult) []*domain.BalanceResult {
+	next := 0
+	for i := range results {
+		if results[i] == nil && next < len(fetched) {
+			results[i] = fetched[next]
+			next++
+		}
+	}
+	return results
+}
+
+func invalidBalanceResult(request domain.BalanceRequest, err error) *domain.BalanceResult {
+	errorMsg := err.Error()
+	return &domain.BalanceResult{
+		CryptoSymbol: strings.ToUpper(request.CryptoSymbol),
+		Address:      request.Address,
+		FiatSymbol:   request.FiatSymbol,
+		Timestamp:    time.Now(),
+		Error:        &errorMsg,
+		ErrorCode:    domain.CodeOf(err),
+	}
+}
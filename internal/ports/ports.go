@@ -1,15 +1,122 @@
 package ports
 
-import "github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+import (
+	"context"
+	"time"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
+)
 
 // Provider interface for getting balance with fiat conversion.
 type Provider interface {
-	GetBalance(symbol, address, fiatSymbol string) (*domain.BalanceResult, error)
-	GetBalances(requests []domain.BalanceRequest) ([]*domain.BalanceResult, error)
-	GetBatchBalances(requests []domain.BalanceRequest) ([]*domain.BalanceResult, error)
+	GetBalance(ctx context.Context, symbol, address, fiatSymbol string) (*domain.BalanceResult, error)
+	GetBalances(ctx context.Context, requests []domain.BalanceRequest) ([]*domain.BalanceResult, error)
+	GetBatchBalances(ctx context.Context, requests []domain.BalanceRequest) ([]*domain.BalanceResult, error)
+	GetTransactions(
+		ctx context.Context, symbol, address, fiatSymbol string, page domain.PageRequest,
+	) (*domain.TransactionPage, error)
+	GetTransactionDetail(ctx context.Context, symbol, txid, wallet string) (*domain.TransactionDetail, error)
+	GetBalanceHistory(
+		ctx context.Context, requests []domain.BalanceRequest, fiatSymbol string, from, to time.Time,
+	) (*domain.BalanceHistory, error)
+	GetPortfolio(
+		ctx context.Context, wallets []domain.PortfolioWallet, fiatSymbols []string,
+	) (*domain.Portfolio, error)
+	ValidateAddress(ctx context.Context, symbol, address string) (*domain.AddressValidation, error)
+	GetReceiveAddresses(ctx context.Context, symbol, xpub string, count int) ([]domain.ReceiveAddress, error)
+	GetUTXOs(ctx context.Context, symbol, address string, filter domain.UTXOFilter) ([]domain.UTXO, error)
+	EstimateFees(ctx context.Context, symbol, fiatSymbol string) (*domain.FeeEstimates, error)
+	BuildUnsignedTx(ctx context.Context, symbol string, request domain.UnsignedTxRequest) (*domain.UnsignedTx, error)
+	BumpFee(ctx context.Context, symbol string, request domain.FeeBumpRequest) (*domain.UnsignedTx, error)
 }
 
 // CryptoProvider interface for individual cryptocurrency providers.
 type CryptoProvider interface {
-	GetBalance(address string) (float64, error)
+	GetBalance(ctx context.Context, address string) (float64, error)
+}
+
+// BalanceBreakdownProvider is implemented by crypto providers that can tell
+// confirmed funds apart from pending ones under the given options.
+type BalanceBreakdownProvider interface {
+	GetBalanceBreakdown(
+		ctx context.Context, address string, opts domain.BalanceOptions,
+	) (*domain.BalanceBreakdown, error)
+}
+
+// TransactionProvider is implemented by crypto providers that can list the
+// on-chain history of an address or xpub.
+type TransactionProvider interface {
+	GetTransactions(ctx context.Context, address string) ([]domain.Transaction, error)
+}
+
+// TransactionPager is implemented by crypto providers whose node pages the
+// history of an address by cursor, where listing all of it is expensive.
+type TransactionPager interface {
+	GetTransactionPage(ctx context.Context, address string, page domain.PageRequest) (*domain.TransactionPage, error)
+}
+
+// TransactionDetailProvider is implemented by crypto providers that can look
+// up and decode a single transaction. A non-empty wallet is the address or
+// extended public key whose parts of the transaction are marked owned.
+type TransactionDetailProvider interface {
+	GetTransactionDetail(ctx context.Context, txid, wallet string) (*domain.TransactionDetail, error)
+}
+
+// TransactionIndexer lists the transfers touching an EVM address, which plain
+// JSON-RPC cannot do. Normal transactions, internal transactions and token
+// transfers are returned together in no particular order.
+type TransactionIndexer interface {
+	GetTransfers(ctx context.Context, address string) ([]domain.IndexedTransfer, error)
+}
+
+// ReceiveAddressProvider is implemented by crypto providers that can derive
+// the next unused receive addresses of an extended public key.
+type ReceiveAddressProvider interface {
+	GetReceiveAddresses(ctx context.Context, xpub string, count int) ([]domain.ReceiveAddress, error)
+}
+
+// UTXOProvider is implemented by crypto providers of UTXO chains that can
+// list the unspent outputs of an address, xpub or descriptor.
+type UTXOProvider interface {
+	GetUTXOs(ctx context.Context, address string) ([]domain.UTXO, error)
+}
+
+// FeeEstimator is implemented by crypto providers that can estimate the
+// slow, normal and fast fees of a typical transaction.
+type FeeEstimator interface {
+	EstimateFees(ctx context.Context) (*domain.FeeEstimates, error)
+}
+
+// UnsignedTxBuilder is implemented by crypto providers that can build an
+// unsigned transaction for an air-gapped signer.
+type UnsignedTxBuilder interface {
+	BuildUnsignedTx(ctx context.Context, request domain.UnsignedTxRequest) (*domain.UnsignedTx, error)
+}
+
+// BatchTxBuilder is implemented by crypto providers of UTXO chains that can
+// build unsigned transactions with the batch options of a request.
+type BatchTxBuilder interface {
+	BuildBatchTx(ctx context.Context, request domain.UnsignedTxRequest) (*domain.UnsignedTx, error)
+}
+
+// FeeBumper is implemented by crypto providers that can build a transaction
+// speeding up an unconfirmed transaction of a wallet.
+type FeeBumper interface {
+	BumpFee(ctx context.Context, request domain.FeeBumpRequest) (*domain.UnsignedTx, error)
+}
+
+// RateProvider interface for crypto to fiat exchange rate sources.
+type RateProvider interface {
+	GetRate(ctx context.Context, cryptoSymbol, fiatSymbol string) (*domain.Rate, error)
+}
+
+// HistoricalRateProvider interface for crypto to fiat exchange rates at a past point in time.
+type HistoricalRateProvider interface {
+	GetHistoricalRate(ctx context.Context, cryptoSymbol, fiatSymbol string, at time.Time) (*domain.Rate, error)
+}
+
+// AddressValidator is implemented by crypto providers that can check an
+// address or extended public key offline, before any upstream call.
+type AddressValidator interface {
+	ValidateAddress(address string) (*domain.AddressValidation, error)
 }
@@ -0,0 +1,127 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/config"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	InstrumentationName = "github.com/airgap-solution/crypto-wallet-rest"
+
+	NetworkMainnet = "mainnet"
+	NetworkTestnet = "testnet"
+
+	testnetSuffix = "_TESTNET"
+)
+
+// Span attribute keys. Addresses, xpubs and scripthashes are never recorded.
+const (
+	AttrChainSymbol = attribute.Key("crypto.symbol")
+	AttrNetwork     = attribute.Key("crypto.network")
+	AttrEndpoint    = attribute.Key("rpc.endpoint")
+	AttrRPCMethod   = attribute.Key("rpc.method")
+	AttrCacheName   = attribute.Key("cache.name")
+	AttrCacheHit    = attribute.Key("cache.hit")
+	AttrBatchSize   = attribute.Key("batch.size")
+)
+
+// ShutdownFunc flushes pending spans and releases the exporter.
+type ShutdownFunc func(ctx context.Context) error
+
+// Setup installs the global tracer provider. When tracing is disabled the
+// global no-op provider is left in place and the returned shutdown is a no-op.
+func Setup(ctx context.Context, cfg config.TracingConfig) (ShutdownFunc, error) {
+	if !cfg.Enabled {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	opts := []otlptracehttp.Option{otlptracehttp.WithEndpoint(cfg.Endpoint)}
+	if cfg.Insecure {
+		opts = append(opts, otlptracehttp.WithInsecure())
+	}
+	exporter, err := otlptracehttp.New(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("could not create otlp exporter: %w", err)
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(
+		semconv.SchemaURL,
+		semconv.ServiceName(cfg.ServiceName),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("could not create tracing resource: %w", err)
+	}
+
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+	)
+	otel.SetTracerProvider(tp)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{}, propagation.Baggage{},
+	))
+
+	return tp.Shutdown, nil
+}
+
+// Tracer returns a tracer scoped to the given package path.
+func Tracer(pkg string) trace.Tracer {
+	return otel.Tracer(InstrumentationName + "/" + pkg)
+}
+
+// End records err on the span, if any, and ends it.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// Network derives the network name from a provider symbol such as BTC_TESTNET.
+func Network(symbol string) string {
+	if strings.HasSuffix(strings.ToUpper(symbol), testnetSuffix) {
+		return NetworkTestnet
+	}
+	return NetworkMainnet
+}
+
+// NetworkOf maps a testnet flag to its network name.
+func NetworkOf(isTestnet bool) string {
+	if isTestnet {
+		return NetworkTestnet
+	}
+	return NetworkMainnet
+}
+
+// Endpoint strips everything but the host from an upstream address so API keys
+// embedded in RPC URL paths or query strings never end up in span attributes.
+func Endpoint(raw string) string {
+	u, err := url.Parse(raw)
+	if err != nil || u.Host == "" {
+		return raw
+	}
+	return u.Host
+}
+
+// ChainAttributes returns the common attributes for a chain symbol.
+func ChainAttributes(symbol string) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		AttrChainSymbol.String(strings.ToUpper(symbol)),
+		AttrNetwork.String(Network(symbol)),
+	}
+}
@@ -0,0 +1,99 @@
+package tracing_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/airgap-solution/crypto-wallet-rest/internal/config"
+	"github.com/airgap-solution/crypto-wallet-rest/internal/tracing"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+var errUpstream = errors.New("upstream failed")
+
+func TestSetup_Disabled(t *testing.T) {
+	t.Parallel()
+
+	shutdown, err := tracing.Setup(t.Context(), config.TracingConfig{Enabled: false})
+	require.NoError(t, err)
+	require.NotNil(t, shutdown)
+	assert.NoError(t, shutdown(t.Context()))
+}
+
+func TestNetwork(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, tracing.NetworkMainnet, tracing.Network("BTC"))
+	assert.Equal(t, tracing.NetworkTestnet, tracing.Network("BTC_TESTNET"))
+	assert.Equal(t, tracing.NetworkTestnet, tracing.Network("eth_testnet"))
+	assert.Equal(t, tracing.NetworkMainnet, tracing.NetworkOf(false))
+	assert.Equal(t, tracing.NetworkTestnet, tracing.NetworkOf(true))
+}
+
+func TestEndpoint(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "mainnet.infura.io", tracing.Endpoint("https://mainnet.infura.io/v3/secret-key"))
+	assert.Equal(t, "api.kaspa.org", tracing.Endpoint("https://api.kaspa.org"))
+	assert.Equal(t, "electrum.blockstream.info:50001", tracing.Endpoint("electrum.blockstream.info:50001"))
+	assert.Equal(t, "192.168.2.71:8765", tracing.Endpoint("192.168.2.71:8765"))
+}
+
+func TestChainAttributes(t *testing.T) {
+	t.Parallel()
+
+	attrs := tracing.ChainAttributes("ltc_testnet")
+	assert.Contains(t, attrs, tracing.AttrChainSymbol.String("LTC_TESTNET"))
+	assert.Contains(t, attrs, tracing.AttrNetwork.String(tracing.NetworkTestnet))
+}
+
+func TestEnd_RecordsError(t *testing.T) {
+	t.Parallel()
+
+	recorder := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	_, span := tp.Tracer("test").Start(t.Context(), "op")
+	tracing.End(span, errUpstream)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 1)
+	assert.Equal(t, codes.Error, spans[0].Status().Code)
+	assert.Equal(t, errUpstream.Error(), spans[0].Status().Description)
+}
+
+//nolint:paralleltest // swaps the global tracer provider
+func TestMiddleware_UsesRouteTemplate(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	prev := otel.GetTracerProvider()
+	otel.SetTracerProvider(tp)
+	t.Cleanup(func() { otel.SetTracerProvider(prev) })
+
+	router := mux.NewRouter()
+	router.Use(tracing.Middleware)
+	router.HandleFunc("/transactions/{address}", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/transactions/bc1qsecretaddress", nil)
+	router.ServeHTTP(httptest.NewRecorder(), req)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 1)
+	assert.Equal(t, "GET /transactions/{address}", spans[0].Name())
+	assert.Contains(t, spans[0].Attributes(), attribute.Int("http.response.status_code", http.StatusBadGateway))
+	assert.Equal(t, codes.Error, spans[0].Status().Code)
+	for _, attr := range spans[0].Attributes() {
+		assert.NotContains(t, attr.Value.Emit(), "bc1qsecretaddress")
+	}
+}
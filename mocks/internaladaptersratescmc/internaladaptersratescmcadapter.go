@@ -1,13 +1,13 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: ./internal/adapters/provider/adapter.go
+// Source: ./internal/adapters/rates/cmc/adapter.go
 //
 // Generated by this command:
 //
-//	mockgen -source=./internal/adapters/provider/adapter.go -destination=mocks/internaladaptersprovider/internaladaptersprovideradapter.go -package=internaladaptersprovidermocks
+//	mockgen -source=./internal/adapters/rates/cmc/adapter.go -destination=mocks/internaladaptersratescmc/internaladaptersratescmcadapter.go -package=internaladaptersratescmcmocks
 //
 
-// Package internaladaptersprovidermocks is a generated GoMock package.
-package internaladaptersprovidermocks
+// Package internaladaptersratescmcmocks is a generated GoMock package.
+package internaladaptersratescmcmocks
 
 import (
 	context "context"
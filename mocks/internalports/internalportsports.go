@@ -10,7 +10,9 @@
 package internalportsmocks
 
 import (
+	context "context"
 	reflect "reflect"
+	time "time"
 
 	domain "github.com/airgap-solution/crypto-wallet-rest/internal/core/domain"
 	gomock "go.uber.org/mock/gomock"
@@ -40,49 +42,199 @@ func (m *MockProvider) EXPECT() *MockProviderMockRecorder {
 	return m.recorder
 }
 
+// BuildUnsignedTx mocks base method.
+func (m *MockProvider) BuildUnsignedTx(ctx context.Context, symbol string, request domain.UnsignedTxRequest) (*domain.UnsignedTx, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BuildUnsignedTx", ctx, symbol, request)
+	ret0, _ := ret[0].(*domain.UnsignedTx)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BuildUnsignedTx indicates an expected call of BuildUnsignedTx.
+func (mr *MockProviderMockRecorder) BuildUnsignedTx(ctx, symbol, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildUnsignedTx", reflect.TypeOf((*MockProvider)(nil).BuildUnsignedTx), ctx, symbol, request)
+}
+
+// BumpFee mocks base method.
+func (m *MockProvider) BumpFee(ctx context.Context, symbol string, request domain.FeeBumpRequest) (*domain.UnsignedTx, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BumpFee", ctx, symbol, request)
+	ret0, _ := ret[0].(*domain.UnsignedTx)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BumpFee indicates an expected call of BumpFee.
+func (mr *MockProviderMockRecorder) BumpFee(ctx, symbol, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BumpFee", reflect.TypeOf((*MockProvider)(nil).BumpFee), ctx, symbol, request)
+}
+
+// EstimateFees mocks base method.
+func (m *MockProvider) EstimateFees(ctx context.Context, symbol, fiatSymbol string) (*domain.FeeEstimates, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EstimateFees", ctx, symbol, fiatSymbol)
+	ret0, _ := ret[0].(*domain.FeeEstimates)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EstimateFees indicates an expected call of EstimateFees.
+func (mr *MockProviderMockRecorder) EstimateFees(ctx, symbol, fiatSymbol any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EstimateFees", reflect.TypeOf((*MockProvider)(nil).EstimateFees), ctx, symbol, fiatSymbol)
+}
+
 // GetBalance mocks base method.
-func (m *MockProvider) GetBalance(symbol, address, fiatSymbol string) (*domain.BalanceResult, error) {
+func (m *MockProvider) GetBalance(ctx context.Context, symbol, address, fiatSymbol string) (*domain.BalanceResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBalance", symbol, address, fiatSymbol)
+	ret := m.ctrl.Call(m, "GetBalance", ctx, symbol, address, fiatSymbol)
 	ret0, _ := ret[0].(*domain.BalanceResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetBalance indicates an expected call of GetBalance.
-func (mr *MockProviderMockRecorder) GetBalance(symbol, address, fiatSymbol any) *gomock.Call {
+func (mr *MockProviderMockRecorder) GetBalance(ctx, symbol, address, fiatSymbol any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBalance", reflect.TypeOf((*MockProvider)(nil).GetBalance), symbol, address, fiatSymbol)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBalance", reflect.TypeOf((*MockProvider)(nil).GetBalance), ctx, symbol, address, fiatSymbol)
+}
+
+// GetBalanceHistory mocks base method.
+func (m *MockProvider) GetBalanceHistory(ctx context.Context, requests []domain.BalanceRequest, fiatSymbol string, from, to time.Time) (*domain.BalanceHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBalanceHistory", ctx, requests, fiatSymbol, from, to)
+	ret0, _ := ret[0].(*domain.BalanceHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBalanceHistory indicates an expected call of GetBalanceHistory.
+func (mr *MockProviderMockRecorder) GetBalanceHistory(ctx, requests, fiatSymbol, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBalanceHistory", reflect.TypeOf((*MockProvider)(nil).GetBalanceHistory), ctx, requests, fiatSymbol, from, to)
 }
 
 // GetBalances mocks base method.
-func (m *MockProvider) GetBalances(requests []domain.BalanceRequest) ([]*domain.BalanceResult, error) {
+func (m *MockProvider) GetBalances(ctx context.Context, requests []domain.BalanceRequest) ([]*domain.BalanceResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBalances", requests)
+	ret := m.ctrl.Call(m, "GetBalances", ctx, requests)
 	ret0, _ := ret[0].([]*domain.BalanceResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetBalances indicates an expected call of GetBalances.
-func (mr *MockProviderMockRecorder) GetBalances(requests any) *gomock.Call {
+func (mr *MockProviderMockRecorder) GetBalances(ctx, requests any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBalances", reflect.TypeOf((*MockProvider)(nil).GetBalances), requests)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBalances", reflect.TypeOf((*MockProvider)(nil).GetBalances), ctx, requests)
 }
 
 // GetBatchBalances mocks base method.
-func (m *MockProvider) GetBatchBalances(requests []domain.BalanceRequest) ([]*domain.BalanceResult, error) {
+func (m *MockProvider) GetBatchBalances(ctx context.Context, requests []domain.BalanceRequest) ([]*domain.BalanceResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBatchBalances", requests)
+	ret := m.ctrl.Call(m, "GetBatchBalances", ctx, requests)
 	ret0, _ := ret[0].([]*domain.BalanceResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetBatchBalances indicates an expected call of GetBatchBalances.
-func (mr *MockProviderMockRecorder) GetBatchBalances(requests any) *gomock.Call {
+func (mr *MockProviderMockRecorder) GetBatchBalances(ctx, requests any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBatchBalances", reflect.TypeOf((*MockProvider)(nil).GetBatchBalances), ctx, requests)
+}
+
+// GetPortfolio mocks base method.
+func (m *MockProvider) GetPortfolio(ctx context.Context, wallets []domain.PortfolioWallet, fiatSymbols []string) (*domain.Portfolio, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPortfolio", ctx, wallets, fiatSymbols)
+	ret0, _ := ret[0].(*domain.Portfolio)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPortfolio indicates an expected call of GetPortfolio.
+func (mr *MockProviderMockRecorder) GetPortfolio(ctx, wallets, fiatSymbols any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPortfolio", reflect.TypeOf((*MockProvider)(nil).GetPortfolio), ctx, wallets, fiatSymbols)
+}
+
+// GetReceiveAddresses mocks base method.
+func (m *MockProvider) GetReceiveAddresses(ctx context.Context, symbol, xpub string, count int) ([]domain.ReceiveAddress, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReceiveAddresses", ctx, symbol, xpub, count)
+	ret0, _ := ret[0].([]domain.ReceiveAddress)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReceiveAddresses indicates an expected call of GetReceiveAddresses.
+func (mr *MockProviderMockRecorder) GetReceiveAddresses(ctx, symbol, xpub, count any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReceiveAddresses", reflect.TypeOf((*MockProvider)(nil).GetReceiveAddresses), ctx, symbol, xpub, count)
+}
+
+// GetTransactionDetail mocks base method.
+func (m *MockProvider) GetTransactionDetail(ctx context.Context, symbol, txid, wallet string) (*domain.TransactionDetail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransactionDetail", ctx, symbol, txid, wallet)
+	ret0, _ := ret[0].(*domain.TransactionDetail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransactionDetail indicates an expected call of GetTransactionDetail.
+func (mr *MockProviderMockRecorder) GetTransactionDetail(ctx, symbol, txid, wallet any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactionDetail", reflect.TypeOf((*MockProvider)(nil).GetTransactionDetail), ctx, symbol, txid, wallet)
+}
+
+// GetTransactions mocks base method.
+func (m *MockProvider) GetTransactions(ctx context.Context, symbol, address, fiatSymbol string, page domain.PageRequest) (*domain.TransactionPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransactions", ctx, symbol, address, fiatSymbol, page)
+	ret0, _ := ret[0].(*domain.TransactionPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransactions indicates an expected call of GetTransactions.
+func (mr *MockProviderMockRecorder) GetTransactions(ctx, symbol, address, fiatSymbol, page any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBatchBalances", reflect.TypeOf((*MockProvider)(nil).GetBatchBalances), requests)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactions", reflect.TypeOf((*MockProvider)(nil).GetTransactions), ctx, symbol, address, fiatSymbol, page)
+}
+
+// GetUTXOs mocks base method.
+func (m *MockProvider) GetUTXOs(ctx context.Context, symbol, address string, filter domain.UTXOFilter) ([]domain.UTXO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUTXOs", ctx, symbol, address, filter)
+	ret0, _ := ret[0].([]domain.UTXO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUTXOs indicates an expected call of GetUTXOs.
+func (mr *MockProviderMockRecorder) GetUTXOs(ctx, symbol, address, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUTXOs", reflect.TypeOf((*MockProvider)(nil).GetUTXOs), ctx, symbol, address, filter)
+}
+
+// ValidateAddress mocks base method.
+func (m *MockProvider) ValidateAddress(ctx context.Context, symbol, address string) (*domain.AddressValidation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateAddress", ctx, symbol, address)
+	ret0, _ := ret[0].(*domain.AddressValidation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidateAddress indicates an expected call of ValidateAddress.
+func (mr *MockProviderMockRecorder) ValidateAddress(ctx, symbol, address any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateAddress", reflect.TypeOf((*MockProvider)(nil).ValidateAddress), ctx, symbol, address)
 }
 
 // MockCryptoProvider is a mock of CryptoProvider interface.
@@ -110,16 +262,562 @@ func (m *MockCryptoProvider) EXPECT() *MockCryptoProviderMockRecorder {
 }
 
 // GetBalance mocks base method.
-func (m *MockCryptoProvider) GetBalance(address string) (float64, error) {
+func (m *MockCryptoProvider) GetBalance(ctx context.Context, address string) (float64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBalance", address)
+	ret := m.ctrl.Call(m, "GetBalance", ctx, address)
 	ret0, _ := ret[0].(float64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetBalance indicates an expected call of GetBalance.
-func (mr *MockCryptoProviderMockRecorder) GetBalance(address any) *gomock.Call {
+func (mr *MockCryptoProviderMockRecorder) GetBalance(ctx, address any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBalance", reflect.TypeOf((*MockCryptoProvider)(nil).GetBalance), ctx, address)
+}
+
+// MockBalanceBreakdownProvider is a mock of BalanceBreakdownProvider interface.
+type MockBalanceBreakdownProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockBalanceBreakdownProviderMockRecorder
+	isgomock struct{}
+}
+
+// MockBalanceBreakdownProviderMockRecorder is the mock recorder for MockBalanceBreakdownProvider.
+type MockBalanceBreakdownProviderMockRecorder struct {
+	mock *MockBalanceBreakdownProvider
+}
+
+// NewMockBalanceBreakdownProvider creates a new mock instance.
+func NewMockBalanceBreakdownProvider(ctrl *gomock.Controller) *MockBalanceBreakdownProvider {
+	mock := &MockBalanceBreakdownProvider{ctrl: ctrl}
+	mock.recorder = &MockBalanceBreakdownProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBalanceBreakdownProvider) EXPECT() *MockBalanceBreakdownProviderMockRecorder {
+	return m.recorder
+}
+
+// GetBalanceBreakdown mocks base method.
+func (m *MockBalanceBreakdownProvider) GetBalanceBreakdown(ctx context.Context, address string, opts domain.BalanceOptions) (*domain.BalanceBreakdown, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBalanceBreakdown", ctx, address, opts)
+	ret0, _ := ret[0].(*domain.BalanceBreakdown)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBalanceBreakdown indicates an expected call of GetBalanceBreakdown.
+func (mr *MockBalanceBreakdownProviderMockRecorder) GetBalanceBreakdown(ctx, address, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBalanceBreakdown", reflect.TypeOf((*MockBalanceBreakdownProvider)(nil).GetBalanceBreakdown), ctx, address, opts)
+}
+
+// MockTransactionProvider is a mock of TransactionProvider interface.
+type MockTransactionProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransactionProviderMockRecorder
+	isgomock struct{}
+}
+
+// MockTransactionProviderMockRecorder is the mock recorder for MockTransactionProvider.
+type MockTransactionProviderMockRecorder struct {
+	mock *MockTransactionProvider
+}
+
+// NewMockTransactionProvider creates a new mock instance.
+func NewMockTransactionProvider(ctrl *gomock.Controller) *MockTransactionProvider {
+	mock := &MockTransactionProvider{ctrl: ctrl}
+	mock.recorder = &MockTransactionProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransactionProvider) EXPECT() *MockTransactionProviderMockRecorder {
+	return m.recorder
+}
+
+// GetTransactions mocks base method.
+func (m *MockTransactionProvider) GetTransactions(ctx context.Context, address string) ([]domain.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransactions", ctx, address)
+	ret0, _ := ret[0].([]domain.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransactions indicates an expected call of GetTransactions.
+func (mr *MockTransactionProviderMockRecorder) GetTransactions(ctx, address any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactions", reflect.TypeOf((*MockTransactionProvider)(nil).GetTransactions), ctx, address)
+}
+
+// MockTransactionPager is a mock of TransactionPager interface.
+type MockTransactionPager struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransactionPagerMockRecorder
+	isgomock struct{}
+}
+
+// MockTransactionPagerMockRecorder is the mock recorder for MockTransactionPager.
+type MockTransactionPagerMockRecorder struct {
+	mock *MockTransactionPager
+}
+
+// NewMockTransactionPager creates a new mock instance.
+func NewMockTransactionPager(ctrl *gomock.Controller) *MockTransactionPager {
+	mock := &MockTransactionPager{ctrl: ctrl}
+	mock.recorder = &MockTransactionPagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransactionPager) EXPECT() *MockTransactionPagerMockRecorder {
+	return m.recorder
+}
+
+// GetTransactionPage mocks base method.
+func (m *MockTransactionPager) GetTransactionPage(ctx context.Context, address string, page domain.PageRequest) (*domain.TransactionPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransactionPage", ctx, address, page)
+	ret0, _ := ret[0].(*domain.TransactionPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransactionPage indicates an expected call of GetTransactionPage.
+func (mr *MockTransactionPagerMockRecorder) GetTransactionPage(ctx, address, page any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactionPage", reflect.TypeOf((*MockTransactionPager)(nil).GetTransactionPage), ctx, address, page)
+}
+
+// MockTransactionDetailProvider is a mock of TransactionDetailProvider interface.
+type MockTransactionDetailProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransactionDetailProviderMockRecorder
+	isgomock struct{}
+}
+
+// MockTransactionDetailProviderMockRecorder is the mock recorder for MockTransactionDetailProvider.
+type MockTransactionDetailProviderMockRecorder struct {
+	mock *MockTransactionDetailProvider
+}
+
+// NewMockTransactionDetailProvider creates a new mock instance.
+func NewMockTransactionDetailProvider(ctrl *gomock.Controller) *MockTransactionDetailProvider {
+	mock := &MockTransactionDetailProvider{ctrl: ctrl}
+	mock.recorder = &MockTransactionDetailProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransactionDetailProvider) EXPECT() *MockTransactionDetailProviderMockRecorder {
+	return m.recorder
+}
+
+// GetTransactionDetail mocks base method.
+func (m *MockTransactionDetailProvider) GetTransactionDetail(ctx context.Context, txid, wallet string) (*domain.TransactionDetail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransactionDetail", ctx, txid, wallet)
+	ret0, _ := ret[0].(*domain.TransactionDetail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransactionDetail indicates an expected call of GetTransactionDetail.
+func (mr *MockTransactionDetailProviderMockRecorder) GetTransactionDetail(ctx, txid, wallet any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactionDetail", reflect.TypeOf((*MockTransactionDetailProvider)(nil).GetTransactionDetail), ctx, txid, wallet)
+}
+
+// MockTransactionIndexer is a mock of TransactionIndexer interface.
+type MockTransactionIndexer struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransactionIndexerMockRecorder
+	isgomock struct{}
+}
+
+// MockTransactionIndexerMockRecorder is the mock recorder for MockTransactionIndexer.
+type MockTransactionIndexerMockRecorder struct {
+	mock *MockTransactionIndexer
+}
+
+// NewMockTransactionIndexer creates a new mock instance.
+func NewMockTransactionIndexer(ctrl *gomock.Controller) *MockTransactionIndexer {
+	mock := &MockTransactionIndexer{ctrl: ctrl}
+	mock.recorder = &MockTransactionIndexerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransactionIndexer) EXPECT() *MockTransactionIndexerMockRecorder {
+	return m.recorder
+}
+
+// GetTransfers mocks base method.
+func (m *MockTransactionIndexer) GetTransfers(ctx context.Context, address string) ([]domain.IndexedTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransfers", ctx, address)
+	ret0, _ := ret[0].([]domain.IndexedTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransfers indicates an expected call of GetTransfers.
+func (mr *MockTransactionIndexerMockRecorder) GetTransfers(ctx, address any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransfers", reflect.TypeOf((*MockTransactionIndexer)(nil).GetTransfers), ctx, address)
+}
+
+// MockReceiveAddressProvider is a mock of ReceiveAddressProvider interface.
+type MockReceiveAddressProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockReceiveAddressProviderMockRecorder
+	isgomock struct{}
+}
+
+// MockReceiveAddressProviderMockRecorder is the mock recorder for MockReceiveAddressProvider.
+type MockReceiveAddressProviderMockRecorder struct {
+	mock *MockReceiveAddressProvider
+}
+
+// NewMockReceiveAddressProvider creates a new mock instance.
+func NewMockReceiveAddressProvider(ctrl *gomock.Controller) *MockReceiveAddressProvider {
+	mock := &MockReceiveAddressProvider{ctrl: ctrl}
+	mock.recorder = &MockReceiveAddressProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReceiveAddressProvider) EXPECT() *MockReceiveAddressProviderMockRecorder {
+	return m.recorder
+}
+
+// GetReceiveAddresses mocks base method.
+func (m *MockReceiveAddressProvider) GetReceiveAddresses(ctx context.Context, xpub string, count int) ([]domain.ReceiveAddress, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReceiveAddresses", ctx, xpub, count)
+	ret0, _ := ret[0].([]domain.ReceiveAddress)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReceiveAddresses indicates an expected call of GetReceiveAddresses.
+func (mr *MockReceiveAddressProviderMockRecorder) GetReceiveAddresses(ctx, xpub, count any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReceiveAddresses", reflect.TypeOf((*MockReceiveAddressProvider)(nil).GetReceiveAddresses), ctx, xpub, count)
+}
+
+// MockUTXOProvider is a mock of UTXOProvider interface.
+type MockUTXOProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockUTXOProviderMockRecorder
+	isgomock struct{}
+}
+
+// MockUTXOProviderMockRecorder is the mock recorder for MockUTXOProvider.
+type MockUTXOProviderMockRecorder struct {
+	mock *MockUTXOProvider
+}
+
+// NewMockUTXOProvider creates a new mock instance.
+func NewMockUTXOProvider(ctrl *gomock.Controller) *MockUTXOProvider {
+	mock := &MockUTXOProvider{ctrl: ctrl}
+	mock.recorder = &MockUTXOProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUTXOProvider) EXPECT() *MockUTXOProviderMockRecorder {
+	return m.recorder
+}
+
+// GetUTXOs mocks base method.
+func (m *MockUTXOProvider) GetUTXOs(ctx context.Context, address string) ([]domain.UTXO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUTXOs", ctx, address)
+	ret0, _ := ret[0].([]domain.UTXO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUTXOs indicates an expected call of GetUTXOs.
+func (mr *MockUTXOProviderMockRecorder) GetUTXOs(ctx, address any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUTXOs", reflect.TypeOf((*MockUTXOProvider)(nil).GetUTXOs), ctx, address)
+}
+
+// MockFeeEstimator is a mock of FeeEstimator interface.
+type MockFeeEstimator struct {
+	ctrl     *gomock.Controller
+	recorder *MockFeeEstimatorMockRecorder
+	isgomock struct{}
+}
+
+// MockFeeEstimatorMockRecorder is the mock recorder for MockFeeEstimator.
+type MockFeeEstimatorMockRecorder struct {
+	mock *MockFeeEstimator
+}
+
+// NewMockFeeEstimator creates a new mock instance.
+func NewMockFeeEstimator(ctrl *gomock.Controller) *MockFeeEstimator {
+	mock := &MockFeeEstimator{ctrl: ctrl}
+	mock.recorder = &MockFeeEstimatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFeeEstimator) EXPECT() *MockFeeEstimatorMockRecorder {
+	return m.recorder
+}
+
+// EstimateFees mocks base method.
+func (m *MockFeeEstimator) EstimateFees(ctx context.Context) (*domain.FeeEstimates, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EstimateFees", ctx)
+	ret0, _ := ret[0].(*domain.FeeEstimates)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EstimateFees indicates an expected call of EstimateFees.
+func (mr *MockFeeEstimatorMockRecorder) EstimateFees(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EstimateFees", reflect.TypeOf((*MockFeeEstimator)(nil).EstimateFees), ctx)
+}
+
+// MockUnsignedTxBuilder is a mock of UnsignedTxBuilder interface.
+type MockUnsignedTxBuilder struct {
+	ctrl     *gomock.Controller
+	recorder *MockUnsignedTxBuilderMockRecorder
+	isgomock struct{}
+}
+
+// MockUnsignedTxBuilderMockRecorder is the mock recorder for MockUnsignedTxBuilder.
+type MockUnsignedTxBuilderMockRecorder struct {
+	mock *MockUnsignedTxBuilder
+}
+
+// NewMockUnsignedTxBuilder creates a new mock instance.
+func NewMockUnsignedTxBuilder(ctrl *gomock.Controller) *MockUnsignedTxBuilder {
+	mock := &MockUnsignedTxBuilder{ctrl: ctrl}
+	mock.recorder = &MockUnsignedTxBuilderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUnsignedTxBuilder) EXPECT() *MockUnsignedTxBuilderMockRecorder {
+	return m.recorder
+}
+
+// BuildUnsignedTx mocks base method.
+func (m *MockUnsignedTxBuilder) BuildUnsignedTx(ctx context.Context, request domain.UnsignedTxRequest) (*domain.UnsignedTx, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BuildUnsignedTx", ctx, request)
+	ret0, _ := ret[0].(*domain.UnsignedTx)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BuildUnsignedTx indicates an expected call of BuildUnsignedTx.
+func (mr *MockUnsignedTxBuilderMockRecorder) BuildUnsignedTx(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildUnsignedTx", reflect.TypeOf((*MockUnsignedTxBuilder)(nil).BuildUnsignedTx), ctx, request)
+}
+
+// MockBatchTxBuilder is a mock of BatchTxBuilder interface.
+type MockBatchTxBuilder struct {
+	ctrl     *gomock.Controller
+	recorder *MockBatchTxBuilderMockRecorder
+	isgomock struct{}
+}
+
+// MockBatchTxBuilderMockRecorder is the mock recorder for MockBatchTxBuilder.
+type MockBatchTxBuilderMockRecorder struct {
+	mock *MockBatchTxBuilder
+}
+
+// NewMockBatchTxBuilder creates a new mock instance.
+func NewMockBatchTxBuilder(ctrl *gomock.Controller) *MockBatchTxBuilder {
+	mock := &MockBatchTxBuilder{ctrl: ctrl}
+	mock.recorder = &MockBatchTxBuilderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBatchTxBuilder) EXPECT() *MockBatchTxBuilderMockRecorder {
+	return m.recorder
+}
+
+// BuildBatchTx mocks base method.
+func (m *MockBatchTxBuilder) BuildBatchTx(ctx context.Context, request domain.UnsignedTxRequest) (*domain.UnsignedTx, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BuildBatchTx", ctx, request)
+	ret0, _ := ret[0].(*domain.UnsignedTx)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BuildBatchTx indicates an expected call of BuildBatchTx.
+func (mr *MockBatchTxBuilderMockRecorder) BuildBatchTx(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildBatchTx", reflect.TypeOf((*MockBatchTxBuilder)(nil).BuildBatchTx), ctx, request)
+}
+
+// MockFeeBumper is a mock of FeeBumper interface.
+type MockFeeBumper struct {
+	ctrl     *gomock.Controller
+	recorder *MockFeeBumperMockRecorder
+	isgomock struct{}
+}
+
+// MockFeeBumperMockRecorder is the mock recorder for MockFeeBumper.
+type MockFeeBumperMockRecorder struct {
+	mock *MockFeeBumper
+}
+
+// NewMockFeeBumper creates a new mock instance.
+func NewMockFeeBumper(ctrl *gomock.Controller) *MockFeeBumper {
+	mock := &MockFeeBumper{ctrl: ctrl}
+	mock.recorder = &MockFeeBumperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFeeBumper) EXPECT() *MockFeeBumperMockRecorder {
+	return m.recorder
+}
+
+// BumpFee mocks base method.
+func (m *MockFeeBumper) BumpFee(ctx context.Context, request domain.FeeBumpRequest) (*domain.UnsignedTx, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BumpFee", ctx, request)
+	ret0, _ := ret[0].(*domain.UnsignedTx)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BumpFee indicates an expected call of BumpFee.
+func (mr *MockFeeBumperMockRecorder) BumpFee(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BumpFee", reflect.TypeOf((*MockFeeBumper)(nil).BumpFee), ctx, request)
+}
+
+// MockRateProvider is a mock of RateProvider interface.
+type MockRateProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockRateProviderMockRecorder
+	isgomock struct{}
+}
+
+// MockRateProviderMockRecorder is the mock recorder for MockRateProvider.
+type MockRateProviderMockRecorder struct {
+	mock *MockRateProvider
+}
+
+// NewMockRateProvider creates a new mock instance.
+func NewMockRateProvider(ctrl *gomock.Controller) *MockRateProvider {
+	mock := &MockRateProvider{ctrl: ctrl}
+	mock.recorder = &MockRateProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRateProvider) EXPECT() *MockRateProviderMockRecorder {
+	return m.recorder
+}
+
+// GetRate mocks base method.
+func (m *MockRateProvider) GetRate(ctx context.Context, cryptoSymbol, fiatSymbol string) (*domain.Rate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRate", ctx, cryptoSymbol, fiatSymbol)
+	ret0, _ := ret[0].(*domain.Rate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRate indicates an expected call of GetRate.
+func (mr *MockRateProviderMockRecorder) GetRate(ctx, cryptoSymbol, fiatSymbol any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRate", reflect.TypeOf((*MockRateProvider)(nil).GetRate), ctx, cryptoSymbol, fiatSymbol)
+}
+
+// MockHistoricalRateProvider is a mock of HistoricalRateProvider interface.
+type MockHistoricalRateProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockHistoricalRateProviderMockRecorder
+	isgomock struct{}
+}
+
+// MockHistoricalRateProviderMockRecorder is the mock recorder for MockHistoricalRateProvider.
+type MockHistoricalRateProviderMockRecorder struct {
+	mock *MockHistoricalRateProvider
+}
+
+// NewMockHistoricalRateProvider creates a new mock instance.
+func NewMockHistoricalRateProvider(ctrl *gomock.Controller) *MockHistoricalRateProvider {
+	mock := &MockHistoricalRateProvider{ctrl: ctrl}
+	mock.recorder = &MockHistoricalRateProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHistoricalRateProvider) EXPECT() *MockHistoricalRateProviderMockRecorder {
+	return m.recorder
+}
+
+// GetHistoricalRate mocks base method.
+func (m *MockHistoricalRateProvider) GetHistoricalRate(ctx context.Context, cryptoSymbol, fiatSymbol string, at time.Time) (*domain.Rate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHistoricalRate", ctx, cryptoSymbol, fiatSymbol, at)
+	ret0, _ := ret[0].(*domain.Rate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHistoricalRate indicates an expected call of GetHistoricalRate.
+func (mr *MockHistoricalRateProviderMockRecorder) GetHistoricalRate(ctx, cryptoSymbol, fiatSymbol, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHistoricalRate", reflect.TypeOf((*MockHistoricalRateProvider)(nil).GetHistoricalRate), ctx, cryptoSymbol, fiatSymbol, at)
+}
+
+// MockAddressValidator is a mock of AddressValidator interface.
+type MockAddressValidator struct {
+	ctrl     *gomock.Controller
+	recorder *MockAddressValidatorMockRecorder
+	isgomock struct{}
+}
+
+// MockAddressValidatorMockRecorder is the mock recorder for MockAddressValidator.
+type MockAddressValidatorMockRecorder struct {
+	mock *MockAddressValidator
+}
+
+// NewMockAddressValidator creates a new mock instance.
+func NewMockAddressValidator(ctrl *gomock.Controller) *MockAddressValidator {
+	mock := &MockAddressValidator{ctrl: ctrl}
+	mock.recorder = &MockAddressValidatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAddressValidator) EXPECT() *MockAddressValidatorMockRecorder {
+	return m.recorder
+}
+
+// ValidateAddress mocks base method.
+func (m *MockAddressValidator) ValidateAddress(address string) (*domain.AddressValidation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateAddress", address)
+	ret0, _ := ret[0].(*domain.AddressValidation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidateAddress indicates an expected call of ValidateAddress.
+func (mr *MockAddressValidatorMockRecorder) ValidateAddress(address any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBalance", reflect.TypeOf((*MockCryptoProvider)(nil).GetBalance), address)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateAddress", reflect.TypeOf((*MockAddressValidator)(nil).ValidateAddress), address)
 }
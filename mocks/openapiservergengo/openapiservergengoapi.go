@@ -42,6 +42,18 @@ func (m *MockDefaultAPIRouter) EXPECT() *MockDefaultAPIRouterMockRecorder {
 	return m.recorder
 }
 
+// BalancesHistoryPost mocks base method.
+func (m *MockDefaultAPIRouter) BalancesHistoryPost(arg0 http.ResponseWriter, arg1 *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "BalancesHistoryPost", arg0, arg1)
+}
+
+// BalancesHistoryPost indicates an expected call of BalancesHistoryPost.
+func (mr *MockDefaultAPIRouterMockRecorder) BalancesHistoryPost(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BalancesHistoryPost", reflect.TypeOf((*MockDefaultAPIRouter)(nil).BalancesHistoryPost), arg0, arg1)
+}
+
 // BalancesPost mocks base method.
 func (m *MockDefaultAPIRouter) BalancesPost(arg0 http.ResponseWriter, arg1 *http.Request) {
 	m.ctrl.T.Helper()
@@ -66,6 +78,42 @@ func (mr *MockDefaultAPIRouterMockRecorder) BroadcastPost(arg0, arg1 any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BroadcastPost", reflect.TypeOf((*MockDefaultAPIRouter)(nil).BroadcastPost), arg0, arg1)
 }
 
+// FeesGet mocks base method.
+func (m *MockDefaultAPIRouter) FeesGet(arg0 http.ResponseWriter, arg1 *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "FeesGet", arg0, arg1)
+}
+
+// FeesGet indicates an expected call of FeesGet.
+func (mr *MockDefaultAPIRouterMockRecorder) FeesGet(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FeesGet", reflect.TypeOf((*MockDefaultAPIRouter)(nil).FeesGet), arg0, arg1)
+}
+
+// PortfolioPost mocks base method.
+func (m *MockDefaultAPIRouter) PortfolioPost(arg0 http.ResponseWriter, arg1 *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "PortfolioPost", arg0, arg1)
+}
+
+// PortfolioPost indicates an expected call of PortfolioPost.
+func (mr *MockDefaultAPIRouterMockRecorder) PortfolioPost(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PortfolioPost", reflect.TypeOf((*MockDefaultAPIRouter)(nil).PortfolioPost), arg0, arg1)
+}
+
+// ReceiveAddressGet mocks base method.
+func (m *MockDefaultAPIRouter) ReceiveAddressGet(arg0 http.ResponseWriter, arg1 *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ReceiveAddressGet", arg0, arg1)
+}
+
+// ReceiveAddressGet indicates an expected call of ReceiveAddressGet.
+func (mr *MockDefaultAPIRouterMockRecorder) ReceiveAddressGet(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReceiveAddressGet", reflect.TypeOf((*MockDefaultAPIRouter)(nil).ReceiveAddressGet), arg0, arg1)
+}
+
 // TransactionsGet mocks base method.
 func (m *MockDefaultAPIRouter) TransactionsGet(arg0 http.ResponseWriter, arg1 *http.Request) {
 	m.ctrl.T.Helper()
@@ -78,6 +126,18 @@ func (mr *MockDefaultAPIRouterMockRecorder) TransactionsGet(arg0, arg1 any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransactionsGet", reflect.TypeOf((*MockDefaultAPIRouter)(nil).TransactionsGet), arg0, arg1)
 }
 
+// UnsignedTxBumpPost mocks base method.
+func (m *MockDefaultAPIRouter) UnsignedTxBumpPost(arg0 http.ResponseWriter, arg1 *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "UnsignedTxBumpPost", arg0, arg1)
+}
+
+// UnsignedTxBumpPost indicates an expected call of UnsignedTxBumpPost.
+func (mr *MockDefaultAPIRouterMockRecorder) UnsignedTxBumpPost(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnsignedTxBumpPost", reflect.TypeOf((*MockDefaultAPIRouter)(nil).UnsignedTxBumpPost), arg0, arg1)
+}
+
 // UnsignedTxGet mocks base method.
 func (m *MockDefaultAPIRouter) UnsignedTxGet(arg0 http.ResponseWriter, arg1 *http.Request) {
 	m.ctrl.T.Helper()
@@ -90,6 +150,42 @@ func (mr *MockDefaultAPIRouterMockRecorder) UnsignedTxGet(arg0, arg1 any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnsignedTxGet", reflect.TypeOf((*MockDefaultAPIRouter)(nil).UnsignedTxGet), arg0, arg1)
 }
 
+// UnsignedTxPost mocks base method.
+func (m *MockDefaultAPIRouter) UnsignedTxPost(arg0 http.ResponseWriter, arg1 *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "UnsignedTxPost", arg0, arg1)
+}
+
+// UnsignedTxPost indicates an expected call of UnsignedTxPost.
+func (mr *MockDefaultAPIRouterMockRecorder) UnsignedTxPost(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnsignedTxPost", reflect.TypeOf((*MockDefaultAPIRouter)(nil).UnsignedTxPost), arg0, arg1)
+}
+
+// UtxosGet mocks base method.
+func (m *MockDefaultAPIRouter) UtxosGet(arg0 http.ResponseWriter, arg1 *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "UtxosGet", arg0, arg1)
+}
+
+// UtxosGet indicates an expected call of UtxosGet.
+func (mr *MockDefaultAPIRouterMockRecorder) UtxosGet(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UtxosGet", reflect.TypeOf((*MockDefaultAPIRouter)(nil).UtxosGet), arg0, arg1)
+}
+
+// ValidateAddressGet mocks base method.
+func (m *MockDefaultAPIRouter) ValidateAddressGet(arg0 http.ResponseWriter, arg1 *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ValidateAddressGet", arg0, arg1)
+}
+
+// ValidateAddressGet indicates an expected call of ValidateAddressGet.
+func (mr *MockDefaultAPIRouterMockRecorder) ValidateAddressGet(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateAddressGet", reflect.TypeOf((*MockDefaultAPIRouter)(nil).ValidateAddressGet), arg0, arg1)
+}
+
 // MockDefaultAPIServicer is a mock of DefaultAPIServicer interface.
 type MockDefaultAPIServicer struct {
 	ctrl     *gomock.Controller
@@ -114,6 +210,21 @@ func (m *MockDefaultAPIServicer) EXPECT() *MockDefaultAPIServicerMockRecorder {
 	return m.recorder
 }
 
+// BalancesHistoryPost mocks base method.
+func (m *MockDefaultAPIServicer) BalancesHistoryPost(arg0 context.Context, arg1 cryptowalletrest.BalancesHistoryPostRequest) (cryptowalletrest.ImplResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BalancesHistoryPost", arg0, arg1)
+	ret0, _ := ret[0].(cryptowalletrest.ImplResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BalancesHistoryPost indicates an expected call of BalancesHistoryPost.
+func (mr *MockDefaultAPIServicerMockRecorder) BalancesHistoryPost(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BalancesHistoryPost", reflect.TypeOf((*MockDefaultAPIServicer)(nil).BalancesHistoryPost), arg0, arg1)
+}
+
 // BalancesPost mocks base method.
 func (m *MockDefaultAPIServicer) BalancesPost(arg0 context.Context, arg1 cryptowalletrest.BalancesPostRequest) (cryptowalletrest.ImplResponse, error) {
 	m.ctrl.T.Helper()
@@ -144,32 +255,137 @@ func (mr *MockDefaultAPIServicerMockRecorder) BroadcastPost(arg0, arg1 any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BroadcastPost", reflect.TypeOf((*MockDefaultAPIServicer)(nil).BroadcastPost), arg0, arg1)
 }
 
+// FeesGet mocks base method.
+func (m *MockDefaultAPIServicer) FeesGet(arg0 context.Context, arg1, arg2 string) (cryptowalletrest.ImplResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FeesGet", arg0, arg1, arg2)
+	ret0, _ := ret[0].(cryptowalletrest.ImplResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FeesGet indicates an expected call of FeesGet.
+func (mr *MockDefaultAPIServicerMockRecorder) FeesGet(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FeesGet", reflect.TypeOf((*MockDefaultAPIServicer)(nil).FeesGet), arg0, arg1, arg2)
+}
+
+// PortfolioPost mocks base method.
+func (m *MockDefaultAPIServicer) PortfolioPost(arg0 context.Context, arg1 cryptowalletrest.PortfolioPostRequest) (cryptowalletrest.ImplResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PortfolioPost", arg0, arg1)
+	ret0, _ := ret[0].(cryptowalletrest.ImplResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PortfolioPost indicates an expected call of PortfolioPost.
+func (mr *MockDefaultAPIServicerMockRecorder) PortfolioPost(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PortfolioPost", reflect.TypeOf((*MockDefaultAPIServicer)(nil).PortfolioPost), arg0, arg1)
+}
+
+// ReceiveAddressGet mocks base method.
+func (m *MockDefaultAPIServicer) ReceiveAddressGet(arg0 context.Context, arg1, arg2 string, arg3 int32) (cryptowalletrest.ImplResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReceiveAddressGet", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(cryptowalletrest.ImplResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReceiveAddressGet indicates an expected call of ReceiveAddressGet.
+func (mr *MockDefaultAPIServicerMockRecorder) ReceiveAddressGet(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReceiveAddressGet", reflect.TypeOf((*MockDefaultAPIServicer)(nil).ReceiveAddressGet), arg0, arg1, arg2, arg3)
+}
+
 // TransactionsGet mocks base method.
-func (m *MockDefaultAPIServicer) TransactionsGet(arg0 context.Context, arg1, arg2 string, arg3, arg4 int32) (cryptowalletrest.ImplResponse, error) {
+func (m *MockDefaultAPIServicer) TransactionsGet(arg0 context.Context, arg1, arg2, arg3 string, arg4, arg5 int32) (cryptowalletrest.ImplResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "TransactionsGet", arg0, arg1, arg2, arg3, arg4)
+	ret := m.ctrl.Call(m, "TransactionsGet", arg0, arg1, arg2, arg3, arg4, arg5)
 	ret0, _ := ret[0].(cryptowalletrest.ImplResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // TransactionsGet indicates an expected call of TransactionsGet.
-func (mr *MockDefaultAPIServicerMockRecorder) TransactionsGet(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
+func (mr *MockDefaultAPIServicerMockRecorder) TransactionsGet(arg0, arg1, arg2, arg3, arg4, arg5 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransactionsGet", reflect.TypeOf((*MockDefaultAPIServicer)(nil).TransactionsGet), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// UnsignedTxBumpPost mocks base method.
+func (m *MockDefaultAPIServicer) UnsignedTxBumpPost(arg0 context.Context, arg1 cryptowalletrest.UnsignedTxBumpPostRequest) (cryptowalletrest.ImplResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnsignedTxBumpPost", arg0, arg1)
+	ret0, _ := ret[0].(cryptowalletrest.ImplResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnsignedTxBumpPost indicates an expected call of UnsignedTxBumpPost.
+func (mr *MockDefaultAPIServicerMockRecorder) UnsignedTxBumpPost(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransactionsGet", reflect.TypeOf((*MockDefaultAPIServicer)(nil).TransactionsGet), arg0, arg1, arg2, arg3, arg4)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnsignedTxBumpPost", reflect.TypeOf((*MockDefaultAPIServicer)(nil).UnsignedTxBumpPost), arg0, arg1)
 }
 
 // UnsignedTxGet mocks base method.
-func (m *MockDefaultAPIServicer) UnsignedTxGet(arg0 context.Context, arg1, arg2, arg3, arg4 string, arg5 float64) (cryptowalletrest.ImplResponse, error) {
+func (m *MockDefaultAPIServicer) UnsignedTxGet(arg0 context.Context, arg1, arg2, arg3, arg4 string, arg5 float64, arg6, arg7 string) (cryptowalletrest.ImplResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UnsignedTxGet", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret := m.ctrl.Call(m, "UnsignedTxGet", arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7)
 	ret0, _ := ret[0].(cryptowalletrest.ImplResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UnsignedTxGet indicates an expected call of UnsignedTxGet.
-func (mr *MockDefaultAPIServicerMockRecorder) UnsignedTxGet(arg0, arg1, arg2, arg3, arg4, arg5 any) *gomock.Call {
+func (mr *MockDefaultAPIServicerMockRecorder) UnsignedTxGet(arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnsignedTxGet", reflect.TypeOf((*MockDefaultAPIServicer)(nil).UnsignedTxGet), arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7)
+}
+
+// UnsignedTxPost mocks base method.
+func (m *MockDefaultAPIServicer) UnsignedTxPost(arg0 context.Context, arg1 cryptowalletrest.UnsignedTxPostRequest) (cryptowalletrest.ImplResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnsignedTxPost", arg0, arg1)
+	ret0, _ := ret[0].(cryptowalletrest.ImplResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnsignedTxPost indicates an expected call of UnsignedTxPost.
+func (mr *MockDefaultAPIServicerMockRecorder) UnsignedTxPost(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnsignedTxPost", reflect.TypeOf((*MockDefaultAPIServicer)(nil).UnsignedTxPost), arg0, arg1)
+}
+
+// UtxosGet mocks base method.
+func (m *MockDefaultAPIServicer) UtxosGet(arg0 context.Context, arg1, arg2 string, arg3 int32, arg4 int64) (cryptowalletrest.ImplResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UtxosGet", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(cryptowalletrest.ImplResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UtxosGet indicates an expected call of UtxosGet.
+func (mr *MockDefaultAPIServicerMockRecorder) UtxosGet(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UtxosGet", reflect.TypeOf((*MockDefaultAPIServicer)(nil).UtxosGet), arg0, arg1, arg2, arg3, arg4)
+}
+
+// ValidateAddressGet mocks base method.
+func (m *MockDefaultAPIServicer) ValidateAddressGet(arg0 context.Context, arg1, arg2 string) (cryptowalletrest.ImplResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateAddressGet", arg0, arg1, arg2)
+	ret0, _ := ret[0].(cryptowalletrest.ImplResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidateAddressGet indicates an expected call of ValidateAddressGet.
+func (mr *MockDefaultAPIServicerMockRecorder) ValidateAddressGet(arg0, arg1, arg2 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnsignedTxGet", reflect.TypeOf((*MockDefaultAPIServicer)(nil).UnsignedTxGet), arg0, arg1, arg2, arg3, arg4, arg5)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateAddressGet", reflect.TypeOf((*MockDefaultAPIServicer)(nil).ValidateAddressGet), arg0, arg1, arg2)
 }
@@ -16,12 +16,144 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"strings"
 )
 
 
 // DefaultAPIService DefaultAPI service
 type DefaultAPIService service
 
+type ApiBalancesHistoryPostRequest struct {
+	ctx context.Context
+	ApiService *DefaultAPIService
+	balancesHistoryPostRequest *BalancesHistoryPostRequest
+}
+
+func (r ApiBalancesHistoryPostRequest) BalancesHistoryPostRequest(balancesHistoryPostRequest BalancesHistoryPostRequest) ApiBalancesHistoryPostRequest {
+	r.balancesHistoryPostRequest = &balancesHistoryPostRequest
+	return r
+}
+
+func (r ApiBalancesHistoryPostRequest) Execute() (*BalancesHistoryPost200Response, *http.Response, error) {
+	return r.ApiService.BalancesHistoryPostExecute(r)
+}
+
+/*
+BalancesHistoryPost Get daily portfolio fiat value over a date range
+
+Reconstructs the end of day balance of each wallet from its transaction history and values it at that day's exchange rate. Days are UTC; the range is inclusive and limited to 366 days.
+
+ @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+ @return ApiBalancesHistoryPostRequest
+*/
+func (a *DefaultAPIService) BalancesHistoryPost(ctx context.Context) ApiBalancesHistoryPostRequest {
+	return ApiBalancesHistoryPostRequest{
+		ApiService: a,
+		ctx: ctx,
+	}
+}
+
+// Execute executes the request
+//  @return BalancesHistoryPost200Response
+func (a *DefaultAPIService) BalancesHistoryPostExecute(r ApiBalancesHistoryPostRequest) (*BalancesHistoryPost200Response, *http.Response, error) {
+	var (
+		localVarHTTPMethod   = http.MethodPost
+		localVarPostBody     interface{}
+		formFiles            []formFile
+		localVarReturnValue  *BalancesHistoryPost200Response
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.BalancesHistoryPost")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/balances/history"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.balancesHistoryPostRequest == nil {
+		return localVarReturnValue, nil, reportError("balancesHistoryPostRequest is required and must be specified")
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"application/json"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	// body params
+	localVarPostBody = r.balancesHistoryPostRequest
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 400 {
+			var v ErrorResponse
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+					newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+					newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 503 {
+			var v ErrorResponse
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+					newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+					newErr.model = v
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
 type ApiBalancesPostRequest struct {
 	ctx context.Context
 	ApiService *DefaultAPIService
@@ -115,6 +247,27 @@ func (a *DefaultAPIService) BalancesPostExecute(r ApiBalancesPostRequest) (*Bala
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
 		}
+		if localVarHTTPResponse.StatusCode == 400 {
+			var v ErrorResponse
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+					newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+					newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 503 {
+			var v ErrorResponse
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+					newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+					newErr.model = v
+		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
 
@@ -223,6 +376,71 @@ func (a *DefaultAPIService) BroadcastPostExecute(r ApiBroadcastPostRequest) (*Br
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
 		}
+		if localVarHTTPResponse.StatusCode == 400 {
+			var v ErrorResponse
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+					newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+					newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 404 {
+			var v ErrorResponse
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+					newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+					newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 422 {
+			var v ErrorResponse
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+					newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+					newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 502 {
+			var v ErrorResponse
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+					newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+					newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 503 {
+			var v ErrorResponse
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+					newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+					newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 504 {
+			var v ErrorResponse
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+					newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+					newErr.model = v
+		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
 
@@ -238,68 +456,59 @@ func (a *DefaultAPIService) BroadcastPostExecute(r ApiBroadcastPostRequest) (*Br
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiTransactionsGetRequest struct {
+type ApiFeesGetRequest struct {
 	ctx context.Context
 	ApiService *DefaultAPIService
 	cryptoSymbol *string
-	address *string
-	limit *int32
-	offset *int32
+	fiatSymbol *string
 }
 
-func (r ApiTransactionsGetRequest) CryptoSymbol(cryptoSymbol string) ApiTransactionsGetRequest {
+func (r ApiFeesGetRequest) CryptoSymbol(cryptoSymbol string) ApiFeesGetRequest {
 	r.cryptoSymbol = &cryptoSymbol
 	return r
 }
 
-func (r ApiTransactionsGetRequest) Address(address string) ApiTransactionsGetRequest {
-	r.address = &address
-	return r
-}
-
-func (r ApiTransactionsGetRequest) Limit(limit int32) ApiTransactionsGetRequest {
-	r.limit = &limit
-	return r
-}
-
-func (r ApiTransactionsGetRequest) Offset(offset int32) ApiTransactionsGetRequest {
-	r.offset = &offset
+// The fiat currency the fee of a typical transaction is valued in
+func (r ApiFeesGetRequest) FiatSymbol(fiatSymbol string) ApiFeesGetRequest {
+	r.fiatSymbol = &fiatSymbol
 	return r
 }
 
-func (r ApiTransactionsGetRequest) Execute() (*TransactionsGet200Response, *http.Response, error) {
-	return r.ApiService.TransactionsGetExecute(r)
+func (r ApiFeesGetRequest) Execute() (*FeesGet200Response, *http.Response, error) {
+	return r.ApiService.FeesGetExecute(r)
 }
 
 /*
-TransactionsGet Get transaction history for an address
+FeesGet Estimate slow, normal and fast fees
+
+Estimates the fee of a typical transaction at three priority levels. BTC and LTC rates come from the Electrum blockchain.estimatefee call in sat/vB, ETH rates are the next base fee plus a priority fee percentile from eth_feeHistory in gwei, SOL rates are priority fee percentiles from getRecentPrioritizationFees in micro-lamports per compute unit on top of the signature fee, and KAS rates come from the node fee estimator in sompi per gram. Chain estimates are cached for 15 seconds.
 
  @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
- @return ApiTransactionsGetRequest
+ @return ApiFeesGetRequest
 */
-func (a *DefaultAPIService) TransactionsGet(ctx context.Context) ApiTransactionsGetRequest {
-	return ApiTransactionsGetRequest{
+func (a *DefaultAPIService) FeesGet(ctx context.Context) ApiFeesGetRequest {
+	return ApiFeesGetRequest{
 		ApiService: a,
 		ctx: ctx,
 	}
 }
 
 // Execute executes the request
-//  @return TransactionsGet200Response
-func (a *DefaultAPIService) TransactionsGetExecute(r ApiTransactionsGetRequest) (*TransactionsGet200Response, *http.Response, error) {
+//  @return FeesGet200Response
+func (a *DefaultAPIService) FeesGetExecute(r ApiFeesGetRequest) (*FeesGet200Response, *http.Response, error) {
 	var (
 		localVarHTTPMethod   = http.MethodGet
 		localVarPostBody     interface{}
 		formFiles            []formFile
-		localVarReturnValue  *TransactionsGet200Response
+		localVarReturnValue  *FeesGet200Response
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.TransactionsGet")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.FeesGet")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/transactions"
+	localVarPath := localBasePath + "/fees"
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
@@ -307,23 +516,13 @@ func (a *DefaultAPIService) TransactionsGetExecute(r ApiTransactionsGetRequest)
 	if r.cryptoSymbol == nil {
 		return localVarReturnValue, nil, reportError("cryptoSymbol is required and must be specified")
 	}
-	if r.address == nil {
-		return localVarReturnValue, nil, reportError("address is required and must be specified")
-	}
 
 	parameterAddToHeaderOrQuery(localVarQueryParams, "crypto_symbol", r.cryptoSymbol, "form", "")
-	parameterAddToHeaderOrQuery(localVarQueryParams, "address", r.address, "form", "")
-	if r.limit != nil {
-		parameterAddToHeaderOrQuery(localVarQueryParams, "limit", r.limit, "form", "")
+	if r.fiatSymbol != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "fiat_symbol", r.fiatSymbol, "form", "")
 	} else {
-		var defaultValue int32 = 50
-		r.limit = &defaultValue
-	}
-	if r.offset != nil {
-		parameterAddToHeaderOrQuery(localVarQueryParams, "offset", r.offset, "form", "")
-	} else {
-		var defaultValue int32 = 0
-		r.offset = &defaultValue
+		var defaultValue string = "USD"
+		r.fiatSymbol = &defaultValue
 	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -364,6 +563,60 @@ func (a *DefaultAPIService) TransactionsGetExecute(r ApiTransactionsGetRequest)
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
 		}
+		if localVarHTTPResponse.StatusCode == 400 {
+			var v ErrorResponse
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+					newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+					newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 404 {
+			var v ErrorResponse
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+					newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+					newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 502 {
+			var v ErrorResponse
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+					newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+					newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 503 {
+			var v ErrorResponse
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+					newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+					newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 504 {
+			var v ErrorResponse
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+					newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+					newErr.model = v
+		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
 
@@ -379,100 +632,62 @@ func (a *DefaultAPIService) TransactionsGetExecute(r ApiTransactionsGetRequest)
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiUnsignedTxGetRequest struct {
+type ApiPortfolioPostRequest struct {
 	ctx context.Context
 	ApiService *DefaultAPIService
-	cryptoSymbol *string
-	fromAddress *string
-	toAddress *string
-	amount *string
-	feeRate *float64
-}
-
-func (r ApiUnsignedTxGetRequest) CryptoSymbol(cryptoSymbol string) ApiUnsignedTxGetRequest {
-	r.cryptoSymbol = &cryptoSymbol
-	return r
+	portfolioPostRequest *PortfolioPostRequest
 }
 
-func (r ApiUnsignedTxGetRequest) FromAddress(fromAddress string) ApiUnsignedTxGetRequest {
-	r.fromAddress = &fromAddress
-	return r
-}
-
-func (r ApiUnsignedTxGetRequest) ToAddress(toAddress string) ApiUnsignedTxGetRequest {
-	r.toAddress = &toAddress
-	return r
-}
-
-func (r ApiUnsignedTxGetRequest) Amount(amount string) ApiUnsignedTxGetRequest {
-	r.amount = &amount
-	return r
-}
-
-func (r ApiUnsignedTxGetRequest) FeeRate(feeRate float64) ApiUnsignedTxGetRequest {
-	r.feeRate = &feeRate
+func (r ApiPortfolioPostRequest) PortfolioPostRequest(portfolioPostRequest PortfolioPostRequest) ApiPortfolioPostRequest {
+	r.portfolioPostRequest = &portfolioPostRequest
 	return r
 }
 
-func (r ApiUnsignedTxGetRequest) Execute() (*UnsignedTxGet200Response, *http.Response, error) {
-	return r.ApiService.UnsignedTxGetExecute(r)
+func (r ApiPortfolioPostRequest) Execute() (*PortfolioPost200Response, *http.Response, error) {
+	return r.ApiService.PortfolioPostExecute(r)
 }
 
 /*
-UnsignedTxGet Generate an unsigned transaction
+PortfolioPost Get the value of named wallets in one or more fiat currencies
+
+Values every account once per fiat currency and returns per-account, per-wallet, per-asset and grand totals with allocation percentages and the 24h change. Balances are fetched once per account regardless of how many fiat currencies are requested.
 
  @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
- @return ApiUnsignedTxGetRequest
+ @return ApiPortfolioPostRequest
 */
-func (a *DefaultAPIService) UnsignedTxGet(ctx context.Context) ApiUnsignedTxGetRequest {
-	return ApiUnsignedTxGetRequest{
+func (a *DefaultAPIService) PortfolioPost(ctx context.Context) ApiPortfolioPostRequest {
+	return ApiPortfolioPostRequest{
 		ApiService: a,
 		ctx: ctx,
 	}
 }
 
 // Execute executes the request
-//  @return UnsignedTxGet200Response
-func (a *DefaultAPIService) UnsignedTxGetExecute(r ApiUnsignedTxGetRequest) (*UnsignedTxGet200Response, *http.Response, error) {
+//  @return PortfolioPost200Response
+func (a *DefaultAPIService) PortfolioPostExecute(r ApiPortfolioPostRequest) (*PortfolioPost200Response, *http.Response, error) {
 	var (
-		localVarHTTPMethod   = http.MethodGet
+		localVarHTTPMethod   = http.MethodPost
 		localVarPostBody     interface{}
 		formFiles            []formFile
-		localVarReturnValue  *UnsignedTxGet200Response
+		localVarReturnValue  *PortfolioPost200Response
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.UnsignedTxGet")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.PortfolioPost")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/unsigned-tx"
+	localVarPath := localBasePath + "/portfolio"
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
-	if r.cryptoSymbol == nil {
-		return localVarReturnValue, nil, reportError("cryptoSymbol is required and must be specified")
-	}
-	if r.fromAddress == nil {
-		return localVarReturnValue, nil, reportError("fromAddress is required and must be specified")
-	}
-	if r.toAddress == nil {
-		return localVarReturnValue, nil, reportError("toAddress is required and must be specified")
-	}
-	if r.amount == nil {
-		return localVarReturnValue, nil, reportError("amount is required and must be specified")
+	if r.portfolioPostRequest == nil {
+		return localVarReturnValue, nil, reportError("portfolioPostRequest is required and must be specified")
 	}
 
-	parameterAddToHeaderOrQuery(localVarQueryParams, "crypto_symbol", r.cryptoSymbol, "form", "")
-	parameterAddToHeaderOrQuery(localVarQueryParams, "from_address", r.fromAddress, "form", "")
-	parameterAddToHeaderOrQuery(localVarQueryParams, "to_address", r.toAddress, "form", "")
-	parameterAddToHeaderOrQuery(localVarQueryParams, "amount", r.amount, "form", "")
-	if r.feeRate != nil {
-		parameterAddToHeaderOrQuery(localVarQueryParams, "fee_rate", r.feeRate, "form", "")
-	}
 	// to determine the Content-Type header
-	localVarHTTPContentTypes := []string{}
+	localVarHTTPContentTypes := []string{"application/json"}
 
 	// set Content-Type header
 	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
@@ -488,6 +703,8 @@ func (a *DefaultAPIService) UnsignedTxGetExecute(r ApiUnsignedTxGetRequest) (*Un
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
+	// body params
+	localVarPostBody = r.portfolioPostRequest
 	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, nil, err
@@ -510,6 +727,1593 @@ func (a *DefaultAPIService) UnsignedTxGetExecute(r ApiUnsignedTxGetRequest) (*Un
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
 		}
+		if localVarHTTPResponse.StatusCode == 400 {
+			var v ErrorResponse
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+					newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+					newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 503 {
+			var v ErrorResponse
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+					newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+					newErr.model = v
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiReceiveAddressGetRequest struct {
+	ctx context.Context
+	ApiService *DefaultAPIService
+	cryptoSymbol *string
+	xpub *string
+	count *int32
+}
+
+func (r ApiReceiveAddressGetRequest) CryptoSymbol(cryptoSymbol string) ApiReceiveAddressGetRequest {
+	r.cryptoSymbol = &cryptoSymbol
+	return r
+}
+
+func (r ApiReceiveAddressGetRequest) Xpub(xpub string) ApiReceiveAddressGetRequest {
+	r.xpub = &xpub
+	return r
+}
+
+// Number of consecutive unused addresses to return
+func (r ApiReceiveAddressGetRequest) Count(count int32) ApiReceiveAddressGetRequest {
+	r.count = &count
+	return r
+}
+
+func (r ApiReceiveAddressGetRequest) Execute() (*ReceiveAddressGet200Response, *http.Response, error) {
+	return r.ApiService.ReceiveAddressGetExecute(r)
+}
+
+/*
+ReceiveAddressGet Get the next unused receive address of an extended public key
+
+Derives addresses on the external chain of xpub and returns the first one after the last address with on-chain history, scanning with a gap limit of 20. The script type follows the key version: zpub and vpub give native segwit, ypub, upub and Mtub nested segwit, and a plain BTC xpub taproot. Set count to also return the addresses after it.
+
+ @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+ @return ApiReceiveAddressGetRequest
+*/
+func (a *DefaultAPIService) ReceiveAddressGet(ctx context.Context) ApiReceiveAddressGetRequest {
+	return ApiReceiveAddressGetRequest{
+		ApiService: a,
+		ctx: ctx,
+	}
+}
+
+// Execute executes the request
+//  @return ReceiveAddressGet200Response
+func (a *DefaultAPIService) ReceiveAddressGetExecute(r ApiReceiveAddressGetRequest) (*ReceiveAddressGet200Response, *http.Response, error) {
+	var (
+		localVarHTTPMethod   = http.MethodGet
+		localVarPostBody     interface{}
+		formFiles            []formFile
+		localVarReturnValue  *ReceiveAddressGet200Response
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.ReceiveAddressGet")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/receive-address"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.cryptoSymbol == nil {
+		return localVarReturnValue, nil, reportError("cryptoSymbol is required and must be specified")
+	}
+	if r.xpub == nil {
+		return localVarReturnValue, nil, reportError("xpub is required and must be specified")
+	}
+
+	parameterAddToHeaderOrQuery(localVarQueryParams, "crypto_symbol", r.cryptoSymbol, "form", "")
+	parameterAddToHeaderOrQuery(localVarQueryParams, "xpub", r.xpub, "form", "")
+	if r.count != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "count", r.count, "form", "")
+	} else {
+		var defaultValue int32 = 1
+		r.count = &defaultValue
+	}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 400 {
+			var v ErrorResponse
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+					newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+					newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 404 {
+			var v ErrorResponse
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+					newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+					newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 502 {
+			var v ErrorResponse
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+					newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+					newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 503 {
+			var v ErrorResponse
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+					newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+					newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 504 {
+			var v ErrorResponse
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+					newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+					newErr.model = v
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiTransactionsCryptoSymbolTxidGetRequest struct {
+	ctx context.Context
+	ApiService *DefaultAPIService
+	cryptoSymbol string
+	txid string
+	wallet *string
+}
+
+// Extended public key, or address on ETH, SOL and KAS, whose inputs, outputs and transfers are marked owned
+func (r ApiTransactionsCryptoSymbolTxidGetRequest) Wallet(wallet string) ApiTransactionsCryptoSymbolTxidGetRequest {
+	r.wallet = &wallet
+	return r
+}
+
+func (r ApiTransactionsCryptoSymbolTxidGetRequest) Execute() (*TransactionsCryptoSymbolTxidGet200Response, *http.Response, error) {
+	return r.ApiService.TransactionsCryptoSymbolTxidGetExecute(r)
+}
+
+/*
+TransactionsCryptoSymbolTxidGet Get the decoded details of one transaction
+
+Looks up one transaction and decodes it in full. BTC, LTC and KAS list the outputs the transaction spends as inputs and the outputs it creates, with their addresses and values. ETH lists the ether the transaction sends and the ERC-20 Transfer events it emits as transfers, along with every log of its receipt; ether moved by internal calls is not listed. SOL lists every instruction, inner instructions included, and the System and SPL token transfers among them as transfers between the owners of the accounts involved. With wallet set, owned marks the inputs and outputs paying the addresses of that extended public key, or of that address on KAS, and from_owned and to_owned mark the transfer sides of that address on ETH and SOL. status is failed for a transaction included in a block whose execution failed, which only cost the fee and lists no transfers. block_height and confirmations count slots on SOL and blue score on KAS.
+
+ @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+ @param cryptoSymbol
+ @param txid Transaction ID, or signature on SOL
+ @return ApiTransactionsCryptoSymbolTxidGetRequest
+*/
+func (a *DefaultAPIService) TransactionsCryptoSymbolTxidGet(ctx context.Context, cryptoSymbol string, txid string) ApiTransactionsCryptoSymbolTxidGetRequest {
+	return ApiTransactionsCryptoSymbolTxidGetRequest{
+		ApiService: a,
+		ctx: ctx,
+		cryptoSymbol: cryptoSymbol,
+		txid: txid,
+	}
+}
+
+// Execute executes the request
+//  @return TransactionsCryptoSymbolTxidGet200Response
+func (a *DefaultAPIService) TransactionsCryptoSymbolTxidGetExecute(r ApiTransactionsCryptoSymbolTxidGetRequest) (*TransactionsCryptoSymbolTxidGet200Response, *http.Response, error) {
+	var (
+		localVarHTTPMethod   = http.MethodGet
+		localVarPostBody     interface{}
+		formFiles            []formFile
+		localVarReturnValue  *TransactionsCryptoSymbolTxidGet200Response
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.TransactionsCryptoSymbolTxidGet")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/transactions/{crypto_symbol}/{txid}"
+	localVarPath = strings.Replace(localVarPath, "{"+"crypto_symbol"+"}", url.PathEscape(parameterValueToString(r.cryptoSymbol, "cryptoSymbol")), -1)
+	localVarPath = strings.Replace(localVarPath, "{"+"txid"+"}", url.PathEscape(parameterValueToString(r.txid, "txid")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	if r.wallet != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "wallet", r.wallet, "form", "")
+	}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 400 {
+			var v ErrorResponse
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+					newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+					newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 404 {
+			var v ErrorResponse
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+					newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+					newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 502 {
+			var v ErrorResponse
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+					newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+					newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 503 {
+			var v ErrorResponse
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+					newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+					newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 504 {
+			var v ErrorResponse
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+					newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+					newErr.model = v
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiTransactionsGetRequest struct {
+	ctx context.Context
+	ApiService *DefaultAPIService
+	cryptoSymbol *string
+	address *string
+	fiatSymbol *string
+	limit *int32
+	offset *int32
+	cursor *string
+	direction *string
+	since *string
+	until *string
+	minAmount *string
+	status *string
+	sort *string
+}
+
+func (r ApiTransactionsGetRequest) CryptoSymbol(cryptoSymbol string) ApiTransactionsGetRequest {
+	r.cryptoSymbol = &cryptoSymbol
+	return r
+}
+
+func (r ApiTransactionsGetRequest) Address(address string) ApiTransactionsGetRequest {
+	r.address = &address
+	return r
+}
+
+// The fiat currency used to value each transaction at its block time
+func (r ApiTransactionsGetRequest) FiatSymbol(fiatSymbol string) ApiTransactionsGetRequest {
+	r.fiatSymbol = &fiatSymbol
+	return r
+}
+
+func (r ApiTransactionsGetRequest) Limit(limit int32) ApiTransactionsGetRequest {
+	r.limit = &limit
+	return r
+}
+
+func (r ApiTransactionsGetRequest) Offset(offset int32) ApiTransactionsGetRequest {
+	r.offset = &offset
+	return r
+}
+
+// Opaque next_cursor of the previous page. Takes precedence over offset
+func (r ApiTransactionsGetRequest) Cursor(cursor string) ApiTransactionsGetRequest {
+	r.cursor = &cursor
+	return r
+}
+
+// incoming or outgoing to only list entries of that direction
+func (r ApiTransactionsGetRequest) Direction(direction string) ApiTransactionsGetRequest {
+	r.direction = &direction
+	return r
+}
+
+// RFC 3339 time, leave out entries older than this
+func (r ApiTransactionsGetRequest) Since(since string) ApiTransactionsGetRequest {
+	r.since = &since
+	return r
+}
+
+// RFC 3339 time, leave out entries newer than this
+func (r ApiTransactionsGetRequest) Until(until string) ApiTransactionsGetRequest {
+	r.until = &until
+	return r
+}
+
+// Leave out entries moving less than this amount, in whole coins or in whole tokens for token entries
+func (r ApiTransactionsGetRequest) MinAmount(minAmount string) ApiTransactionsGetRequest {
+	r.minAmount = &minAmount
+	return r
+}
+
+// confirmed or pending to only list entries with or without confirmations
+func (r ApiTransactionsGetRequest) Status(status string) ApiTransactionsGetRequest {
+	r.status = &status
+	return r
+}
+
+// desc lists newest first, asc oldest first. SOL only lists newest first
+func (r ApiTransactionsGetRequest) Sort(sort string) ApiTransactionsGetRequest {
+	r.sort = &sort
+	return r
+}
+
+func (r ApiTransactionsGetRequest) Execute() (*TransactionsGet200Response, *http.Response, error) {
+	return r.ApiService.TransactionsGetExecute(r)
+}
+
+/*
+TransactionsGet Get transaction history for an address
+
+Lists the history of an address or extended public key, newest first unless sort is asc. amount is the net change to the wallet, so outgoing amounts include the fee it paid. ETH history is read from the Etherscan or Blockscout indexer configured for the network; every ERC-20 token moved gets an entry of its own with token_contract set, under the transaction_id of the ether entry carrying the fee. SOL history is paged by the node with getSignaturesForAddress: offset and limit count signatures, entries are parsed from the System and SPL transfers and the balance changes of each transaction, and total_count is absent. KAS history merges the transactions of every active address of a kpub into one entry per transaction_id; block_height and confirmations count blue score. Filters apply before paging and total_count counts the entries that pass them; on SOL they apply within each page of signatures, so a page can hold fewer entries than limit while has_more is true. Pass next_cursor as cursor to read the next page; unlike offset it keeps its place when new transactions arrive.
+
+ @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+ @return ApiTransactionsGetRequest
+*/
+func (a *DefaultAPIService) TransactionsGet(ctx context.Context) ApiTransactionsGetRequest {
+	return ApiTransactionsGetRequest{
+		ApiService: a,
+		ctx: ctx,
+	}
+}
+
+// Execute executes the request
+//  @return TransactionsGet200Response
+func (a *DefaultAPIService) TransactionsGetExecute(r ApiTransactionsGetRequest) (*TransactionsGet200Response, *http.Response, error) {
+	var (
+		localVarHTTPMethod   = http.MethodGet
+		localVarPostBody     interface{}
+		formFiles            []formFile
+		localVarReturnValue  *TransactionsGet200Response
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.TransactionsGet")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/transactions"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.cryptoSymbol == nil {
+		return localVarReturnValue, nil, reportError("cryptoSymbol is required and must be specified")
+	}
+	if r.address == nil {
+		return localVarReturnValue, nil, reportError("address is required and must be specified")
+	}
+
+	parameterAddToHeaderOrQuery(localVarQueryParams, "crypto_symbol", r.cryptoSymbol, "form", "")
+	parameterAddToHeaderOrQuery(localVarQueryParams, "address", r.address, "form", "")
+	if r.fiatSymbol != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "fiat_symbol", r.fiatSymbol, "form", "")
+	} else {
+		var defaultValue string = "USD"
+		r.fiatSymbol = &defaultValue
+	}
+	if r.limit != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "limit", r.limit, "form", "")
+	} else {
+		var defaultValue int32 = 50
+		r.limit = &defaultValue
+	}
+	if r.offset != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "offset", r.offset, "form", "")
+	} else {
+		var defaultValue int32 = 0
+		r.offset = &defaultValue
+	}
+	if r.cursor != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "cursor", r.cursor, "form", "")
+	}
+	if r.direction != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "direction", r.direction, "form", "")
+	}
+	if r.since != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "since", r.since, "form", "")
+	}
+	if r.until != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "until", r.until, "form", "")
+	}
+	if r.minAmount != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "min_amount", r.minAmount, "form", "")
+	}
+	if r.status != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "status", r.status, "form", "")
+	}
+	if r.sort != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "sort", r.sort, "form", "")
+	} else {
+		var defaultValue string = "desc"
+		r.sort = &defaultValue
+	}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 400 {
+			var v ErrorResponse
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+					newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+					newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 404 {
+			var v ErrorResponse
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+					newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+					newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 502 {
+			var v ErrorResponse
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+					newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+					newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 503 {
+			var v ErrorResponse
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+					newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+					newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 504 {
+			var v ErrorResponse
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+					newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+					newErr.model = v
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiUnsignedTxBumpPostRequest struct {
+	ctx context.Context
+	ApiService *DefaultAPIService
+	unsignedTxBumpPostRequest *UnsignedTxBumpPostRequest
+}
+
+func (r ApiUnsignedTxBumpPostRequest) UnsignedTxBumpPostRequest(unsignedTxBumpPostRequest UnsignedTxBumpPostRequest) ApiUnsignedTxBumpPostRequest {
+	r.unsignedTxBumpPostRequest = &unsignedTxBumpPostRequest
+	return r
+}
+
+func (r ApiUnsignedTxBumpPostRequest) Execute() (*UnsignedTxBumpPost200Response, *http.Response, error) {
+	return r.ApiService.UnsignedTxBumpPostExecute(r)
+}
+
+/*
+UnsignedTxBumpPost Generate a transaction speeding up an unconfirmed one
+
+Builds a PSBT speeding up txid, an unconfirmed transaction of the wallet from_address, BTC only. With rbf the BIP-125 replacement spends the same inputs to the same outputs and takes the higher fee out of the change, paying at least the fee of txid and 1 sat/vB for its own size; every input must belong to the wallet. With cpfp a child spends the largest unspent wallet output of txid to a change address so that parent and child together pay fee_rate.
+
+ @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+ @return ApiUnsignedTxBumpPostRequest
+*/
+func (a *DefaultAPIService) UnsignedTxBumpPost(ctx context.Context) ApiUnsignedTxBumpPostRequest {
+	return ApiUnsignedTxBumpPostRequest{
+		ApiService: a,
+		ctx: ctx,
+	}
+}
+
+// Execute executes the request
+//  @return UnsignedTxBumpPost200Response
+func (a *DefaultAPIService) UnsignedTxBumpPostExecute(r ApiUnsignedTxBumpPostRequest) (*UnsignedTxBumpPost200Response, *http.Response, error) {
+	var (
+		localVarHTTPMethod   = http.MethodPost
+		localVarPostBody     interface{}
+		formFiles            []formFile
+		localVarReturnValue  *UnsignedTxBumpPost200Response
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.UnsignedTxBumpPost")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/unsigned-tx/bump"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.unsignedTxBumpPostRequest == nil {
+		return localVarReturnValue, nil, reportError("unsignedTxBumpPostRequest is required and must be specified")
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"application/json"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	// body params
+	localVarPostBody = r.unsignedTxBumpPostRequest
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 400 {
+			var v ErrorResponse
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+					newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+					newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 404 {
+			var v ErrorResponse
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+					newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+					newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 422 {
+			var v ErrorResponse
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+					newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+					newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 502 {
+			var v ErrorResponse
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+					newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+					newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 503 {
+			var v ErrorResponse
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+					newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+					newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 504 {
+			var v ErrorResponse
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+					newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+					newErr.model = v
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiUnsignedTxGetRequest struct {
+	ctx context.Context
+	ApiService *DefaultAPIService
+	cryptoSymbol *string
+	fromAddress *string
+	toAddress *string
+	amount *string
+	feeRate *float64
+	tokenContract *string
+	nonceAccount *string
+}
+
+func (r ApiUnsignedTxGetRequest) CryptoSymbol(cryptoSymbol string) ApiUnsignedTxGetRequest {
+	r.cryptoSymbol = &cryptoSymbol
+	return r
+}
+
+// Sending address, or the kpub of the wallet on KAS and its extended public key or output descriptor on BTC and LTC
+func (r ApiUnsignedTxGetRequest) FromAddress(fromAddress string) ApiUnsignedTxGetRequest {
+	r.fromAddress = &fromAddress
+	return r
+}
+
+func (r ApiUnsignedTxGetRequest) ToAddress(toAddress string) ApiUnsignedTxGetRequest {
+	r.toAddress = &toAddress
+	return r
+}
+
+// Amount in whole coins, or in whole tokens with token_contract, or max to send everything after fees
+func (r ApiUnsignedTxGetRequest) Amount(amount string) ApiUnsignedTxGetRequest {
+	r.amount = &amount
+	return r
+}
+
+// Fee rate in the rate_unit of /fees: gwei of max fee per gas on ETH, micro-lamports of priority fee per compute unit on SOL, sompi per gram on KAS, sat per vbyte on BTC, litoshi per vbyte on LTC. Defaults to the normal estimate on ETH, KAS, BTC and LTC and no priority fee on SOL
+func (r ApiUnsignedTxGetRequest) FeeRate(feeRate float64) ApiUnsignedTxGetRequest {
+	r.feeRate = &feeRate
+	return r
+}
+
+// ERC-20 contract or SPL token mint of the token to send, ETH and SOL only
+func (r ApiUnsignedTxGetRequest) TokenContract(tokenContract string) ApiUnsignedTxGetRequest {
+	r.tokenContract = &tokenContract
+	return r
+}
+
+// Durable nonce account to use instead of a recent blockhash, SOL only. from_address must be its nonce authority
+func (r ApiUnsignedTxGetRequest) NonceAccount(nonceAccount string) ApiUnsignedTxGetRequest {
+	r.nonceAccount = &nonceAccount
+	return r
+}
+
+func (r ApiUnsignedTxGetRequest) Execute() (*UnsignedTxGet200Response, *http.Response, error) {
+	return r.ApiService.UnsignedTxGetExecute(r)
+}
+
+/*
+UnsignedTxGet Generate an unsigned transaction
+
+Builds an unsigned transaction for an air-gapped signer. ETH transactions are EIP-1559 typed transactions, RLP encoded with the chain ID, nonced from the pending state and limited by eth_estimateGas; with token_contract the amount is sent as an ERC-20 transfer of that token instead of ether. SOL transactions are System transfers, or with token_contract SPL transfers of that mint that create the associated token account of the recipient when it is missing; unsigned_tx is the base64 message the signer signs as is. With nonce_account the SOL message uses the durable nonce of that account instead of a recent blockhash, so it stays valid until the nonce is advanced. KAS transactions spend the outputs of the kpub in from_address, largest first, with change to its first unused change address, and are returned as kaspawallet partially signed transactions; the fee pays for the larger of the compute mass and the KIP-9 storage mass, and outputs too small for the storage mass limit are rejected. BTC and LTC transactions are PSBTs spending the P2WPKH, P2SH-P2WPKH and P2PKH outputs, and on BTC the P2TR outputs, of the extended public key, output descriptor or address in from_address, largest first, with change to the first unused change address or back to the address; BTC inputs signal BIP-125 replaceability. Peg-outs from MWEB are not spent before they have 6 confirmations, and MWEB addresses are rejected as recipients. inputs lists the spent outputs with the path and digest each is signed with. fee_amount is the highest fee the transaction can pay, from getFeeForMessage on SOL. With amount=max the transaction sends everything the sender can after fees: BTC, LTC and KAS spend every output worth more than the fee of spending it, without change; ETH sends the pending balance less the max fee; SOL sends the balance less the fee and the rent-exempt minimum of an account without data; token transfers send every token held.
+
+ @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+ @return ApiUnsignedTxGetRequest
+*/
+func (a *DefaultAPIService) UnsignedTxGet(ctx context.Context) ApiUnsignedTxGetRequest {
+	return ApiUnsignedTxGetRequest{
+		ApiService: a,
+		ctx: ctx,
+	}
+}
+
+// Execute executes the request
+//  @return UnsignedTxGet200Response
+func (a *DefaultAPIService) UnsignedTxGetExecute(r ApiUnsignedTxGetRequest) (*UnsignedTxGet200Response, *http.Response, error) {
+	var (
+		localVarHTTPMethod   = http.MethodGet
+		localVarPostBody     interface{}
+		formFiles            []formFile
+		localVarReturnValue  *UnsignedTxGet200Response
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.UnsignedTxGet")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/unsigned-tx"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.cryptoSymbol == nil {
+		return localVarReturnValue, nil, reportError("cryptoSymbol is required and must be specified")
+	}
+	if r.fromAddress == nil {
+		return localVarReturnValue, nil, reportError("fromAddress is required and must be specified")
+	}
+	if r.toAddress == nil {
+		return localVarReturnValue, nil, reportError("toAddress is required and must be specified")
+	}
+	if r.amount == nil {
+		return localVarReturnValue, nil, reportError("amount is required and must be specified")
+	}
+
+	parameterAddToHeaderOrQuery(localVarQueryParams, "crypto_symbol", r.cryptoSymbol, "form", "")
+	parameterAddToHeaderOrQuery(localVarQueryParams, "from_address", r.fromAddress, "form", "")
+	parameterAddToHeaderOrQuery(localVarQueryParams, "to_address", r.toAddress, "form", "")
+	parameterAddToHeaderOrQuery(localVarQueryParams, "amount", r.amount, "form", "")
+	if r.feeRate != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "fee_rate", r.feeRate, "form", "")
+	}
+	if r.tokenContract != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "token_contract", r.tokenContract, "form", "")
+	}
+	if r.nonceAccount != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "nonce_account", r.nonceAccount, "form", "")
+	}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 400 {
+			var v ErrorResponse
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+					newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+					newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 404 {
+			var v ErrorResponse
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+					newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+					newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 422 {
+			var v ErrorResponse
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+					newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+					newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 502 {
+			var v ErrorResponse
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+					newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+					newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 503 {
+			var v ErrorResponse
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+					newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+					newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 504 {
+			var v ErrorResponse
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+					newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+					newErr.model = v
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiUnsignedTxPostRequest struct {
+	ctx context.Context
+	ApiService *DefaultAPIService
+	unsignedTxPostRequest *UnsignedTxPostRequest
+}
+
+func (r ApiUnsignedTxPostRequest) UnsignedTxPostRequest(unsignedTxPostRequest UnsignedTxPostRequest) ApiUnsignedTxPostRequest {
+	r.unsignedTxPostRequest = &unsignedTxPostRequest
+	return r
+}
+
+func (r ApiUnsignedTxPostRequest) Execute() (*UnsignedTxPost200Response, *http.Response, error) {
+	return r.ApiService.UnsignedTxPostExecute(r)
+}
+
+/*
+UnsignedTxPost Generate an unsigned transaction with several outputs
+
+Builds an unsigned transaction like GET /unsigned-tx from a JSON body that can pay several outputs. On BTC op_return adds a data output of up to 80 bytes after the recipients, required_inputs are spent before any other output of from_address and excluded_inputs are never spent, inputs signal BIP-125 replaceability unless disable_rbf is set, and lock_time sets the lock time of the transaction. A single output may send max to sweep from_address. Other chains take a single output without these options.
+
+ @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+ @return ApiUnsignedTxPostRequest
+*/
+func (a *DefaultAPIService) UnsignedTxPost(ctx context.Context) ApiUnsignedTxPostRequest {
+	return ApiUnsignedTxPostRequest{
+		ApiService: a,
+		ctx: ctx,
+	}
+}
+
+// Execute executes the request
+//  @return UnsignedTxPost200Response
+func (a *DefaultAPIService) UnsignedTxPostExecute(r ApiUnsignedTxPostRequest) (*UnsignedTxPost200Response, *http.Response, error) {
+	var (
+		localVarHTTPMethod   = http.MethodPost
+		localVarPostBody     interface{}
+		formFiles            []formFile
+		localVarReturnValue  *UnsignedTxPost200Response
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.UnsignedTxPost")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/unsigned-tx"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.unsignedTxPostRequest == nil {
+		return localVarReturnValue, nil, reportError("unsignedTxPostRequest is required and must be specified")
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"application/json"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	// body params
+	localVarPostBody = r.unsignedTxPostRequest
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 400 {
+			var v ErrorResponse
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+					newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+					newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 404 {
+			var v ErrorResponse
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+					newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+					newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 422 {
+			var v ErrorResponse
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+					newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+					newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 502 {
+			var v ErrorResponse
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+					newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+					newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 503 {
+			var v ErrorResponse
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+					newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+					newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 504 {
+			var v ErrorResponse
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+					newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+					newErr.model = v
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiUtxosGetRequest struct {
+	ctx context.Context
+	ApiService *DefaultAPIService
+	cryptoSymbol *string
+	address *string
+	minConfirmations *int32
+	dustThreshold *int64
+}
+
+func (r ApiUtxosGetRequest) CryptoSymbol(cryptoSymbol string) ApiUtxosGetRequest {
+	r.cryptoSymbol = &cryptoSymbol
+	return r
+}
+
+// Address, extended public key or output descriptor
+func (r ApiUtxosGetRequest) Address(address string) ApiUtxosGetRequest {
+	r.address = &address
+	return r
+}
+
+// Leave out outputs with fewer confirmations, 0 includes the mempool
+func (r ApiUtxosGetRequest) MinConfirmations(minConfirmations int32) ApiUtxosGetRequest {
+	r.minConfirmations = &minConfirmations
+	return r
+}
+
+// Leave out outputs worth less than this many satoshi, litoshi or sompi
+func (r ApiUtxosGetRequest) DustThreshold(dustThreshold int64) ApiUtxosGetRequest {
+	r.dustThreshold = &dustThreshold
+	return r
+}
+
+func (r ApiUtxosGetRequest) Execute() (*UtxosGet200Response, *http.Response, error) {
+	return r.ApiService.UtxosGetExecute(r)
+}
+
+/*
+UtxosGet List the unspent outputs of an address, xpub or descriptor
+
+Lists the UTXO set of a BTC or LTC address, SLIP-132 extended public key or single key wpkh, sh(wpkh) or tr output descriptor, or of a Kaspa address or kpub. Keys are scanned on their external and change chains up to a gap limit of 20. Values are in the smallest unit of the chain; on Kaspa block_height is the DAA score of the accepting block.
+
+ @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+ @return ApiUtxosGetRequest
+*/
+func (a *DefaultAPIService) UtxosGet(ctx context.Context) ApiUtxosGetRequest {
+	return ApiUtxosGetRequest{
+		ApiService: a,
+		ctx: ctx,
+	}
+}
+
+// Execute executes the request
+//  @return UtxosGet200Response
+func (a *DefaultAPIService) UtxosGetExecute(r ApiUtxosGetRequest) (*UtxosGet200Response, *http.Response, error) {
+	var (
+		localVarHTTPMethod   = http.MethodGet
+		localVarPostBody     interface{}
+		formFiles            []formFile
+		localVarReturnValue  *UtxosGet200Response
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.UtxosGet")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/utxos"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.cryptoSymbol == nil {
+		return localVarReturnValue, nil, reportError("cryptoSymbol is required and must be specified")
+	}
+	if r.address == nil {
+		return localVarReturnValue, nil, reportError("address is required and must be specified")
+	}
+
+	parameterAddToHeaderOrQuery(localVarQueryParams, "crypto_symbol", r.cryptoSymbol, "form", "")
+	parameterAddToHeaderOrQuery(localVarQueryParams, "address", r.address, "form", "")
+	if r.minConfirmations != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "min_confirmations", r.minConfirmations, "form", "")
+	} else {
+		var defaultValue int32 = 0
+		r.minConfirmations = &defaultValue
+	}
+	if r.dustThreshold != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "dust_threshold", r.dustThreshold, "form", "")
+	} else {
+		var defaultValue int64 = 0
+		r.dustThreshold = &defaultValue
+	}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 400 {
+			var v ErrorResponse
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+					newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+					newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 404 {
+			var v ErrorResponse
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+					newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+					newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 502 {
+			var v ErrorResponse
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+					newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+					newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 503 {
+			var v ErrorResponse
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+					newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+					newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 504 {
+			var v ErrorResponse
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+					newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+					newErr.model = v
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiValidateAddressGetRequest struct {
+	ctx context.Context
+	ApiService *DefaultAPIService
+	cryptoSymbol *string
+	address *string
+}
+
+func (r ApiValidateAddressGetRequest) CryptoSymbol(cryptoSymbol string) ApiValidateAddressGetRequest {
+	r.cryptoSymbol = &cryptoSymbol
+	return r
+}
+
+func (r ApiValidateAddressGetRequest) Address(address string) ApiValidateAddressGetRequest {
+	r.address = &address
+	return r
+}
+
+func (r ApiValidateAddressGetRequest) Execute() (*ValidateAddressGet200Response, *http.Response, error) {
+	return r.ApiService.ValidateAddressGetExecute(r)
+}
+
+/*
+ValidateAddressGet Validate an address or extended public key
+
+Checks an address or extended public key against the chain of crypto_symbol without any upstream call: EIP-55 checksum for ETH, bech32 or base58 with a network check for BTC and LTC, the Kaspa bech32 prefix for KAS and an on-curve base58 key for SOL. A malformed address, or one of the other network, is reported with valid set to false.
+
+ @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+ @return ApiValidateAddressGetRequest
+*/
+func (a *DefaultAPIService) ValidateAddressGet(ctx context.Context) ApiValidateAddressGetRequest {
+	return ApiValidateAddressGetRequest{
+		ApiService: a,
+		ctx: ctx,
+	}
+}
+
+// Execute executes the request
+//  @return ValidateAddressGet200Response
+func (a *DefaultAPIService) ValidateAddressGetExecute(r ApiValidateAddressGetRequest) (*ValidateAddressGet200Response, *http.Response, error) {
+	var (
+		localVarHTTPMethod   = http.MethodGet
+		localVarPostBody     interface{}
+		formFiles            []formFile
+		localVarReturnValue  *ValidateAddressGet200Response
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.ValidateAddressGet")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/validate-address"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.cryptoSymbol == nil {
+		return localVarReturnValue, nil, reportError("cryptoSymbol is required and must be specified")
+	}
+	if r.address == nil {
+		return localVarReturnValue, nil, reportError("address is required and must be specified")
+	}
+
+	parameterAddToHeaderOrQuery(localVarQueryParams, "crypto_symbol", r.cryptoSymbol, "form", "")
+	parameterAddToHeaderOrQuery(localVarQueryParams, "address", r.address, "form", "")
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 400 {
+			var v ErrorResponse
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+					newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+					newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 404 {
+			var v ErrorResponse
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+					newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+					newErr.model = v
+		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
 
@@ -21,6 +21,8 @@ var _ MappedNullable = &BalancesPost200Response{}
 // BalancesPost200Response struct for BalancesPost200Response
 type BalancesPost200Response struct {
 	Results []BalancesPost200ResponseResultsInner `json:"results,omitempty"`
+	// Portfolio value and price changes per fiat symbol across all results
+	Totals []BalancesPost200ResponseTotalsInner `json:"totals,omitempty"`
 	Timestamp *time.Time `json:"timestamp,omitempty"`
 }
 
@@ -73,6 +75,38 @@ func (o *BalancesPost200Response) SetResults(v []BalancesPost200ResponseResultsI
 	o.Results = v
 }
 
+// GetTotals returns the Totals field value if set, zero value otherwise.
+func (o *BalancesPost200Response) GetTotals() []BalancesPost200ResponseTotalsInner {
+	if o == nil || IsNil(o.Totals) {
+		var ret []BalancesPost200ResponseTotalsInner
+		return ret
+	}
+	return o.Totals
+}
+
+// GetTotalsOk returns a tuple with the Totals field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *BalancesPost200Response) GetTotalsOk() ([]BalancesPost200ResponseTotalsInner, bool) {
+	if o == nil || IsNil(o.Totals) {
+		return nil, false
+	}
+	return o.Totals, true
+}
+
+// HasTotals returns a boolean if a field has been set.
+func (o *BalancesPost200Response) HasTotals() bool {
+	if o != nil && !IsNil(o.Totals) {
+		return true
+	}
+
+	return false
+}
+
+// SetTotals gets a reference to the given []BalancesPost200ResponseTotalsInner and assigns it to the Totals field.
+func (o *BalancesPost200Response) SetTotals(v []BalancesPost200ResponseTotalsInner) {
+	o.Totals = v
+}
+
 // GetTimestamp returns the Timestamp field value if set, zero value otherwise.
 func (o *BalancesPost200Response) GetTimestamp() time.Time {
 	if o == nil || IsNil(o.Timestamp) {
@@ -118,6 +152,9 @@ func (o BalancesPost200Response) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.Results) {
 		toSerialize["results"] = o.Results
 	}
+	if !IsNil(o.Totals) {
+		toSerialize["totals"] = o.Totals
+	}
 	if !IsNil(o.Timestamp) {
 		toSerialize["timestamp"] = o.Timestamp
 	}
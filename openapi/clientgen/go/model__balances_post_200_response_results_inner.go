@@ -24,15 +24,40 @@ var _ MappedNullable = &BalancesPost200ResponseResultsInner{}
 type BalancesPost200ResponseResultsInner struct {
 	CryptoSymbol string `json:"crypto_symbol"`
 	Address string `json:"address"`
+	// Balance once every pending movement settles, confirmed_balance plus pending_incoming minus pending_outgoing
 	CryptoBalance float64 `json:"crypto_balance"`
+	// Funds meeting the min_confirmations or commitment of the request
+	ConfirmedBalance float64 `json:"confirmed_balance"`
+	// Funds on their way into the wallet that are not confirmed yet
+	PendingIncoming float64 `json:"pending_incoming"`
+	// Funds on their way out of the wallet that are not confirmed yet
+	PendingOutgoing float64 `json:"pending_outgoing"`
+	// LTC the wallet moved into the MWEB extension block, net of the peg-outs it received back. MWEB coins cannot be spent on the canonical chain and are not part of crypto_balance. Only reported for LTC.
+	MwebBalance *float64 `json:"mweb_balance,omitempty"`
 	FiatSymbol string `json:"fiat_symbol"`
-	FiatValue float64 `json:"fiat_value"`
-	ExchangeRate float64 `json:"exchange_rate"`
-	// Absolute change in fiat value over the last 24 hours
-	Change24h float64 `json:"change24h"`
+	// Fiat value of the balance, null when no exchange rate is available
+	FiatValue NullableFloat64 `json:"fiat_value"`
+	// Exchange rate used for the conversion, null when no exchange rate is available
+	ExchangeRate NullableFloat64 `json:"exchange_rate"`
+	// Absolute change in fiat value of the current balance over the last 24 hours, same as the fiat_change of the 24h entry in price_changes. Null when no exchange rate or no rate from 24 hours ago is available.
+	Change24h NullableFloat64 `json:"change24h"`
+	// Rate changes over the 1h, 24h, 7d and 30d windows; windows without a reference rate are left out
+	PriceChanges []PriceChange `json:"price_changes,omitempty"`
 	Timestamp time.Time `json:"timestamp"`
 	// Error message if this specific balance fetch failed
 	Error *string `json:"error,omitempty"`
+	// Stable error code if this specific balance fetch failed, same values as ErrorResponse.error
+	ErrorCode *string `json:"error_code,omitempty"`
+	// Set when the exchange rate could not be fetched. The crypto balance is still returned; fiat fields are null unless a last known rate was used (see rate_stale).
+	RateError *string `json:"rate_error,omitempty"`
+	// Stable error code for rate_error, same values as ErrorResponse.error
+	RateErrorCode *string `json:"rate_error_code,omitempty"`
+	// True when fiat fields were computed from the last known rate instead of a fresh one
+	RateStale *bool `json:"rate_stale,omitempty"`
+	// Age of the exchange rate in seconds when rate_stale is true
+	RateAgeSeconds *int64 `json:"rate_age_seconds,omitempty"`
+	// The balance converted to every fiat symbol of the request item, keyed by fiat symbol. Only present when the item has fiat_symbols.
+	Conversions *map[string]FiatConversion `json:"conversions,omitempty"`
 }
 
 type _BalancesPost200ResponseResultsInner BalancesPost200ResponseResultsInner
@@ -41,11 +66,14 @@ type _BalancesPost200ResponseResultsInner BalancesPost200ResponseResultsInner
 // This constructor will assign default values to properties that have it defined,
 // and makes sure properties required by API are set, but the set of arguments
 // will change when the set of required properties is changed
-func NewBalancesPost200ResponseResultsInner(cryptoSymbol string, address string, cryptoBalance float64, fiatSymbol string, fiatValue float64, exchangeRate float64, change24h float64, timestamp time.Time) *BalancesPost200ResponseResultsInner {
+func NewBalancesPost200ResponseResultsInner(cryptoSymbol string, address string, cryptoBalance float64, confirmedBalance float64, pendingIncoming float64, pendingOutgoing float64, fiatSymbol string, fiatValue NullableFloat64, exchangeRate NullableFloat64, change24h NullableFloat64, timestamp time.Time) *BalancesPost200ResponseResultsInner {
 	this := BalancesPost200ResponseResultsInner{}
 	this.CryptoSymbol = cryptoSymbol
 	this.Address = address
 	this.CryptoBalance = cryptoBalance
+	this.ConfirmedBalance = confirmedBalance
+	this.PendingIncoming = pendingIncoming
+	this.PendingOutgoing = pendingOutgoing
 	this.FiatSymbol = fiatSymbol
 	this.FiatValue = fiatValue
 	this.ExchangeRate = exchangeRate
@@ -134,6 +162,110 @@ func (o *BalancesPost200ResponseResultsInner) SetCryptoBalance(v float64) {
 	o.CryptoBalance = v
 }
 
+// GetConfirmedBalance returns the ConfirmedBalance field value
+func (o *BalancesPost200ResponseResultsInner) GetConfirmedBalance() float64 {
+	if o == nil {
+		var ret float64
+		return ret
+	}
+
+	return o.ConfirmedBalance
+}
+
+// GetConfirmedBalanceOk returns a tuple with the ConfirmedBalance field value
+// and a boolean to check if the value has been set.
+func (o *BalancesPost200ResponseResultsInner) GetConfirmedBalanceOk() (*float64, bool) {
+	if o == nil {
+		return nil, false
+	}
+	return &o.ConfirmedBalance, true
+}
+
+// SetConfirmedBalance sets field value
+func (o *BalancesPost200ResponseResultsInner) SetConfirmedBalance(v float64) {
+	o.ConfirmedBalance = v
+}
+
+// GetPendingIncoming returns the PendingIncoming field value
+func (o *BalancesPost200ResponseResultsInner) GetPendingIncoming() float64 {
+	if o == nil {
+		var ret float64
+		return ret
+	}
+
+	return o.PendingIncoming
+}
+
+// GetPendingIncomingOk returns a tuple with the PendingIncoming field value
+// and a boolean to check if the value has been set.
+func (o *BalancesPost200ResponseResultsInner) GetPendingIncomingOk() (*float64, bool) {
+	if o == nil {
+		return nil, false
+	}
+	return &o.PendingIncoming, true
+}
+
+// SetPendingIncoming sets field value
+func (o *BalancesPost200ResponseResultsInner) SetPendingIncoming(v float64) {
+	o.PendingIncoming = v
+}
+
+// GetPendingOutgoing returns the PendingOutgoing field value
+func (o *BalancesPost200ResponseResultsInner) GetPendingOutgoing() float64 {
+	if o == nil {
+		var ret float64
+		return ret
+	}
+
+	return o.PendingOutgoing
+}
+
+// GetPendingOutgoingOk returns a tuple with the PendingOutgoing field value
+// and a boolean to check if the value has been set.
+func (o *BalancesPost200ResponseResultsInner) GetPendingOutgoingOk() (*float64, bool) {
+	if o == nil {
+		return nil, false
+	}
+	return &o.PendingOutgoing, true
+}
+
+// SetPendingOutgoing sets field value
+func (o *BalancesPost200ResponseResultsInner) SetPendingOutgoing(v float64) {
+	o.PendingOutgoing = v
+}
+
+// GetMwebBalance returns the MwebBalance field value if set, zero value otherwise.
+func (o *BalancesPost200ResponseResultsInner) GetMwebBalance() float64 {
+	if o == nil || IsNil(o.MwebBalance) {
+		var ret float64
+		return ret
+	}
+	return *o.MwebBalance
+}
+
+// GetMwebBalanceOk returns a tuple with the MwebBalance field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *BalancesPost200ResponseResultsInner) GetMwebBalanceOk() (*float64, bool) {
+	if o == nil || IsNil(o.MwebBalance) {
+		return nil, false
+	}
+	return o.MwebBalance, true
+}
+
+// HasMwebBalance returns a boolean if a field has been set.
+func (o *BalancesPost200ResponseResultsInner) HasMwebBalance() bool {
+	if o != nil && !IsNil(o.MwebBalance) {
+		return true
+	}
+
+	return false
+}
+
+// SetMwebBalance gets a reference to the given float64 and assigns it to the MwebBalance field.
+func (o *BalancesPost200ResponseResultsInner) SetMwebBalance(v float64) {
+	o.MwebBalance = &v
+}
+
 // GetFiatSymbol returns the FiatSymbol field value
 func (o *BalancesPost200ResponseResultsInner) GetFiatSymbol() string {
 	if o == nil {
@@ -159,75 +291,113 @@ func (o *BalancesPost200ResponseResultsInner) SetFiatSymbol(v string) {
 }
 
 // GetFiatValue returns the FiatValue field value
+// If the value is explicit nil, the zero value for float64 will be returned
 func (o *BalancesPost200ResponseResultsInner) GetFiatValue() float64 {
-	if o == nil {
+	if o == nil || o.FiatValue.Get() == nil {
 		var ret float64
 		return ret
 	}
 
-	return o.FiatValue
+	return *o.FiatValue.Get()
 }
 
 // GetFiatValueOk returns a tuple with the FiatValue field value
 // and a boolean to check if the value has been set.
+// NOTE: If the value is an explicit nil, `nil, true` will be returned
 func (o *BalancesPost200ResponseResultsInner) GetFiatValueOk() (*float64, bool) {
 	if o == nil {
 		return nil, false
 	}
-	return &o.FiatValue, true
+	return o.FiatValue.Get(), o.FiatValue.IsSet()
 }
 
 // SetFiatValue sets field value
 func (o *BalancesPost200ResponseResultsInner) SetFiatValue(v float64) {
-	o.FiatValue = v
+	o.FiatValue.Set(&v)
 }
 
 // GetExchangeRate returns the ExchangeRate field value
+// If the value is explicit nil, the zero value for float64 will be returned
 func (o *BalancesPost200ResponseResultsInner) GetExchangeRate() float64 {
-	if o == nil {
+	if o == nil || o.ExchangeRate.Get() == nil {
 		var ret float64
 		return ret
 	}
 
-	return o.ExchangeRate
+	return *o.ExchangeRate.Get()
 }
 
 // GetExchangeRateOk returns a tuple with the ExchangeRate field value
 // and a boolean to check if the value has been set.
+// NOTE: If the value is an explicit nil, `nil, true` will be returned
 func (o *BalancesPost200ResponseResultsInner) GetExchangeRateOk() (*float64, bool) {
 	if o == nil {
 		return nil, false
 	}
-	return &o.ExchangeRate, true
+	return o.ExchangeRate.Get(), o.ExchangeRate.IsSet()
 }
 
 // SetExchangeRate sets field value
 func (o *BalancesPost200ResponseResultsInner) SetExchangeRate(v float64) {
-	o.ExchangeRate = v
+	o.ExchangeRate.Set(&v)
 }
 
 // GetChange24h returns the Change24h field value
+// If the value is explicit nil, the zero value for float64 will be returned
 func (o *BalancesPost200ResponseResultsInner) GetChange24h() float64 {
-	if o == nil {
+	if o == nil || o.Change24h.Get() == nil {
 		var ret float64
 		return ret
 	}
 
-	return o.Change24h
+	return *o.Change24h.Get()
 }
 
 // GetChange24hOk returns a tuple with the Change24h field value
 // and a boolean to check if the value has been set.
+// NOTE: If the value is an explicit nil, `nil, true` will be returned
 func (o *BalancesPost200ResponseResultsInner) GetChange24hOk() (*float64, bool) {
 	if o == nil {
 		return nil, false
 	}
-	return &o.Change24h, true
+	return o.Change24h.Get(), o.Change24h.IsSet()
 }
 
 // SetChange24h sets field value
 func (o *BalancesPost200ResponseResultsInner) SetChange24h(v float64) {
-	o.Change24h = v
+	o.Change24h.Set(&v)
+}
+
+// GetPriceChanges returns the PriceChanges field value if set, zero value otherwise.
+func (o *BalancesPost200ResponseResultsInner) GetPriceChanges() []PriceChange {
+	if o == nil || IsNil(o.PriceChanges) {
+		var ret []PriceChange
+		return ret
+	}
+	return o.PriceChanges
+}
+
+// GetPriceChangesOk returns a tuple with the PriceChanges field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *BalancesPost200ResponseResultsInner) GetPriceChangesOk() ([]PriceChange, bool) {
+	if o == nil || IsNil(o.PriceChanges) {
+		return nil, false
+	}
+	return o.PriceChanges, true
+}
+
+// HasPriceChanges returns a boolean if a field has been set.
+func (o *BalancesPost200ResponseResultsInner) HasPriceChanges() bool {
+	if o != nil && !IsNil(o.PriceChanges) {
+		return true
+	}
+
+	return false
+}
+
+// SetPriceChanges gets a reference to the given []PriceChange and assigns it to the PriceChanges field.
+func (o *BalancesPost200ResponseResultsInner) SetPriceChanges(v []PriceChange) {
+	o.PriceChanges = v
 }
 
 // GetTimestamp returns the Timestamp field value
@@ -286,6 +456,198 @@ func (o *BalancesPost200ResponseResultsInner) SetError(v string) {
 	o.Error = &v
 }
 
+// GetErrorCode returns the ErrorCode field value if set, zero value otherwise.
+func (o *BalancesPost200ResponseResultsInner) GetErrorCode() string {
+	if o == nil || IsNil(o.ErrorCode) {
+		var ret string
+		return ret
+	}
+	return *o.ErrorCode
+}
+
+// GetErrorCodeOk returns a tuple with the ErrorCode field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *BalancesPost200ResponseResultsInner) GetErrorCodeOk() (*string, bool) {
+	if o == nil || IsNil(o.ErrorCode) {
+		return nil, false
+	}
+	return o.ErrorCode, true
+}
+
+// HasErrorCode returns a boolean if a field has been set.
+func (o *BalancesPost200ResponseResultsInner) HasErrorCode() bool {
+	if o != nil && !IsNil(o.ErrorCode) {
+		return true
+	}
+
+	return false
+}
+
+// SetErrorCode gets a reference to the given string and assigns it to the ErrorCode field.
+func (o *BalancesPost200ResponseResultsInner) SetErrorCode(v string) {
+	o.ErrorCode = &v
+}
+
+// GetRateError returns the RateError field value if set, zero value otherwise.
+func (o *BalancesPost200ResponseResultsInner) GetRateError() string {
+	if o == nil || IsNil(o.RateError) {
+		var ret string
+		return ret
+	}
+	return *o.RateError
+}
+
+// GetRateErrorOk returns a tuple with the RateError field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *BalancesPost200ResponseResultsInner) GetRateErrorOk() (*string, bool) {
+	if o == nil || IsNil(o.RateError) {
+		return nil, false
+	}
+	return o.RateError, true
+}
+
+// HasRateError returns a boolean if a field has been set.
+func (o *BalancesPost200ResponseResultsInner) HasRateError() bool {
+	if o != nil && !IsNil(o.RateError) {
+		return true
+	}
+
+	return false
+}
+
+// SetRateError gets a reference to the given string and assigns it to the RateError field.
+func (o *BalancesPost200ResponseResultsInner) SetRateError(v string) {
+	o.RateError = &v
+}
+
+// GetRateErrorCode returns the RateErrorCode field value if set, zero value otherwise.
+func (o *BalancesPost200ResponseResultsInner) GetRateErrorCode() string {
+	if o == nil || IsNil(o.RateErrorCode) {
+		var ret string
+		return ret
+	}
+	return *o.RateErrorCode
+}
+
+// GetRateErrorCodeOk returns a tuple with the RateErrorCode field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *BalancesPost200ResponseResultsInner) GetRateErrorCodeOk() (*string, bool) {
+	if o == nil || IsNil(o.RateErrorCode) {
+		return nil, false
+	}
+	return o.RateErrorCode, true
+}
+
+// HasRateErrorCode returns a boolean if a field has been set.
+func (o *BalancesPost200ResponseResultsInner) HasRateErrorCode() bool {
+	if o != nil && !IsNil(o.RateErrorCode) {
+		return true
+	}
+
+	return false
+}
+
+// SetRateErrorCode gets a reference to the given string and assigns it to the RateErrorCode field.
+func (o *BalancesPost200ResponseResultsInner) SetRateErrorCode(v string) {
+	o.RateErrorCode = &v
+}
+
+// GetRateStale returns the RateStale field value if set, zero value otherwise.
+func (o *BalancesPost200ResponseResultsInner) GetRateStale() bool {
+	if o == nil || IsNil(o.RateStale) {
+		var ret bool
+		return ret
+	}
+	return *o.RateStale
+}
+
+// GetRateStaleOk returns a tuple with the RateStale field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *BalancesPost200ResponseResultsInner) GetRateStaleOk() (*bool, bool) {
+	if o == nil || IsNil(o.RateStale) {
+		return nil, false
+	}
+	return o.RateStale, true
+}
+
+// HasRateStale returns a boolean if a field has been set.
+func (o *BalancesPost200ResponseResultsInner) HasRateStale() bool {
+	if o != nil && !IsNil(o.RateStale) {
+		return true
+	}
+
+	return false
+}
+
+// SetRateStale gets a reference to the given bool and assigns it to the RateStale field.
+func (o *BalancesPost200ResponseResultsInner) SetRateStale(v bool) {
+	o.RateStale = &v
+}
+
+// GetRateAgeSeconds returns the RateAgeSeconds field value if set, zero value otherwise.
+func (o *BalancesPost200ResponseResultsInner) GetRateAgeSeconds() int64 {
+	if o == nil || IsNil(o.RateAgeSeconds) {
+		var ret int64
+		return ret
+	}
+	return *o.RateAgeSeconds
+}
+
+// GetRateAgeSecondsOk returns a tuple with the RateAgeSeconds field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *BalancesPost200ResponseResultsInner) GetRateAgeSecondsOk() (*int64, bool) {
+	if o == nil || IsNil(o.RateAgeSeconds) {
+		return nil, false
+	}
+	return o.RateAgeSeconds, true
+}
+
+// HasRateAgeSeconds returns a boolean if a field has been set.
+func (o *BalancesPost200ResponseResultsInner) HasRateAgeSeconds() bool {
+	if o != nil && !IsNil(o.RateAgeSeconds) {
+		return true
+	}
+
+	return false
+}
+
+// SetRateAgeSeconds gets a reference to the given int64 and assigns it to the RateAgeSeconds field.
+func (o *BalancesPost200ResponseResultsInner) SetRateAgeSeconds(v int64) {
+	o.RateAgeSeconds = &v
+}
+
+// GetConversions returns the Conversions field value if set, zero value otherwise.
+func (o *BalancesPost200ResponseResultsInner) GetConversions() map[string]FiatConversion {
+	if o == nil || IsNil(o.Conversions) {
+		var ret map[string]FiatConversion
+		return ret
+	}
+	return *o.Conversions
+}
+
+// GetConversionsOk returns a tuple with the Conversions field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *BalancesPost200ResponseResultsInner) GetConversionsOk() (*map[string]FiatConversion, bool) {
+	if o == nil || IsNil(o.Conversions) {
+		return nil, false
+	}
+	return o.Conversions, true
+}
+
+// HasConversions returns a boolean if a field has been set.
+func (o *BalancesPost200ResponseResultsInner) HasConversions() bool {
+	if o != nil && !IsNil(o.Conversions) {
+		return true
+	}
+
+	return false
+}
+
+// SetConversions gets a reference to the given map[string]FiatConversion and assigns it to the Conversions field.
+func (o *BalancesPost200ResponseResultsInner) SetConversions(v map[string]FiatConversion) {
+	o.Conversions = &v
+}
+
 func (o BalancesPost200ResponseResultsInner) MarshalJSON() ([]byte, error) {
 	toSerialize,err := o.ToMap()
 	if err != nil {
@@ -299,14 +661,41 @@ func (o BalancesPost200ResponseResultsInner) ToMap() (map[string]interface{}, er
 	toSerialize["crypto_symbol"] = o.CryptoSymbol
 	toSerialize["address"] = o.Address
 	toSerialize["crypto_balance"] = o.CryptoBalance
+	toSerialize["confirmed_balance"] = o.ConfirmedBalance
+	toSerialize["pending_incoming"] = o.PendingIncoming
+	toSerialize["pending_outgoing"] = o.PendingOutgoing
+	if !IsNil(o.MwebBalance) {
+		toSerialize["mweb_balance"] = o.MwebBalance
+	}
 	toSerialize["fiat_symbol"] = o.FiatSymbol
-	toSerialize["fiat_value"] = o.FiatValue
-	toSerialize["exchange_rate"] = o.ExchangeRate
-	toSerialize["change24h"] = o.Change24h
+	toSerialize["fiat_value"] = o.FiatValue.Get()
+	toSerialize["exchange_rate"] = o.ExchangeRate.Get()
+	toSerialize["change24h"] = o.Change24h.Get()
+	if !IsNil(o.PriceChanges) {
+		toSerialize["price_changes"] = o.PriceChanges
+	}
 	toSerialize["timestamp"] = o.Timestamp
 	if !IsNil(o.Error) {
 		toSerialize["error"] = o.Error
 	}
+	if !IsNil(o.ErrorCode) {
+		toSerialize["error_code"] = o.ErrorCode
+	}
+	if !IsNil(o.RateError) {
+		toSerialize["rate_error"] = o.RateError
+	}
+	if !IsNil(o.RateErrorCode) {
+		toSerialize["rate_error_code"] = o.RateErrorCode
+	}
+	if !IsNil(o.RateStale) {
+		toSerialize["rate_stale"] = o.RateStale
+	}
+	if !IsNil(o.RateAgeSeconds) {
+		toSerialize["rate_age_seconds"] = o.RateAgeSeconds
+	}
+	if !IsNil(o.Conversions) {
+		toSerialize["conversions"] = o.Conversions
+	}
 	return toSerialize, nil
 }
 
@@ -318,6 +707,9 @@ func (o *BalancesPost200ResponseResultsInner) UnmarshalJSON(data []byte) (err er
 		"crypto_symbol",
 		"address",
 		"crypto_balance",
+		"confirmed_balance",
+		"pending_incoming",
+		"pending_outgoing",
 		"fiat_symbol",
 		"fiat_value",
 		"exchange_rate",
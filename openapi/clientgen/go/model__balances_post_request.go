@@ -22,8 +22,12 @@ var _ MappedNullable = &BalancesPostRequest{}
 // BalancesPostRequest struct for BalancesPostRequest
 type BalancesPostRequest struct {
 	Requests []BalancesPostRequestRequestsInner `json:"requests"`
-	// Default fiat currency symbol for all requests if not specified individually
+	// Default ISO 4217 fiat currency symbol for all requests if not specified individually
 	FiatSymbol *string `json:"fiat_symbol,omitempty"`
+	// Confirmations BTC, LTC and ETH funds need to count as confirmed; younger funds are reported as pending, except BTC and LTC change the wallet paid to itself. Defaults to 1. Kaspa balances are always reported as confirmed.
+	MinConfirmations *int64 `json:"min_confirmations,omitempty"`
+	// Solana commitment level a balance needs to count as confirmed; the difference to the processed balance is reported as pending. Defaults to finalized.
+	Commitment *string `json:"commitment,omitempty"`
 }
 
 type _BalancesPostRequest BalancesPostRequest
@@ -106,6 +110,70 @@ func (o *BalancesPostRequest) SetFiatSymbol(v string) {
 	o.FiatSymbol = &v
 }
 
+// GetMinConfirmations returns the MinConfirmations field value if set, zero value otherwise.
+func (o *BalancesPostRequest) GetMinConfirmations() int64 {
+	if o == nil || IsNil(o.MinConfirmations) {
+		var ret int64
+		return ret
+	}
+	return *o.MinConfirmations
+}
+
+// GetMinConfirmationsOk returns a tuple with the MinConfirmations field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *BalancesPostRequest) GetMinConfirmationsOk() (*int64, bool) {
+	if o == nil || IsNil(o.MinConfirmations) {
+		return nil, false
+	}
+	return o.MinConfirmations, true
+}
+
+// HasMinConfirmations returns a boolean if a field has been set.
+func (o *BalancesPostRequest) HasMinConfirmations() bool {
+	if o != nil && !IsNil(o.MinConfirmations) {
+		return true
+	}
+
+	return false
+}
+
+// SetMinConfirmations gets a reference to the given int64 and assigns it to the MinConfirmations field.
+func (o *BalancesPostRequest) SetMinConfirmations(v int64) {
+	o.MinConfirmations = &v
+}
+
+// GetCommitment returns the Commitment field value if set, zero value otherwise.
+func (o *BalancesPostRequest) GetCommitment() string {
+	if o == nil || IsNil(o.Commitment) {
+		var ret string
+		return ret
+	}
+	return *o.Commitment
+}
+
+// GetCommitmentOk returns a tuple with the Commitment field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *BalancesPostRequest) GetCommitmentOk() (*string, bool) {
+	if o == nil || IsNil(o.Commitment) {
+		return nil, false
+	}
+	return o.Commitment, true
+}
+
+// HasCommitment returns a boolean if a field has been set.
+func (o *BalancesPostRequest) HasCommitment() bool {
+	if o != nil && !IsNil(o.Commitment) {
+		return true
+	}
+
+	return false
+}
+
+// SetCommitment gets a reference to the given string and assigns it to the Commitment field.
+func (o *BalancesPostRequest) SetCommitment(v string) {
+	o.Commitment = &v
+}
+
 func (o BalancesPostRequest) MarshalJSON() ([]byte, error) {
 	toSerialize,err := o.ToMap()
 	if err != nil {
@@ -120,6 +188,12 @@ func (o BalancesPostRequest) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.FiatSymbol) {
 		toSerialize["fiat_symbol"] = o.FiatSymbol
 	}
+	if !IsNil(o.MinConfirmations) {
+		toSerialize["min_confirmations"] = o.MinConfirmations
+	}
+	if !IsNil(o.Commitment) {
+		toSerialize["commitment"] = o.Commitment
+	}
 	return toSerialize, nil
 }
 
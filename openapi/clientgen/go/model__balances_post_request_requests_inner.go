@@ -25,8 +25,10 @@ type BalancesPostRequestRequestsInner struct {
 	CryptoSymbol string `json:"crypto_symbol"`
 	// The cryptocurrency address or xpub
 	Address string `json:"address"`
-	// The fiat currency symbol for conversion (USD, EUR, CAD, etc.)
+	// The ISO 4217 fiat currency symbol for conversion (USD, EUR, CAD, etc.). Defaults to the request level fiat_symbol, or to the first entry of fiat_symbols when those are given.
 	FiatSymbol *string `json:"fiat_symbol,omitempty"`
+	// Additional ISO 4217 fiat currency symbols to convert the balance to. Each one is returned in conversions; the balance is only fetched once.
+	FiatSymbols []string `json:"fiat_symbols,omitempty"`
 }
 
 type _BalancesPostRequestRequestsInner BalancesPostRequestRequestsInner
@@ -39,8 +41,6 @@ func NewBalancesPostRequestRequestsInner(cryptoSymbol string, address string) *B
 	this := BalancesPostRequestRequestsInner{}
 	this.CryptoSymbol = cryptoSymbol
 	this.Address = address
-	var fiatSymbol string = "USD"
-	this.FiatSymbol = &fiatSymbol
 	return &this
 }
 
@@ -49,8 +49,6 @@ func NewBalancesPostRequestRequestsInner(cryptoSymbol string, address string) *B
 // but it doesn't guarantee that properties required by API are set
 func NewBalancesPostRequestRequestsInnerWithDefaults() *BalancesPostRequestRequestsInner {
 	this := BalancesPostRequestRequestsInner{}
-	var fiatSymbol string = "USD"
-	this.FiatSymbol = &fiatSymbol
 	return &this
 }
 
@@ -134,6 +132,38 @@ func (o *BalancesPostRequestRequestsInner) SetFiatSymbol(v string) {
 	o.FiatSymbol = &v
 }
 
+// GetFiatSymbols returns the FiatSymbols field value if set, zero value otherwise.
+func (o *BalancesPostRequestRequestsInner) GetFiatSymbols() []string {
+	if o == nil || IsNil(o.FiatSymbols) {
+		var ret []string
+		return ret
+	}
+	return o.FiatSymbols
+}
+
+// GetFiatSymbolsOk returns a tuple with the FiatSymbols field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *BalancesPostRequestRequestsInner) GetFiatSymbolsOk() ([]string, bool) {
+	if o == nil || IsNil(o.FiatSymbols) {
+		return nil, false
+	}
+	return o.FiatSymbols, true
+}
+
+// HasFiatSymbols returns a boolean if a field has been set.
+func (o *BalancesPostRequestRequestsInner) HasFiatSymbols() bool {
+	if o != nil && !IsNil(o.FiatSymbols) {
+		return true
+	}
+
+	return false
+}
+
+// SetFiatSymbols gets a reference to the given []string and assigns it to the FiatSymbols field.
+func (o *BalancesPostRequestRequestsInner) SetFiatSymbols(v []string) {
+	o.FiatSymbols = v
+}
+
 func (o BalancesPostRequestRequestsInner) MarshalJSON() ([]byte, error) {
 	toSerialize,err := o.ToMap()
 	if err != nil {
@@ -149,6 +179,9 @@ func (o BalancesPostRequestRequestsInner) ToMap() (map[string]interface{}, error
 	if !IsNil(o.FiatSymbol) {
 		toSerialize["fiat_symbol"] = o.FiatSymbol
 	}
+	if !IsNil(o.FiatSymbols) {
+		toSerialize["fiat_symbols"] = o.FiatSymbols
+	}
 	return toSerialize, nil
 }
 
@@ -24,8 +24,11 @@ type TransactionsGet200Response struct {
 	CryptoSymbol string `json:"crypto_symbol"`
 	Address string `json:"address"`
 	Transactions []Transaction `json:"transactions"`
-	TotalCount int32 `json:"total_count"`
+	// Number of entries in the whole history, absent where the chain pages history by cursor and the total is unknown
+	TotalCount NullableInt32 `json:"total_count,omitempty"`
 	HasMore bool `json:"has_more"`
+	// Cursor of the next page, absent on the last page
+	NextCursor *string `json:"next_cursor,omitempty"`
 }
 
 type _TransactionsGet200Response TransactionsGet200Response
@@ -34,12 +37,11 @@ type _TransactionsGet200Response TransactionsGet200Response
 // This constructor will assign default values to properties that have it defined,
 // and makes sure properties required by API are set, but the set of arguments
 // will change when the set of required properties is changed
-func NewTransactionsGet200Response(cryptoSymbol string, address string, transactions []Transaction, totalCount int32, hasMore bool) *TransactionsGet200Response {
+func NewTransactionsGet200Response(cryptoSymbol string, address string, transactions []Transaction, hasMore bool) *TransactionsGet200Response {
 	this := TransactionsGet200Response{}
 	this.CryptoSymbol = cryptoSymbol
 	this.Address = address
 	this.Transactions = transactions
-	this.TotalCount = totalCount
 	this.HasMore = hasMore
 	return &this
 }
@@ -124,28 +126,46 @@ func (o *TransactionsGet200Response) SetTransactions(v []Transaction) {
 	o.Transactions = v
 }
 
-// GetTotalCount returns the TotalCount field value
+// GetTotalCount returns the TotalCount field value if set, zero value otherwise (both if not set or set to explicit null).
 func (o *TransactionsGet200Response) GetTotalCount() int32 {
-	if o == nil {
+	if o == nil || IsNil(o.TotalCount.Get()) {
 		var ret int32
 		return ret
 	}
-
-	return o.TotalCount
+	return *o.TotalCount.Get()
 }
 
-// GetTotalCountOk returns a tuple with the TotalCount field value
+// GetTotalCountOk returns a tuple with the TotalCount field value if set, nil otherwise
 // and a boolean to check if the value has been set.
+// NOTE: If the value is an explicit nil, `nil, true` will be returned
 func (o *TransactionsGet200Response) GetTotalCountOk() (*int32, bool) {
 	if o == nil {
 		return nil, false
 	}
-	return &o.TotalCount, true
+	return o.TotalCount.Get(), o.TotalCount.IsSet()
 }
 
-// SetTotalCount sets field value
+// HasTotalCount returns a boolean if a field has been set.
+func (o *TransactionsGet200Response) HasTotalCount() bool {
+	if o != nil && o.TotalCount.IsSet() {
+		return true
+	}
+
+	return false
+}
+
+// SetTotalCount gets a reference to the given NullableInt32 and assigns it to the TotalCount field.
 func (o *TransactionsGet200Response) SetTotalCount(v int32) {
-	o.TotalCount = v
+	o.TotalCount.Set(&v)
+}
+// SetTotalCountNil sets the value for TotalCount to be an explicit nil
+func (o *TransactionsGet200Response) SetTotalCountNil() {
+	o.TotalCount.Set(nil)
+}
+
+// UnsetTotalCount ensures that no value is present for TotalCount, not even an explicit nil
+func (o *TransactionsGet200Response) UnsetTotalCount() {
+	o.TotalCount.Unset()
 }
 
 // GetHasMore returns the HasMore field value
@@ -172,6 +192,38 @@ func (o *TransactionsGet200Response) SetHasMore(v bool) {
 	o.HasMore = v
 }
 
+// GetNextCursor returns the NextCursor field value if set, zero value otherwise.
+func (o *TransactionsGet200Response) GetNextCursor() string {
+	if o == nil || IsNil(o.NextCursor) {
+		var ret string
+		return ret
+	}
+	return *o.NextCursor
+}
+
+// GetNextCursorOk returns a tuple with the NextCursor field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *TransactionsGet200Response) GetNextCursorOk() (*string, bool) {
+	if o == nil || IsNil(o.NextCursor) {
+		return nil, false
+	}
+	return o.NextCursor, true
+}
+
+// HasNextCursor returns a boolean if a field has been set.
+func (o *TransactionsGet200Response) HasNextCursor() bool {
+	if o != nil && !IsNil(o.NextCursor) {
+		return true
+	}
+
+	return false
+}
+
+// SetNextCursor gets a reference to the given string and assigns it to the NextCursor field.
+func (o *TransactionsGet200Response) SetNextCursor(v string) {
+	o.NextCursor = &v
+}
+
 func (o TransactionsGet200Response) MarshalJSON() ([]byte, error) {
 	toSerialize,err := o.ToMap()
 	if err != nil {
@@ -185,8 +237,13 @@ func (o TransactionsGet200Response) ToMap() (map[string]interface{}, error) {
 	toSerialize["crypto_symbol"] = o.CryptoSymbol
 	toSerialize["address"] = o.Address
 	toSerialize["transactions"] = o.Transactions
-	toSerialize["total_count"] = o.TotalCount
+	if o.TotalCount.IsSet() {
+		toSerialize["total_count"] = o.TotalCount.Get()
+	}
 	toSerialize["has_more"] = o.HasMore
+	if !IsNil(o.NextCursor) {
+		toSerialize["next_cursor"] = o.NextCursor
+	}
 	return toSerialize, nil
 }
 
@@ -198,7 +255,6 @@ func (o *TransactionsGet200Response) UnmarshalJSON(data []byte) (err error) {
 		"crypto_symbol",
 		"address",
 		"transactions",
-		"total_count",
 		"has_more",
 	}
 
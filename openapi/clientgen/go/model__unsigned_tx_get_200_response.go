@@ -24,10 +24,21 @@ type UnsignedTxGet200Response struct {
 	CryptoSymbol string `json:"crypto_symbol"`
 	FromAddress string `json:"from_address"`
 	ToAddress string `json:"to_address"`
+	// Amount sent in whole coins or tokens, the sweep amount with amount=max
 	Amount string `json:"amount"`
+	// Highest fee the transaction can pay in whole coins, exact to the base unit of the chain
 	FeeAmount string `json:"fee_amount"`
+	// Unsigned transaction, a PSBT on BTC and LTC or on SOL the message to sign, in unsigned_tx_encoding
 	UnsignedTx string `json:"unsigned_tx"`
+	// Encoding of unsigned_tx, base64 on SOL, BTC and LTC and hex elsewhere
+	UnsignedTxEncoding string `json:"unsigned_tx_encoding"`
 	TxSizeBytes *int32 `json:"tx_size_bytes,omitempty"`
+	// Hex encoded digest the signer signs, absent where the signer signs unsigned_tx itself or signs per input
+	SigningHash *string `json:"signing_hash,omitempty"`
+	// Outputs spent by the transaction on UTXO chains, in input order
+	Inputs []UnsignedTxInput `json:"inputs,omitempty"`
+	// Human-readable breakdown of the transaction for the signing device to display
+	Details []UnsignedTxDetail `json:"details,omitempty"`
 }
 
 type _UnsignedTxGet200Response UnsignedTxGet200Response
@@ -36,7 +47,7 @@ type _UnsignedTxGet200Response UnsignedTxGet200Response
 // This constructor will assign default values to properties that have it defined,
 // and makes sure properties required by API are set, but the set of arguments
 // will change when the set of required properties is changed
-func NewUnsignedTxGet200Response(cryptoSymbol string, fromAddress string, toAddress string, amount string, feeAmount string, unsignedTx string) *UnsignedTxGet200Response {
+func NewUnsignedTxGet200Response(cryptoSymbol string, fromAddress string, toAddress string, amount string, feeAmount string, unsignedTx string, unsignedTxEncoding string) *UnsignedTxGet200Response {
 	this := UnsignedTxGet200Response{}
 	this.CryptoSymbol = cryptoSymbol
 	this.FromAddress = fromAddress
@@ -44,6 +55,7 @@ func NewUnsignedTxGet200Response(cryptoSymbol string, fromAddress string, toAddr
 	this.Amount = amount
 	this.FeeAmount = feeAmount
 	this.UnsignedTx = unsignedTx
+	this.UnsignedTxEncoding = unsignedTxEncoding
 	return &this
 }
 
@@ -199,6 +211,30 @@ func (o *UnsignedTxGet200Response) SetUnsignedTx(v string) {
 	o.UnsignedTx = v
 }
 
+// GetUnsignedTxEncoding returns the UnsignedTxEncoding field value
+func (o *UnsignedTxGet200Response) GetUnsignedTxEncoding() string {
+	if o == nil {
+		var ret string
+		return ret
+	}
+
+	return o.UnsignedTxEncoding
+}
+
+// GetUnsignedTxEncodingOk returns a tuple with the UnsignedTxEncoding field value
+// and a boolean to check if the value has been set.
+func (o *UnsignedTxGet200Response) GetUnsignedTxEncodingOk() (*string, bool) {
+	if o == nil {
+		return nil, false
+	}
+	return &o.UnsignedTxEncoding, true
+}
+
+// SetUnsignedTxEncoding sets field value
+func (o *UnsignedTxGet200Response) SetUnsignedTxEncoding(v string) {
+	o.UnsignedTxEncoding = v
+}
+
 // GetTxSizeBytes returns the TxSizeBytes field value if set, zero value otherwise.
 func (o *UnsignedTxGet200Response) GetTxSizeBytes() int32 {
 	if o == nil || IsNil(o.TxSizeBytes) {
@@ -231,6 +267,102 @@ func (o *UnsignedTxGet200Response) SetTxSizeBytes(v int32) {
 	o.TxSizeBytes = &v
 }
 
+// GetSigningHash returns the SigningHash field value if set, zero value otherwise.
+func (o *UnsignedTxGet200Response) GetSigningHash() string {
+	if o == nil || IsNil(o.SigningHash) {
+		var ret string
+		return ret
+	}
+	return *o.SigningHash
+}
+
+// GetSigningHashOk returns a tuple with the SigningHash field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *UnsignedTxGet200Response) GetSigningHashOk() (*string, bool) {
+	if o == nil || IsNil(o.SigningHash) {
+		return nil, false
+	}
+	return o.SigningHash, true
+}
+
+// HasSigningHash returns a boolean if a field has been set.
+func (o *UnsignedTxGet200Response) HasSigningHash() bool {
+	if o != nil && !IsNil(o.SigningHash) {
+		return true
+	}
+
+	return false
+}
+
+// SetSigningHash gets a reference to the given string and assigns it to the SigningHash field.
+func (o *UnsignedTxGet200Response) SetSigningHash(v string) {
+	o.SigningHash = &v
+}
+
+// GetInputs returns the Inputs field value if set, zero value otherwise.
+func (o *UnsignedTxGet200Response) GetInputs() []UnsignedTxInput {
+	if o == nil || IsNil(o.Inputs) {
+		var ret []UnsignedTxInput
+		return ret
+	}
+	return o.Inputs
+}
+
+// GetInputsOk returns a tuple with the Inputs field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *UnsignedTxGet200Response) GetInputsOk() ([]UnsignedTxInput, bool) {
+	if o == nil || IsNil(o.Inputs) {
+		return nil, false
+	}
+	return o.Inputs, true
+}
+
+// HasInputs returns a boolean if a field has been set.
+func (o *UnsignedTxGet200Response) HasInputs() bool {
+	if o != nil && !IsNil(o.Inputs) {
+		return true
+	}
+
+	return false
+}
+
+// SetInputs gets a reference to the given []UnsignedTxInput and assigns it to the Inputs field.
+func (o *UnsignedTxGet200Response) SetInputs(v []UnsignedTxInput) {
+	o.Inputs = v
+}
+
+// GetDetails returns the Details field value if set, zero value otherwise.
+func (o *UnsignedTxGet200Response) GetDetails() []UnsignedTxDetail {
+	if o == nil || IsNil(o.Details) {
+		var ret []UnsignedTxDetail
+		return ret
+	}
+	return o.Details
+}
+
+// GetDetailsOk returns a tuple with the Details field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *UnsignedTxGet200Response) GetDetailsOk() ([]UnsignedTxDetail, bool) {
+	if o == nil || IsNil(o.Details) {
+		return nil, false
+	}
+	return o.Details, true
+}
+
+// HasDetails returns a boolean if a field has been set.
+func (o *UnsignedTxGet200Response) HasDetails() bool {
+	if o != nil && !IsNil(o.Details) {
+		return true
+	}
+
+	return false
+}
+
+// SetDetails gets a reference to the given []UnsignedTxDetail and assigns it to the Details field.
+func (o *UnsignedTxGet200Response) SetDetails(v []UnsignedTxDetail) {
+	o.Details = v
+}
+
 func (o UnsignedTxGet200Response) MarshalJSON() ([]byte, error) {
 	toSerialize,err := o.ToMap()
 	if err != nil {
@@ -247,9 +379,19 @@ func (o UnsignedTxGet200Response) ToMap() (map[string]interface{}, error) {
 	toSerialize["amount"] = o.Amount
 	toSerialize["fee_amount"] = o.FeeAmount
 	toSerialize["unsigned_tx"] = o.UnsignedTx
+	toSerialize["unsigned_tx_encoding"] = o.UnsignedTxEncoding
 	if !IsNil(o.TxSizeBytes) {
 		toSerialize["tx_size_bytes"] = o.TxSizeBytes
 	}
+	if !IsNil(o.SigningHash) {
+		toSerialize["signing_hash"] = o.SigningHash
+	}
+	if !IsNil(o.Inputs) {
+		toSerialize["inputs"] = o.Inputs
+	}
+	if !IsNil(o.Details) {
+		toSerialize["details"] = o.Details
+	}
 	return toSerialize, nil
 }
 
@@ -264,6 +406,7 @@ func (o *UnsignedTxGet200Response) UnmarshalJSON(data []byte) (err error) {
 		"amount",
 		"fee_amount",
 		"unsigned_tx",
+		"unsigned_tx_encoding",
 	}
 
 	allProperties := make(map[string]interface{})
@@ -22,6 +22,7 @@ var _ MappedNullable = &ErrorResponse{}
 
 // ErrorResponse struct for ErrorResponse
 type ErrorResponse struct {
+	// Stable error code. One of BAD_REQUEST, INVALID_ADDRESS, UNSUPPORTED_SYMBOL, UNSUPPORTED_FIAT, INSUFFICIENT_FUNDS, TRANSACTION_NOT_FOUND, RATE_UNAVAILABLE, PROVIDER_UNAVAILABLE, UPSTREAM_TIMEOUT, INTERNAL_ERROR.
 	Error string `json:"error"`
 	Message string `json:"message"`
 	Timestamp time.Time `json:"timestamp"`
@@ -31,6 +31,15 @@ type Transaction struct {
 	FeeAmount *string `json:"fee_amount,omitempty"`
 	FromAddresses []string `json:"from_addresses,omitempty"`
 	ToAddresses []string `json:"to_addresses,omitempty"`
+	FiatSymbol *string `json:"fiat_symbol,omitempty"`
+	// Fiat value of the amount at the block time, null when no historical rate is available
+	FiatValue NullableFloat64 `json:"fiat_value,omitempty"`
+	// Daily exchange rate on the day of the block time, null when no historical rate is available
+	ExchangeRate NullableFloat64 `json:"exchange_rate,omitempty"`
+	// Contract of the ERC-20 token moved, absent for native coin entries. The amount is then in whole tokens and has no fiat value
+	TokenContract *string `json:"token_contract,omitempty"`
+	// Symbol of the token moved, absent for native coin entries
+	TokenSymbol *string `json:"token_symbol,omitempty"`
 }
 
 type _Transaction Transaction
@@ -305,6 +314,186 @@ func (o *Transaction) SetToAddresses(v []string) {
 	o.ToAddresses = v
 }
 
+// GetFiatSymbol returns the FiatSymbol field value if set, zero value otherwise.
+func (o *Transaction) GetFiatSymbol() string {
+	if o == nil || IsNil(o.FiatSymbol) {
+		var ret string
+		return ret
+	}
+	return *o.FiatSymbol
+}
+
+// GetFiatSymbolOk returns a tuple with the FiatSymbol field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Transaction) GetFiatSymbolOk() (*string, bool) {
+	if o == nil || IsNil(o.FiatSymbol) {
+		return nil, false
+	}
+	return o.FiatSymbol, true
+}
+
+// HasFiatSymbol returns a boolean if a field has been set.
+func (o *Transaction) HasFiatSymbol() bool {
+	if o != nil && !IsNil(o.FiatSymbol) {
+		return true
+	}
+
+	return false
+}
+
+// SetFiatSymbol gets a reference to the given string and assigns it to the FiatSymbol field.
+func (o *Transaction) SetFiatSymbol(v string) {
+	o.FiatSymbol = &v
+}
+
+// GetFiatValue returns the FiatValue field value if set, zero value otherwise (both if not set or set to explicit null).
+func (o *Transaction) GetFiatValue() float64 {
+	if o == nil || IsNil(o.FiatValue.Get()) {
+		var ret float64
+		return ret
+	}
+	return *o.FiatValue.Get()
+}
+
+// GetFiatValueOk returns a tuple with the FiatValue field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+// NOTE: If the value is an explicit nil, `nil, true` will be returned
+func (o *Transaction) GetFiatValueOk() (*float64, bool) {
+	if o == nil {
+		return nil, false
+	}
+	return o.FiatValue.Get(), o.FiatValue.IsSet()
+}
+
+// HasFiatValue returns a boolean if a field has been set.
+func (o *Transaction) HasFiatValue() bool {
+	if o != nil && o.FiatValue.IsSet() {
+		return true
+	}
+
+	return false
+}
+
+// SetFiatValue gets a reference to the given NullableFloat64 and assigns it to the FiatValue field.
+func (o *Transaction) SetFiatValue(v float64) {
+	o.FiatValue.Set(&v)
+}
+// SetFiatValueNil sets the value for FiatValue to be an explicit nil
+func (o *Transaction) SetFiatValueNil() {
+	o.FiatValue.Set(nil)
+}
+
+// UnsetFiatValue ensures that no value is present for FiatValue, not even an explicit nil
+func (o *Transaction) UnsetFiatValue() {
+	o.FiatValue.Unset()
+}
+
+// GetExchangeRate returns the ExchangeRate field value if set, zero value otherwise (both if not set or set to explicit null).
+func (o *Transaction) GetExchangeRate() float64 {
+	if o == nil || IsNil(o.ExchangeRate.Get()) {
+		var ret float64
+		return ret
+	}
+	return *o.ExchangeRate.Get()
+}
+
+// GetExchangeRateOk returns a tuple with the ExchangeRate field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+// NOTE: If the value is an explicit nil, `nil, true` will be returned
+func (o *Transaction) GetExchangeRateOk() (*float64, bool) {
+	if o == nil {
+		return nil, false
+	}
+	return o.ExchangeRate.Get(), o.ExchangeRate.IsSet()
+}
+
+// HasExchangeRate returns a boolean if a field has been set.
+func (o *Transaction) HasExchangeRate() bool {
+	if o != nil && o.ExchangeRate.IsSet() {
+		return true
+	}
+
+	return false
+}
+
+// SetExchangeRate gets a reference to the given NullableFloat64 and assigns it to the ExchangeRate field.
+func (o *Transaction) SetExchangeRate(v float64) {
+	o.ExchangeRate.Set(&v)
+}
+// SetExchangeRateNil sets the value for ExchangeRate to be an explicit nil
+func (o *Transaction) SetExchangeRateNil() {
+	o.ExchangeRate.Set(nil)
+}
+
+// UnsetExchangeRate ensures that no value is present for ExchangeRate, not even an explicit nil
+func (o *Transaction) UnsetExchangeRate() {
+	o.ExchangeRate.Unset()
+}
+
+// GetTokenContract returns the TokenContract field value if set, zero value otherwise.
+func (o *Transaction) GetTokenContract() string {
+	if o == nil || IsNil(o.TokenContract) {
+		var ret string
+		return ret
+	}
+	return *o.TokenContract
+}
+
+// GetTokenContractOk returns a tuple with the TokenContract field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Transaction) GetTokenContractOk() (*string, bool) {
+	if o == nil || IsNil(o.TokenContract) {
+		return nil, false
+	}
+	return o.TokenContract, true
+}
+
+// HasTokenContract returns a boolean if a field has been set.
+func (o *Transaction) HasTokenContract() bool {
+	if o != nil && !IsNil(o.TokenContract) {
+		return true
+	}
+
+	return false
+}
+
+// SetTokenContract gets a reference to the given string and assigns it to the TokenContract field.
+func (o *Transaction) SetTokenContract(v string) {
+	o.TokenContract = &v
+}
+
+// GetTokenSymbol returns the TokenSymbol field value if set, zero value otherwise.
+func (o *Transaction) GetTokenSymbol() string {
+	if o == nil || IsNil(o.TokenSymbol) {
+		var ret string
+		return ret
+	}
+	return *o.TokenSymbol
+}
+
+// GetTokenSymbolOk returns a tuple with the TokenSymbol field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Transaction) GetTokenSymbolOk() (*string, bool) {
+	if o == nil || IsNil(o.TokenSymbol) {
+		return nil, false
+	}
+	return o.TokenSymbol, true
+}
+
+// HasTokenSymbol returns a boolean if a field has been set.
+func (o *Transaction) HasTokenSymbol() bool {
+	if o != nil && !IsNil(o.TokenSymbol) {
+		return true
+	}
+
+	return false
+}
+
+// SetTokenSymbol gets a reference to the given string and assigns it to the TokenSymbol field.
+func (o *Transaction) SetTokenSymbol(v string) {
+	o.TokenSymbol = &v
+}
+
 func (o Transaction) MarshalJSON() ([]byte, error) {
 	toSerialize,err := o.ToMap()
 	if err != nil {
@@ -332,6 +521,21 @@ func (o Transaction) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.ToAddresses) {
 		toSerialize["to_addresses"] = o.ToAddresses
 	}
+	if !IsNil(o.FiatSymbol) {
+		toSerialize["fiat_symbol"] = o.FiatSymbol
+	}
+	if o.FiatValue.IsSet() {
+		toSerialize["fiat_value"] = o.FiatValue.Get()
+	}
+	if o.ExchangeRate.IsSet() {
+		toSerialize["exchange_rate"] = o.ExchangeRate.Get()
+	}
+	if !IsNil(o.TokenContract) {
+		toSerialize["token_contract"] = o.TokenContract
+	}
+	if !IsNil(o.TokenSymbol) {
+		toSerialize["token_symbol"] = o.TokenSymbol
+	}
 	return toSerialize, nil
 }
 
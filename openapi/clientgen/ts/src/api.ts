@@ -23,34 +23,191 @@ import type { RequestArgs } from './base';
 // @ts-ignore
 import { BASE_PATH, COLLECTION_FORMATS, BaseAPI, RequiredError, operationServerMap } from './base';
 
+export interface BalanceHistoryPoint {
+    'date': string;
+    /**
+     * Balance at the end of the day (UTC)
+     */
+    'crypto_balance': number;
+    /**
+     * Exchange rate for the day, null when no historical rate is available
+     */
+    'exchange_rate': number | null;
+    /**
+     * Fiat value of the end of day balance, null when no historical rate is available
+     */
+    'fiat_value': number | null;
+}
+export interface BalancesHistoryPost200Response {
+    'fiat_symbol': string;
+    'from': string;
+    'to': string;
+    'totals': Array<BalancesHistoryPost200ResponseTotalsInner>;
+    'wallets': Array<BalancesHistoryPost200ResponseWalletsInner>;
+}
+export interface BalancesHistoryPost200ResponseTotalsInner {
+    'date': string;
+    'fiat_value': number;
+    /**
+     * False when a wallet failed or had no exchange rate for this day; fiat_value then only covers the wallets that could be valued.
+     */
+    'complete': boolean;
+}
+export interface BalancesHistoryPost200ResponseWalletsInner {
+    'crypto_symbol': string;
+    'address': string;
+    'points': Array<BalanceHistoryPoint>;
+    /**
+     * Error message if the history of this wallet could not be built
+     */
+    'error'?: string;
+    /**
+     * Stable error code for error, same values as ErrorResponse.error
+     */
+    'error_code'?: string;
+}
+export interface BalancesHistoryPostRequest {
+    'requests': Array<BalancesHistoryPostRequestRequestsInner>;
+    /**
+     * The fiat currency symbol for conversion (USD, EUR, CAD, etc.)
+     */
+    'fiat_symbol'?: string;
+    /**
+     * First day of the range (YYYY-MM-DD)
+     */
+    'from': string;
+    /**
+     * Last day of the range (YYYY-MM-DD)
+     */
+    'to': string;
+}
+export interface BalancesHistoryPostRequestRequestsInner {
+    /**
+     * The cryptocurrency symbol (BTC, ETH, etc.)
+     */
+    'crypto_symbol': string;
+    /**
+     * The cryptocurrency address or xpub
+     */
+    'address': string;
+}
 export interface BalancesPost200Response {
     'results'?: Array<BalancesPost200ResponseResultsInner>;
+    /**
+     * Portfolio value and price changes per fiat symbol across all results
+     */
+    'totals'?: Array<BalancesPost200ResponseTotalsInner>;
     'timestamp'?: string;
 }
 export interface BalancesPost200ResponseResultsInner {
     'crypto_symbol': string;
     'address': string;
+    /**
+     * Balance once every pending movement settles, confirmed_balance plus pending_incoming minus pending_outgoing
+     */
     'crypto_balance': number;
+    /**
+     * Funds meeting the min_confirmations or commitment of the request
+     */
+    'confirmed_balance': number;
+    /**
+     * Funds on their way into the wallet that are not confirmed yet
+     */
+    'pending_incoming': number;
+    /**
+     * Funds on their way out of the wallet that are not confirmed yet
+     */
+    'pending_outgoing': number;
+    /**
+     * LTC the wallet moved into the MWEB extension block, net of the peg-outs it received back. MWEB coins cannot be spent on the canonical chain and are not part of crypto_balance. Only reported for LTC.
+     */
+    'mweb_balance'?: number;
     'fiat_symbol': string;
-    'fiat_value': number;
-    'exchange_rate': number;
     /**
-     * Absolute change in fiat value over the last 24 hours
+     * Fiat value of the balance, null when no exchange rate is available
+     */
+    'fiat_value': number | null;
+    /**
+     * Exchange rate used for the conversion, null when no exchange rate is available
+     */
+    'exchange_rate': number | null;
+    /**
+     * Absolute change in fiat value of the current balance over the last 24 hours, same as the fiat_change of the 24h entry in price_changes. Null when no exchange rate or no rate from 24 hours ago is available.
+     */
+    'change24h': number | null;
+    /**
+     * Rate changes over the 1h, 24h, 7d and 30d windows; windows without a reference rate are left out
      */
-    'change24h': number;
+    'price_changes'?: Array<PriceChange>;
     'timestamp': string;
     /**
      * Error message if this specific balance fetch failed
      */
     'error'?: string;
+    /**
+     * Stable error code if this specific balance fetch failed, same values as ErrorResponse.error
+     */
+    'error_code'?: string;
+    /**
+     * Set when the exchange rate could not be fetched. The crypto balance is still returned; fiat fields are null unless a last known rate was used (see rate_stale).
+     */
+    'rate_error'?: string;
+    /**
+     * Stable error code for rate_error, same values as ErrorResponse.error
+     */
+    'rate_error_code'?: string;
+    /**
+     * True when fiat fields were computed from the last known rate instead of a fresh one
+     */
+    'rate_stale'?: boolean;
+    /**
+     * Age of the exchange rate in seconds when rate_stale is true
+     */
+    'rate_age_seconds'?: number;
+    /**
+     * The balance converted to every fiat symbol of the request item, keyed by fiat symbol. Only present when the item has fiat_symbols.
+     */
+    'conversions'?: { [key: string]: FiatConversion; };
+}
+export interface BalancesPost200ResponseTotalsInner {
+    'fiat_symbol': string;
+    /**
+     * Sum of the fiat values of all results in this fiat symbol
+     */
+    'fiat_value': number;
+    /**
+     * False when a result failed or had no exchange rate, so fiat_value only covers part of the portfolio
+     */
+    'complete': boolean;
+    /**
+     * Portfolio changes for the windows every valued result has a reference rate for
+     */
+    'price_changes': Array<PriceChange>;
 }
 export interface BalancesPostRequest {
     'requests': Array<BalancesPostRequestRequestsInner>;
     /**
-     * Default fiat currency symbol for all requests if not specified individually
+     * Default ISO 4217 fiat currency symbol for all requests if not specified individually
      */
     'fiat_symbol'?: string;
+    /**
+     * Confirmations BTC, LTC and ETH funds need to count as confirmed; younger funds are reported as pending, except BTC and LTC change the wallet paid to itself. Defaults to 1. Kaspa balances are always reported as confirmed.
+     */
+    'min_confirmations'?: number;
+    /**
+     * Solana commitment level a balance needs to count as confirmed; the difference to the processed balance is reported as pending. Defaults to finalized.
+     */
+    'commitment'?: BalancesPostRequestCommitmentEnum;
 }
+
+export const BalancesPostRequestCommitmentEnum = {
+    Processed: 'processed',
+    Confirmed: 'confirmed',
+    Finalized: 'finalized'
+} as const;
+
+export type BalancesPostRequestCommitmentEnum = typeof BalancesPostRequestCommitmentEnum[keyof typeof BalancesPostRequestCommitmentEnum];
+
 export interface BalancesPostRequestRequestsInner {
     /**
      * The cryptocurrency symbol (BTC, ETH, etc.)
@@ -61,9 +218,13 @@ export interface BalancesPostRequestRequestsInner {
      */
     'address': string;
     /**
-     * The fiat currency symbol for conversion (USD, EUR, CAD, etc.)
+     * The ISO 4217 fiat currency symbol for conversion (USD, EUR, CAD, etc.). Defaults to the request level fiat_symbol, or to the first entry of fiat_symbols when those are given.
      */
     'fiat_symbol'?: string;
+    /**
+     * Additional ISO 4217 fiat currency symbols to convert the balance to. Each one is returned in conversions; the balance is only fetched once.
+     */
+    'fiat_symbols'?: Array<string>;
 }
 export interface BroadcastPost200Response {
     'crypto_symbol': string;
@@ -87,10 +248,251 @@ export interface BroadcastPostRequest {
     'signed_tx': string;
 }
 export interface ErrorResponse {
+    /**
+     * Stable error code. One of BAD_REQUEST, INVALID_ADDRESS, UNSUPPORTED_SYMBOL, UNSUPPORTED_FIAT, INSUFFICIENT_FUNDS, TRANSACTION_NOT_FOUND, RATE_UNAVAILABLE, PROVIDER_UNAVAILABLE, UPSTREAM_TIMEOUT, INTERNAL_ERROR.
+     */
     'error': string;
     'message': string;
     'timestamp': string;
 }
+export interface FeeEstimate {
+    'level': FeeEstimateLevelEnum;
+    /**
+     * Fee rate in rate_unit
+     */
+    'fee_rate': number;
+    /**
+     * Fee of a typical transaction in whole coins
+     */
+    'fee_amount': string;
+    /**
+     * Fiat value of fee_amount, null when no exchange rate is available
+     */
+    'fiat_value': number | null;
+    /**
+     * Base fee part of fee_rate on EIP-1559 chains
+     */
+    'base_fee'?: number;
+    /**
+     * Priority fee part of fee_rate on EIP-1559 chains
+     */
+    'priority_fee'?: number;
+    /**
+     * Expected time to confirmation, absent when the chain gives no target
+     */
+    'estimated_seconds'?: number;
+}
+
+export const FeeEstimateLevelEnum = {
+    Slow: 'slow',
+    Normal: 'normal',
+    Fast: 'fast'
+} as const;
+
+export type FeeEstimateLevelEnum = typeof FeeEstimateLevelEnum[keyof typeof FeeEstimateLevelEnum];
+
+export interface FeesGet200Response {
+    'crypto_symbol': string;
+    'fiat_symbol': string;
+    /**
+     * Unit of fee_rate, one of sat/vB, litoshi/vB, gwei, micro-lamports/CU or sompi/gram
+     */
+    'rate_unit': string;
+    /**
+     * Unit of typical_size, one of vbytes, gas, compute units or grams
+     */
+    'size_unit': string;
+    /**
+     * Size of the typical transaction fee amounts are priced for
+     */
+    'typical_size': number;
+    /**
+     * Exchange rate used for fiat values, null when no exchange rate is available
+     */
+    'exchange_rate': number | null;
+    'estimates': Array<FeeEstimate>;
+    /**
+     * Set when the exchange rate could not be fetched
+     */
+    'rate_error'?: string;
+    /**
+     * Stable error code for rate_error, same values as ErrorResponse.error
+     */
+    'rate_error_code'?: string;
+}
+export interface FiatConversion {
+    /**
+     * Fiat value of the balance, null when no exchange rate is available
+     */
+    'fiat_value': number | null;
+    /**
+     * Exchange rate used for the conversion, null when no exchange rate is available
+     */
+    'exchange_rate': number | null;
+    /**
+     * Absolute change in fiat value of the current balance over the last 24 hours
+     */
+    'change24h': number | null;
+    /**
+     * Rate changes over the 1h, 24h, 7d and 30d windows; windows without a reference rate are left out
+     */
+    'price_changes': Array<PriceChange>;
+    /**
+     * Set when the exchange rate could not be fetched
+     */
+    'rate_error'?: string;
+    /**
+     * Stable error code for rate_error, same values as ErrorResponse.error
+     */
+    'rate_error_code'?: string;
+    /**
+     * True when fiat fields were computed from the last known rate instead of a fresh one
+     */
+    'rate_stale'?: boolean;
+    /**
+     * Age of the exchange rate in seconds when rate_stale is true
+     */
+    'rate_age_seconds'?: number;
+}
+export interface Outpoint {
+    'txid': string;
+    /**
+     * Index of the output in the transaction, 0 when omitted
+     */
+    'vout'?: number;
+}
+export interface PortfolioPost200Response {
+    'fiat_symbols': Array<string>;
+    /**
+     * Grand total per fiat currency
+     */
+    'totals': Array<PortfolioValue>;
+    'wallets': Array<PortfolioPost200ResponseWalletsInner>;
+    /**
+     * Balance and value per crypto symbol across all wallets
+     */
+    'assets': Array<PortfolioPost200ResponseAssetsInner>;
+    'timestamp': string;
+}
+export interface PortfolioPost200ResponseAssetsInner {
+    'crypto_symbol': string;
+    'crypto_balance': number;
+    'values': Array<PortfolioValue>;
+}
+export interface PortfolioPost200ResponseWalletsInner {
+    'name': string;
+    /**
+     * Wallet total per fiat currency
+     */
+    'values': Array<PortfolioValue>;
+    'accounts': Array<PortfolioPost200ResponseWalletsInnerAccountsInner>;
+}
+export interface PortfolioPost200ResponseWalletsInnerAccountsInner {
+    'crypto_symbol': string;
+    'address': string;
+    'crypto_balance': number;
+    'values': Array<PortfolioValue>;
+    /**
+     * Error message if the balance of this account could not be fetched
+     */
+    'error'?: string;
+    /**
+     * Stable error code for error, same values as ErrorResponse.error
+     */
+    'error_code'?: string;
+}
+export interface PortfolioPostRequest {
+    'wallets': Array<PortfolioPostRequestWalletsInner>;
+    /**
+     * Fiat currencies to value the portfolio in, USD when empty
+     */
+    'fiat_symbols'?: Array<string>;
+}
+export interface PortfolioPostRequestWalletsInner {
+    /**
+     * Name of the wallet, echoed back in the response
+     */
+    'name': string;
+    'accounts': Array<PortfolioPostRequestWalletsInnerAccountsInner>;
+}
+export interface PortfolioPostRequestWalletsInnerAccountsInner {
+    /**
+     * The cryptocurrency symbol (BTC, ETH, etc.)
+     */
+    'crypto_symbol': string;
+    /**
+     * The cryptocurrency address or xpub
+     */
+    'address': string;
+}
+export interface PortfolioValue {
+    'fiat_symbol': string;
+    /**
+     * Fiat value of the balances that could be valued
+     */
+    'fiat_value': number;
+    /**
+     * Exchange rate used, only set for a single account or asset
+     */
+    'exchange_rate'?: number | null;
+    /**
+     * Absolute change in fiat value over the last 24 hours, null when a valued balance has no 24h reference rate
+     */
+    'change24h': number | null;
+    /**
+     * Change over the last 24 hours in percent of the value 24 hours ago
+     */
+    'change24h_percent': number | null;
+    /**
+     * Share of the grand total in the same fiat currency, omitted for the grand total itself
+     */
+    'allocation_percent'?: number;
+    /**
+     * False when a balance failed or had no exchange rate, so fiat_value only covers part of it
+     */
+    'complete': boolean;
+}
+export interface PriceChange {
+    'window': PriceChangeWindowEnum;
+    /**
+     * Change of the exchange rate over the window in percent
+     */
+    'percent': number;
+    /**
+     * Absolute change in fiat value of the current balance over the window
+     */
+    'fiat_change': number;
+    /**
+     * Exchange rate at the start of the window, omitted for portfolio totals
+     */
+    'reference_rate'?: number;
+}
+
+export const PriceChangeWindowEnum = {
+    _1h: '1h',
+    _24h: '24h',
+    _7d: '7d',
+    _30d: '30d'
+} as const;
+
+export type PriceChangeWindowEnum = typeof PriceChangeWindowEnum[keyof typeof PriceChangeWindowEnum];
+
+export interface ReceiveAddress {
+    /**
+     * Index of the address on the external chain
+     */
+    'index': number;
+    'address': string;
+    /**
+     * Full BIP44 style path for account level keys, relative to the key otherwise
+     */
+    'derivation_path': string;
+}
+export interface ReceiveAddressGet200Response {
+    'crypto_symbol': string;
+    'xpub': string;
+    'addresses': Array<ReceiveAddress>;
+}
 export interface Transaction {
     'transaction_id': string;
     'block_height'?: number;
@@ -101,6 +503,23 @@ export interface Transaction {
     'fee_amount'?: string;
     'from_addresses'?: Array<string>;
     'to_addresses'?: Array<string>;
+    'fiat_symbol'?: string;
+    /**
+     * Fiat value of the amount at the block time, null when no historical rate is available
+     */
+    'fiat_value'?: number | null;
+    /**
+     * Daily exchange rate on the day of the block time, null when no historical rate is available
+     */
+    'exchange_rate'?: number | null;
+    /**
+     * Contract of the ERC-20 token moved, absent for native coin entries. The amount is then in whole tokens and has no fiat value
+     */
+    'token_contract'?: string;
+    /**
+     * Symbol of the token moved, absent for native coin entries
+     */
+    'token_symbol'?: string;
 }
 
 export const TransactionDirectionEnum = {
@@ -108,41 +527,651 @@ export const TransactionDirectionEnum = {
     Outgoing: 'outgoing'
 } as const;
 
-export type TransactionDirectionEnum = typeof TransactionDirectionEnum[keyof typeof TransactionDirectionEnum];
+export type TransactionDirectionEnum = typeof TransactionDirectionEnum[keyof typeof TransactionDirectionEnum];
+
+export interface TransactionInput {
+    'index': number;
+    /**
+     * Transaction of the output spent, absent for coinbase inputs
+     */
+    'previous_txid'?: string;
+    /**
+     * Index of the output spent in previous_txid
+     */
+    'previous_vout'?: number;
+    'address'?: string;
+    /**
+     * Value of the output spent in the smallest unit of the chain
+     */
+    'value': number;
+    'amount': string;
+    /**
+     * Whether the output spent paid an address of wallet
+     */
+    'owned': boolean;
+}
+export interface TransactionInstruction {
+    /**
+     * Position of the instruction among the instructions of the message, or among the inner instructions invoked by parent_index
+     */
+    'index': number;
+    /**
+     * Index of the instruction that invoked this inner instruction, null for instructions of the message
+     */
+    'parent_index'?: number | null;
+    'program_id': string;
+    /**
+     * Name of the program where it is known, such as system or spl-token
+     */
+    'program'?: string;
+    /**
+     * Name of the instruction where the program is known
+     */
+    'type'?: string;
+    'accounts': Array<string>;
+    /**
+     * Base58 encoded instruction data
+     */
+    'data': string;
+}
+export interface TransactionLog {
+    /**
+     * Index of the log in its block
+     */
+    'log_index': number;
+    /**
+     * Contract that emitted the log
+     */
+    'address': string;
+    /**
+     * Hex encoded topics, the event signature hash first
+     */
+    'topics': Array<string>;
+    /**
+     * Hex encoded data
+     */
+    'data': string;
+}
+export interface TransactionOutput {
+    'vout': number;
+    /**
+     * Address paid, absent for scripts without one such as OP_RETURN
+     */
+    'address'?: string;
+    /**
+     * Value in the smallest unit of the chain
+     */
+    'value': number;
+    'amount': string;
+    /**
+     * Whether the output pays an address of wallet
+     */
+    'owned': boolean;
+}
+export interface TransactionTransfer {
+    'from_address': string;
+    'to_address': string;
+    /**
+     * Amount in whole coins, or in whole tokens with token_contract
+     */
+    'amount': string;
+    /**
+     * ERC-20 contract or SPL token mint of the token moved, absent for the native coin
+     */
+    'token_contract'?: string;
+    /**
+     * Symbol of the token moved where it is known
+     */
+    'token_symbol'?: string;
+    /**
+     * Whether from_address is wallet
+     */
+    'from_owned': boolean;
+    /**
+     * Whether to_address is wallet
+     */
+    'to_owned': boolean;
+}
+export interface TransactionsCryptoSymbolTxidGet200Response {
+    'crypto_symbol': string;
+    'txid': string;
+    'status': TransactionsCryptoSymbolTxidGet200ResponseStatusEnum;
+    'confirmations': number;
+    /**
+     * Height of the block holding the transaction, absent while it is pending
+     */
+    'block_height'?: number;
+    /**
+     * Hash of the block holding the transaction, absent while it is pending
+     */
+    'block_hash'?: string;
+    /**
+     * Time of the block holding the transaction, absent while it is pending
+     */
+    'timestamp'?: string | null;
+    /**
+     * Fee paid in whole coins, absent for coinbase transactions
+     */
+    'fee_amount'?: string;
+    /**
+     * Outputs spent by the transaction, in input order, on BTC, LTC and KAS
+     */
+    'inputs'?: Array<TransactionInput>;
+    /**
+     * Outputs created by the transaction on BTC, LTC and KAS
+     */
+    'outputs'?: Array<TransactionOutput>;
+    /**
+     * Ether and token transfers on ETH, System and SPL token transfers on SOL
+     */
+    'transfers'?: Array<TransactionTransfer>;
+    /**
+     * Logs of the transaction receipt on ETH
+     */
+    'logs'?: Array<TransactionLog>;
+    /**
+     * Instructions of the transaction on SOL, each followed by the inner instructions it invoked
+     */
+    'instructions'?: Array<TransactionInstruction>;
+}
+
+export const TransactionsCryptoSymbolTxidGet200ResponseStatusEnum = {
+    Confirmed: 'confirmed',
+    Pending: 'pending',
+    Failed: 'failed'
+} as const;
+
+export type TransactionsCryptoSymbolTxidGet200ResponseStatusEnum = typeof TransactionsCryptoSymbolTxidGet200ResponseStatusEnum[keyof typeof TransactionsCryptoSymbolTxidGet200ResponseStatusEnum];
+
+export interface TransactionsGet200Response {
+    'crypto_symbol': string;
+    'address': string;
+    'transactions': Array<Transaction>;
+    /**
+     * Number of entries in the whole history, absent where the chain pages history by cursor and the total is unknown
+     */
+    'total_count'?: number | null;
+    'has_more': boolean;
+    /**
+     * Cursor of the next page, absent on the last page
+     */
+    'next_cursor'?: string;
+}
+export interface UnsignedTxBumpPost200Response {
+    'crypto_symbol': string;
+    'from_address': string;
+    /**
+     * Transaction sped up
+     */
+    'txid': string;
+    /**
+     * Outputs paid other than the change, the change address of the wallet with cpfp
+     */
+    'outputs': Array<UnsignedTxOutput>;
+    /**
+     * Total sent to the outputs in whole coins
+     */
+    'amount': string;
+    /**
+     * Fee of the new transaction in whole coins
+     */
+    'fee_amount': string;
+    /**
+     * Unsigned transaction as a PSBT, in unsigned_tx_encoding
+     */
+    'unsigned_tx': string;
+    /**
+     * Encoding of unsigned_tx, base64 on SOL, BTC and LTC and hex elsewhere
+     */
+    'unsigned_tx_encoding': UnsignedTxBumpPost200ResponseUnsignedTxEncodingEnum;
+    'tx_size_bytes'?: number;
+    /**
+     * Outputs spent by the transaction, in input order
+     */
+    'inputs'?: Array<UnsignedTxInput>;
+    /**
+     * Human-readable breakdown of the transaction for the signing device to display
+     */
+    'details'?: Array<UnsignedTxDetail>;
+}
+
+export const UnsignedTxBumpPost200ResponseUnsignedTxEncodingEnum = {
+    Hex: 'hex',
+    Base64: 'base64'
+} as const;
+
+export type UnsignedTxBumpPost200ResponseUnsignedTxEncodingEnum = typeof UnsignedTxBumpPost200ResponseUnsignedTxEncodingEnum[keyof typeof UnsignedTxBumpPost200ResponseUnsignedTxEncodingEnum];
+
+export interface UnsignedTxBumpPostRequest {
+    /**
+     * The cryptocurrency symbol, BTC only
+     */
+    'crypto_symbol': string;
+    /**
+     * Extended public key, output descriptor or address of the wallet txid belongs to
+     */
+    'from_address': string;
+    /**
+     * Unconfirmed transaction of the wallet to speed up
+     */
+    'txid': string;
+    /**
+     * rbf to replace txid, cpfp to spend one of its outputs with a child paying for both
+     */
+    'method': UnsignedTxBumpPostRequestMethodEnum;
+    /**
+     * Fee rate to reach in sat/vB, of the parent and child together with cpfp; defaults to the normal estimate and at least 1 sat/vB above the rate txid pays
+     */
+    'fee_rate'?: number;
+}
+
+export const UnsignedTxBumpPostRequestMethodEnum = {
+    Rbf: 'rbf',
+    Cpfp: 'cpfp'
+} as const;
+
+export type UnsignedTxBumpPostRequestMethodEnum = typeof UnsignedTxBumpPostRequestMethodEnum[keyof typeof UnsignedTxBumpPostRequestMethodEnum];
+
+export interface UnsignedTxDetail {
+    'label': string;
+    'value': string;
+}
+export interface UnsignedTxGet200Response {
+    'crypto_symbol': string;
+    'from_address': string;
+    'to_address': string;
+    /**
+     * Amount sent in whole coins or tokens, the sweep amount with amount=max
+     */
+    'amount': string;
+    /**
+     * Highest fee the transaction can pay in whole coins, exact to the base unit of the chain
+     */
+    'fee_amount': string;
+    /**
+     * Unsigned transaction, a PSBT on BTC and LTC or on SOL the message to sign, in unsigned_tx_encoding
+     */
+    'unsigned_tx': string;
+    /**
+     * Encoding of unsigned_tx, base64 on SOL, BTC and LTC and hex elsewhere
+     */
+    'unsigned_tx_encoding': UnsignedTxGet200ResponseUnsignedTxEncodingEnum;
+    'tx_size_bytes'?: number;
+    /**
+     * Hex encoded digest the signer signs, absent where the signer signs unsigned_tx itself or signs per input
+     */
+    'signing_hash'?: string;
+    /**
+     * Outputs spent by the transaction on UTXO chains, in input order
+     */
+    'inputs'?: Array<UnsignedTxInput>;
+    /**
+     * Human-readable breakdown of the transaction for the signing device to display
+     */
+    'details'?: Array<UnsignedTxDetail>;
+}
+
+export const UnsignedTxGet200ResponseUnsignedTxEncodingEnum = {
+    Hex: 'hex',
+    Base64: 'base64'
+} as const;
+
+export type UnsignedTxGet200ResponseUnsignedTxEncodingEnum = typeof UnsignedTxGet200ResponseUnsignedTxEncodingEnum[keyof typeof UnsignedTxGet200ResponseUnsignedTxEncodingEnum];
+
+export interface UnsignedTxInput {
+    'txid': string;
+    'vout': number;
+    /**
+     * Value in the smallest unit of the chain
+     */
+    'value': number;
+    'address': string;
+    /**
+     * Path of the address holding the output
+     */
+    'derivation_path'?: string;
+    /**
+     * Hex encoded digest the input is signed over
+     */
+    'signing_hash': string;
+}
+
+export interface UnsignedTxOutput {
+    'address': string;
+    /**
+     * Amount in whole coins, or in whole tokens with token_contract, or max to send everything after fees
+     */
+    'amount': string;
+}
+export interface UnsignedTxPost200Response {
+    'crypto_symbol': string;
+    'from_address': string;
+    /**
+     * Recipients paid, with the sweep amount with max
+     */
+    'outputs': Array<UnsignedTxOutput>;
+    /**
+     * Total sent to the outputs in whole coins or tokens
+     */
+    'amount': string;
+    /**
+     * Highest fee the transaction can pay in whole coins, exact to the base unit of the chain
+     */
+    'fee_amount': string;
+    /**
+     * Unsigned transaction, a PSBT on BTC and LTC or on SOL the message to sign, in unsigned_tx_encoding
+     */
+    'unsigned_tx': string;
+    /**
+     * Encoding of unsigned_tx, base64 on SOL, BTC and LTC and hex elsewhere
+     */
+    'unsigned_tx_encoding': UnsignedTxPost200ResponseUnsignedTxEncodingEnum;
+    'tx_size_bytes'?: number;
+    /**
+     * Hex encoded digest the signer signs, absent where the signer signs unsigned_tx itself or signs per input
+     */
+    'signing_hash'?: string;
+    /**
+     * Outputs spent by the transaction on UTXO chains, in input order
+     */
+    'inputs'?: Array<UnsignedTxInput>;
+    /**
+     * Human-readable breakdown of the transaction for the signing device to display
+     */
+    'details'?: Array<UnsignedTxDetail>;
+}
+
+export const UnsignedTxPost200ResponseUnsignedTxEncodingEnum = {
+    Hex: 'hex',
+    Base64: 'base64'
+} as const;
+
+export type UnsignedTxPost200ResponseUnsignedTxEncodingEnum = typeof UnsignedTxPost200ResponseUnsignedTxEncodingEnum[keyof typeof UnsignedTxPost200ResponseUnsignedTxEncodingEnum];
+
+export interface UnsignedTxPostRequest {
+    /**
+     * The cryptocurrency symbol (BTC, ETH, etc.)
+     */
+    'crypto_symbol': string;
+    /**
+     * Sending address, or the kpub of the wallet on KAS and its extended public key or output descriptor on BTC and LTC
+     */
+    'from_address': string;
+    /**
+     * Recipients paid in order, several only on BTC
+     */
+    'outputs': Array<UnsignedTxOutput>;
+    /**
+     * Fee rate in the rate_unit of /fees, as for GET /unsigned-tx
+     */
+    'fee_rate'?: number;
+    /**
+     * ERC-20 contract or SPL token mint of the token to send, ETH and SOL only
+     */
+    'token_contract'?: string;
+    /**
+     * Durable nonce account to use instead of a recent blockhash, SOL only
+     */
+    'nonce_account'?: string;
+    /**
+     * Hex encoded data of an OP_RETURN output of up to 80 bytes, BTC only
+     */
+    'op_return'?: string;
+    /**
+     * Outputs of from_address the transaction must spend, BTC only
+     */
+    'required_inputs'?: Array<Outpoint>;
+    /**
+     * Outputs of from_address the transaction must not spend, BTC only
+     */
+    'excluded_inputs'?: Array<Outpoint>;
+    /**
+     * Do not signal BIP-125 replaceability, BTC only
+     */
+    'disable_rbf'?: boolean;
+    /**
+     * Lock time of the transaction, a block height below 500000000 and a Unix time from there up to 4294967295, BTC only
+     */
+    'lock_time'?: number;
+}
+export interface UnspentOutput {
+    'txid': string;
+    'vout': number;
+    /**
+     * Value in the smallest unit of the chain
+     */
+    'value': number;
+    /**
+     * Value in whole coins
+     */
+    'amount': string;
+    'confirmations': number;
+    /**
+     * Height of the confirming block, absent for outputs in the mempool
+     */
+    'block_height'?: number;
+    /**
+     * Hex encoded output script
+     */
+    'script_pubkey': string;
+    'address': string;
+    /**
+     * Path of the address for keys and descriptors, absent for a single address
+     */
+    'derivation_path'?: string;
+}
+export interface UtxosGet200Response {
+    'crypto_symbol': string;
+    'address': string;
+    'utxos': Array<UnspentOutput>;
+    /**
+     * Sum of the listed outputs in the smallest unit of the chain
+     */
+    'total_value': number;
+    /**
+     * Sum of the listed outputs in whole coins
+     */
+    'total_amount': string;
+}
+export interface ValidateAddressGet200Response {
+    'crypto_symbol': string;
+    'address': string;
+    'valid': boolean;
+    /**
+     * Whether the input is a plain address or an extended public key
+     */
+    'kind'?: ValidateAddressGet200ResponseKindEnum;
+    /**
+     * Encoding or script type, such as p2wpkh, zpub or eip55
+     */
+    'format'?: string;
+    'network'?: ValidateAddressGet200ResponseNetworkEnum;
+    /**
+     * Why the address is invalid
+     */
+    'error'?: string;
+    'error_code'?: string;
+}
+
+export const ValidateAddressGet200ResponseKindEnum = {
+    Address: 'address',
+    ExtendedKey: 'extended_key'
+} as const;
+
+export type ValidateAddressGet200ResponseKindEnum = typeof ValidateAddressGet200ResponseKindEnum[keyof typeof ValidateAddressGet200ResponseKindEnum];
+export const ValidateAddressGet200ResponseNetworkEnum = {
+    Mainnet: 'mainnet',
+    Testnet: 'testnet'
+} as const;
+
+export type ValidateAddressGet200ResponseNetworkEnum = typeof ValidateAddressGet200ResponseNetworkEnum[keyof typeof ValidateAddressGet200ResponseNetworkEnum];
+
+/**
+ * DefaultApi - axios parameter creator
+ */
+export const DefaultApiAxiosParamCreator = function (configuration?: Configuration) {
+    return {
+        /**
+         * Reconstructs the end of day balance of each wallet from its transaction history and values it at that day\'s exchange rate. Days are UTC; the range is inclusive and limited to 366 days. 
+         * @summary Get daily portfolio fiat value over a date range
+         * @param {BalancesHistoryPostRequest} balancesHistoryPostRequest 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        balancesHistoryPost: async (balancesHistoryPostRequest: BalancesHistoryPostRequest, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            // verify required parameter 'balancesHistoryPostRequest' is not null or undefined
+            assertParamExists('balancesHistoryPost', 'balancesHistoryPostRequest', balancesHistoryPostRequest)
+            const localVarPath = `/balances/history`;
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'POST', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+
+    
+            localVarHeaderParameter['Content-Type'] = 'application/json';
+
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+            localVarRequestOptions.data = serializeDataIfNeeded(balancesHistoryPostRequest, localVarRequestOptions, configuration)
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+        /**
+         * 
+         * @summary Get balances for multiple addresses and cryptocurrencies
+         * @param {BalancesPostRequest} balancesPostRequest 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        balancesPost: async (balancesPostRequest: BalancesPostRequest, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            // verify required parameter 'balancesPostRequest' is not null or undefined
+            assertParamExists('balancesPost', 'balancesPostRequest', balancesPostRequest)
+            const localVarPath = `/balances`;
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'POST', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+
+    
+            localVarHeaderParameter['Content-Type'] = 'application/json';
+
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+            localVarRequestOptions.data = serializeDataIfNeeded(balancesPostRequest, localVarRequestOptions, configuration)
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+        /**
+         * 
+         * @summary Broadcast signed transaction
+         * @param {BroadcastPostRequest} broadcastPostRequest 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        broadcastPost: async (broadcastPostRequest: BroadcastPostRequest, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            // verify required parameter 'broadcastPostRequest' is not null or undefined
+            assertParamExists('broadcastPost', 'broadcastPostRequest', broadcastPostRequest)
+            const localVarPath = `/broadcast`;
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'POST', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+
+    
+            localVarHeaderParameter['Content-Type'] = 'application/json';
+
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+            localVarRequestOptions.data = serializeDataIfNeeded(broadcastPostRequest, localVarRequestOptions, configuration)
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+        /**
+         * Estimates the fee of a typical transaction at three priority levels. BTC and LTC rates come from the Electrum blockchain.estimatefee call in sat/vB, ETH rates are the next base fee plus a priority fee percentile from eth_feeHistory in gwei, SOL rates are priority fee percentiles from getRecentPrioritizationFees in micro-lamports per compute unit on top of the signature fee, and KAS rates come from the node fee estimator in sompi per gram. Chain estimates are cached for 15 seconds. 
+         * @summary Estimate slow, normal and fast fees
+         * @param {string} cryptoSymbol 
+         * @param {string} [fiatSymbol] The fiat currency the fee of a typical transaction is valued in
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        feesGet: async (cryptoSymbol: string, fiatSymbol?: string, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            // verify required parameter 'cryptoSymbol' is not null or undefined
+            assertParamExists('feesGet', 'cryptoSymbol', cryptoSymbol)
+            const localVarPath = `/fees`;
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'GET', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+            if (cryptoSymbol !== undefined) {
+                localVarQueryParameter['crypto_symbol'] = cryptoSymbol;
+            }
+
+            if (fiatSymbol !== undefined) {
+                localVarQueryParameter['fiat_symbol'] = fiatSymbol;
+            }
 
-export interface TransactionsGet200Response {
-    'crypto_symbol': string;
-    'address': string;
-    'transactions': Array<Transaction>;
-    'total_count': number;
-    'has_more': boolean;
-}
-export interface UnsignedTxGet200Response {
-    'crypto_symbol': string;
-    'from_address': string;
-    'to_address': string;
-    'amount': string;
-    'fee_amount': string;
-    'unsigned_tx': string;
-    'tx_size_bytes'?: number;
-}
 
-/**
- * DefaultApi - axios parameter creator
- */
-export const DefaultApiAxiosParamCreator = function (configuration?: Configuration) {
-    return {
+    
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
         /**
-         * 
-         * @summary Get balances for multiple addresses and cryptocurrencies
-         * @param {BalancesPostRequest} balancesPostRequest 
+         * Values every account once per fiat currency and returns per-account, per-wallet, per-asset and grand totals with allocation percentages and the 24h change. Balances are fetched once per account regardless of how many fiat currencies are requested. 
+         * @summary Get the value of named wallets in one or more fiat currencies
+         * @param {PortfolioPostRequest} portfolioPostRequest 
          * @param {*} [options] Override http request option.
          * @throws {RequiredError}
          */
-        balancesPost: async (balancesPostRequest: BalancesPostRequest, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
-            // verify required parameter 'balancesPostRequest' is not null or undefined
-            assertParamExists('balancesPost', 'balancesPostRequest', balancesPostRequest)
-            const localVarPath = `/balances`;
+        portfolioPost: async (portfolioPostRequest: PortfolioPostRequest, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            // verify required parameter 'portfolioPostRequest' is not null or undefined
+            assertParamExists('portfolioPost', 'portfolioPostRequest', portfolioPostRequest)
+            const localVarPath = `/portfolio`;
             // use dummy base URL string because the URL constructor only accepts absolute URLs.
             const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
             let baseOptions;
@@ -161,7 +1190,7 @@ export const DefaultApiAxiosParamCreator = function (configuration?: Configurati
             setSearchParams(localVarUrlObj, localVarQueryParameter);
             let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
             localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
-            localVarRequestOptions.data = serializeDataIfNeeded(balancesPostRequest, localVarRequestOptions, configuration)
+            localVarRequestOptions.data = serializeDataIfNeeded(portfolioPostRequest, localVarRequestOptions, configuration)
 
             return {
                 url: toPathString(localVarUrlObj),
@@ -169,16 +1198,20 @@ export const DefaultApiAxiosParamCreator = function (configuration?: Configurati
             };
         },
         /**
-         * 
-         * @summary Broadcast signed transaction
-         * @param {BroadcastPostRequest} broadcastPostRequest 
+         * Derives addresses on the external chain of xpub and returns the first one after the last address with on-chain history, scanning with a gap limit of 20. The script type follows the key version: zpub and vpub give native segwit, ypub, upub and Mtub nested segwit, and a plain BTC xpub taproot. Set count to also return the addresses after it. 
+         * @summary Get the next unused receive address of an extended public key
+         * @param {string} cryptoSymbol 
+         * @param {string} xpub 
+         * @param {number} [count] Number of consecutive unused addresses to return
          * @param {*} [options] Override http request option.
          * @throws {RequiredError}
          */
-        broadcastPost: async (broadcastPostRequest: BroadcastPostRequest, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
-            // verify required parameter 'broadcastPostRequest' is not null or undefined
-            assertParamExists('broadcastPost', 'broadcastPostRequest', broadcastPostRequest)
-            const localVarPath = `/broadcast`;
+        receiveAddressGet: async (cryptoSymbol: string, xpub: string, count?: number, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            // verify required parameter 'cryptoSymbol' is not null or undefined
+            assertParamExists('receiveAddressGet', 'cryptoSymbol', cryptoSymbol)
+            // verify required parameter 'xpub' is not null or undefined
+            assertParamExists('receiveAddressGet', 'xpub', xpub)
+            const localVarPath = `/receive-address`;
             // use dummy base URL string because the URL constructor only accepts absolute URLs.
             const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
             let baseOptions;
@@ -186,18 +1219,70 @@ export const DefaultApiAxiosParamCreator = function (configuration?: Configurati
                 baseOptions = configuration.baseOptions;
             }
 
-            const localVarRequestOptions = { method: 'POST', ...baseOptions, ...options};
+            const localVarRequestOptions = { method: 'GET', ...baseOptions, ...options};
             const localVarHeaderParameter = {} as any;
             const localVarQueryParameter = {} as any;
 
+            if (cryptoSymbol !== undefined) {
+                localVarQueryParameter['crypto_symbol'] = cryptoSymbol;
+            }
+
+            if (xpub !== undefined) {
+                localVarQueryParameter['xpub'] = xpub;
+            }
+
+            if (count !== undefined) {
+                localVarQueryParameter['count'] = count;
+            }
+
 
     
-            localVarHeaderParameter['Content-Type'] = 'application/json';
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+        /**
+         * Looks up one transaction and decodes it in full. BTC, LTC and KAS list the outputs the transaction spends as inputs and the outputs it creates, with their addresses and values. ETH lists the ether the transaction sends and the ERC-20 Transfer events it emits as transfers, along with every log of its receipt; ether moved by internal calls is not listed. SOL lists every instruction, inner instructions included, and the System and SPL token transfers among them as transfers between the owners of the accounts involved. With wallet set, owned marks the inputs and outputs paying the addresses of that extended public key, or of that address on KAS, and from_owned and to_owned mark the transfer sides of that address on ETH and SOL. status is failed for a transaction included in a block whose execution failed, which only cost the fee and lists no transfers. block_height and confirmations count slots on SOL and blue score on KAS. 
+         * @summary Get the decoded details of one transaction
+         * @param {string} cryptoSymbol 
+         * @param {string} txid Transaction ID, or signature on SOL
+         * @param {string} [wallet] Extended public key, or address on ETH, SOL and KAS, whose inputs, outputs and transfers are marked owned
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        transactionsCryptoSymbolTxidGet: async (cryptoSymbol: string, txid: string, wallet?: string, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            // verify required parameter 'cryptoSymbol' is not null or undefined
+            assertParamExists('transactionsCryptoSymbolTxidGet', 'cryptoSymbol', cryptoSymbol)
+            // verify required parameter 'txid' is not null or undefined
+            assertParamExists('transactionsCryptoSymbolTxidGet', 'txid', txid)
+            const localVarPath = `/transactions/{crypto_symbol}/{txid}`
+                .replace(`{${"crypto_symbol"}}`, encodeURIComponent(String(cryptoSymbol)))
+                .replace(`{${"txid"}}`, encodeURIComponent(String(txid)));
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'GET', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+            if (wallet !== undefined) {
+                localVarQueryParameter['wallet'] = wallet;
+            }
+
 
+    
             setSearchParams(localVarUrlObj, localVarQueryParameter);
             let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
             localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
-            localVarRequestOptions.data = serializeDataIfNeeded(broadcastPostRequest, localVarRequestOptions, configuration)
 
             return {
                 url: toPathString(localVarUrlObj),
@@ -205,16 +1290,24 @@ export const DefaultApiAxiosParamCreator = function (configuration?: Configurati
             };
         },
         /**
-         * 
+         * Lists the history of an address or extended public key, newest first unless sort is asc. amount is the net change to the wallet, so outgoing amounts include the fee it paid. ETH history is read from the Etherscan or Blockscout indexer configured for the network; every ERC-20 token moved gets an entry of its own with token_contract set, under the transaction_id of the ether entry carrying the fee. SOL history is paged by the node with getSignaturesForAddress: offset and limit count signatures, entries are parsed from the System and SPL transfers and the balance changes of each transaction, and total_count is absent. KAS history merges the transactions of every active address of a kpub into one entry per transaction_id; block_height and confirmations count blue score. Filters apply before paging and total_count counts the entries that pass them; on SOL they apply within each page of signatures, so a page can hold fewer entries than limit while has_more is true. Pass next_cursor as cursor to read the next page; unlike offset it keeps its place when new transactions arrive. 
          * @summary Get transaction history for an address
          * @param {string} cryptoSymbol 
          * @param {string} address 
+         * @param {string} [fiatSymbol] The fiat currency used to value each transaction at its block time
          * @param {number} [limit] 
          * @param {number} [offset] 
+         * @param {string} [cursor] Opaque next_cursor of the previous page. Takes precedence over offset
+         * @param {string} [direction] incoming or outgoing to only list entries of that direction
+         * @param {string} [since] RFC 3339 time, leave out entries older than this
+         * @param {string} [until] RFC 3339 time, leave out entries newer than this
+         * @param {string} [minAmount] Leave out entries moving less than this amount, in whole coins or in whole tokens for token entries
+         * @param {string} [status] confirmed or pending to only list entries with or without confirmations
+         * @param {string} [sort] desc lists newest first, asc oldest first. SOL only lists newest first
          * @param {*} [options] Override http request option.
          * @throws {RequiredError}
          */
-        transactionsGet: async (cryptoSymbol: string, address: string, limit?: number, offset?: number, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+        transactionsGet: async (cryptoSymbol: string, address: string, fiatSymbol?: string, limit?: number, offset?: number, cursor?: string, direction?: string, since?: string, until?: string, minAmount?: string, status?: string, sort?: string, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
             // verify required parameter 'cryptoSymbol' is not null or undefined
             assertParamExists('transactionsGet', 'cryptoSymbol', cryptoSymbol)
             // verify required parameter 'address' is not null or undefined
@@ -239,6 +1332,10 @@ export const DefaultApiAxiosParamCreator = function (configuration?: Configurati
                 localVarQueryParameter['address'] = address;
             }
 
+            if (fiatSymbol !== undefined) {
+                localVarQueryParameter['fiat_symbol'] = fiatSymbol;
+            }
+
             if (limit !== undefined) {
                 localVarQueryParameter['limit'] = limit;
             }
@@ -247,6 +1344,34 @@ export const DefaultApiAxiosParamCreator = function (configuration?: Configurati
                 localVarQueryParameter['offset'] = offset;
             }
 
+            if (cursor !== undefined) {
+                localVarQueryParameter['cursor'] = cursor;
+            }
+
+            if (direction !== undefined) {
+                localVarQueryParameter['direction'] = direction;
+            }
+
+            if (since !== undefined) {
+                localVarQueryParameter['since'] = since;
+            }
+
+            if (until !== undefined) {
+                localVarQueryParameter['until'] = until;
+            }
+
+            if (minAmount !== undefined) {
+                localVarQueryParameter['min_amount'] = minAmount;
+            }
+
+            if (status !== undefined) {
+                localVarQueryParameter['status'] = status;
+            }
+
+            if (sort !== undefined) {
+                localVarQueryParameter['sort'] = sort;
+            }
+
 
     
             setSearchParams(localVarUrlObj, localVarQueryParameter);
@@ -259,17 +1384,55 @@ export const DefaultApiAxiosParamCreator = function (configuration?: Configurati
             };
         },
         /**
-         * 
+         * Builds a PSBT speeding up txid, an unconfirmed transaction of the wallet from_address, BTC only. With rbf the BIP-125 replacement spends the same inputs to the same outputs and takes the higher fee out of the change, paying at least the fee of txid and 1 sat/vB for its own size; every input must belong to the wallet. With cpfp a child spends the largest unspent wallet output of txid to a change address so that parent and child together pay fee_rate. 
+         * @summary Generate a transaction speeding up an unconfirmed one
+         * @param {UnsignedTxBumpPostRequest} unsignedTxBumpPostRequest 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        unsignedTxBumpPost: async (unsignedTxBumpPostRequest: UnsignedTxBumpPostRequest, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            // verify required parameter 'unsignedTxBumpPostRequest' is not null or undefined
+            assertParamExists('unsignedTxBumpPost', 'unsignedTxBumpPostRequest', unsignedTxBumpPostRequest)
+            const localVarPath = `/unsigned-tx/bump`;
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'POST', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+
+    
+            localVarHeaderParameter['Content-Type'] = 'application/json';
+
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+            localVarRequestOptions.data = serializeDataIfNeeded(unsignedTxBumpPostRequest, localVarRequestOptions, configuration)
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+        /**
+         * Builds an unsigned transaction for an air-gapped signer. ETH transactions are EIP-1559 typed transactions, RLP encoded with the chain ID, nonced from the pending state and limited by eth_estimateGas; with token_contract the amount is sent as an ERC-20 transfer of that token instead of ether. SOL transactions are System transfers, or with token_contract SPL transfers of that mint that create the associated token account of the recipient when it is missing; unsigned_tx is the base64 message the signer signs as is. With nonce_account the SOL message uses the durable nonce of that account instead of a recent blockhash, so it stays valid until the nonce is advanced. KAS transactions spend the outputs of the kpub in from_address, largest first, with change to its first unused change address, and are returned as kaspawallet partially signed transactions; the fee pays for the larger of the compute mass and the KIP-9 storage mass, and outputs too small for the storage mass limit are rejected. BTC and LTC transactions are PSBTs spending the P2WPKH, P2SH-P2WPKH and P2PKH outputs, and on BTC the P2TR outputs, of the extended public key, output descriptor or address in from_address, largest first, with change to the first unused change address or back to the address; BTC inputs signal BIP-125 replaceability. Peg-outs from MWEB are not spent before they have 6 confirmations, and MWEB addresses are rejected as recipients. inputs lists the spent outputs with the path and digest each is signed with. fee_amount is the highest fee the transaction can pay, from getFeeForMessage on SOL. With amount=max the transaction sends everything the sender can after fees: BTC, LTC and KAS spend every output worth more than the fee of spending it, without change; ETH sends the pending balance less the max fee; SOL sends the balance less the fee and the rent-exempt minimum of an account without data; token transfers send every token held. 
          * @summary Generate an unsigned transaction
          * @param {string} cryptoSymbol 
-         * @param {string} fromAddress 
+         * @param {string} fromAddress Sending address, or the kpub of the wallet on KAS and its extended public key or output descriptor on BTC and LTC
          * @param {string} toAddress 
-         * @param {string} amount 
-         * @param {number} [feeRate] 
+         * @param {string} amount Amount in whole coins, or in whole tokens with token_contract, or max to send everything after fees
+         * @param {number} [feeRate] Fee rate in the rate_unit of /fees: gwei of max fee per gas on ETH, micro-lamports of priority fee per compute unit on SOL, sompi per gram on KAS, sat per vbyte on BTC, litoshi per vbyte on LTC. Defaults to the normal estimate on ETH, KAS, BTC and LTC and no priority fee on SOL
+         * @param {string} [tokenContract] ERC-20 contract or SPL token mint of the token to send, ETH and SOL only
+         * @param {string} [nonceAccount] Durable nonce account to use instead of a recent blockhash, SOL only. from_address must be its nonce authority
          * @param {*} [options] Override http request option.
          * @throws {RequiredError}
          */
-        unsignedTxGet: async (cryptoSymbol: string, fromAddress: string, toAddress: string, amount: string, feeRate?: number, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+        unsignedTxGet: async (cryptoSymbol: string, fromAddress: string, toAddress: string, amount: string, feeRate?: number, tokenContract?: string, nonceAccount?: string, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
             // verify required parameter 'cryptoSymbol' is not null or undefined
             assertParamExists('unsignedTxGet', 'cryptoSymbol', cryptoSymbol)
             // verify required parameter 'fromAddress' is not null or undefined
@@ -294,20 +1457,162 @@ export const DefaultApiAxiosParamCreator = function (configuration?: Configurati
                 localVarQueryParameter['crypto_symbol'] = cryptoSymbol;
             }
 
-            if (fromAddress !== undefined) {
-                localVarQueryParameter['from_address'] = fromAddress;
-            }
-
-            if (toAddress !== undefined) {
-                localVarQueryParameter['to_address'] = toAddress;
-            }
-
-            if (amount !== undefined) {
-                localVarQueryParameter['amount'] = amount;
-            }
-
-            if (feeRate !== undefined) {
-                localVarQueryParameter['fee_rate'] = feeRate;
+            if (fromAddress !== undefined) {
+                localVarQueryParameter['from_address'] = fromAddress;
+            }
+
+            if (toAddress !== undefined) {
+                localVarQueryParameter['to_address'] = toAddress;
+            }
+
+            if (amount !== undefined) {
+                localVarQueryParameter['amount'] = amount;
+            }
+
+            if (feeRate !== undefined) {
+                localVarQueryParameter['fee_rate'] = feeRate;
+            }
+
+            if (tokenContract !== undefined) {
+                localVarQueryParameter['token_contract'] = tokenContract;
+            }
+
+            if (nonceAccount !== undefined) {
+                localVarQueryParameter['nonce_account'] = nonceAccount;
+            }
+
+
+    
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+        /**
+         * Builds an unsigned transaction like GET /unsigned-tx from a JSON body that can pay several outputs. On BTC op_return adds a data output of up to 80 bytes after the recipients, required_inputs are spent before any other output of from_address and excluded_inputs are never spent, inputs signal BIP-125 replaceability unless disable_rbf is set, and lock_time sets the lock time of the transaction. A single output may send max to sweep from_address. Other chains take a single output without these options. 
+         * @summary Generate an unsigned transaction with several outputs
+         * @param {UnsignedTxPostRequest} unsignedTxPostRequest 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        unsignedTxPost: async (unsignedTxPostRequest: UnsignedTxPostRequest, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            // verify required parameter 'unsignedTxPostRequest' is not null or undefined
+            assertParamExists('unsignedTxPost', 'unsignedTxPostRequest', unsignedTxPostRequest)
+            const localVarPath = `/unsigned-tx`;
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'POST', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+
+    
+            localVarHeaderParameter['Content-Type'] = 'application/json';
+
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+            localVarRequestOptions.data = serializeDataIfNeeded(unsignedTxPostRequest, localVarRequestOptions, configuration)
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+        /**
+         * Lists the UTXO set of a BTC or LTC address, SLIP-132 extended public key or single key wpkh, sh(wpkh) or tr output descriptor, or of a Kaspa address or kpub. Keys are scanned on their external and change chains up to a gap limit of 20. Values are in the smallest unit of the chain; on Kaspa block_height is the DAA score of the accepting block. 
+         * @summary List the unspent outputs of an address, xpub or descriptor
+         * @param {string} cryptoSymbol 
+         * @param {string} address Address, extended public key or output descriptor
+         * @param {number} [minConfirmations] Leave out outputs with fewer confirmations, 0 includes the mempool
+         * @param {number} [dustThreshold] Leave out outputs worth less than this many satoshi, litoshi or sompi
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        utxosGet: async (cryptoSymbol: string, address: string, minConfirmations?: number, dustThreshold?: number, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            // verify required parameter 'cryptoSymbol' is not null or undefined
+            assertParamExists('utxosGet', 'cryptoSymbol', cryptoSymbol)
+            // verify required parameter 'address' is not null or undefined
+            assertParamExists('utxosGet', 'address', address)
+            const localVarPath = `/utxos`;
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'GET', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+            if (cryptoSymbol !== undefined) {
+                localVarQueryParameter['crypto_symbol'] = cryptoSymbol;
+            }
+
+            if (address !== undefined) {
+                localVarQueryParameter['address'] = address;
+            }
+
+            if (minConfirmations !== undefined) {
+                localVarQueryParameter['min_confirmations'] = minConfirmations;
+            }
+
+            if (dustThreshold !== undefined) {
+                localVarQueryParameter['dust_threshold'] = dustThreshold;
+            }
+
+
+    
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+        /**
+         * Checks an address or extended public key against the chain of crypto_symbol without any upstream call: EIP-55 checksum for ETH, bech32 or base58 with a network check for BTC and LTC, the Kaspa bech32 prefix for KAS and an on-curve base58 key for SOL. A malformed address, or one of the other network, is reported with valid set to false. 
+         * @summary Validate an address or extended public key
+         * @param {string} cryptoSymbol 
+         * @param {string} address 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        validateAddressGet: async (cryptoSymbol: string, address: string, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            // verify required parameter 'cryptoSymbol' is not null or undefined
+            assertParamExists('validateAddressGet', 'cryptoSymbol', cryptoSymbol)
+            // verify required parameter 'address' is not null or undefined
+            assertParamExists('validateAddressGet', 'address', address)
+            const localVarPath = `/validate-address`;
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'GET', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+            if (cryptoSymbol !== undefined) {
+                localVarQueryParameter['crypto_symbol'] = cryptoSymbol;
+            }
+
+            if (address !== undefined) {
+                localVarQueryParameter['address'] = address;
             }
 
 
@@ -330,6 +1635,19 @@ export const DefaultApiAxiosParamCreator = function (configuration?: Configurati
 export const DefaultApiFp = function(configuration?: Configuration) {
     const localVarAxiosParamCreator = DefaultApiAxiosParamCreator(configuration)
     return {
+        /**
+         * Reconstructs the end of day balance of each wallet from its transaction history and values it at that day\'s exchange rate. Days are UTC; the range is inclusive and limited to 366 days. 
+         * @summary Get daily portfolio fiat value over a date range
+         * @param {BalancesHistoryPostRequest} balancesHistoryPostRequest 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async balancesHistoryPost(balancesHistoryPostRequest: BalancesHistoryPostRequest, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<BalancesHistoryPost200Response>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.balancesHistoryPost(balancesHistoryPostRequest, options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['DefaultApi.balancesHistoryPost']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
         /**
          * 
          * @summary Get balances for multiple addresses and cryptocurrencies
@@ -357,38 +1675,161 @@ export const DefaultApiFp = function(configuration?: Configuration) {
             return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
         },
         /**
-         * 
+         * Estimates the fee of a typical transaction at three priority levels. BTC and LTC rates come from the Electrum blockchain.estimatefee call in sat/vB, ETH rates are the next base fee plus a priority fee percentile from eth_feeHistory in gwei, SOL rates are priority fee percentiles from getRecentPrioritizationFees in micro-lamports per compute unit on top of the signature fee, and KAS rates come from the node fee estimator in sompi per gram. Chain estimates are cached for 15 seconds. 
+         * @summary Estimate slow, normal and fast fees
+         * @param {string} cryptoSymbol 
+         * @param {string} [fiatSymbol] The fiat currency the fee of a typical transaction is valued in
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async feesGet(cryptoSymbol: string, fiatSymbol?: string, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<FeesGet200Response>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.feesGet(cryptoSymbol, fiatSymbol, options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['DefaultApi.feesGet']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+        /**
+         * Values every account once per fiat currency and returns per-account, per-wallet, per-asset and grand totals with allocation percentages and the 24h change. Balances are fetched once per account regardless of how many fiat currencies are requested. 
+         * @summary Get the value of named wallets in one or more fiat currencies
+         * @param {PortfolioPostRequest} portfolioPostRequest 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async portfolioPost(portfolioPostRequest: PortfolioPostRequest, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<PortfolioPost200Response>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.portfolioPost(portfolioPostRequest, options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['DefaultApi.portfolioPost']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+        /**
+         * Derives addresses on the external chain of xpub and returns the first one after the last address with on-chain history, scanning with a gap limit of 20. The script type follows the key version: zpub and vpub give native segwit, ypub, upub and Mtub nested segwit, and a plain BTC xpub taproot. Set count to also return the addresses after it. 
+         * @summary Get the next unused receive address of an extended public key
+         * @param {string} cryptoSymbol 
+         * @param {string} xpub 
+         * @param {number} [count] Number of consecutive unused addresses to return
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async receiveAddressGet(cryptoSymbol: string, xpub: string, count?: number, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<ReceiveAddressGet200Response>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.receiveAddressGet(cryptoSymbol, xpub, count, options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['DefaultApi.receiveAddressGet']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+        /**
+         * Looks up one transaction and decodes it in full. BTC, LTC and KAS list the outputs the transaction spends as inputs and the outputs it creates, with their addresses and values. ETH lists the ether the transaction sends and the ERC-20 Transfer events it emits as transfers, along with every log of its receipt; ether moved by internal calls is not listed. SOL lists every instruction, inner instructions included, and the System and SPL token transfers among them as transfers between the owners of the accounts involved. With wallet set, owned marks the inputs and outputs paying the addresses of that extended public key, or of that address on KAS, and from_owned and to_owned mark the transfer sides of that address on ETH and SOL. status is failed for a transaction included in a block whose execution failed, which only cost the fee and lists no transfers. block_height and confirmations count slots on SOL and blue score on KAS. 
+         * @summary Get the decoded details of one transaction
+         * @param {string} cryptoSymbol 
+         * @param {string} txid Transaction ID, or signature on SOL
+         * @param {string} [wallet] Extended public key, or address on ETH, SOL and KAS, whose inputs, outputs and transfers are marked owned
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async transactionsCryptoSymbolTxidGet(cryptoSymbol: string, txid: string, wallet?: string, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<TransactionsCryptoSymbolTxidGet200Response>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.transactionsCryptoSymbolTxidGet(cryptoSymbol, txid, wallet, options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['DefaultApi.transactionsCryptoSymbolTxidGet']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+        /**
+         * Lists the history of an address or extended public key, newest first unless sort is asc. amount is the net change to the wallet, so outgoing amounts include the fee it paid. ETH history is read from the Etherscan or Blockscout indexer configured for the network; every ERC-20 token moved gets an entry of its own with token_contract set, under the transaction_id of the ether entry carrying the fee. SOL history is paged by the node with getSignaturesForAddress: offset and limit count signatures, entries are parsed from the System and SPL transfers and the balance changes of each transaction, and total_count is absent. KAS history merges the transactions of every active address of a kpub into one entry per transaction_id; block_height and confirmations count blue score. Filters apply before paging and total_count counts the entries that pass them; on SOL they apply within each page of signatures, so a page can hold fewer entries than limit while has_more is true. Pass next_cursor as cursor to read the next page; unlike offset it keeps its place when new transactions arrive. 
          * @summary Get transaction history for an address
          * @param {string} cryptoSymbol 
          * @param {string} address 
+         * @param {string} [fiatSymbol] The fiat currency used to value each transaction at its block time
          * @param {number} [limit] 
          * @param {number} [offset] 
+         * @param {string} [cursor] Opaque next_cursor of the previous page. Takes precedence over offset
+         * @param {string} [direction] incoming or outgoing to only list entries of that direction
+         * @param {string} [since] RFC 3339 time, leave out entries older than this
+         * @param {string} [until] RFC 3339 time, leave out entries newer than this
+         * @param {string} [minAmount] Leave out entries moving less than this amount, in whole coins or in whole tokens for token entries
+         * @param {string} [status] confirmed or pending to only list entries with or without confirmations
+         * @param {string} [sort] desc lists newest first, asc oldest first. SOL only lists newest first
          * @param {*} [options] Override http request option.
          * @throws {RequiredError}
          */
-        async transactionsGet(cryptoSymbol: string, address: string, limit?: number, offset?: number, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<TransactionsGet200Response>> {
-            const localVarAxiosArgs = await localVarAxiosParamCreator.transactionsGet(cryptoSymbol, address, limit, offset, options);
+        async transactionsGet(cryptoSymbol: string, address: string, fiatSymbol?: string, limit?: number, offset?: number, cursor?: string, direction?: string, since?: string, until?: string, minAmount?: string, status?: string, sort?: string, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<TransactionsGet200Response>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.transactionsGet(cryptoSymbol, address, fiatSymbol, limit, offset, cursor, direction, since, until, minAmount, status, sort, options);
             const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
             const localVarOperationServerBasePath = operationServerMap['DefaultApi.transactionsGet']?.[localVarOperationServerIndex]?.url;
             return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
         },
         /**
-         * 
+         * Builds a PSBT speeding up txid, an unconfirmed transaction of the wallet from_address, BTC only. With rbf the BIP-125 replacement spends the same inputs to the same outputs and takes the higher fee out of the change, paying at least the fee of txid and 1 sat/vB for its own size; every input must belong to the wallet. With cpfp a child spends the largest unspent wallet output of txid to a change address so that parent and child together pay fee_rate. 
+         * @summary Generate a transaction speeding up an unconfirmed one
+         * @param {UnsignedTxBumpPostRequest} unsignedTxBumpPostRequest 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async unsignedTxBumpPost(unsignedTxBumpPostRequest: UnsignedTxBumpPostRequest, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<UnsignedTxBumpPost200Response>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.unsignedTxBumpPost(unsignedTxBumpPostRequest, options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['DefaultApi.unsignedTxBumpPost']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+        /**
+         * Builds an unsigned transaction for an air-gapped signer. ETH transactions are EIP-1559 typed transactions, RLP encoded with the chain ID, nonced from the pending state and limited by eth_estimateGas; with token_contract the amount is sent as an ERC-20 transfer of that token instead of ether. SOL transactions are System transfers, or with token_contract SPL transfers of that mint that create the associated token account of the recipient when it is missing; unsigned_tx is the base64 message the signer signs as is. With nonce_account the SOL message uses the durable nonce of that account instead of a recent blockhash, so it stays valid until the nonce is advanced. KAS transactions spend the outputs of the kpub in from_address, largest first, with change to its first unused change address, and are returned as kaspawallet partially signed transactions; the fee pays for the larger of the compute mass and the KIP-9 storage mass, and outputs too small for the storage mass limit are rejected. BTC and LTC transactions are PSBTs spending the P2WPKH, P2SH-P2WPKH and P2PKH outputs, and on BTC the P2TR outputs, of the extended public key, output descriptor or address in from_address, largest first, with change to the first unused change address or back to the address; BTC inputs signal BIP-125 replaceability. Peg-outs from MWEB are not spent before they have 6 confirmations, and MWEB addresses are rejected as recipients. inputs lists the spent outputs with the path and digest each is signed with. fee_amount is the highest fee the transaction can pay, from getFeeForMessage on SOL. With amount=max the transaction sends everything the sender can after fees: BTC, LTC and KAS spend every output worth more than the fee of spending it, without change; ETH sends the pending balance less the max fee; SOL sends the balance less the fee and the rent-exempt minimum of an account without data; token transfers send every token held. 
          * @summary Generate an unsigned transaction
          * @param {string} cryptoSymbol 
-         * @param {string} fromAddress 
+         * @param {string} fromAddress Sending address, or the kpub of the wallet on KAS and its extended public key or output descriptor on BTC and LTC
          * @param {string} toAddress 
-         * @param {string} amount 
-         * @param {number} [feeRate] 
+         * @param {string} amount Amount in whole coins, or in whole tokens with token_contract, or max to send everything after fees
+         * @param {number} [feeRate] Fee rate in the rate_unit of /fees: gwei of max fee per gas on ETH, micro-lamports of priority fee per compute unit on SOL, sompi per gram on KAS, sat per vbyte on BTC, litoshi per vbyte on LTC. Defaults to the normal estimate on ETH, KAS, BTC and LTC and no priority fee on SOL
+         * @param {string} [tokenContract] ERC-20 contract or SPL token mint of the token to send, ETH and SOL only
+         * @param {string} [nonceAccount] Durable nonce account to use instead of a recent blockhash, SOL only. from_address must be its nonce authority
          * @param {*} [options] Override http request option.
          * @throws {RequiredError}
          */
-        async unsignedTxGet(cryptoSymbol: string, fromAddress: string, toAddress: string, amount: string, feeRate?: number, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<UnsignedTxGet200Response>> {
-            const localVarAxiosArgs = await localVarAxiosParamCreator.unsignedTxGet(cryptoSymbol, fromAddress, toAddress, amount, feeRate, options);
+        async unsignedTxGet(cryptoSymbol: string, fromAddress: string, toAddress: string, amount: string, feeRate?: number, tokenContract?: string, nonceAccount?: string, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<UnsignedTxGet200Response>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.unsignedTxGet(cryptoSymbol, fromAddress, toAddress, amount, feeRate, tokenContract, nonceAccount, options);
             const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
             const localVarOperationServerBasePath = operationServerMap['DefaultApi.unsignedTxGet']?.[localVarOperationServerIndex]?.url;
             return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
         },
+        /**
+         * Builds an unsigned transaction like GET /unsigned-tx from a JSON body that can pay several outputs. On BTC op_return adds a data output of up to 80 bytes after the recipients, required_inputs are spent before any other output of from_address and excluded_inputs are never spent, inputs signal BIP-125 replaceability unless disable_rbf is set, and lock_time sets the lock time of the transaction. A single output may send max to sweep from_address. Other chains take a single output without these options. 
+         * @summary Generate an unsigned transaction with several outputs
+         * @param {UnsignedTxPostRequest} unsignedTxPostRequest 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async unsignedTxPost(unsignedTxPostRequest: UnsignedTxPostRequest, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<UnsignedTxPost200Response>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.unsignedTxPost(unsignedTxPostRequest, options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['DefaultApi.unsignedTxPost']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+        /**
+         * Lists the UTXO set of a BTC or LTC address, SLIP-132 extended public key or single key wpkh, sh(wpkh) or tr output descriptor, or of a Kaspa address or kpub. Keys are scanned on their external and change chains up to a gap limit of 20. Values are in the smallest unit of the chain; on Kaspa block_height is the DAA score of the accepting block. 
+         * @summary List the unspent outputs of an address, xpub or descriptor
+         * @param {string} cryptoSymbol 
+         * @param {string} address Address, extended public key or output descriptor
+         * @param {number} [minConfirmations] Leave out outputs with fewer confirmations, 0 includes the mempool
+         * @param {number} [dustThreshold] Leave out outputs worth less than this many satoshi, litoshi or sompi
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async utxosGet(cryptoSymbol: string, address: string, minConfirmations?: number, dustThreshold?: number, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<UtxosGet200Response>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.utxosGet(cryptoSymbol, address, minConfirmations, dustThreshold, options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['DefaultApi.utxosGet']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+        /**
+         * Checks an address or extended public key against the chain of crypto_symbol without any upstream call: EIP-55 checksum for ETH, bech32 or base58 with a network check for BTC and LTC, the Kaspa bech32 prefix for KAS and an on-curve base58 key for SOL. A malformed address, or one of the other network, is reported with valid set to false. 
+         * @summary Validate an address or extended public key
+         * @param {string} cryptoSymbol 
+         * @param {string} address 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async validateAddressGet(cryptoSymbol: string, address: string, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<ValidateAddressGet200Response>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.validateAddressGet(cryptoSymbol, address, options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['DefaultApi.validateAddressGet']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
     }
 };
 
@@ -398,6 +1839,16 @@ export const DefaultApiFp = function(configuration?: Configuration) {
 export const DefaultApiFactory = function (configuration?: Configuration, basePath?: string, axios?: AxiosInstance) {
     const localVarFp = DefaultApiFp(configuration)
     return {
+        /**
+         * Reconstructs the end of day balance of each wallet from its transaction history and values it at that day\'s exchange rate. Days are UTC; the range is inclusive and limited to 366 days. 
+         * @summary Get daily portfolio fiat value over a date range
+         * @param {BalancesHistoryPostRequest} balancesHistoryPostRequest 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        balancesHistoryPost(balancesHistoryPostRequest: BalancesHistoryPostRequest, options?: RawAxiosRequestConfig): AxiosPromise<BalancesHistoryPost200Response> {
+            return localVarFp.balancesHistoryPost(balancesHistoryPostRequest, options).then((request) => request(axios, basePath));
+        },
         /**
          * 
          * @summary Get balances for multiple addresses and cryptocurrencies
@@ -419,31 +1870,130 @@ export const DefaultApiFactory = function (configuration?: Configuration, basePa
             return localVarFp.broadcastPost(broadcastPostRequest, options).then((request) => request(axios, basePath));
         },
         /**
-         * 
+         * Estimates the fee of a typical transaction at three priority levels. BTC and LTC rates come from the Electrum blockchain.estimatefee call in sat/vB, ETH rates are the next base fee plus a priority fee percentile from eth_feeHistory in gwei, SOL rates are priority fee percentiles from getRecentPrioritizationFees in micro-lamports per compute unit on top of the signature fee, and KAS rates come from the node fee estimator in sompi per gram. Chain estimates are cached for 15 seconds. 
+         * @summary Estimate slow, normal and fast fees
+         * @param {string} cryptoSymbol 
+         * @param {string} [fiatSymbol] The fiat currency the fee of a typical transaction is valued in
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        feesGet(cryptoSymbol: string, fiatSymbol?: string, options?: RawAxiosRequestConfig): AxiosPromise<FeesGet200Response> {
+            return localVarFp.feesGet(cryptoSymbol, fiatSymbol, options).then((request) => request(axios, basePath));
+        },
+        /**
+         * Values every account once per fiat currency and returns per-account, per-wallet, per-asset and grand totals with allocation percentages and the 24h change. Balances are fetched once per account regardless of how many fiat currencies are requested. 
+         * @summary Get the value of named wallets in one or more fiat currencies
+         * @param {PortfolioPostRequest} portfolioPostRequest 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        portfolioPost(portfolioPostRequest: PortfolioPostRequest, options?: RawAxiosRequestConfig): AxiosPromise<PortfolioPost200Response> {
+            return localVarFp.portfolioPost(portfolioPostRequest, options).then((request) => request(axios, basePath));
+        },
+        /**
+         * Derives addresses on the external chain of xpub and returns the first one after the last address with on-chain history, scanning with a gap limit of 20. The script type follows the key version: zpub and vpub give native segwit, ypub, upub and Mtub nested segwit, and a plain BTC xpub taproot. Set count to also return the addresses after it. 
+         * @summary Get the next unused receive address of an extended public key
+         * @param {string} cryptoSymbol 
+         * @param {string} xpub 
+         * @param {number} [count] Number of consecutive unused addresses to return
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        receiveAddressGet(cryptoSymbol: string, xpub: string, count?: number, options?: RawAxiosRequestConfig): AxiosPromise<ReceiveAddressGet200Response> {
+            return localVarFp.receiveAddressGet(cryptoSymbol, xpub, count, options).then((request) => request(axios, basePath));
+        },
+        /**
+         * Looks up one transaction and decodes it in full. BTC, LTC and KAS list the outputs the transaction spends as inputs and the outputs it creates, with their addresses and values. ETH lists the ether the transaction sends and the ERC-20 Transfer events it emits as transfers, along with every log of its receipt; ether moved by internal calls is not listed. SOL lists every instruction, inner instructions included, and the System and SPL token transfers among them as transfers between the owners of the accounts involved. With wallet set, owned marks the inputs and outputs paying the addresses of that extended public key, or of that address on KAS, and from_owned and to_owned mark the transfer sides of that address on ETH and SOL. status is failed for a transaction included in a block whose execution failed, which only cost the fee and lists no transfers. block_height and confirmations count slots on SOL and blue score on KAS. 
+         * @summary Get the decoded details of one transaction
+         * @param {string} cryptoSymbol 
+         * @param {string} txid Transaction ID, or signature on SOL
+         * @param {string} [wallet] Extended public key, or address on ETH, SOL and KAS, whose inputs, outputs and transfers are marked owned
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        transactionsCryptoSymbolTxidGet(cryptoSymbol: string, txid: string, wallet?: string, options?: RawAxiosRequestConfig): AxiosPromise<TransactionsCryptoSymbolTxidGet200Response> {
+            return localVarFp.transactionsCryptoSymbolTxidGet(cryptoSymbol, txid, wallet, options).then((request) => request(axios, basePath));
+        },
+        /**
+         * Lists the history of an address or extended public key, newest first unless sort is asc. amount is the net change to the wallet, so outgoing amounts include the fee it paid. ETH history is read from the Etherscan or Blockscout indexer configured for the network; every ERC-20 token moved gets an entry of its own with token_contract set, under the transaction_id of the ether entry carrying the fee. SOL history is paged by the node with getSignaturesForAddress: offset and limit count signatures, entries are parsed from the System and SPL transfers and the balance changes of each transaction, and total_count is absent. KAS history merges the transactions of every active address of a kpub into one entry per transaction_id; block_height and confirmations count blue score. Filters apply before paging and total_count counts the entries that pass them; on SOL they apply within each page of signatures, so a page can hold fewer entries than limit while has_more is true. Pass next_cursor as cursor to read the next page; unlike offset it keeps its place when new transactions arrive. 
          * @summary Get transaction history for an address
          * @param {string} cryptoSymbol 
          * @param {string} address 
+         * @param {string} [fiatSymbol] The fiat currency used to value each transaction at its block time
          * @param {number} [limit] 
          * @param {number} [offset] 
+         * @param {string} [cursor] Opaque next_cursor of the previous page. Takes precedence over offset
+         * @param {string} [direction] incoming or outgoing to only list entries of that direction
+         * @param {string} [since] RFC 3339 time, leave out entries older than this
+         * @param {string} [until] RFC 3339 time, leave out entries newer than this
+         * @param {string} [minAmount] Leave out entries moving less than this amount, in whole coins or in whole tokens for token entries
+         * @param {string} [status] confirmed or pending to only list entries with or without confirmations
+         * @param {string} [sort] desc lists newest first, asc oldest first. SOL only lists newest first
          * @param {*} [options] Override http request option.
          * @throws {RequiredError}
          */
-        transactionsGet(cryptoSymbol: string, address: string, limit?: number, offset?: number, options?: RawAxiosRequestConfig): AxiosPromise<TransactionsGet200Response> {
-            return localVarFp.transactionsGet(cryptoSymbol, address, limit, offset, options).then((request) => request(axios, basePath));
+        transactionsGet(cryptoSymbol: string, address: string, fiatSymbol?: string, limit?: number, offset?: number, cursor?: string, direction?: string, since?: string, until?: string, minAmount?: string, status?: string, sort?: string, options?: RawAxiosRequestConfig): AxiosPromise<TransactionsGet200Response> {
+            return localVarFp.transactionsGet(cryptoSymbol, address, fiatSymbol, limit, offset, cursor, direction, since, until, minAmount, status, sort, options).then((request) => request(axios, basePath));
         },
         /**
-         * 
+         * Builds a PSBT speeding up txid, an unconfirmed transaction of the wallet from_address, BTC only. With rbf the BIP-125 replacement spends the same inputs to the same outputs and takes the higher fee out of the change, paying at least the fee of txid and 1 sat/vB for its own size; every input must belong to the wallet. With cpfp a child spends the largest unspent wallet output of txid to a change address so that parent and child together pay fee_rate. 
+         * @summary Generate a transaction speeding up an unconfirmed one
+         * @param {UnsignedTxBumpPostRequest} unsignedTxBumpPostRequest 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        unsignedTxBumpPost(unsignedTxBumpPostRequest: UnsignedTxBumpPostRequest, options?: RawAxiosRequestConfig): AxiosPromise<UnsignedTxBumpPost200Response> {
+            return localVarFp.unsignedTxBumpPost(unsignedTxBumpPostRequest, options).then((request) => request(axios, basePath));
+        },
+        /**
+         * Builds an unsigned transaction for an air-gapped signer. ETH transactions are EIP-1559 typed transactions, RLP encoded with the chain ID, nonced from the pending state and limited by eth_estimateGas; with token_contract the amount is sent as an ERC-20 transfer of that token instead of ether. SOL transactions are System transfers, or with token_contract SPL transfers of that mint that create the associated token account of the recipient when it is missing; unsigned_tx is the base64 message the signer signs as is. With nonce_account the SOL message uses the durable nonce of that account instead of a recent blockhash, so it stays valid until the nonce is advanced. KAS transactions spend the outputs of the kpub in from_address, largest first, with change to its first unused change address, and are returned as kaspawallet partially signed transactions; the fee pays for the larger of the compute mass and the KIP-9 storage mass, and outputs too small for the storage mass limit are rejected. BTC and LTC transactions are PSBTs spending the P2WPKH, P2SH-P2WPKH and P2PKH outputs, and on BTC the P2TR outputs, of the extended public key, output descriptor or address in from_address, largest first, with change to the first unused change address or back to the address; BTC inputs signal BIP-125 replaceability. Peg-outs from MWEB are not spent before they have 6 confirmations, and MWEB addresses are rejected as recipients. inputs lists the spent outputs with the path and digest each is signed with. fee_amount is the highest fee the transaction can pay, from getFeeForMessage on SOL. With amount=max the transaction sends everything the sender can after fees: BTC, LTC and KAS spend every output worth more than the fee of spending it, without change; ETH sends the pending balance less the max fee; SOL sends the balance less the fee and the rent-exempt minimum of an account without data; token transfers send every token held. 
          * @summary Generate an unsigned transaction
          * @param {string} cryptoSymbol 
-         * @param {string} fromAddress 
+         * @param {string} fromAddress Sending address, or the kpub of the wallet on KAS and its extended public key or output descriptor on BTC and LTC
          * @param {string} toAddress 
-         * @param {string} amount 
-         * @param {number} [feeRate] 
+         * @param {string} amount Amount in whole coins, or in whole tokens with token_contract, or max to send everything after fees
+         * @param {number} [feeRate] Fee rate in the rate_unit of /fees: gwei of max fee per gas on ETH, micro-lamports of priority fee per compute unit on SOL, sompi per gram on KAS, sat per vbyte on BTC, litoshi per vbyte on LTC. Defaults to the normal estimate on ETH, KAS, BTC and LTC and no priority fee on SOL
+         * @param {string} [tokenContract] ERC-20 contract or SPL token mint of the token to send, ETH and SOL only
+         * @param {string} [nonceAccount] Durable nonce account to use instead of a recent blockhash, SOL only. from_address must be its nonce authority
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        unsignedTxGet(cryptoSymbol: string, fromAddress: string, toAddress: string, amount: string, feeRate?: number, tokenContract?: string, nonceAccount?: string, options?: RawAxiosRequestConfig): AxiosPromise<UnsignedTxGet200Response> {
+            return localVarFp.unsignedTxGet(cryptoSymbol, fromAddress, toAddress, amount, feeRate, tokenContract, nonceAccount, options).then((request) => request(axios, basePath));
+        },
+        /**
+         * Builds an unsigned transaction like GET /unsigned-tx from a JSON body that can pay several outputs. On BTC op_return adds a data output of up to 80 bytes after the recipients, required_inputs are spent before any other output of from_address and excluded_inputs are never spent, inputs signal BIP-125 replaceability unless disable_rbf is set, and lock_time sets the lock time of the transaction. A single output may send max to sweep from_address. Other chains take a single output without these options. 
+         * @summary Generate an unsigned transaction with several outputs
+         * @param {UnsignedTxPostRequest} unsignedTxPostRequest 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        unsignedTxPost(unsignedTxPostRequest: UnsignedTxPostRequest, options?: RawAxiosRequestConfig): AxiosPromise<UnsignedTxPost200Response> {
+            return localVarFp.unsignedTxPost(unsignedTxPostRequest, options).then((request) => request(axios, basePath));
+        },
+        /**
+         * Lists the UTXO set of a BTC or LTC address, SLIP-132 extended public key or single key wpkh, sh(wpkh) or tr output descriptor, or of a Kaspa address or kpub. Keys are scanned on their external and change chains up to a gap limit of 20. Values are in the smallest unit of the chain; on Kaspa block_height is the DAA score of the accepting block. 
+         * @summary List the unspent outputs of an address, xpub or descriptor
+         * @param {string} cryptoSymbol 
+         * @param {string} address Address, extended public key or output descriptor
+         * @param {number} [minConfirmations] Leave out outputs with fewer confirmations, 0 includes the mempool
+         * @param {number} [dustThreshold] Leave out outputs worth less than this many satoshi, litoshi or sompi
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        utxosGet(cryptoSymbol: string, address: string, minConfirmations?: number, dustThreshold?: number, options?: RawAxiosRequestConfig): AxiosPromise<UtxosGet200Response> {
+            return localVarFp.utxosGet(cryptoSymbol, address, minConfirmations, dustThreshold, options).then((request) => request(axios, basePath));
+        },
+        /**
+         * Checks an address or extended public key against the chain of crypto_symbol without any upstream call: EIP-55 checksum for ETH, bech32 or base58 with a network check for BTC and LTC, the Kaspa bech32 prefix for KAS and an on-curve base58 key for SOL. A malformed address, or one of the other network, is reported with valid set to false. 
+         * @summary Validate an address or extended public key
+         * @param {string} cryptoSymbol 
+         * @param {string} address 
          * @param {*} [options] Override http request option.
          * @throws {RequiredError}
          */
-        unsignedTxGet(cryptoSymbol: string, fromAddress: string, toAddress: string, amount: string, feeRate?: number, options?: RawAxiosRequestConfig): AxiosPromise<UnsignedTxGet200Response> {
-            return localVarFp.unsignedTxGet(cryptoSymbol, fromAddress, toAddress, amount, feeRate, options).then((request) => request(axios, basePath));
+        validateAddressGet(cryptoSymbol: string, address: string, options?: RawAxiosRequestConfig): AxiosPromise<ValidateAddressGet200Response> {
+            return localVarFp.validateAddressGet(cryptoSymbol, address, options).then((request) => request(axios, basePath));
         },
     };
 };
@@ -452,6 +2002,15 @@ export const DefaultApiFactory = function (configuration?: Configuration, basePa
  * DefaultApi - interface
  */
 export interface DefaultApiInterface {
+    /**
+     * Reconstructs the end of day balance of each wallet from its transaction history and values it at that day\'s exchange rate. Days are UTC; the range is inclusive and limited to 366 days. 
+     * @summary Get daily portfolio fiat value over a date range
+     * @param {BalancesHistoryPostRequest} balancesHistoryPostRequest 
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     */
+    balancesHistoryPost(balancesHistoryPostRequest: BalancesHistoryPostRequest, options?: RawAxiosRequestConfig): AxiosPromise<BalancesHistoryPost200Response>;
+
     /**
      * 
      * @summary Get balances for multiple addresses and cryptocurrencies
@@ -471,29 +2030,120 @@ export interface DefaultApiInterface {
     broadcastPost(broadcastPostRequest: BroadcastPostRequest, options?: RawAxiosRequestConfig): AxiosPromise<BroadcastPost200Response>;
 
     /**
-     * 
+     * Estimates the fee of a typical transaction at three priority levels. BTC and LTC rates come from the Electrum blockchain.estimatefee call in sat/vB, ETH rates are the next base fee plus a priority fee percentile from eth_feeHistory in gwei, SOL rates are priority fee percentiles from getRecentPrioritizationFees in micro-lamports per compute unit on top of the signature fee, and KAS rates come from the node fee estimator in sompi per gram. Chain estimates are cached for 15 seconds. 
+     * @summary Estimate slow, normal and fast fees
+     * @param {string} cryptoSymbol 
+     * @param {string} [fiatSymbol] The fiat currency the fee of a typical transaction is valued in
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     */
+    feesGet(cryptoSymbol: string, fiatSymbol?: string, options?: RawAxiosRequestConfig): AxiosPromise<FeesGet200Response>;
+
+    /**
+     * Values every account once per fiat currency and returns per-account, per-wallet, per-asset and grand totals with allocation percentages and the 24h change. Balances are fetched once per account regardless of how many fiat currencies are requested. 
+     * @summary Get the value of named wallets in one or more fiat currencies
+     * @param {PortfolioPostRequest} portfolioPostRequest 
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     */
+    portfolioPost(portfolioPostRequest: PortfolioPostRequest, options?: RawAxiosRequestConfig): AxiosPromise<PortfolioPost200Response>;
+
+    /**
+     * Derives addresses on the external chain of xpub and returns the first one after the last address with on-chain history, scanning with a gap limit of 20. The script type follows the key version: zpub and vpub give native segwit, ypub, upub and Mtub nested segwit, and a plain BTC xpub taproot. Set count to also return the addresses after it. 
+     * @summary Get the next unused receive address of an extended public key
+     * @param {string} cryptoSymbol 
+     * @param {string} xpub 
+     * @param {number} [count] Number of consecutive unused addresses to return
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     */
+    receiveAddressGet(cryptoSymbol: string, xpub: string, count?: number, options?: RawAxiosRequestConfig): AxiosPromise<ReceiveAddressGet200Response>;
+
+    /**
+     * Looks up one transaction and decodes it in full. BTC, LTC and KAS list the outputs the transaction spends as inputs and the outputs it creates, with their addresses and values. ETH lists the ether the transaction sends and the ERC-20 Transfer events it emits as transfers, along with every log of its receipt; ether moved by internal calls is not listed. SOL lists every instruction, inner instructions included, and the System and SPL token transfers among them as transfers between the owners of the accounts involved. With wallet set, owned marks the inputs and outputs paying the addresses of that extended public key, or of that address on KAS, and from_owned and to_owned mark the transfer sides of that address on ETH and SOL. status is failed for a transaction included in a block whose execution failed, which only cost the fee and lists no transfers. block_height and confirmations count slots on SOL and blue score on KAS. 
+     * @summary Get the decoded details of one transaction
+     * @param {string} cryptoSymbol 
+     * @param {string} txid Transaction ID, or signature on SOL
+     * @param {string} [wallet] Extended public key, or address on ETH, SOL and KAS, whose inputs, outputs and transfers are marked owned
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     */
+    transactionsCryptoSymbolTxidGet(cryptoSymbol: string, txid: string, wallet?: string, options?: RawAxiosRequestConfig): AxiosPromise<TransactionsCryptoSymbolTxidGet200Response>;
+
+    /**
+     * Lists the history of an address or extended public key, newest first unless sort is asc. amount is the net change to the wallet, so outgoing amounts include the fee it paid. ETH history is read from the Etherscan or Blockscout indexer configured for the network; every ERC-20 token moved gets an entry of its own with token_contract set, under the transaction_id of the ether entry carrying the fee. SOL history is paged by the node with getSignaturesForAddress: offset and limit count signatures, entries are parsed from the System and SPL transfers and the balance changes of each transaction, and total_count is absent. KAS history merges the transactions of every active address of a kpub into one entry per transaction_id; block_height and confirmations count blue score. Filters apply before paging and total_count counts the entries that pass them; on SOL they apply within each page of signatures, so a page can hold fewer entries than limit while has_more is true. Pass next_cursor as cursor to read the next page; unlike offset it keeps its place when new transactions arrive. 
      * @summary Get transaction history for an address
      * @param {string} cryptoSymbol 
      * @param {string} address 
+     * @param {string} [fiatSymbol] The fiat currency used to value each transaction at its block time
      * @param {number} [limit] 
      * @param {number} [offset] 
+     * @param {string} [cursor] Opaque next_cursor of the previous page. Takes precedence over offset
+     * @param {string} [direction] incoming or outgoing to only list entries of that direction
+     * @param {string} [since] RFC 3339 time, leave out entries older than this
+     * @param {string} [until] RFC 3339 time, leave out entries newer than this
+     * @param {string} [minAmount] Leave out entries moving less than this amount, in whole coins or in whole tokens for token entries
+     * @param {string} [status] confirmed or pending to only list entries with or without confirmations
+     * @param {string} [sort] desc lists newest first, asc oldest first. SOL only lists newest first
      * @param {*} [options] Override http request option.
      * @throws {RequiredError}
      */
-    transactionsGet(cryptoSymbol: string, address: string, limit?: number, offset?: number, options?: RawAxiosRequestConfig): AxiosPromise<TransactionsGet200Response>;
+    transactionsGet(cryptoSymbol: string, address: string, fiatSymbol?: string, limit?: number, offset?: number, cursor?: string, direction?: string, since?: string, until?: string, minAmount?: string, status?: string, sort?: string, options?: RawAxiosRequestConfig): AxiosPromise<TransactionsGet200Response>;
 
     /**
-     * 
+     * Builds a PSBT speeding up txid, an unconfirmed transaction of the wallet from_address, BTC only. With rbf the BIP-125 replacement spends the same inputs to the same outputs and takes the higher fee out of the change, paying at least the fee of txid and 1 sat/vB for its own size; every input must belong to the wallet. With cpfp a child spends the largest unspent wallet output of txid to a change address so that parent and child together pay fee_rate. 
+     * @summary Generate a transaction speeding up an unconfirmed one
+     * @param {UnsignedTxBumpPostRequest} unsignedTxBumpPostRequest 
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     */
+    unsignedTxBumpPost(unsignedTxBumpPostRequest: UnsignedTxBumpPostRequest, options?: RawAxiosRequestConfig): AxiosPromise<UnsignedTxBumpPost200Response>;
+
+    /**
+     * Builds an unsigned transaction for an air-gapped signer. ETH transactions are EIP-1559 typed transactions, RLP encoded with the chain ID, nonced from the pending state and limited by eth_estimateGas; with token_contract the amount is sent as an ERC-20 transfer of that token instead of ether. SOL transactions are System transfers, or with token_contract SPL transfers of that mint that create the associated token account of the recipient when it is missing; unsigned_tx is the base64 message the signer signs as is. With nonce_account the SOL message uses the durable nonce of that account instead of a recent blockhash, so it stays valid until the nonce is advanced. KAS transactions spend the outputs of the kpub in from_address, largest first, with change to its first unused change address, and are returned as kaspawallet partially signed transactions; the fee pays for the larger of the compute mass and the KIP-9 storage mass, and outputs too small for the storage mass limit are rejected. BTC and LTC transactions are PSBTs spending the P2WPKH, P2SH-P2WPKH and P2PKH outputs, and on BTC the P2TR outputs, of the extended public key, output descriptor or address in from_address, largest first, with change to the first unused change address or back to the address; BTC inputs signal BIP-125 replaceability. Peg-outs from MWEB are not spent before they have 6 confirmations, and MWEB addresses are rejected as recipients. inputs lists the spent outputs with the path and digest each is signed with. fee_amount is the highest fee the transaction can pay, from getFeeForMessage on SOL. With amount=max the transaction sends everything the sender can after fees: BTC, LTC and KAS spend every output worth more than the fee of spending it, without change; ETH sends the pending balance less the max fee; SOL sends the balance less the fee and the rent-exempt minimum of an account without data; token transfers send every token held. 
      * @summary Generate an unsigned transaction
      * @param {string} cryptoSymbol 
-     * @param {string} fromAddress 
+     * @param {string} fromAddress Sending address, or the kpub of the wallet on KAS and its extended public key or output descriptor on BTC and LTC
      * @param {string} toAddress 
-     * @param {string} amount 
-     * @param {number} [feeRate] 
+     * @param {string} amount Amount in whole coins, or in whole tokens with token_contract, or max to send everything after fees
+     * @param {number} [feeRate] Fee rate in the rate_unit of /fees: gwei of max fee per gas on ETH, micro-lamports of priority fee per compute unit on SOL, sompi per gram on KAS, sat per vbyte on BTC, litoshi per vbyte on LTC. Defaults to the normal estimate on ETH, KAS, BTC and LTC and no priority fee on SOL
+     * @param {string} [tokenContract] ERC-20 contract or SPL token mint of the token to send, ETH and SOL only
+     * @param {string} [nonceAccount] Durable nonce account to use instead of a recent blockhash, SOL only. from_address must be its nonce authority
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     */
+    unsignedTxGet(cryptoSymbol: string, fromAddress: string, toAddress: string, amount: string, feeRate?: number, tokenContract?: string, nonceAccount?: string, options?: RawAxiosRequestConfig): AxiosPromise<UnsignedTxGet200Response>;
+
+    /**
+     * Builds an unsigned transaction like GET /unsigned-tx from a JSON body that can pay several outputs. On BTC op_return adds a data output of up to 80 bytes after the recipients, required_inputs are spent before any other output of from_address and excluded_inputs are never spent, inputs signal BIP-125 replaceability unless disable_rbf is set, and lock_time sets the lock time of the transaction. A single output may send max to sweep from_address. Other chains take a single output without these options. 
+     * @summary Generate an unsigned transaction with several outputs
+     * @param {UnsignedTxPostRequest} unsignedTxPostRequest 
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     */
+    unsignedTxPost(unsignedTxPostRequest: UnsignedTxPostRequest, options?: RawAxiosRequestConfig): AxiosPromise<UnsignedTxPost200Response>;
+
+    /**
+     * Lists the UTXO set of a BTC or LTC address, SLIP-132 extended public key or single key wpkh, sh(wpkh) or tr output descriptor, or of a Kaspa address or kpub. Keys are scanned on their external and change chains up to a gap limit of 20. Values are in the smallest unit of the chain; on Kaspa block_height is the DAA score of the accepting block. 
+     * @summary List the unspent outputs of an address, xpub or descriptor
+     * @param {string} cryptoSymbol 
+     * @param {string} address Address, extended public key or output descriptor
+     * @param {number} [minConfirmations] Leave out outputs with fewer confirmations, 0 includes the mempool
+     * @param {number} [dustThreshold] Leave out outputs worth less than this many satoshi, litoshi or sompi
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     */
+    utxosGet(cryptoSymbol: string, address: string, minConfirmations?: number, dustThreshold?: number, options?: RawAxiosRequestConfig): AxiosPromise<UtxosGet200Response>;
+
+    /**
+     * Checks an address or extended public key against the chain of crypto_symbol without any upstream call: EIP-55 checksum for ETH, bech32 or base58 with a network check for BTC and LTC, the Kaspa bech32 prefix for KAS and an on-curve base58 key for SOL. A malformed address, or one of the other network, is reported with valid set to false. 
+     * @summary Validate an address or extended public key
+     * @param {string} cryptoSymbol 
+     * @param {string} address 
      * @param {*} [options] Override http request option.
      * @throws {RequiredError}
      */
-    unsignedTxGet(cryptoSymbol: string, fromAddress: string, toAddress: string, amount: string, feeRate?: number, options?: RawAxiosRequestConfig): AxiosPromise<UnsignedTxGet200Response>;
+    validateAddressGet(cryptoSymbol: string, address: string, options?: RawAxiosRequestConfig): AxiosPromise<ValidateAddressGet200Response>;
 
 }
 
@@ -501,6 +2151,17 @@ export interface DefaultApiInterface {
  * DefaultApi - object-oriented interface
  */
 export class DefaultApi extends BaseAPI implements DefaultApiInterface {
+    /**
+     * Reconstructs the end of day balance of each wallet from its transaction history and values it at that day\'s exchange rate. Days are UTC; the range is inclusive and limited to 366 days. 
+     * @summary Get daily portfolio fiat value over a date range
+     * @param {BalancesHistoryPostRequest} balancesHistoryPostRequest 
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     */
+    public balancesHistoryPost(balancesHistoryPostRequest: BalancesHistoryPostRequest, options?: RawAxiosRequestConfig) {
+        return DefaultApiFp(this.configuration).balancesHistoryPost(balancesHistoryPostRequest, options).then((request) => request(this.axios, this.basePath));
+    }
+
     /**
      * 
      * @summary Get balances for multiple addresses and cryptocurrencies
@@ -524,32 +2185,136 @@ export class DefaultApi extends BaseAPI implements DefaultApiInterface {
     }
 
     /**
-     * 
+     * Estimates the fee of a typical transaction at three priority levels. BTC and LTC rates come from the Electrum blockchain.estimatefee call in sat/vB, ETH rates are the next base fee plus a priority fee percentile from eth_feeHistory in gwei, SOL rates are priority fee percentiles from getRecentPrioritizationFees in micro-lamports per compute unit on top of the signature fee, and KAS rates come from the node fee estimator in sompi per gram. Chain estimates are cached for 15 seconds. 
+     * @summary Estimate slow, normal and fast fees
+     * @param {string} cryptoSymbol 
+     * @param {string} [fiatSymbol] The fiat currency the fee of a typical transaction is valued in
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     */
+    public feesGet(cryptoSymbol: string, fiatSymbol?: string, options?: RawAxiosRequestConfig) {
+        return DefaultApiFp(this.configuration).feesGet(cryptoSymbol, fiatSymbol, options).then((request) => request(this.axios, this.basePath));
+    }
+    /**
+     * Values every account once per fiat currency and returns per-account, per-wallet, per-asset and grand totals with allocation percentages and the 24h change. Balances are fetched once per account regardless of how many fiat currencies are requested. 
+     * @summary Get the value of named wallets in one or more fiat currencies
+     * @param {PortfolioPostRequest} portfolioPostRequest 
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     */
+    public portfolioPost(portfolioPostRequest: PortfolioPostRequest, options?: RawAxiosRequestConfig) {
+        return DefaultApiFp(this.configuration).portfolioPost(portfolioPostRequest, options).then((request) => request(this.axios, this.basePath));
+    }
+
+    /**
+     * Derives addresses on the external chain of xpub and returns the first one after the last address with on-chain history, scanning with a gap limit of 20. The script type follows the key version: zpub and vpub give native segwit, ypub, upub and Mtub nested segwit, and a plain BTC xpub taproot. Set count to also return the addresses after it. 
+     * @summary Get the next unused receive address of an extended public key
+     * @param {string} cryptoSymbol 
+     * @param {string} xpub 
+     * @param {number} [count] Number of consecutive unused addresses to return
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     */
+    public receiveAddressGet(cryptoSymbol: string, xpub: string, count?: number, options?: RawAxiosRequestConfig) {
+        return DefaultApiFp(this.configuration).receiveAddressGet(cryptoSymbol, xpub, count, options).then((request) => request(this.axios, this.basePath));
+    }
+    /**
+     * Looks up one transaction and decodes it in full. BTC, LTC and KAS list the outputs the transaction spends as inputs and the outputs it creates, with their addresses and values. ETH lists the ether the transaction sends and the ERC-20 Transfer events it emits as transfers, along with every log of its receipt; ether moved by internal calls is not listed. SOL lists every instruction, inner instructions included, and the System and SPL token transfers among them as transfers between the owners of the accounts involved. With wallet set, owned marks the inputs and outputs paying the addresses of that extended public key, or of that address on KAS, and from_owned and to_owned mark the transfer sides of that address on ETH and SOL. status is failed for a transaction included in a block whose execution failed, which only cost the fee and lists no transfers. block_height and confirmations count slots on SOL and blue score on KAS. 
+     * @summary Get the decoded details of one transaction
+     * @param {string} cryptoSymbol 
+     * @param {string} txid Transaction ID, or signature on SOL
+     * @param {string} [wallet] Extended public key, or address on ETH, SOL and KAS, whose inputs, outputs and transfers are marked owned
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     */
+    public transactionsCryptoSymbolTxidGet(cryptoSymbol: string, txid: string, wallet?: string, options?: RawAxiosRequestConfig) {
+        return DefaultApiFp(this.configuration).transactionsCryptoSymbolTxidGet(cryptoSymbol, txid, wallet, options).then((request) => request(this.axios, this.basePath));
+    }
+
+    /**
+     * Lists the history of an address or extended public key, newest first unless sort is asc. amount is the net change to the wallet, so outgoing amounts include the fee it paid. ETH history is read from the Etherscan or Blockscout indexer configured for the network; every ERC-20 token moved gets an entry of its own with token_contract set, under the transaction_id of the ether entry carrying the fee. SOL history is paged by the node with getSignaturesForAddress: offset and limit count signatures, entries are parsed from the System and SPL transfers and the balance changes of each transaction, and total_count is absent. KAS history merges the transactions of every active address of a kpub into one entry per transaction_id; block_height and confirmations count blue score. Filters apply before paging and total_count counts the entries that pass them; on SOL they apply within each page of signatures, so a page can hold fewer entries than limit while has_more is true. Pass next_cursor as cursor to read the next page; unlike offset it keeps its place when new transactions arrive. 
      * @summary Get transaction history for an address
      * @param {string} cryptoSymbol 
      * @param {string} address 
+     * @param {string} [fiatSymbol] The fiat currency used to value each transaction at its block time
      * @param {number} [limit] 
      * @param {number} [offset] 
+     * @param {string} [cursor] Opaque next_cursor of the previous page. Takes precedence over offset
+     * @param {string} [direction] incoming or outgoing to only list entries of that direction
+     * @param {string} [since] RFC 3339 time, leave out entries older than this
+     * @param {string} [until] RFC 3339 time, leave out entries newer than this
+     * @param {string} [minAmount] Leave out entries moving less than this amount, in whole coins or in whole tokens for token entries
+     * @param {string} [status] confirmed or pending to only list entries with or without confirmations
+     * @param {string} [sort] desc lists newest first, asc oldest first. SOL only lists newest first
      * @param {*} [options] Override http request option.
      * @throws {RequiredError}
      */
-    public transactionsGet(cryptoSymbol: string, address: string, limit?: number, offset?: number, options?: RawAxiosRequestConfig) {
-        return DefaultApiFp(this.configuration).transactionsGet(cryptoSymbol, address, limit, offset, options).then((request) => request(this.axios, this.basePath));
+    public transactionsGet(cryptoSymbol: string, address: string, fiatSymbol?: string, limit?: number, offset?: number, cursor?: string, direction?: string, since?: string, until?: string, minAmount?: string, status?: string, sort?: string, options?: RawAxiosRequestConfig) {
+        return DefaultApiFp(this.configuration).transactionsGet(cryptoSymbol, address, fiatSymbol, limit, offset, cursor, direction, since, until, minAmount, status, sort, options).then((request) => request(this.axios, this.basePath));
     }
 
     /**
-     * 
+     * Builds a PSBT speeding up txid, an unconfirmed transaction of the wallet from_address, BTC only. With rbf the BIP-125 replacement spends the same inputs to the same outputs and takes the higher fee out of the change, paying at least the fee of txid and 1 sat/vB for its own size; every input must belong to the wallet. With cpfp a child spends the largest unspent wallet output of txid to a change address so that parent and child together pay fee_rate. 
+     * @summary Generate a transaction speeding up an unconfirmed one
+     * @param {UnsignedTxBumpPostRequest} unsignedTxBumpPostRequest 
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     */
+    public unsignedTxBumpPost(unsignedTxBumpPostRequest: UnsignedTxBumpPostRequest, options?: RawAxiosRequestConfig) {
+        return DefaultApiFp(this.configuration).unsignedTxBumpPost(unsignedTxBumpPostRequest, options).then((request) => request(this.axios, this.basePath));
+    }
+
+    /**
+     * Builds an unsigned transaction for an air-gapped signer. ETH transactions are EIP-1559 typed transactions, RLP encoded with the chain ID, nonced from the pending state and limited by eth_estimateGas; with token_contract the amount is sent as an ERC-20 transfer of that token instead of ether. SOL transactions are System transfers, or with token_contract SPL transfers of that mint that create the associated token account of the recipient when it is missing; unsigned_tx is the base64 message the signer signs as is. With nonce_account the SOL message uses the durable nonce of that account instead of a recent blockhash, so it stays valid until the nonce is advanced. KAS transactions spend the outputs of the kpub in from_address, largest first, with change to its first unused change address, and are returned as kaspawallet partially signed transactions; the fee pays for the larger of the compute mass and the KIP-9 storage mass, and outputs too small for the storage mass limit are rejected. BTC and LTC transactions are PSBTs spending the P2WPKH, P2SH-P2WPKH and P2PKH outputs, and on BTC the P2TR outputs, of the extended public key, output descriptor or address in from_address, largest first, with change to the first unused change address or back to the address; BTC inputs signal BIP-125 replaceability. Peg-outs from MWEB are not spent before they have 6 confirmations, and MWEB addresses are rejected as recipients. inputs lists the spent outputs with the path and digest each is signed with. fee_amount is the highest fee the transaction can pay, from getFeeForMessage on SOL. With amount=max the transaction sends everything the sender can after fees: BTC, LTC and KAS spend every output worth more than the fee of spending it, without change; ETH sends the pending balance less the max fee; SOL sends the balance less the fee and the rent-exempt minimum of an account without data; token transfers send every token held. 
      * @summary Generate an unsigned transaction
      * @param {string} cryptoSymbol 
-     * @param {string} fromAddress 
+     * @param {string} fromAddress Sending address, or the kpub of the wallet on KAS and its extended public key or output descriptor on BTC and LTC
      * @param {string} toAddress 
-     * @param {string} amount 
-     * @param {number} [feeRate] 
+     * @param {string} amount Amount in whole coins, or in whole tokens with token_contract, or max to send everything after fees
+     * @param {number} [feeRate] Fee rate in the rate_unit of /fees: gwei of max fee per gas on ETH, micro-lamports of priority fee per compute unit on SOL, sompi per gram on KAS, sat per vbyte on BTC, litoshi per vbyte on LTC. Defaults to the normal estimate on ETH, KAS, BTC and LTC and no priority fee on SOL
+     * @param {string} [tokenContract] ERC-20 contract or SPL token mint of the token to send, ETH and SOL only
+     * @param {string} [nonceAccount] Durable nonce account to use instead of a recent blockhash, SOL only. from_address must be its nonce authority
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     */
+    public unsignedTxGet(cryptoSymbol: string, fromAddress: string, toAddress: string, amount: string, feeRate?: number, tokenContract?: string, nonceAccount?: string, options?: RawAxiosRequestConfig) {
+        return DefaultApiFp(this.configuration).unsignedTxGet(cryptoSymbol, fromAddress, toAddress, amount, feeRate, tokenContract, nonceAccount, options).then((request) => request(this.axios, this.basePath));
+    }
+
+    /**
+     * Builds an unsigned transaction like GET /unsigned-tx from a JSON body that can pay several outputs. On BTC op_return adds a data output of up to 80 bytes after the recipients, required_inputs are spent before any other output of from_address and excluded_inputs are never spent, inputs signal BIP-125 replaceability unless disable_rbf is set, and lock_time sets the lock time of the transaction. A single output may send max to sweep from_address. Other chains take a single output without these options. 
+     * @summary Generate an unsigned transaction with several outputs
+     * @param {UnsignedTxPostRequest} unsignedTxPostRequest 
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     */
+    public unsignedTxPost(unsignedTxPostRequest: UnsignedTxPostRequest, options?: RawAxiosRequestConfig) {
+        return DefaultApiFp(this.configuration).unsignedTxPost(unsignedTxPostRequest, options).then((request) => request(this.axios, this.basePath));
+    }
+
+    /**
+     * Lists the UTXO set of a BTC or LTC address, SLIP-132 extended public key or single key wpkh, sh(wpkh) or tr output descriptor, or of a Kaspa address or kpub. Keys are scanned on their external and change chains up to a gap limit of 20. Values are in the smallest unit of the chain; on Kaspa block_height is the DAA score of the accepting block. 
+     * @summary List the unspent outputs of an address, xpub or descriptor
+     * @param {string} cryptoSymbol 
+     * @param {string} address Address, extended public key or output descriptor
+     * @param {number} [minConfirmations] Leave out outputs with fewer confirmations, 0 includes the mempool
+     * @param {number} [dustThreshold] Leave out outputs worth less than this many satoshi, litoshi or sompi
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     */
+    public utxosGet(cryptoSymbol: string, address: string, minConfirmations?: number, dustThreshold?: number, options?: RawAxiosRequestConfig) {
+        return DefaultApiFp(this.configuration).utxosGet(cryptoSymbol, address, minConfirmations, dustThreshold, options).then((request) => request(this.axios, this.basePath));
+    }
+    /**
+     * Checks an address or extended public key against the chain of crypto_symbol without any upstream call: EIP-55 checksum for ETH, bech32 or base58 with a network check for BTC and LTC, the Kaspa bech32 prefix for KAS and an on-curve base58 key for SOL. A malformed address, or one of the other network, is reported with valid set to false. 
+     * @summary Validate an address or extended public key
+     * @param {string} cryptoSymbol 
+     * @param {string} address 
      * @param {*} [options] Override http request option.
      * @throws {RequiredError}
      */
-    public unsignedTxGet(cryptoSymbol: string, fromAddress: string, toAddress: string, amount: string, feeRate?: number, options?: RawAxiosRequestConfig) {
-        return DefaultApiFp(this.configuration).unsignedTxGet(cryptoSymbol, fromAddress, toAddress, amount, feeRate, options).then((request) => request(this.axios, this.basePath));
+    public validateAddressGet(cryptoSymbol: string, address: string, options?: RawAxiosRequestConfig) {
+        return DefaultApiFp(this.configuration).validateAddressGet(cryptoSymbol, address, options).then((request) => request(this.axios, this.basePath));
     }
 }
 
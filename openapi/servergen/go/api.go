@@ -22,8 +22,17 @@ import (
 // pass the data to a DefaultAPIServicer to perform the required actions, then write the service results to the http response.
 type DefaultAPIRouter interface { 
 	BalancesPost(http.ResponseWriter, *http.Request)
+	BalancesHistoryPost(http.ResponseWriter, *http.Request)
+	PortfolioPost(http.ResponseWriter, *http.Request)
+	ValidateAddressGet(http.ResponseWriter, *http.Request)
+	ReceiveAddressGet(http.ResponseWriter, *http.Request)
+	UtxosGet(http.ResponseWriter, *http.Request)
 	TransactionsGet(http.ResponseWriter, *http.Request)
+	TransactionsCryptoSymbolTxidGet(http.ResponseWriter, *http.Request)
+	FeesGet(http.ResponseWriter, *http.Request)
 	UnsignedTxGet(http.ResponseWriter, *http.Request)
+	UnsignedTxPost(http.ResponseWriter, *http.Request)
+	UnsignedTxBumpPost(http.ResponseWriter, *http.Request)
 	BroadcastPost(http.ResponseWriter, *http.Request)
 }
 
@@ -34,7 +43,16 @@ type DefaultAPIRouter interface {
 // and updated with the logic required for the API.
 type DefaultAPIServicer interface { 
 	BalancesPost(context.Context, BalancesPostRequest) (ImplResponse, error)
-	TransactionsGet(context.Context, string, string, int32, int32) (ImplResponse, error)
-	UnsignedTxGet(context.Context, string, string, string, string, float64) (ImplResponse, error)
+	BalancesHistoryPost(context.Context, BalancesHistoryPostRequest) (ImplResponse, error)
+	PortfolioPost(context.Context, PortfolioPostRequest) (ImplResponse, error)
+	ValidateAddressGet(context.Context, string, string) (ImplResponse, error)
+	ReceiveAddressGet(context.Context, string, string, int32) (ImplResponse, error)
+	UtxosGet(context.Context, string, string, int32, int64) (ImplResponse, error)
+	TransactionsGet(context.Context, string, string, string, int32, int32, string, string, string, string, string, string, string) (ImplResponse, error)
+	TransactionsCryptoSymbolTxidGet(context.Context, string, string, string) (ImplResponse, error)
+	FeesGet(context.Context, string, string) (ImplResponse, error)
+	UnsignedTxGet(context.Context, string, string, string, string, float64, string, string) (ImplResponse, error)
+	UnsignedTxPost(context.Context, UnsignedTxPostRequest) (ImplResponse, error)
+	UnsignedTxBumpPost(context.Context, UnsignedTxBumpPostRequest) (ImplResponse, error)
 	BroadcastPost(context.Context, BroadcastPostRequest) (ImplResponse, error)
 }
@@ -14,6 +14,8 @@ import (
 	"encoding/json"
 	"net/http"
 	"strings"
+
+	"github.com/gorilla/mux"
 )
 
 // DefaultAPIController binds http requests to an api service and writes the service results to the http response
@@ -55,18 +57,72 @@ func (c *DefaultAPIController) Routes() Routes {
 			"/balances",
 			c.BalancesPost,
 		},
+		"BalancesHistoryPost": Route{
+			"BalancesHistoryPost",
+			strings.ToUpper("Post"),
+			"/balances/history",
+			c.BalancesHistoryPost,
+		},
+		"PortfolioPost": Route{
+			"PortfolioPost",
+			strings.ToUpper("Post"),
+			"/portfolio",
+			c.PortfolioPost,
+		},
+		"ValidateAddressGet": Route{
+			"ValidateAddressGet",
+			strings.ToUpper("Get"),
+			"/validate-address",
+			c.ValidateAddressGet,
+		},
+		"ReceiveAddressGet": Route{
+			"ReceiveAddressGet",
+			strings.ToUpper("Get"),
+			"/receive-address",
+			c.ReceiveAddressGet,
+		},
+		"UtxosGet": Route{
+			"UtxosGet",
+			strings.ToUpper("Get"),
+			"/utxos",
+			c.UtxosGet,
+		},
 		"TransactionsGet": Route{
 			"TransactionsGet",
 			strings.ToUpper("Get"),
 			"/transactions",
 			c.TransactionsGet,
 		},
+		"TransactionsCryptoSymbolTxidGet": Route{
+			"TransactionsCryptoSymbolTxidGet",
+			strings.ToUpper("Get"),
+			"/transactions/{crypto_symbol}/{txid}",
+			c.TransactionsCryptoSymbolTxidGet,
+		},
+		"FeesGet": Route{
+			"FeesGet",
+			strings.ToUpper("Get"),
+			"/fees",
+			c.FeesGet,
+		},
 		"UnsignedTxGet": Route{
 			"UnsignedTxGet",
 			strings.ToUpper("Get"),
 			"/unsigned-tx",
 			c.UnsignedTxGet,
 		},
+		"UnsignedTxPost": Route{
+			"UnsignedTxPost",
+			strings.ToUpper("Post"),
+			"/unsigned-tx",
+			c.UnsignedTxPost,
+		},
+		"UnsignedTxBumpPost": Route{
+			"UnsignedTxBumpPost",
+			strings.ToUpper("Post"),
+			"/unsigned-tx/bump",
+			c.UnsignedTxBumpPost,
+		},
 		"BroadcastPost": Route{
 			"BroadcastPost",
 			strings.ToUpper("Post"),
@@ -85,18 +141,72 @@ func (c *DefaultAPIController) OrderedRoutes() []Route {
 			"/balances",
 			c.BalancesPost,
 		},
+		Route{
+			"BalancesHistoryPost",
+			strings.ToUpper("Post"),
+			"/balances/history",
+			c.BalancesHistoryPost,
+		},
+		Route{
+			"PortfolioPost",
+			strings.ToUpper("Post"),
+			"/portfolio",
+			c.PortfolioPost,
+		},
+		Route{
+			"ValidateAddressGet",
+			strings.ToUpper("Get"),
+			"/validate-address",
+			c.ValidateAddressGet,
+		},
+		Route{
+			"ReceiveAddressGet",
+			strings.ToUpper("Get"),
+			"/receive-address",
+			c.ReceiveAddressGet,
+		},
+		Route{
+			"UtxosGet",
+			strings.ToUpper("Get"),
+			"/utxos",
+			c.UtxosGet,
+		},
 		Route{
 			"TransactionsGet",
 			strings.ToUpper("Get"),
 			"/transactions",
 			c.TransactionsGet,
 		},
+		Route{
+			"TransactionsCryptoSymbolTxidGet",
+			strings.ToUpper("Get"),
+			"/transactions/{crypto_symbol}/{txid}",
+			c.TransactionsCryptoSymbolTxidGet,
+		},
+		Route{
+			"FeesGet",
+			strings.ToUpper("Get"),
+			"/fees",
+			c.FeesGet,
+		},
 		Route{
 			"UnsignedTxGet",
 			strings.ToUpper("Get"),
 			"/unsigned-tx",
 			c.UnsignedTxGet,
 		},
+		Route{
+			"UnsignedTxPost",
+			strings.ToUpper("Post"),
+			"/unsigned-tx",
+			c.UnsignedTxPost,
+		},
+		Route{
+			"UnsignedTxBumpPost",
+			strings.ToUpper("Post"),
+			"/unsigned-tx/bump",
+			c.UnsignedTxBumpPost,
+		},
 		Route{
 			"BroadcastPost",
 			strings.ToUpper("Post"),
@@ -135,6 +245,217 @@ func (c *DefaultAPIController) BalancesPost(w http.ResponseWriter, r *http.Reque
 	_ = EncodeJSONResponse(result.Body, &result.Code, w)
 }
 
+// BalancesHistoryPost - Get daily portfolio fiat value over a date range
+func (c *DefaultAPIController) BalancesHistoryPost(w http.ResponseWriter, r *http.Request) {
+	var balancesHistoryPostRequestParam BalancesHistoryPostRequest
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&balancesHistoryPostRequestParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := AssertBalancesHistoryPostRequestRequired(balancesHistoryPostRequestParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	if err := AssertBalancesHistoryPostRequestConstraints(balancesHistoryPostRequestParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.BalancesHistoryPost(r.Context(), balancesHistoryPostRequestParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// PortfolioPost - Get the value of named wallets in one or more fiat currencies
+func (c *DefaultAPIController) PortfolioPost(w http.ResponseWriter, r *http.Request) {
+	var portfolioPostRequestParam PortfolioPostRequest
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&portfolioPostRequestParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := AssertPortfolioPostRequestRequired(portfolioPostRequestParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	if err := AssertPortfolioPostRequestConstraints(portfolioPostRequestParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.PortfolioPost(r.Context(), portfolioPostRequestParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// ValidateAddressGet - Validate an address or extended public key
+func (c *DefaultAPIController) ValidateAddressGet(w http.ResponseWriter, r *http.Request) {
+	query, err := parseQuery(r.URL.RawQuery)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	var cryptoSymbolParam string
+	if query.Has("crypto_symbol") {
+		param := query.Get("crypto_symbol")
+
+		cryptoSymbolParam = param
+	} else {
+		c.errorHandler(w, r, &RequiredError{Field: "crypto_symbol"}, nil)
+		return
+	}
+	var addressParam string
+	if query.Has("address") {
+		param := query.Get("address")
+
+		addressParam = param
+	} else {
+		c.errorHandler(w, r, &RequiredError{Field: "address"}, nil)
+		return
+	}
+	result, err := c.service.ValidateAddressGet(r.Context(), cryptoSymbolParam, addressParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// ReceiveAddressGet - Get the next unused receive address of an extended public key
+func (c *DefaultAPIController) ReceiveAddressGet(w http.ResponseWriter, r *http.Request) {
+	query, err := parseQuery(r.URL.RawQuery)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	var cryptoSymbolParam string
+	if query.Has("crypto_symbol") {
+		param := query.Get("crypto_symbol")
+
+		cryptoSymbolParam = param
+	} else {
+		c.errorHandler(w, r, &RequiredError{Field: "crypto_symbol"}, nil)
+		return
+	}
+	var xpubParam string
+	if query.Has("xpub") {
+		param := query.Get("xpub")
+
+		xpubParam = param
+	} else {
+		c.errorHandler(w, r, &RequiredError{Field: "xpub"}, nil)
+		return
+	}
+	var countParam int32
+	if query.Has("count") {
+		param, err := parseNumericParameter[int32](
+			query.Get("count"),
+			WithParse[int32](parseInt32),
+			WithMinimum[int32](1),
+			WithMaximum[int32](100),
+		)
+		if err != nil {
+			c.errorHandler(w, r, &ParsingError{Param: "count", Err: err}, nil)
+			return
+		}
+
+		countParam = param
+	} else {
+		var param int32 = 1
+		countParam = param
+	}
+	result, err := c.service.ReceiveAddressGet(r.Context(), cryptoSymbolParam, xpubParam, countParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// UtxosGet - List the unspent outputs of an address, xpub or descriptor
+func (c *DefaultAPIController) UtxosGet(w http.ResponseWriter, r *http.Request) {
+	query, err := parseQuery(r.URL.RawQuery)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	var cryptoSymbolParam string
+	if query.Has("crypto_symbol") {
+		param := query.Get("crypto_symbol")
+
+		cryptoSymbolParam = param
+	} else {
+		c.errorHandler(w, r, &RequiredError{Field: "crypto_symbol"}, nil)
+		return
+	}
+	var addressParam string
+	if query.Has("address") {
+		param := query.Get("address")
+
+		addressParam = param
+	} else {
+		c.errorHandler(w, r, &RequiredError{Field: "address"}, nil)
+		return
+	}
+	var minConfirmationsParam int32
+	if query.Has("min_confirmations") {
+		param, err := parseNumericParameter[int32](
+			query.Get("min_confirmations"),
+			WithParse[int32](parseInt32),
+			WithMinimum[int32](0),
+		)
+		if err != nil {
+			c.errorHandler(w, r, &ParsingError{Param: "min_confirmations", Err: err}, nil)
+			return
+		}
+
+		minConfirmationsParam = param
+	} else {
+		var param int32 = 0
+		minConfirmationsParam = param
+	}
+	var dustThresholdParam int64
+	if query.Has("dust_threshold") {
+		param, err := parseNumericParameter[int64](
+			query.Get("dust_threshold"),
+			WithParse[int64](parseInt64),
+			WithMinimum[int64](0),
+		)
+		if err != nil {
+			c.errorHandler(w, r, &ParsingError{Param: "dust_threshold", Err: err}, nil)
+			return
+		}
+
+		dustThresholdParam = param
+	} else {
+		var param int64 = 0
+		dustThresholdParam = param
+	}
+	result, err := c.service.UtxosGet(r.Context(), cryptoSymbolParam, addressParam, minConfirmationsParam, dustThresholdParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
 // TransactionsGet - Get transaction history for an address
 func (c *DefaultAPIController) TransactionsGet(w http.ResponseWriter, r *http.Request) {
 	query, err := parseQuery(r.URL.RawQuery)
@@ -160,6 +481,15 @@ func (c *DefaultAPIController) TransactionsGet(w http.ResponseWriter, r *http.Re
 		c.errorHandler(w, r, &RequiredError{Field: "address"}, nil)
 		return
 	}
+	var fiatSymbolParam string
+	if query.Has("fiat_symbol") {
+		param := query.Get("fiat_symbol")
+
+		fiatSymbolParam = param
+	} else {
+		param := "USD"
+		fiatSymbolParam = param
+	}
 	var limitParam int32
 	if query.Has("limit") {
 		param, err := parseNumericParameter[int32](
@@ -195,7 +525,128 @@ func (c *DefaultAPIController) TransactionsGet(w http.ResponseWriter, r *http.Re
 		var param int32 = 0
 		offsetParam = param
 	}
-	result, err := c.service.TransactionsGet(r.Context(), cryptoSymbolParam, addressParam, limitParam, offsetParam)
+	var cursorParam string
+	if query.Has("cursor") {
+		param := query.Get("cursor")
+
+		cursorParam = param
+	} else {
+	}
+	var directionParam string
+	if query.Has("direction") {
+		param := query.Get("direction")
+
+		directionParam = param
+	} else {
+	}
+	var sinceParam string
+	if query.Has("since") {
+		param := query.Get("since")
+
+		sinceParam = param
+	} else {
+	}
+	var untilParam string
+	if query.Has("until") {
+		param := query.Get("until")
+
+		untilParam = param
+	} else {
+	}
+	var minAmountParam string
+	if query.Has("min_amount") {
+		param := query.Get("min_amount")
+
+		minAmountParam = param
+	} else {
+	}
+	var statusParam string
+	if query.Has("status") {
+		param := query.Get("status")
+
+		statusParam = param
+	} else {
+	}
+	var sortParam string
+	if query.Has("sort") {
+		param := query.Get("sort")
+
+		sortParam = param
+	} else {
+		param := "desc"
+		sortParam = param
+	}
+	result, err := c.service.TransactionsGet(r.Context(), cryptoSymbolParam, addressParam, fiatSymbolParam, limitParam, offsetParam, cursorParam, directionParam, sinceParam, untilParam, minAmountParam, statusParam, sortParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// TransactionsCryptoSymbolTxidGet - Get the decoded details of one transaction
+func (c *DefaultAPIController) TransactionsCryptoSymbolTxidGet(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	query, err := parseQuery(r.URL.RawQuery)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	cryptoSymbolParam := params["crypto_symbol"]
+	if cryptoSymbolParam == "" {
+		c.errorHandler(w, r, &RequiredError{Field: "crypto_symbol"}, nil)
+		return
+	}
+	txidParam := params["txid"]
+	if txidParam == "" {
+		c.errorHandler(w, r, &RequiredError{Field: "txid"}, nil)
+		return
+	}
+	var walletParam string
+	if query.Has("wallet") {
+		param := query.Get("wallet")
+
+		walletParam = param
+	} else {
+	}
+	result, err := c.service.TransactionsCryptoSymbolTxidGet(r.Context(), cryptoSymbolParam, txidParam, walletParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// FeesGet - Estimate slow, normal and fast fees
+func (c *DefaultAPIController) FeesGet(w http.ResponseWriter, r *http.Request) {
+	query, err := parseQuery(r.URL.RawQuery)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	var cryptoSymbolParam string
+	if query.Has("crypto_symbol") {
+		param := query.Get("crypto_symbol")
+
+		cryptoSymbolParam = param
+	} else {
+		c.errorHandler(w, r, &RequiredError{Field: "crypto_symbol"}, nil)
+		return
+	}
+	var fiatSymbolParam string
+	if query.Has("fiat_symbol") {
+		param := query.Get("fiat_symbol")
+
+		fiatSymbolParam = param
+	} else {
+		param := "USD"
+		fiatSymbolParam = param
+	}
+	result, err := c.service.FeesGet(r.Context(), cryptoSymbolParam, fiatSymbolParam)
 	// If an error occurred, encode the error with the status code
 	if err != nil {
 		c.errorHandler(w, r, err, &result)
@@ -262,7 +713,75 @@ func (c *DefaultAPIController) UnsignedTxGet(w http.ResponseWriter, r *http.Requ
 		feeRateParam = param
 	} else {
 	}
-	result, err := c.service.UnsignedTxGet(r.Context(), cryptoSymbolParam, fromAddressParam, toAddressParam, amountParam, feeRateParam)
+	var tokenContractParam string
+	if query.Has("token_contract") {
+		param := query.Get("token_contract")
+
+		tokenContractParam = param
+	} else {
+	}
+	var nonceAccountParam string
+	if query.Has("nonce_account") {
+		param := query.Get("nonce_account")
+
+		nonceAccountParam = param
+	} else {
+	}
+	result, err := c.service.UnsignedTxGet(r.Context(), cryptoSymbolParam, fromAddressParam, toAddressParam, amountParam, feeRateParam, tokenContractParam, nonceAccountParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// UnsignedTxPost - Generate an unsigned transaction with several outputs
+func (c *DefaultAPIController) UnsignedTxPost(w http.ResponseWriter, r *http.Request) {
+	var unsignedTxPostRequestParam UnsignedTxPostRequest
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&unsignedTxPostRequestParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := AssertUnsignedTxPostRequestRequired(unsignedTxPostRequestParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	if err := AssertUnsignedTxPostRequestConstraints(unsignedTxPostRequestParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.UnsignedTxPost(r.Context(), unsignedTxPostRequestParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// UnsignedTxBumpPost - Generate a transaction speeding up an unconfirmed one
+func (c *DefaultAPIController) UnsignedTxBumpPost(w http.ResponseWriter, r *http.Request) {
+	var unsignedTxBumpPostRequestParam UnsignedTxBumpPostRequest
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&unsignedTxBumpPostRequestParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := AssertUnsignedTxBumpPostRequestRequired(unsignedTxBumpPostRequestParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	if err := AssertUnsignedTxBumpPostRequestConstraints(unsignedTxBumpPostRequestParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.UnsignedTxBumpPost(r.Context(), unsignedTxBumpPostRequestParam)
 	// If an error occurred, encode the error with the status code
 	if err != nil {
 		c.errorHandler(w, r, err, &result)
@@ -35,31 +35,283 @@ func (s *DefaultAPIService) BalancesPost(ctx context.Context, balancesPostReques
 	// TODO: Uncomment the next line to return response Response(200, BalancesPost200Response{}) or use other options such as http.Ok ...
 	// return Response(200, BalancesPost200Response{}), nil
 
+	// TODO: Uncomment the next line to return response Response(400, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(400, ErrorResponse{}), nil
+
+	// TODO: Uncomment the next line to return response Response(503, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(503, ErrorResponse{}), nil
+
 	return Response(http.StatusNotImplemented, nil), errors.New("BalancesPost method not implemented")
 }
 
+// BalancesHistoryPost - Get daily portfolio fiat value over a date range
+func (s *DefaultAPIService) BalancesHistoryPost(ctx context.Context, balancesHistoryPostRequest BalancesHistoryPostRequest) (ImplResponse, error) {
+	// TODO - update BalancesHistoryPost with the required logic for this service method.
+	// Add api_default_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(200, BalancesHistoryPost200Response{}) or use other options such as http.Ok ...
+	// return Response(200, BalancesHistoryPost200Response{}), nil
+
+	// TODO: Uncomment the next line to return response Response(400, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(400, ErrorResponse{}), nil
+
+	// TODO: Uncomment the next line to return response Response(503, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(503, ErrorResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("BalancesHistoryPost method not implemented")
+}
+
+// PortfolioPost - Get the value of named wallets in one or more fiat currencies
+func (s *DefaultAPIService) PortfolioPost(ctx context.Context, portfolioPostRequest PortfolioPostRequest) (ImplResponse, error) {
+	// TODO - update PortfolioPost with the required logic for this service method.
+	// Add api_default_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(200, PortfolioPost200Response{}) or use other options such as http.Ok ...
+	// return Response(200, PortfolioPost200Response{}), nil
+
+	// TODO: Uncomment the next line to return response Response(400, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(400, ErrorResponse{}), nil
+
+	// TODO: Uncomment the next line to return response Response(503, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(503, ErrorResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("PortfolioPost method not implemented")
+}
+
+// ValidateAddressGet - Validate an address or extended public key
+func (s *DefaultAPIService) ValidateAddressGet(ctx context.Context, cryptoSymbol string, address string) (ImplResponse, error) {
+	// TODO - update ValidateAddressGet with the required logic for this service method.
+	// Add api_default_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(200, ValidateAddressGet200Response{}) or use other options such as http.Ok ...
+	// return Response(200, ValidateAddressGet200Response{}), nil
+
+	// TODO: Uncomment the next line to return response Response(400, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(400, ErrorResponse{}), nil
+
+	// TODO: Uncomment the next line to return response Response(404, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(404, ErrorResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("ValidateAddressGet method not implemented")
+}
+
+// ReceiveAddressGet - Get the next unused receive address of an extended public key
+func (s *DefaultAPIService) ReceiveAddressGet(ctx context.Context, cryptoSymbol string, xpub string, count int32) (ImplResponse, error) {
+	// TODO - update ReceiveAddressGet with the required logic for this service method.
+	// Add api_default_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(200, ReceiveAddressGet200Response{}) or use other options such as http.Ok ...
+	// return Response(200, ReceiveAddressGet200Response{}), nil
+
+	// TODO: Uncomment the next line to return response Response(400, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(400, ErrorResponse{}), nil
+
+	// TODO: Uncomment the next line to return response Response(404, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(404, ErrorResponse{}), nil
+
+	// TODO: Uncomment the next line to return response Response(502, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(502, ErrorResponse{}), nil
+
+	// TODO: Uncomment the next line to return response Response(503, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(503, ErrorResponse{}), nil
+
+	// TODO: Uncomment the next line to return response Response(504, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(504, ErrorResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("ReceiveAddressGet method not implemented")
+}
+
+// UtxosGet - List the unspent outputs of an address, xpub or descriptor
+func (s *DefaultAPIService) UtxosGet(ctx context.Context, cryptoSymbol string, address string, minConfirmations int32, dustThreshold int64) (ImplResponse, error) {
+	// TODO - update UtxosGet with the required logic for this service method.
+	// Add api_default_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(200, UtxosGet200Response{}) or use other options such as http.Ok ...
+	// return Response(200, UtxosGet200Response{}), nil
+
+	// TODO: Uncomment the next line to return response Response(400, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(400, ErrorResponse{}), nil
+
+	// TODO: Uncomment the next line to return response Response(404, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(404, ErrorResponse{}), nil
+
+	// TODO: Uncomment the next line to return response Response(502, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(502, ErrorResponse{}), nil
+
+	// TODO: Uncomment the next line to return response Response(503, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(503, ErrorResponse{}), nil
+
+	// TODO: Uncomment the next line to return response Response(504, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(504, ErrorResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("UtxosGet method not implemented")
+}
+
 // TransactionsGet - Get transaction history for an address
-func (s *DefaultAPIService) TransactionsGet(ctx context.Context, cryptoSymbol string, address string, limit int32, offset int32) (ImplResponse, error) {
+func (s *DefaultAPIService) TransactionsGet(ctx context.Context, cryptoSymbol string, address string, fiatSymbol string, limit int32, offset int32, cursor string, direction string, since string, until string, minAmount string, status string, sort string) (ImplResponse, error) {
 	// TODO - update TransactionsGet with the required logic for this service method.
 	// Add api_default_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
 
 	// TODO: Uncomment the next line to return response Response(200, TransactionsGet200Response{}) or use other options such as http.Ok ...
 	// return Response(200, TransactionsGet200Response{}), nil
 
+	// TODO: Uncomment the next line to return response Response(400, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(400, ErrorResponse{}), nil
+
+	// TODO: Uncomment the next line to return response Response(404, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(404, ErrorResponse{}), nil
+
+	// TODO: Uncomment the next line to return response Response(502, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(502, ErrorResponse{}), nil
+
+	// TODO: Uncomment the next line to return response Response(503, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(503, ErrorResponse{}), nil
+
+	// TODO: Uncomment the next line to return response Response(504, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(504, ErrorResponse{}), nil
+
 	return Response(http.StatusNotImplemented, nil), errors.New("TransactionsGet method not implemented")
 }
 
+// TransactionsCryptoSymbolTxidGet - Get the decoded details of one transaction
+func (s *DefaultAPIService) TransactionsCryptoSymbolTxidGet(ctx context.Context, cryptoSymbol string, txid string, wallet string) (ImplResponse, error) {
+	// TODO - update TransactionsCryptoSymbolTxidGet with the required logic for this service method.
+	// Add api_default_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(200, TransactionsCryptoSymbolTxidGet200Response{}) or use other options such as http.Ok ...
+	// return Response(200, TransactionsCryptoSymbolTxidGet200Response{}), nil
+
+	// TODO: Uncomment the next line to return response Response(400, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(400, ErrorResponse{}), nil
+
+	// TODO: Uncomment the next line to return response Response(404, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(404, ErrorResponse{}), nil
+
+	// TODO: Uncomment the next line to return response Response(502, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(502, ErrorResponse{}), nil
+
+	// TODO: Uncomment the next line to return response Response(503, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(503, ErrorResponse{}), nil
+
+	// TODO: Uncomment the next line to return response Response(504, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(504, ErrorResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("TransactionsCryptoSymbolTxidGet method not implemented")
+}
+
+// FeesGet - Estimate slow, normal and fast fees
+func (s *DefaultAPIService) FeesGet(ctx context.Context, cryptoSymbol string, fiatSymbol string) (ImplResponse, error) {
+	// TODO - update FeesGet with the required logic for this service method.
+	// Add api_default_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(200, FeesGet200Response{}) or use other options such as http.Ok ...
+	// return Response(200, FeesGet200Response{}), nil
+
+	// TODO: Uncomment the next line to return response Response(400, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(400, ErrorResponse{}), nil
+
+	// TODO: Uncomment the next line to return response Response(404, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(404, ErrorResponse{}), nil
+
+	// TODO: Uncomment the next line to return response Response(502, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(502, ErrorResponse{}), nil
+
+	// TODO: Uncomment the next line to return response Response(503, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(503, ErrorResponse{}), nil
+
+	// TODO: Uncomment the next line to return response Response(504, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(504, ErrorResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("FeesGet method not implemented")
+}
+
 // UnsignedTxGet - Generate an unsigned transaction
-func (s *DefaultAPIService) UnsignedTxGet(ctx context.Context, cryptoSymbol string, fromAddress string, toAddress string, amount string, feeRate float64) (ImplResponse, error) {
+func (s *DefaultAPIService) UnsignedTxGet(ctx context.Context, cryptoSymbol string, fromAddress string, toAddress string, amount string, feeRate float64, tokenContract string, nonceAccount string) (ImplResponse, error) {
 	// TODO - update UnsignedTxGet with the required logic for this service method.
 	// Add api_default_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
 
 	// TODO: Uncomment the next line to return response Response(200, UnsignedTxGet200Response{}) or use other options such as http.Ok ...
 	// return Response(200, UnsignedTxGet200Response{}), nil
 
+	// TODO: Uncomment the next line to return response Response(400, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(400, ErrorResponse{}), nil
+
+	// TODO: Uncomment the next line to return response Response(404, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(404, ErrorResponse{}), nil
+
+	// TODO: Uncomment the next line to return response Response(422, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(422, ErrorResponse{}), nil
+
+	// TODO: Uncomment the next line to return response Response(502, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(502, ErrorResponse{}), nil
+
+	// TODO: Uncomment the next line to return response Response(503, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(503, ErrorResponse{}), nil
+
+	// TODO: Uncomment the next line to return response Response(504, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(504, ErrorResponse{}), nil
+
 	return Response(http.StatusNotImplemented, nil), errors.New("UnsignedTxGet method not implemented")
 }
 
+// UnsignedTxPost - Generate an unsigned transaction with several outputs
+func (s *DefaultAPIService) UnsignedTxPost(ctx context.Context, unsignedTxPostRequest UnsignedTxPostRequest) (ImplResponse, error) {
+	// TODO - update UnsignedTxPost with the required logic for this service method.
+	// Add api_default_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(200, UnsignedTxPost200Response{}) or use other options such as http.Ok ...
+	// return Response(200, UnsignedTxPost200Response{}), nil
+
+	// TODO: Uncomment the next line to return response Response(400, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(400, ErrorResponse{}), nil
+
+	// TODO: Uncomment the next line to return response Response(404, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(404, ErrorResponse{}), nil
+
+	// TODO: Uncomment the next line to return response Response(422, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(422, ErrorResponse{}), nil
+
+	// TODO: Uncomment the next line to return response Response(502, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(502, ErrorResponse{}), nil
+
+	// TODO: Uncomment the next line to return response Response(503, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(503, ErrorResponse{}), nil
+
+	// TODO: Uncomment the next line to return response Response(504, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(504, ErrorResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("UnsignedTxPost method not implemented")
+}
+
+// UnsignedTxBumpPost - Generate a transaction speeding up an unconfirmed one
+func (s *DefaultAPIService) UnsignedTxBumpPost(ctx context.Context, unsignedTxBumpPostRequest UnsignedTxBumpPostRequest) (ImplResponse, error) {
+	// TODO - update UnsignedTxBumpPost with the required logic for this service method.
+	// Add api_default_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(200, UnsignedTxBumpPost200Response{}) or use other options such as http.Ok ...
+	// return Response(200, UnsignedTxBumpPost200Response{}), nil
+
+	// TODO: Uncomment the next line to return response Response(400, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(400, ErrorResponse{}), nil
+
+	// TODO: Uncomment the next line to return response Response(404, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(404, ErrorResponse{}), nil
+
+	// TODO: Uncomment the next line to return response Response(422, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(422, ErrorResponse{}), nil
+
+	// TODO: Uncomment the next line to return response Response(502, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(502, ErrorResponse{}), nil
+
+	// TODO: Uncomment the next line to return response Response(503, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(503, ErrorResponse{}), nil
+
+	// TODO: Uncomment the next line to return response Response(504, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(504, ErrorResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("UnsignedTxBumpPost method not implemented")
+}
+
 // BroadcastPost - Broadcast signed transaction
 func (s *DefaultAPIService) BroadcastPost(ctx context.Context, broadcastPostRequest BroadcastPostRequest) (ImplResponse, error) {
 	// TODO - update BroadcastPost with the required logic for this service method.
@@ -68,5 +320,23 @@ func (s *DefaultAPIService) BroadcastPost(ctx context.Context, broadcastPostRequ
 	// TODO: Uncomment the next line to return response Response(200, BroadcastPost200Response{}) or use other options such as http.Ok ...
 	// return Response(200, BroadcastPost200Response{}), nil
 
+	// TODO: Uncomment the next line to return response Response(400, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(400, ErrorResponse{}), nil
+
+	// TODO: Uncomment the next line to return response Response(404, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(404, ErrorResponse{}), nil
+
+	// TODO: Uncomment the next line to return response Response(422, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(422, ErrorResponse{}), nil
+
+	// TODO: Uncomment the next line to return response Response(502, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(502, ErrorResponse{}), nil
+
+	// TODO: Uncomment the next line to return response Response(503, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(503, ErrorResponse{}), nil
+
+	// TODO: Uncomment the next line to return response Response(504, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(504, ErrorResponse{}), nil
+
 	return Response(http.StatusNotImplemented, nil), errors.New("BroadcastPost method not implemented")
 }
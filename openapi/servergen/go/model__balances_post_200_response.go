@@ -21,6 +21,9 @@ type BalancesPost200Response struct {
 
 	Results []BalancesPost200ResponseResultsInner `json:"results,omitempty"`
 
+	// Portfolio value and price changes per fiat symbol across all results
+	Totals []BalancesPost200ResponseTotalsInner `json:"totals,omitempty"`
+
 	Timestamp time.Time `json:"timestamp,omitempty"`
 }
 
@@ -31,6 +34,11 @@ func AssertBalancesPost200ResponseRequired(obj BalancesPost200Response) error {
 			return err
 		}
 	}
+	for _, el := range obj.Totals {
+		if err := AssertBalancesPost200ResponseTotalsInnerRequired(el); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -41,5 +49,10 @@ func AssertBalancesPost200ResponseConstraints(obj BalancesPost200Response) error
 			return err
 		}
 	}
+	for _, el := range obj.Totals {
+		if err := AssertBalancesPost200ResponseTotalsInnerConstraints(el); err != nil {
+			return err
+		}
+	}
 	return nil
 }
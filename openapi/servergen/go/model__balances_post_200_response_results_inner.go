@@ -23,21 +23,57 @@ type BalancesPost200ResponseResultsInner struct {
 
 	Address string `json:"address"`
 
+	// Balance once every pending movement settles, confirmed_balance plus pending_incoming minus pending_outgoing
 	CryptoBalance float64 `json:"crypto_balance"`
 
+	// Funds meeting the min_confirmations or commitment of the request
+	ConfirmedBalance float64 `json:"confirmed_balance"`
+
+	// Funds on their way into the wallet that are not confirmed yet
+	PendingIncoming float64 `json:"pending_incoming"`
+
+	// Funds on their way out of the wallet that are not confirmed yet
+	PendingOutgoing float64 `json:"pending_outgoing"`
+
+	// LTC the wallet moved into the MWEB extension block, net of the peg-outs it received back. MWEB coins cannot be spent on the canonical chain and are not part of crypto_balance. Only reported for LTC.
+	MwebBalance float64 `json:"mweb_balance,omitempty"`
+
 	FiatSymbol string `json:"fiat_symbol"`
 
-	FiatValue float64 `json:"fiat_value"`
+	// Fiat value of the balance, null when no exchange rate is available
+	FiatValue *float64 `json:"fiat_value"`
 
-	ExchangeRate float64 `json:"exchange_rate"`
+	// Exchange rate used for the conversion, null when no exchange rate is available
+	ExchangeRate *float64 `json:"exchange_rate"`
 
-	// Absolute change in fiat value over the last 24 hours
-	Change24h float64 `json:"change24h"`
+	// Absolute change in fiat value of the current balance over the last 24 hours, same as the fiat_change of the 24h entry in price_changes. Null when no exchange rate or no rate from 24 hours ago is available.
+	Change24h *float64 `json:"change24h"`
+
+	// Rate changes over the 1h, 24h, 7d and 30d windows; windows without a reference rate are left out
+	PriceChanges []PriceChange `json:"price_changes,omitempty"`
 
 	Timestamp time.Time `json:"timestamp"`
 
 	// Error message if this specific balance fetch failed
 	Error string `json:"error,omitempty"`
+
+	// Stable error code if this specific balance fetch failed, same values as ErrorResponse.error
+	ErrorCode string `json:"error_code,omitempty"`
+
+	// Set when the exchange rate could not be fetched. The crypto balance is still returned; fiat fields are null unless a last known rate was used (see rate_stale).
+	RateError string `json:"rate_error,omitempty"`
+
+	// Stable error code for rate_error, same values as ErrorResponse.error
+	RateErrorCode string `json:"rate_error_code,omitempty"`
+
+	// True when fiat fields were computed from the last known rate instead of a fresh one
+	RateStale bool `json:"rate_stale,omitempty"`
+
+	// Age of the exchange rate in seconds when rate_stale is true
+	RateAgeSeconds int64 `json:"rate_age_seconds,omitempty"`
+
+	// The balance converted to every fiat symbol of the request item, keyed by fiat symbol. Only present when the item has fiat_symbols.
+	Conversions map[string]FiatConversion `json:"conversions,omitempty"`
 }
 
 // AssertBalancesPost200ResponseResultsInnerRequired checks if the required fields are not zero-ed
@@ -46,6 +82,9 @@ func AssertBalancesPost200ResponseResultsInnerRequired(obj BalancesPost200Respon
 		"crypto_symbol": obj.CryptoSymbol,
 		"address": obj.Address,
 		"crypto_balance": obj.CryptoBalance,
+		"confirmed_balance": obj.ConfirmedBalance,
+		"pending_incoming": obj.PendingIncoming,
+		"pending_outgoing": obj.PendingOutgoing,
 		"fiat_symbol": obj.FiatSymbol,
 		"fiat_value": obj.FiatValue,
 		"exchange_rate": obj.ExchangeRate,
@@ -58,10 +97,20 @@ func AssertBalancesPost200ResponseResultsInnerRequired(obj BalancesPost200Respon
 		}
 	}
 
+	for _, el := range obj.PriceChanges {
+		if err := AssertPriceChangeRequired(el); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
 // AssertBalancesPost200ResponseResultsInnerConstraints checks if the values respects the defined constraints
 func AssertBalancesPost200ResponseResultsInnerConstraints(obj BalancesPost200ResponseResultsInner) error {
+	for _, el := range obj.PriceChanges {
+		if err := AssertPriceChangeConstraints(el); err != nil {
+			return err
+		}
+	}
 	return nil
 }
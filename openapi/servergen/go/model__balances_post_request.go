@@ -11,14 +11,24 @@
 package cryptowalletrest
 
 
+import (
+	"errors"
+)
+
 
 
 type BalancesPostRequest struct {
 
 	Requests []BalancesPostRequestRequestsInner `json:"requests"`
 
-	// Default fiat currency symbol for all requests if not specified individually
+	// Default ISO 4217 fiat currency symbol for all requests if not specified individually
 	FiatSymbol string `json:"fiat_symbol,omitempty"`
+
+	// Confirmations BTC, LTC and ETH funds need to count as confirmed; younger funds are reported as pending, except BTC and LTC change the wallet paid to itself. Defaults to 1. Kaspa balances are always reported as confirmed.
+	MinConfirmations int64 `json:"min_confirmations,omitempty"`
+
+	// Solana commitment level a balance needs to count as confirmed; the difference to the processed balance is reported as pending. Defaults to finalized.
+	Commitment string `json:"commitment,omitempty"`
 }
 
 // AssertBalancesPostRequestRequired checks if the required fields are not zero-ed
@@ -42,6 +52,9 @@ func AssertBalancesPostRequestRequired(obj BalancesPostRequest) error {
 
 // AssertBalancesPostRequestConstraints checks if the values respects the defined constraints
 func AssertBalancesPostRequestConstraints(obj BalancesPostRequest) error {
+	if obj.MinConfirmations < 0 {
+		return &ParsingError{Param: "MinConfirmations", Err: errors.New(errMsgMinValueConstraint)}
+	}
 	for _, el := range obj.Requests {
 		if err := AssertBalancesPostRequestRequestsInnerConstraints(el); err != nil {
 			return err
@@ -21,8 +21,11 @@ type BalancesPostRequestRequestsInner struct {
 	// The cryptocurrency address or xpub
 	Address string `json:"address"`
 
-	// The fiat currency symbol for conversion (USD, EUR, CAD, etc.)
+	// The ISO 4217 fiat currency symbol for conversion (USD, EUR, CAD, etc.). Defaults to the request level fiat_symbol, or to the first entry of fiat_symbols when those are given.
 	FiatSymbol string `json:"fiat_symbol,omitempty"`
+
+	// Additional ISO 4217 fiat currency symbols to convert the balance to. Each one is returned in conversions; the balance is only fetched once.
+	FiatSymbols []string `json:"fiat_symbols,omitempty"`
 }
 
 // AssertBalancesPostRequestRequestsInnerRequired checks if the required fields are not zero-ed
@@ -21,9 +21,13 @@ type TransactionsGet200Response struct {
 
 	Transactions []Transaction `json:"transactions"`
 
-	TotalCount int32 `json:"total_count"`
+	// Number of entries in the whole history, absent where the chain pages history by cursor and the total is unknown
+	TotalCount *int32 `json:"total_count,omitempty"`
 
 	HasMore bool `json:"has_more"`
+
+	// Cursor of the next page, absent on the last page
+	NextCursor string `json:"next_cursor,omitempty"`
 }
 
 // AssertTransactionsGet200ResponseRequired checks if the required fields are not zero-ed
@@ -32,7 +36,6 @@ func AssertTransactionsGet200ResponseRequired(obj TransactionsGet200Response) er
 		"crypto_symbol": obj.CryptoSymbol,
 		"address": obj.Address,
 		"transactions": obj.Transactions,
-		"total_count": obj.TotalCount,
 		"has_more": obj.HasMore,
 	}
 	for name, el := range elements {
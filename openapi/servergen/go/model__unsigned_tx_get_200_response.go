@@ -21,13 +21,28 @@ type UnsignedTxGet200Response struct {
 
 	ToAddress string `json:"to_address"`
 
+	// Amount sent in whole coins or tokens, the sweep amount with amount=max
 	Amount string `json:"amount"`
 
+	// Highest fee the transaction can pay in whole coins, exact to the base unit of the chain
 	FeeAmount string `json:"fee_amount"`
 
+	// Unsigned transaction, a PSBT on BTC and LTC or on SOL the message to sign, in unsigned_tx_encoding
 	UnsignedTx string `json:"unsigned_tx"`
 
+	// Encoding of unsigned_tx, base64 on SOL, BTC and LTC and hex elsewhere
+	UnsignedTxEncoding string `json:"unsigned_tx_encoding"`
+
 	TxSizeBytes int32 `json:"tx_size_bytes,omitempty"`
+
+	// Hex encoded digest the signer signs, absent where the signer signs unsigned_tx itself or signs per input
+	SigningHash string `json:"signing_hash,omitempty"`
+
+	// Outputs spent by the transaction on UTXO chains, in input order
+	Inputs []UnsignedTxInput `json:"inputs,omitempty"`
+
+	// Human-readable breakdown of the transaction for the signing device to display
+	Details []UnsignedTxDetail `json:"details,omitempty"`
 }
 
 // AssertUnsignedTxGet200ResponseRequired checks if the required fields are not zero-ed
@@ -39,6 +54,7 @@ func AssertUnsignedTxGet200ResponseRequired(obj UnsignedTxGet200Response) error
 		"amount": obj.Amount,
 		"fee_amount": obj.FeeAmount,
 		"unsigned_tx": obj.UnsignedTx,
+		"unsigned_tx_encoding": obj.UnsignedTxEncoding,
 	}
 	for name, el := range elements {
 		if isZero := IsZeroValue(el); isZero {
@@ -46,10 +62,30 @@ func AssertUnsignedTxGet200ResponseRequired(obj UnsignedTxGet200Response) error
 		}
 	}
 
+	for _, el := range obj.Inputs {
+		if err := AssertUnsignedTxInputRequired(el); err != nil {
+			return err
+		}
+	}
+	for _, el := range obj.Details {
+		if err := AssertUnsignedTxDetailRequired(el); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
 // AssertUnsignedTxGet200ResponseConstraints checks if the values respects the defined constraints
 func AssertUnsignedTxGet200ResponseConstraints(obj UnsignedTxGet200Response) error {
+	for _, el := range obj.Inputs {
+		if err := AssertUnsignedTxInputConstraints(el); err != nil {
+			return err
+		}
+	}
+	for _, el := range obj.Details {
+		if err := AssertUnsignedTxDetailConstraints(el); err != nil {
+			return err
+		}
+	}
 	return nil
 }
@@ -19,6 +19,7 @@ import (
 
 type ErrorResponse struct {
 
+	// Stable error code. One of BAD_REQUEST, INVALID_ADDRESS, UNSUPPORTED_SYMBOL, UNSUPPORTED_FIAT, INSUFFICIENT_FUNDS, TRANSACTION_NOT_FOUND, RATE_UNAVAILABLE, PROVIDER_UNAVAILABLE, UPSTREAM_TIMEOUT, INTERNAL_ERROR.
 	Error string `json:"error"`
 
 	Message string `json:"message"`
@@ -36,6 +36,20 @@ type Transaction struct {
 	FromAddresses []string `json:"from_addresses,omitempty"`
 
 	ToAddresses []string `json:"to_addresses,omitempty"`
+
+	FiatSymbol string `json:"fiat_symbol,omitempty"`
+
+	// Fiat value of the amount at the block time, null when no historical rate is available
+	FiatValue *float64 `json:"fiat_value,omitempty"`
+
+	// Daily exchange rate on the day of the block time, null when no historical rate is available
+	ExchangeRate *float64 `json:"exchange_rate,omitempty"`
+
+	// Contract of the ERC-20 token moved, absent for native coin entries. The amount is then in whole tokens and has no fiat value
+	TokenContract string `json:"token_contract,omitempty"`
+
+	// Symbol of the token moved, absent for native coin entries
+	TokenSymbol string `json:"token_symbol,omitempty"`
 }
 
 // AssertTransactionRequired checks if the required fields are not zero-ed